@@ -8,10 +8,12 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/m-szczepanski/gocalc-api/internal/config"
 	"github.com/m-szczepanski/gocalc-api/internal/handlers"
 	"github.com/m-szczepanski/gocalc-api/internal/middleware"
+	"github.com/m-szczepanski/gocalc-api/pkg/calculations"
 )
 
 func main() {
@@ -20,6 +22,17 @@ func main() {
 	if err != nil {
 		log.Fatalf("Failed to load configuration: %v", err)
 	}
+
+	// Custom units are checked against the built-in units before serving
+	if cfg.Units.File != "" {
+		count, err := calculations.LoadCustomUnits(cfg.Units.File)
+		if err != nil {
+			log.Fatalf("Failed to load custom units: %v", err)
+		}
+		log.Printf("Loaded %d custom units from %s", count, cfg.Units.File)
+		go watchUnitsFile(cfg.Units.File, cfg.Units.ReloadInterval)
+	}
+
 	mux := http.NewServeMux()
 	mux.HandleFunc("/health", handlers.HealthHandler)
 	mux.HandleFunc("/ready", handlers.ReadinessHandler)
@@ -28,6 +41,37 @@ func main() {
 	mux.HandleFunc("/api/math/subtract", handlers.SubtractHandler)
 	mux.HandleFunc("/api/math/multiply", handlers.MultiplyHandler)
 	mux.HandleFunc("/api/math/divide", handlers.DivideHandler)
+	mux.HandleFunc("/api/math/function", handlers.FunctionHandler)
+	mux.HandleFunc("/api/math/complex", handlers.ComplexHandler)
+	mux.HandleFunc("/api/math/base-convert", handlers.BaseConvertHandler)
+	mux.HandleFunc("/api/math/bitwise", handlers.BitwiseHandler)
+	mux.HandleFunc("/api/math/integrate", handlers.IntegralHandler)
+	mux.HandleFunc("/api/math/derivative", handlers.DerivativeHandler)
+	mux.HandleFunc("/api/math/root", handlers.RootHandler)
+	mux.HandleFunc("/api/math/polynomial-roots", handlers.PolynomialRootsHandler)
+	mux.HandleFunc("/api/math/equations", handlers.EquationsHandler)
+	mux.HandleFunc("/api/math/vector/{operation}", handlers.VectorHandler)
+	mux.HandleFunc("/api/math/geometry/{shape}", handlers.NewGeometryHandler(cfg.Limits.MaxDatasetSize))
+	mux.HandleFunc("/api/math/distribution/{name}", handlers.DistributionHandler)
+	mux.HandleFunc("/api/math/hypothesis-test/{test}", handlers.NewHypothesisTestHandler(cfg.Limits.MaxDatasetSize))
+	mux.HandleFunc("/api/math/confidence-interval/{parameter}", handlers.NewConfidenceIntervalHandler(cfg.Limits.MaxDatasetSize))
+	mux.HandleFunc("/api/math/random", handlers.NewRandomHandler(cfg.Limits.MaxDatasetSize))
+	mux.HandleFunc("/api/math/sum", handlers.NewSumHandler(cfg.Limits.MaxDatasetSize))
+	mux.HandleFunc("/api/math/product", handlers.NewProductHandler(cfg.Limits.MaxDatasetSize))
+	mux.HandleFunc("/api/math/min", handlers.NewMinHandler(cfg.Limits.MaxDatasetSize))
+	mux.HandleFunc("/api/math/max", handlers.NewMaxHandler(cfg.Limits.MaxDatasetSize))
+	mux.HandleFunc("/api/math/mean", handlers.NewMeanHandler(cfg.Limits.MaxDatasetSize))
+	mux.HandleFunc("/api/math/statistics", handlers.NewStatisticsHandler(cfg.Limits.MaxDatasetSize))
+	mux.HandleFunc("/api/math/regression", handlers.NewRegressionHandler(cfg.Limits.MaxDatasetSize))
+	mux.HandleFunc("/api/math/matrix/{operation}", handlers.NewMatrixHandler(cfg.Limits.MaxDatasetSize))
+	mux.HandleFunc("/api/math/gcd", handlers.NewGCDHandler(cfg.Limits.MaxDatasetSize))
+	mux.HandleFunc("/api/math/lcm", handlers.NewLCMHandler(cfg.Limits.MaxDatasetSize))
+	mux.HandleFunc("/api/math/is-prime", handlers.PrimalityHandler)
+	mux.HandleFunc("/api/math/factorize", handlers.FactorizeHandler)
+	mux.HandleFunc("/api/math/primes", handlers.PrimesHandler)
+	mux.HandleFunc("/api/math/modpow", handlers.ModPowHandler)
+	mux.HandleFunc("/api/math/modinverse", handlers.ModInverseHandler)
+	mux.HandleFunc("/api/math/totient", handlers.TotientHandler)
 
 	mux.HandleFunc("/api/finance/vat", handlers.VATHandler)
 	mux.HandleFunc("/api/finance/compound-interest", handlers.CompoundInterestHandler)
@@ -35,6 +79,9 @@ func main() {
 
 	mux.HandleFunc("/api/utils/bmi", handlers.BMIHandler)
 	mux.HandleFunc("/api/utils/unit-conversion", handlers.UnitConversionHandler)
+	mux.HandleFunc("/api/utils/units", handlers.UnitListHandler)
+	mux.HandleFunc("/api/utils/units/{type}", handlers.UnitTypeHandler)
+	mux.HandleFunc("/api/utils/geo-distance", handlers.NewGeoDistanceHandler(cfg.Limits.MaxDatasetSize))
 
 	// Configure rate limiter with requests per second (RPM / 60)
 	rps := cfg.RateLimit.RequestsPerMinute / 60.0
@@ -80,3 +127,47 @@ func main() {
 
 	log.Println("Server stopped")
 }
+
+// watchUnitsFile reloads the custom units file on SIGHUP and, when interval is
+// positive, whenever the file's modification time or size changes. A file
+// that fails to load leaves the previous units in use.
+func watchUnitsFile(path string, interval time.Duration) {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+
+	var tick <-chan time.Time
+	if interval > 0 {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	version := fileVersion(path)
+	for {
+		select {
+		case <-hup:
+		case <-tick:
+			if fileVersion(path) == version {
+				continue
+			}
+		}
+		version = fileVersion(path)
+
+		count, err := calculations.LoadCustomUnits(path)
+		if err != nil {
+			log.Printf("Failed to reload custom units, keeping the previous ones: %v", err)
+			continue
+		}
+		log.Printf("Reloaded %d custom units from %s", count, path)
+	}
+}
+
+// fileVersion identifies the current contents of a file well enough to
+// notice edits; a missing file has the zero version.
+func fileVersion(path string) [2]int64 {
+	info, err := os.Stat(path)
+	if err != nil {
+		return [2]int64{}
+	}
+	return [2]int64{info.ModTime().UnixNano(), info.Size()}
+}
@@ -11,6 +11,8 @@ import (
 type Config struct {
 	Server    ServerConfig
 	RateLimit RateLimitConfig
+	Limits    LimitsConfig
+	Units     UnitsConfig
 }
 
 // ServerConfig holds HTTP server configuration.
@@ -29,6 +31,17 @@ type RateLimitConfig struct {
 	Burst             int
 }
 
+// LimitsConfig holds limits on request payload sizes.
+type LimitsConfig struct {
+	MaxDatasetSize int // Maximum number of values accepted in a single dataset
+}
+
+// UnitsConfig holds the custom unit definitions added to the built-in units.
+type UnitsConfig struct {
+	File           string        // JSON or YAML file of unit definitions; empty for none
+	ReloadInterval time.Duration // How often to check File for changes; 0 reloads only on SIGHUP
+}
+
 // Load reads configuration from environment variables with sensible defaults.
 func Load() (*Config, error) {
 	cfg := &Config{
@@ -44,6 +57,13 @@ func Load() (*Config, error) {
 			RequestsPerMinute: getFloatEnv("RATE_LIMIT_RPM", 100.0),
 			Burst:             getIntEnv("RATE_LIMIT_BURST", 20),
 		},
+		Limits: LimitsConfig{
+			MaxDatasetSize: getIntEnv("MAX_DATASET_SIZE", 10000),
+		},
+		Units: UnitsConfig{
+			File:           getEnv("UNITS_FILE", ""),
+			ReloadInterval: getDurationEnv("UNITS_RELOAD_INTERVAL", 0),
+		},
 	}
 
 	if err := cfg.validate(); err != nil {
@@ -92,6 +112,14 @@ func (c *Config) validate() error {
 		return fmt.Errorf("invalid RATE_LIMIT_BURST: must be positive")
 	}
 
+	if c.Limits.MaxDatasetSize <= 0 {
+		return fmt.Errorf("invalid MAX_DATASET_SIZE: must be positive")
+	}
+
+	if c.Units.ReloadInterval < 0 {
+		return fmt.Errorf("invalid UNITS_RELOAD_INTERVAL: cannot be negative")
+	}
+
 	return nil
 }
 
@@ -28,6 +28,9 @@ func TestLoad(t *testing.T) {
 					RequestsPerMinute: 100.0,
 					Burst:             20,
 				},
+				Limits: LimitsConfig{
+					MaxDatasetSize: 10000,
+				},
 			},
 			wantErr: false,
 		},
@@ -49,6 +52,9 @@ func TestLoad(t *testing.T) {
 					RequestsPerMinute: 100.0,
 					Burst:             20,
 				},
+				Limits: LimitsConfig{
+					MaxDatasetSize: 10000,
+				},
 			},
 			wantErr: false,
 		},
@@ -74,6 +80,9 @@ func TestLoad(t *testing.T) {
 					RequestsPerMinute: 100.0,
 					Burst:             20,
 				},
+				Limits: LimitsConfig{
+					MaxDatasetSize: 10000,
+				},
 			},
 			wantErr: false,
 		},
@@ -96,6 +105,9 @@ func TestLoad(t *testing.T) {
 					RequestsPerMinute: 200.0,
 					Burst:             50,
 				},
+				Limits: LimitsConfig{
+					MaxDatasetSize: 10000,
+				},
 			},
 			wantErr: false,
 		},
@@ -117,6 +129,9 @@ func TestLoad(t *testing.T) {
 					RequestsPerMinute: 100.0,
 					Burst:             20,
 				},
+				Limits: LimitsConfig{
+					MaxDatasetSize: 10000,
+				},
 			},
 			wantErr: false,
 		},
@@ -139,9 +154,72 @@ func TestLoad(t *testing.T) {
 					RequestsPerMinute: 100.0,
 					Burst:             20,
 				},
+				Limits: LimitsConfig{
+					MaxDatasetSize: 10000,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "custom dataset limit",
+			envVars: map[string]string{
+				"MAX_DATASET_SIZE": "500",
+			},
+			want: &Config{
+				Server: ServerConfig{
+					Port:            "8080",
+					ReadTimeout:     10 * time.Second,
+					WriteTimeout:    10 * time.Second,
+					IdleTimeout:     120 * time.Second,
+					ShutdownTimeout: 15 * time.Second,
+					RequestTimeout:  30 * time.Second,
+				},
+				RateLimit: RateLimitConfig{
+					RequestsPerMinute: 100.0,
+					Burst:             20,
+				},
+				Limits: LimitsConfig{
+					MaxDatasetSize: 500,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "custom units file",
+			envVars: map[string]string{
+				"UNITS_FILE":            "/etc/gocalc/units.yaml",
+				"UNITS_RELOAD_INTERVAL": "30s",
+			},
+			want: &Config{
+				Server: ServerConfig{
+					Port:            "8080",
+					ReadTimeout:     10 * time.Second,
+					WriteTimeout:    10 * time.Second,
+					IdleTimeout:     120 * time.Second,
+					ShutdownTimeout: 15 * time.Second,
+					RequestTimeout:  30 * time.Second,
+				},
+				RateLimit: RateLimitConfig{
+					RequestsPerMinute: 100.0,
+					Burst:             20,
+				},
+				Limits: LimitsConfig{
+					MaxDatasetSize: 10000,
+				},
+				Units: UnitsConfig{
+					File:           "/etc/gocalc/units.yaml",
+					ReloadInterval: 30 * time.Second,
+				},
 			},
 			wantErr: false,
 		},
+		{
+			name: "negative units reload interval",
+			envVars: map[string]string{
+				"UNITS_RELOAD_INTERVAL": "-1s",
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -182,6 +260,12 @@ func TestLoad(t *testing.T) {
 				if got.RateLimit.Burst != tt.want.RateLimit.Burst {
 					t.Errorf("Burst = %v, want %v", got.RateLimit.Burst, tt.want.RateLimit.Burst)
 				}
+				if got.Limits.MaxDatasetSize != tt.want.Limits.MaxDatasetSize {
+					t.Errorf("MaxDatasetSize = %v, want %v", got.Limits.MaxDatasetSize, tt.want.Limits.MaxDatasetSize)
+				}
+				if got.Units != tt.want.Units {
+					t.Errorf("Units = %+v, want %+v", got.Units, tt.want.Units)
+				}
 			}
 		})
 	}
@@ -208,6 +292,9 @@ func TestValidate(t *testing.T) {
 					RequestsPerMinute: 100.0,
 					Burst:             20,
 				},
+				Limits: LimitsConfig{
+					MaxDatasetSize: 10000,
+				},
 			},
 			wantErr: false,
 		},
@@ -226,6 +313,9 @@ func TestValidate(t *testing.T) {
 					RequestsPerMinute: 100.0,
 					Burst:             20,
 				},
+				Limits: LimitsConfig{
+					MaxDatasetSize: 10000,
+				},
 			},
 			wantErr: true,
 		},
@@ -244,6 +334,9 @@ func TestValidate(t *testing.T) {
 					RequestsPerMinute: 100.0,
 					Burst:             20,
 				},
+				Limits: LimitsConfig{
+					MaxDatasetSize: 10000,
+				},
 			},
 			wantErr: true,
 		},
@@ -262,6 +355,9 @@ func TestValidate(t *testing.T) {
 					RequestsPerMinute: 100.0,
 					Burst:             20,
 				},
+				Limits: LimitsConfig{
+					MaxDatasetSize: 10000,
+				},
 			},
 			wantErr: true,
 		},
@@ -280,6 +376,9 @@ func TestValidate(t *testing.T) {
 					RequestsPerMinute: 100.0,
 					Burst:             20,
 				},
+				Limits: LimitsConfig{
+					MaxDatasetSize: 10000,
+				},
 			},
 			wantErr: true,
 		},
@@ -298,6 +397,9 @@ func TestValidate(t *testing.T) {
 					RequestsPerMinute: 100.0,
 					Burst:             20,
 				},
+				Limits: LimitsConfig{
+					MaxDatasetSize: 10000,
+				},
 			},
 			wantErr: true,
 		},
@@ -316,6 +418,9 @@ func TestValidate(t *testing.T) {
 					RequestsPerMinute: 0,
 					Burst:             20,
 				},
+				Limits: LimitsConfig{
+					MaxDatasetSize: 10000,
+				},
 			},
 			wantErr: true,
 		},
@@ -334,6 +439,30 @@ func TestValidate(t *testing.T) {
 					RequestsPerMinute: 100.0,
 					Burst:             -1,
 				},
+				Limits: LimitsConfig{
+					MaxDatasetSize: 10000,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "zero dataset limit",
+			config: &Config{
+				Server: ServerConfig{
+					Port:            "8080",
+					ReadTimeout:     10 * time.Second,
+					WriteTimeout:    10 * time.Second,
+					IdleTimeout:     120 * time.Second,
+					ShutdownTimeout: 15 * time.Second,
+					RequestTimeout:  30 * time.Second,
+				},
+				RateLimit: RateLimitConfig{
+					RequestsPerMinute: 100.0,
+					Burst:             20,
+				},
+				Limits: LimitsConfig{
+					MaxDatasetSize: 0,
+				},
 			},
 			wantErr: true,
 		},
@@ -10,6 +10,8 @@ const (
 	ErrCodeInvalidInput      = "INVALID_INPUT"
 	ErrCodeValidationError   = "VALIDATION_ERROR"
 	ErrCodeDivisionByZero    = "DIVISION_BY_ZERO"
+	ErrCodeDomainError       = "DOMAIN_ERROR"
+	ErrCodeSingularMatrix    = "SINGULAR_MATRIX"
 	ErrCodeMethodNotAllowed  = "METHOD_NOT_ALLOWED"
 	ErrCodeInternalError     = "INTERNAL_ERROR"
 	ErrCodeRateLimitExceeded = "RATE_LIMIT_EXCEEDED"
@@ -17,10 +19,11 @@ const (
 
 // APIError represents a structured error returned by the API.
 type APIError struct {
-	Code    string `json:"code"`
-	Message string `json:"message"`
-	Details string `json:"details,omitempty"`
-	err     error  // underlying error for logging/debugging
+	Code    string                 `json:"code"`
+	Message string                 `json:"message"`
+	Details string                 `json:"details,omitempty"`
+	Meta    map[string]interface{} `json:"meta,omitempty"` // structured context, e.g. a condition number or per-row errors
+	err     error                  // underlying error for logging/debugging
 }
 
 // NewAPIError creates a new APIError with the given code and message.
@@ -37,6 +40,15 @@ func (e *APIError) WithDetails(details string) *APIError {
 	return e
 }
 
+// WithMeta attaches a structured value to the error and returns itself for chaining.
+func (e *APIError) WithMeta(key string, value interface{}) *APIError {
+	if e.Meta == nil {
+		e.Meta = make(map[string]interface{})
+	}
+	e.Meta[key] = value
+	return e
+}
+
 // WithError wraps an underlying error for debugging purposes.
 func (e *APIError) WithError(err error) *APIError {
 	e.err = err
@@ -58,6 +70,8 @@ func (e *APIError) HTTPStatus() int {
 		return http.StatusBadRequest
 	case ErrCodeDivisionByZero:
 		return http.StatusBadRequest
+	case ErrCodeDomainError, ErrCodeSingularMatrix:
+		return http.StatusUnprocessableEntity
 	case ErrCodeMethodNotAllowed:
 		return http.StatusMethodNotAllowed
 	case ErrCodeRateLimitExceeded:
@@ -86,6 +100,16 @@ func DivisionByZero() *APIError {
 	return NewAPIError(ErrCodeDivisionByZero, "division by zero is not allowed")
 }
 
+// DomainError returns an error for inputs outside a function's mathematical domain.
+func DomainError(message string) *APIError {
+	return NewAPIError(ErrCodeDomainError, message)
+}
+
+// SingularMatrix returns an error for a singular or ill-conditioned matrix.
+func SingularMatrix(message string) *APIError {
+	return NewAPIError(ErrCodeSingularMatrix, message)
+}
+
 // MethodNotAllowed returns a method not allowed error.
 func MethodNotAllowed(method string) *APIError {
 	return NewAPIError(ErrCodeMethodNotAllowed, fmt.Sprintf("method %s not allowed", method))
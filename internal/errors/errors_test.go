@@ -24,6 +24,17 @@ func TestAPIErrorWithDetails(t *testing.T) {
 	}
 }
 
+func TestAPIErrorWithMeta(t *testing.T) {
+	err := NewAPIError("TEST_CODE", "message").WithMeta("condition_number", 1e15).WithMeta("singular", false)
+
+	if err.Meta["condition_number"] != 1e15 {
+		t.Errorf("Meta[condition_number] = %v, want 1e15", err.Meta["condition_number"])
+	}
+	if err.Meta["singular"] != false {
+		t.Errorf("Meta[singular] = %v, want false", err.Meta["singular"])
+	}
+}
+
 func TestAPIErrorWithError(t *testing.T) {
 	originalErr := NewAPIError("ORIGINAL", "original error")
 	err := NewAPIError("TEST_CODE", "message").WithError(originalErr)
@@ -80,6 +91,8 @@ func TestHTTPStatus(t *testing.T) {
 		{ErrCodeValidationError, http.StatusBadRequest},
 		{ErrCodeInvalidInput, http.StatusBadRequest},
 		{ErrCodeDivisionByZero, http.StatusBadRequest},
+		{ErrCodeDomainError, http.StatusUnprocessableEntity},
+		{ErrCodeSingularMatrix, http.StatusUnprocessableEntity},
 		{ErrCodeMethodNotAllowed, http.StatusMethodNotAllowed},
 		{ErrCodeInternalError, http.StatusInternalServerError},
 		{ErrCodeRateLimitExceeded, http.StatusTooManyRequests},
@@ -112,6 +125,16 @@ func TestErrorConstructors(t *testing.T) {
 			errFunc:  func() *APIError { return DivisionByZero() },
 			wantCode: ErrCodeDivisionByZero,
 		},
+		{
+			name:     "DomainError",
+			errFunc:  func() *APIError { return DomainError("test") },
+			wantCode: ErrCodeDomainError,
+		},
+		{
+			name:     "SingularMatrix",
+			errFunc:  func() *APIError { return SingularMatrix("test") },
+			wantCode: ErrCodeSingularMatrix,
+		},
 		{
 			name:     "MethodNotAllowed",
 			errFunc:  func() *APIError { return MethodNotAllowed("POST") },
@@ -0,0 +1,163 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	apierrors "github.com/m-szczepanski/gocalc-api/internal/errors"
+	"github.com/m-szczepanski/gocalc-api/internal/models"
+	"github.com/m-szczepanski/gocalc-api/internal/validation"
+	"github.com/m-szczepanski/gocalc-api/pkg/calculations"
+)
+
+// NewSumHandler returns a handler for /api/math/sum accepting up to maxValues numbers.
+func NewSumHandler(maxValues int) http.HandlerFunc {
+	return newAggregateHandler(calculations.AggregateSum, maxValues)
+}
+
+// NewProductHandler returns a handler for /api/math/product accepting up to maxValues numbers.
+func NewProductHandler(maxValues int) http.HandlerFunc {
+	return newAggregateHandler(calculations.AggregateProduct, maxValues)
+}
+
+// NewMinHandler returns a handler for /api/math/min accepting up to maxValues numbers.
+func NewMinHandler(maxValues int) http.HandlerFunc {
+	return newAggregateHandler(calculations.AggregateMin, maxValues)
+}
+
+// NewMaxHandler returns a handler for /api/math/max accepting up to maxValues numbers.
+func NewMaxHandler(maxValues int) http.HandlerFunc {
+	return newAggregateHandler(calculations.AggregateMax, maxValues)
+}
+
+// NewMeanHandler returns a handler for /api/math/mean accepting up to maxValues numbers.
+func NewMeanHandler(maxValues int) http.HandlerFunc {
+	return newAggregateHandler(calculations.AggregateMean, maxValues)
+}
+
+// newAggregateHandler reduces a values array with op. The array is streamed
+// from the body one number at a time rather than buffered by decodeJSONBody.
+func newAggregateHandler(op calculations.Aggregation, maxValues int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if err := validation.ValidateMethod(r.Method, http.MethodPost); err != nil {
+			writeErrorWithDetails(w, r, err)
+			return
+		}
+
+		aggregator := calculations.NewAggregator(op)
+		var countErr *apierrors.APIError
+		field, err := streamValues(r, func(v float64) error {
+			if err := aggregator.Add(v); err != nil {
+				return err
+			}
+			if countErr = validation.ValidateStreamedCount("values", aggregator.Count(), maxValues); countErr != nil {
+				return errStopStream
+			}
+			return nil
+		})
+		if countErr != nil {
+			writeErrorWithDetails(w, r, countErr)
+			return
+		}
+		if err != nil {
+			writeErrorWithDetails(w, r, apierrors.InvalidInput("invalid request body").WithError(err))
+			return
+		}
+		if err := validation.ValidateStreamedCount("values", aggregator.Count(), maxValues); err != nil {
+			writeErrorWithDetails(w, r, err)
+			return
+		}
+		rounding, apiErr := roundingField(r, field)
+		if apiErr != nil {
+			writeErrorWithDetails(w, r, apiErr)
+			return
+		}
+
+		result, err := aggregator.Result()
+		if err != nil {
+			writeErrorWithDetails(w, r, calculationError(err))
+			return
+		}
+
+		response := models.AggregateResponse{
+			Operation: string(op),
+			Result:    result,
+			Count:     aggregator.Count(),
+		}
+
+		if err := writeSuccessResponse(w, r, response, rounding); err != nil {
+			// Error already logged, headers likely already sent
+			return
+		}
+	}
+}
+
+var errStopStream = errors.New("stream stopped")
+
+// streamValues reads a body of the form {"values": [...]} token by token,
+// passing each number to add as soon as it is decoded. A raw "rounding" field
+// is returned for roundingField and other fields are ignored. Reading stops at
+// the first error returned by add.
+func streamValues(r *http.Request, add func(float64) error) (rounding json.RawMessage, err error) {
+	defer r.Body.Close()
+	dec := json.NewDecoder(r.Body)
+
+	if err := expectDelim(dec, '{', "request body must be a JSON object"); err != nil {
+		return nil, err
+	}
+	seenValues := false
+	for dec.More() {
+		token, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		switch key := token.(string); key {
+		case "values":
+			if seenValues {
+				return nil, fmt.Errorf("duplicate values field")
+			}
+			seenValues = true
+			if err := expectDelim(dec, '[', "values must be an array of numbers"); err != nil {
+				return nil, err
+			}
+			for i := 0; dec.More(); i++ {
+				var v float64
+				if err := dec.Decode(&v); err != nil {
+					return nil, fmt.Errorf("values[%d]: %w", i, err)
+				}
+				if err := add(v); err != nil {
+					return nil, err
+				}
+			}
+			if _, err := dec.Token(); err != nil {
+				return nil, err
+			}
+		case "rounding":
+			if err := dec.Decode(&rounding); err != nil {
+				return nil, err
+			}
+		default:
+			var skipped json.RawMessage
+			if err := dec.Decode(&skipped); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if _, err := dec.Token(); err != nil {
+		return nil, err
+	}
+	return rounding, nil
+}
+
+func expectDelim(dec *json.Decoder, delim json.Delim, message string) error {
+	token, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if token != delim {
+		return errors.New(message)
+	}
+	return nil
+}
@@ -0,0 +1,246 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/m-szczepanski/gocalc-api/internal/middleware"
+	"github.com/m-szczepanski/gocalc-api/internal/models"
+)
+
+func TestAggregateHandlers(t *testing.T) {
+	tests := []struct {
+		name           string
+		handler        func(int) http.HandlerFunc
+		method         string
+		body           string
+		expectedStatus int
+		expectedCode   string
+		expected       models.AggregateResponse
+	}{
+		{
+			name:           "sum",
+			handler:        NewSumHandler,
+			method:         http.MethodPost,
+			body:           `{"values": [1, 2, 3.5]}`,
+			expectedStatus: http.StatusOK,
+			expected:       models.AggregateResponse{Operation: "sum", Result: 6.5, Count: 3},
+		},
+		{
+			name:           "sum is compensated",
+			handler:        NewSumHandler,
+			method:         http.MethodPost,
+			body:           `{"values": [0.1, 0.1, 0.1, 0.1, 0.1, 0.1, 0.1, 0.1, 0.1, 0.1]}`,
+			expectedStatus: http.StatusOK,
+			expected:       models.AggregateResponse{Operation: "sum", Result: 1, Count: 10},
+		},
+		{
+			name:           "product",
+			handler:        NewProductHandler,
+			method:         http.MethodPost,
+			body:           `{"values": [2, -3, 0.5]}`,
+			expectedStatus: http.StatusOK,
+			expected:       models.AggregateResponse{Operation: "product", Result: -3, Count: 3},
+		},
+		{
+			name:           "min ignores unknown fields",
+			handler:        NewMinHandler,
+			method:         http.MethodPost,
+			body:           `{"label": {"nested": [1]}, "values": [3, -1, 2]}`,
+			expectedStatus: http.StatusOK,
+			expected:       models.AggregateResponse{Operation: "min", Result: -1, Count: 3},
+		},
+		{
+			name:           "max",
+			handler:        NewMaxHandler,
+			method:         http.MethodPost,
+			body:           `{"values": [3, -1, 2]}`,
+			expectedStatus: http.StatusOK,
+			expected:       models.AggregateResponse{Operation: "max", Result: 3, Count: 3},
+		},
+		{
+			name:           "mean with rounding after values",
+			handler:        NewMeanHandler,
+			method:         http.MethodPost,
+			body:           `{"values": [1, 2, 2], "rounding": "3sf"}`,
+			expectedStatus: http.StatusOK,
+			expected:       models.AggregateResponse{Operation: "mean", Result: 1.67, Count: 3},
+		},
+		{
+			name:           "mean of values whose sum overflows",
+			handler:        NewMeanHandler,
+			method:         http.MethodPost,
+			body:           `{"values": [1e308, 1e308]}`,
+			expectedStatus: http.StatusOK,
+			expected:       models.AggregateResponse{Operation: "mean", Result: 1e308, Count: 2},
+		},
+		{
+			name:           "product overflow",
+			handler:        NewProductHandler,
+			method:         http.MethodPost,
+			body:           `{"values": [1e200, 1e200]}`,
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedCode:   "DOMAIN_ERROR",
+		},
+		{
+			name:           "empty values",
+			handler:        NewSumHandler,
+			method:         http.MethodPost,
+			body:           `{"values": []}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_ERROR",
+		},
+		{
+			name:           "missing values",
+			handler:        NewSumHandler,
+			method:         http.MethodPost,
+			body:           `{}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_ERROR",
+		},
+		{
+			name:           "too many values",
+			handler:        NewSumHandler,
+			method:         http.MethodPost,
+			body:           `{"values": [1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11]}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_ERROR",
+		},
+		{
+			name:           "non-numeric value",
+			handler:        NewSumHandler,
+			method:         http.MethodPost,
+			body:           `{"values": [1, "2"]}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "INVALID_INPUT",
+		},
+		{
+			name:           "values not an array",
+			handler:        NewSumHandler,
+			method:         http.MethodPost,
+			body:           `{"values": 3}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "INVALID_INPUT",
+		},
+		{
+			name:           "duplicate values",
+			handler:        NewSumHandler,
+			method:         http.MethodPost,
+			body:           `{"values": [1], "values": [2]}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "INVALID_INPUT",
+		},
+		{
+			name:           "truncated body",
+			handler:        NewSumHandler,
+			method:         http.MethodPost,
+			body:           `{"values": [1, 2`,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "INVALID_INPUT",
+		},
+		{
+			name:           "body not an object",
+			handler:        NewSumHandler,
+			method:         http.MethodPost,
+			body:           `[1, 2]`,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "INVALID_INPUT",
+		},
+		{
+			name:           "invalid rounding",
+			handler:        NewSumHandler,
+			method:         http.MethodPost,
+			body:           `{"values": [1], "rounding": "2 places"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_ERROR",
+		},
+		{
+			name:           "non-string rounding",
+			handler:        NewSumHandler,
+			method:         http.MethodPost,
+			body:           `{"values": [1], "rounding": 2}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_ERROR",
+		},
+		{
+			name:           "wrong method",
+			handler:        NewSumHandler,
+			method:         http.MethodGet,
+			expectedStatus: http.StatusMethodNotAllowed,
+			expectedCode:   "METHOD_NOT_ALLOWED",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, "/api/math/sum", bytes.NewReader([]byte(tt.body)))
+			ctx := context.WithValue(req.Context(), middleware.RequestIDKey, "test-123")
+			req = req.WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			tt.handler(10)(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("status = %d, want %d: %s", w.Code, tt.expectedStatus, w.Body.String())
+			}
+
+			if tt.expectedCode != "" {
+				var resp models.APIErrorResponse
+				json.NewDecoder(w.Body).Decode(&resp)
+				if resp.Code != tt.expectedCode {
+					t.Errorf("error code = %s, want %s", resp.Code, tt.expectedCode)
+				}
+				return
+			}
+
+			var resp struct {
+				Data models.AggregateResponse `json:"data"`
+			}
+			json.NewDecoder(w.Body).Decode(&resp)
+			if resp.Data != tt.expected {
+				t.Errorf("data = %+v, want %+v", resp.Data, tt.expected)
+			}
+		})
+	}
+}
+
+// countingReader records how many bytes the handler read from the body.
+type countingReader struct {
+	r    io.Reader
+	read int
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.read += n
+	return n, err
+}
+
+func TestAggregateHandlerStopsReadingAtLimit(t *testing.T) {
+	// A body far larger than the limit: the handler must reject it after
+	// reading little more than maxValues numbers instead of buffering it all.
+	const maxValues = 100
+	body := &countingReader{r: io.MultiReader(
+		strings.NewReader(`{"values": [`),
+		strings.NewReader(strings.Repeat("1, ", 1_000_000)),
+		strings.NewReader(`1]}`),
+	)}
+	req := httptest.NewRequest(http.MethodPost, "/api/math/sum", body)
+	req = req.WithContext(context.WithValue(req.Context(), middleware.RequestIDKey, "test-123"))
+	w := httptest.NewRecorder()
+
+	NewSumHandler(maxValues)(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("status = %d, want %d: %s", w.Code, http.StatusBadRequest, w.Body.String())
+	}
+	if body.read > 64*1024 {
+		t.Errorf("handler read %d bytes before rejecting the body", body.read)
+	}
+}
@@ -0,0 +1,127 @@
+package handlers
+
+import (
+	"fmt"
+	"math/big"
+	"net/http"
+	"strconv"
+
+	apierrors "github.com/m-szczepanski/gocalc-api/internal/errors"
+	"github.com/m-szczepanski/gocalc-api/internal/models"
+	"github.com/m-szczepanski/gocalc-api/internal/validation"
+	"github.com/m-szczepanski/gocalc-api/pkg/calculations"
+)
+
+const defaultBasePrecision = 20
+
+func BaseConvertHandler(w http.ResponseWriter, r *http.Request) {
+	if err := validation.ValidateMethod(r.Method, http.MethodPost); err != nil {
+		writeErrorWithDetails(w, r, err)
+		return
+	}
+
+	var req models.BaseConvertRequest
+	rounding, apiErr := decodeJSONBody(r, &req)
+	if apiErr != nil {
+		writeErrorWithDetails(w, r, apiErr)
+		return
+	}
+
+	if err := validation.ValidateBaseConvertRequest(&req); err != nil {
+		writeErrorWithDetails(w, r, err)
+		return
+	}
+
+	fromBase, fromRoman, _ := calculations.ParseNumeralBase(string(req.FromBase))
+	toBase, toRoman, _ := calculations.ParseNumeralBase(string(req.ToBase))
+
+	var value *big.Rat
+	if fromRoman {
+		n, _ := calculations.FromRoman(req.Value)
+		value = new(big.Rat).SetInt64(int64(n))
+	} else {
+		value, _ = calculations.ParseInBase(req.Value, fromBase)
+	}
+
+	precision := defaultBasePrecision
+	if req.Precision != nil {
+		precision = *req.Precision
+	}
+
+	decimal, _ := calculations.FormatInBase(value, 10, precision)
+	response := models.BaseConvertResponse{
+		FromBase: baseName(fromBase, fromRoman),
+		ToBase:   baseName(toBase, toRoman),
+		Decimal:  decimal,
+		IEEE754: models.IEEE754Layouts{
+			Float32: floatLayoutModel(calculations.Float32Layout(value)),
+			Float64: floatLayoutModel(calculations.Float64Layout(value)),
+		},
+	}
+
+	if toRoman {
+		roman, err := toRomanNumeral(value)
+		if err != nil {
+			writeErrorWithDetails(w, r, calculationError(err))
+			return
+		}
+		response.Value, response.Exact = roman, true
+	} else {
+		response.Value, response.Exact = calculations.FormatInBase(value, toBase, precision)
+	}
+
+	if req.BitWidth > 0 {
+		if !value.IsInt() {
+			writeErrorWithDetails(w, r, apierrors.ValidationError(
+				"invalid value",
+				"two's complement requires an integer value",
+			))
+			return
+		}
+		pattern, err := calculations.TwosComplement(value.Num(), req.BitWidth)
+		if err != nil {
+			writeErrorWithDetails(w, r, calculationError(err))
+			return
+		}
+		response.TwosComplement = &models.TwosComplement{
+			BitWidth: req.BitWidth,
+			Binary:   fmt.Sprintf("%0*s", req.BitWidth, pattern.Text(2)),
+			Hex:      fmt.Sprintf("%0*s", (req.BitWidth+3)/4, pattern.Text(16)),
+			Unsigned: pattern.String(),
+		}
+	}
+
+	if err := writeSuccessResponse(w, r, response, rounding); err != nil {
+		// Error already logged, headers likely already sent
+		return
+	}
+}
+
+func toRomanNumeral(value *big.Rat) (string, error) {
+	if !value.IsInt() || !value.Num().IsInt64() {
+		return "", fmt.Errorf("%w: Roman numerals represent integers from 1 to %d, got %s",
+			calculations.ErrDomain, calculations.MaxRoman, value.RatString())
+	}
+	return calculations.ToRoman(int(value.Num().Int64()))
+}
+
+func baseName(base int, roman bool) string {
+	if roman {
+		return calculations.RomanBase
+	}
+	return strconv.Itoa(base)
+}
+
+func floatLayoutModel(l calculations.FloatLayout) models.FloatLayout {
+	return models.FloatLayout{
+		Bits:             l.Bits,
+		Hex:              l.Hex,
+		Sign:             l.Sign,
+		ExponentBits:     l.ExponentBits,
+		MantissaBits:     l.MantissaBits,
+		BiasedExponent:   l.BiasedExponent,
+		UnbiasedExponent: l.UnbiasedExponent,
+		Class:            l.Class,
+		Stored:           l.Stored,
+	}
+}
@@ -0,0 +1,145 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/m-szczepanski/gocalc-api/internal/middleware"
+	"github.com/m-szczepanski/gocalc-api/internal/models"
+)
+
+func TestBaseConvertHandler(t *testing.T) {
+	tests := []struct {
+		name           string
+		method         string
+		body           string
+		expectedStatus int
+		expectedCode   string
+		checkData      func(t *testing.T, data map[string]interface{})
+	}{
+		{
+			name:           "hex to binary with two's complement",
+			method:         http.MethodPost,
+			body:           `{"value": "-1", "from_base": 16, "to_base": 2, "bit_width": 8}`,
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				if data["value"] != "-1" {
+					t.Errorf("value = %v, want -1", data["value"])
+				}
+				tc := data["twos_complement"].(map[string]interface{})
+				if tc["binary"] != "11111111" || tc["hex"] != "ff" || tc["unsigned"] != "255" {
+					t.Errorf("twos_complement = %v", tc)
+				}
+			},
+		},
+		{
+			name:           "fraction to binary is inexact",
+			method:         http.MethodPost,
+			body:           `{"value": "0.1", "to_base": 2, "precision": 8}`,
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				if data["value"] != "0.00011001" || data["exact"] != false {
+					t.Errorf("value = %v, exact = %v", data["value"], data["exact"])
+				}
+				ieee := data["ieee754"].(map[string]interface{})
+				f32 := ieee["float32"].(map[string]interface{})
+				if f32["hex"] != "3dcccccd" || f32["stored"] != "0.100000001490116119384765625" {
+					t.Errorf("float32 = %v", f32)
+				}
+			},
+		},
+		{
+			name:           "decimal to roman",
+			method:         http.MethodPost,
+			body:           `{"value": "1994", "to_base": "roman"}`,
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				if data["value"] != "MCMXCIV" || data["to_base"] != "roman" {
+					t.Errorf("value = %v, to_base = %v", data["value"], data["to_base"])
+				}
+			},
+		},
+		{
+			name:           "roman to hex",
+			method:         http.MethodPost,
+			body:           `{"value": "mmxxiv", "from_base": "roman", "to_base": 16}`,
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				if data["value"] != "7e8" || data["decimal"] != "2024" {
+					t.Errorf("value = %v, decimal = %v", data["value"], data["decimal"])
+				}
+			},
+		},
+		{
+			name:           "roman out of range",
+			method:         http.MethodPost,
+			body:           `{"value": "4000", "to_base": "roman"}`,
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedCode:   "DOMAIN_ERROR",
+		},
+		{
+			name:           "two's complement overflow",
+			method:         http.MethodPost,
+			body:           `{"value": "200", "bit_width": 8}`,
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedCode:   "DOMAIN_ERROR",
+		},
+		{
+			name:           "two's complement of fraction",
+			method:         http.MethodPost,
+			body:           `{"value": "1.5", "bit_width": 8}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_ERROR",
+		},
+		{
+			name:           "invalid digit",
+			method:         http.MethodPost,
+			body:           `{"value": "102", "from_base": 2}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_ERROR",
+		},
+		{
+			name:           "method not allowed",
+			method:         http.MethodGet,
+			body:           `{}`,
+			expectedStatus: http.StatusMethodNotAllowed,
+			expectedCode:   "METHOD_NOT_ALLOWED",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, "/api/math/base-convert", bytes.NewReader([]byte(tt.body)))
+			ctx := context.WithValue(req.Context(), middleware.RequestIDKey, "test-123")
+			req = req.WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			BaseConvertHandler(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("status = %d, want %d", w.Code, tt.expectedStatus)
+			}
+
+			if tt.expectedCode != "" {
+				var resp models.APIErrorResponse
+				json.NewDecoder(w.Body).Decode(&resp)
+				if resp.Code != tt.expectedCode {
+					t.Errorf("error code = %s, want %s", resp.Code, tt.expectedCode)
+				}
+				return
+			}
+
+			var resp models.SuccessResponse
+			json.NewDecoder(w.Body).Decode(&resp)
+			data, ok := resp.Data.(map[string]interface{})
+			if !ok {
+				t.Fatalf("expected data in response")
+			}
+			tt.checkData(t, data)
+		})
+	}
+}
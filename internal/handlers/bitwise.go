@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/m-szczepanski/gocalc-api/internal/models"
+	"github.com/m-szczepanski/gocalc-api/internal/validation"
+	"github.com/m-szczepanski/gocalc-api/pkg/calculations"
+)
+
+func BitwiseHandler(w http.ResponseWriter, r *http.Request) {
+	if err := validation.ValidateMethod(r.Method, http.MethodPost); err != nil {
+		writeErrorWithDetails(w, r, err)
+		return
+	}
+
+	var req models.BitwiseRequest
+	rounding, apiErr := decodeJSONBody(r, &req)
+	if apiErr != nil {
+		writeErrorWithDetails(w, r, apiErr)
+		return
+	}
+
+	if err := validation.ValidateBitwiseRequest(&req); err != nil {
+		writeErrorWithDetails(w, r, err)
+		return
+	}
+
+	op := calculations.BitwiseOperation(strings.ToLower(strings.TrimSpace(req.Operation)))
+	intType, _ := calculations.ParseIntType(req.Type)
+	mode, _ := calculations.ParseOverflowMode(req.Overflow)
+
+	response := models.BitwiseResponse{
+		Operation: string(op),
+		Type:      intType.String(),
+		Overflow:  string(mode),
+	}
+
+	var (
+		a, result  uint64
+		overflowed bool
+		err        error
+	)
+	if op != calculations.BitwiseMask {
+		a, response.Overflowed, err = calculations.ParseFixedWidth(req.A, intType, mode)
+		if err != nil {
+			writeErrorWithDetails(w, r, calculationError(err))
+			return
+		}
+	}
+
+	switch {
+	case calculations.RequiresSecondBitwiseOperand(op):
+		var b uint64
+		b, overflowed, err = calculations.ParseFixedWidth(req.B, intType, mode)
+		if err != nil {
+			writeErrorWithDetails(w, r, calculationError(err))
+			return
+		}
+		response.Overflowed = response.Overflowed || overflowed
+		result, overflowed, err = calculations.BitwiseBinary(op, intType, mode, a, b)
+	case op == calculations.BitwiseNot:
+		result = calculations.Complement(intType, a)
+	case op == calculations.BitwiseMask:
+		result, err = calculations.BitMask(intType, req.Offset, *req.N)
+	case calculations.RequiresBitCount(op):
+		result, overflowed, err = calculations.Shift(op, intType, mode, a, *req.N)
+	default:
+		count, countErr := calculations.BitCount(op, intType, a)
+		if countErr != nil {
+			writeErrorWithDetails(w, r, calculationError(countErr))
+			return
+		}
+		response.Count = &count
+		if err := writeSuccessResponse(w, r, response, rounding); err != nil {
+			// Error already logged, headers likely already sent
+			return
+		}
+		return
+	}
+	if err != nil {
+		writeErrorWithDetails(w, r, calculationError(err))
+		return
+	}
+
+	response.Overflowed = response.Overflowed || overflowed
+	response.Result = intType.Value(result).String()
+	response.Hex, response.Binary = calculations.FormatPattern(intType, result)
+
+	if err := writeSuccessResponse(w, r, response, rounding); err != nil {
+		// Error already logged, headers likely already sent
+		return
+	}
+}
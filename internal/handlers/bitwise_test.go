@@ -0,0 +1,168 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/m-szczepanski/gocalc-api/internal/middleware"
+	"github.com/m-szczepanski/gocalc-api/internal/models"
+)
+
+func TestBitwiseHandler(t *testing.T) {
+	tests := []struct {
+		name           string
+		method         string
+		body           string
+		expectedStatus int
+		expectedCode   string
+		checkData      func(t *testing.T, data map[string]interface{})
+	}{
+		{
+			name:           "and with hex and binary literals",
+			method:         http.MethodPost,
+			body:           `{"operation": "and", "type": "uint8", "a": "0xF0", "b": "0b1010_1010"}`,
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				if data["result"] != "160" || data["hex"] != "0xa0" || data["binary"] != "0b10100000" {
+					t.Errorf("result = %v, hex = %v, binary = %v", data["result"], data["hex"], data["binary"])
+				}
+				if data["overflowed"] != false {
+					t.Errorf("overflowed = %v, want false", data["overflowed"])
+				}
+			},
+		},
+		{
+			name:           "hex literal is a bit pattern",
+			method:         http.MethodPost,
+			body:           `{"operation": "not", "type": "int8", "a": "0xff", "overflow": "error"}`,
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				if data["result"] != "0" {
+					t.Errorf("result = %v, want 0", data["result"])
+				}
+			},
+		},
+		{
+			name:           "add wraps by default",
+			method:         http.MethodPost,
+			body:           `{"operation": "add", "type": "int8", "a": "127", "b": "1"}`,
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				if data["result"] != "-128" || data["overflow"] != "wrap" || data["overflowed"] != true {
+					t.Errorf("result = %v, overflow = %v, overflowed = %v", data["result"], data["overflow"], data["overflowed"])
+				}
+			},
+		},
+		{
+			name:           "shl saturates",
+			method:         http.MethodPost,
+			body:           `{"operation": "shl", "type": "uint16", "a": "40000", "n": 1, "overflow": "saturate"}`,
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				if data["result"] != "65535" || data["overflowed"] != true {
+					t.Errorf("result = %v, overflowed = %v", data["result"], data["overflowed"])
+				}
+			},
+		},
+		{
+			name:           "rotr",
+			method:         http.MethodPost,
+			body:           `{"operation": "rotr", "type": "uint32", "a": "1", "n": 1}`,
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				if data["hex"] != "0x80000000" {
+					t.Errorf("hex = %v, want 0x80000000", data["hex"])
+				}
+			},
+		},
+		{
+			name:           "popcount",
+			method:         http.MethodPost,
+			body:           `{"operation": "popcount", "type": "int64", "a": "-1"}`,
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				if data["count"] != float64(64) {
+					t.Errorf("count = %v, want 64", data["count"])
+				}
+				if _, ok := data["result"]; ok {
+					t.Errorf("result should be omitted for popcount")
+				}
+			},
+		},
+		{
+			name:           "mask",
+			method:         http.MethodPost,
+			body:           `{"operation": "mask", "type": "uint16", "n": 4, "offset": 8}`,
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				if data["hex"] != "0x0f00" {
+					t.Errorf("hex = %v, want 0x0f00", data["hex"])
+				}
+			},
+		},
+		{
+			name:           "overflow error",
+			method:         http.MethodPost,
+			body:           `{"operation": "mul", "type": "int32", "a": "65536", "b": "65536", "overflow": "error"}`,
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedCode:   "DOMAIN_ERROR",
+		},
+		{
+			name:           "operand out of range",
+			method:         http.MethodPost,
+			body:           `{"operation": "not", "type": "uint8", "a": "-1", "overflow": "error"}`,
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedCode:   "DOMAIN_ERROR",
+		},
+		{
+			name:           "invalid literal",
+			method:         http.MethodPost,
+			body:           `{"operation": "xor", "a": "0xZZ", "b": "1"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_ERROR",
+		},
+		{
+			name:           "method not allowed",
+			method:         http.MethodGet,
+			body:           `{}`,
+			expectedStatus: http.StatusMethodNotAllowed,
+			expectedCode:   "METHOD_NOT_ALLOWED",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, "/api/math/bitwise", bytes.NewReader([]byte(tt.body)))
+			ctx := context.WithValue(req.Context(), middleware.RequestIDKey, "test-123")
+			req = req.WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			BitwiseHandler(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("status = %d, want %d", w.Code, tt.expectedStatus)
+			}
+
+			if tt.expectedCode != "" {
+				var resp models.APIErrorResponse
+				json.NewDecoder(w.Body).Decode(&resp)
+				if resp.Code != tt.expectedCode {
+					t.Errorf("error code = %s, want %s", resp.Code, tt.expectedCode)
+				}
+				return
+			}
+
+			var resp models.SuccessResponse
+			json.NewDecoder(w.Body).Decode(&resp)
+			data, ok := resp.Data.(map[string]interface{})
+			if !ok {
+				t.Fatalf("expected data in response")
+			}
+			tt.checkData(t, data)
+		})
+	}
+}
@@ -0,0 +1,188 @@
+package handlers
+
+import (
+	"errors"
+	"math"
+	"net/http"
+
+	apierrors "github.com/m-szczepanski/gocalc-api/internal/errors"
+	"github.com/m-szczepanski/gocalc-api/internal/models"
+	"github.com/m-szczepanski/gocalc-api/internal/validation"
+	"github.com/m-szczepanski/gocalc-api/pkg/calculations/calculus"
+	"github.com/m-szczepanski/gocalc-api/pkg/calculations/expr"
+)
+
+func IntegralHandler(w http.ResponseWriter, r *http.Request) {
+	if err := validation.ValidateMethod(r.Method, http.MethodPost); err != nil {
+		writeErrorWithDetails(w, r, err)
+		return
+	}
+
+	var req models.IntegralRequest
+	rounding, apiErr := decodeJSONBody(r, &req)
+	if apiErr != nil {
+		writeErrorWithDetails(w, r, apiErr)
+		return
+	}
+
+	if err := validation.ValidateIntegralRequest(&req); err != nil {
+		writeErrorWithDetails(w, r, err)
+		return
+	}
+
+	f, _ := expr.Parse(req.Expression, "x")
+	method, _ := calculus.ParseIntegrationMethod(req.Method)
+	tol, maxIterations := convergenceLimits(req.Tolerance, req.MaxIterations, calculus.DefaultIntegrationIterations)
+
+	result, err := calculus.Integrate(r.Context(), f.Func(), *req.Lower, *req.Upper, method, tol, maxIterations)
+	if err != nil {
+		writeCalculusError(w, r, err, result)
+		return
+	}
+
+	if err := writeSuccessResponse(w, r, models.IntegralResponse{
+		Expression:    req.Expression,
+		Method:        string(method),
+		Lower:         *req.Lower,
+		Upper:         *req.Upper,
+		Value:         result.Value,
+		ErrorEstimate: result.ErrorEstimate,
+		Iterations:    result.Iterations,
+		Evaluations:   result.Evaluations,
+	}, rounding); err != nil {
+		// Error already logged, headers likely already sent
+		return
+	}
+}
+
+func DerivativeHandler(w http.ResponseWriter, r *http.Request) {
+	if err := validation.ValidateMethod(r.Method, http.MethodPost); err != nil {
+		writeErrorWithDetails(w, r, err)
+		return
+	}
+
+	var req models.DerivativeRequest
+	rounding, apiErr := decodeJSONBody(r, &req)
+	if apiErr != nil {
+		writeErrorWithDetails(w, r, apiErr)
+		return
+	}
+
+	if err := validation.ValidateDerivativeRequest(&req); err != nil {
+		writeErrorWithDetails(w, r, err)
+		return
+	}
+
+	f, _ := expr.Parse(req.Expression, "x")
+	order := req.Order
+	if order == 0 {
+		order = 1
+	}
+	tol, maxIterations := convergenceLimits(req.Tolerance, req.MaxIterations, calculus.DefaultDerivativeIterations)
+
+	result, err := calculus.Derivative(r.Context(), f.Func(), *req.X, order, tol, maxIterations)
+	if err != nil {
+		writeCalculusError(w, r, err, result)
+		return
+	}
+
+	if err := writeSuccessResponse(w, r, models.DerivativeResponse{
+		Expression:    req.Expression,
+		X:             *req.X,
+		Order:         order,
+		Value:         result.Value,
+		ErrorEstimate: result.ErrorEstimate,
+		Iterations:    result.Iterations,
+		Evaluations:   result.Evaluations,
+	}, rounding); err != nil {
+		// Error already logged, headers likely already sent
+		return
+	}
+}
+
+func RootHandler(w http.ResponseWriter, r *http.Request) {
+	if err := validation.ValidateMethod(r.Method, http.MethodPost); err != nil {
+		writeErrorWithDetails(w, r, err)
+		return
+	}
+
+	var req models.RootRequest
+	rounding, apiErr := decodeJSONBody(r, &req)
+	if apiErr != nil {
+		writeErrorWithDetails(w, r, apiErr)
+		return
+	}
+
+	if err := validation.ValidateRootRequest(&req); err != nil {
+		writeErrorWithDetails(w, r, err)
+		return
+	}
+
+	f, _ := expr.Parse(req.Expression, "x")
+	method, _ := calculus.ParseRootMethod(req.Method)
+	tol, maxIterations := convergenceLimits(req.Tolerance, req.MaxIterations, calculus.DefaultRootIterations)
+
+	var result calculus.RootResult
+	var err error
+	switch method {
+	case calculus.Bisection:
+		result, err = calculus.FindBisection(r.Context(), f.Func(), *req.Lower, *req.Upper, tol, maxIterations)
+	case calculus.Newton:
+		result, err = calculus.FindNewton(r.Context(), f.Func(), *req.X0, tol, maxIterations)
+	default:
+		result, err = calculus.FindBrent(r.Context(), f.Func(), *req.Lower, *req.Upper, tol, maxIterations)
+	}
+	if err != nil {
+		writeCalculusError(w, r, err, result.Result)
+		return
+	}
+
+	if err := writeSuccessResponse(w, r, models.RootResponse{
+		Expression:    req.Expression,
+		Method:        string(method),
+		Root:          result.Value,
+		Residual:      result.Residual,
+		ErrorEstimate: result.ErrorEstimate,
+		Iterations:    result.Iterations,
+		Evaluations:   result.Evaluations,
+	}, rounding); err != nil {
+		// Error already logged, headers likely already sent
+		return
+	}
+}
+
+func convergenceLimits(tolerance *float64, maxIterations *int, defaultIterations int) (float64, int) {
+	tol, iterations := calculus.DefaultTolerance, defaultIterations
+	if tolerance != nil {
+		tol = *tolerance
+	}
+	if maxIterations != nil {
+		iterations = *maxIterations
+	}
+	return tol, iterations
+}
+
+// writeCalculusError writes err unless the request context ended. When a
+// method fails to converge, its last estimate is reported in the error meta.
+func writeCalculusError(w http.ResponseWriter, r *http.Request, err error, partial calculus.Result) {
+	if requestEnded(err) {
+		return
+	}
+
+	switch {
+	case errors.Is(err, calculus.ErrNoConvergence):
+		apiErr := apierrors.DomainError("method did not converge").WithDetails(err.Error()).
+			WithMeta("iterations", partial.Iterations).
+			WithMeta("evaluations", partial.Evaluations)
+		if !math.IsInf(partial.Value, 0) && !math.IsInf(partial.ErrorEstimate, 0) {
+			apiErr.WithMeta("value", partial.Value).WithMeta("error_estimate", partial.ErrorEstimate)
+		}
+		writeErrorWithDetails(w, r, apiErr)
+	case errors.Is(err, calculus.ErrNoBracket):
+		writeErrorWithDetails(w, r, apierrors.DomainError("root is not bracketed").WithDetails(err.Error()))
+	case errors.Is(err, calculus.ErrNotFinite):
+		writeErrorWithDetails(w, r, apierrors.DomainError("input outside function domain").WithDetails(err.Error()))
+	default:
+		writeErrorWithDetails(w, r, apierrors.ValidationError("calculation error", err.Error()))
+	}
+}
@@ -0,0 +1,272 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/m-szczepanski/gocalc-api/internal/middleware"
+	"github.com/m-szczepanski/gocalc-api/internal/models"
+)
+
+func TestCalculusHandlers(t *testing.T) {
+	tests := []struct {
+		name           string
+		handler        http.HandlerFunc
+		method         string
+		body           string
+		expectedStatus int
+		expectedCode   string
+		checkData      func(t *testing.T, data map[string]interface{})
+	}{
+		{
+			name:           "integrate with gauss-kronrod",
+			handler:        IntegralHandler,
+			method:         http.MethodPost,
+			body:           `{"expression": "x sin(x)", "lower": 0, "upper": 3.141592653589793}`,
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				if !floatEquals(data["value"].(float64), math.Pi, 1e-9) {
+					t.Errorf("value = %v, want π", data["value"])
+				}
+				if data["method"] != "gauss-kronrod" || data["evaluations"].(float64) < 15 {
+					t.Errorf("method = %v, evaluations = %v", data["method"], data["evaluations"])
+				}
+				if _, ok := data["error_estimate"]; !ok {
+					t.Errorf("expected error_estimate in response")
+				}
+			},
+		},
+		{
+			name:           "integrate with simpson",
+			handler:        IntegralHandler,
+			method:         http.MethodPost,
+			body:           `{"expression": "exp(-x^2)", "lower": -3, "upper": 3, "method": "simpson", "tolerance": 1e-8}`,
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				if !floatEquals(data["value"].(float64), math.Sqrt(math.Pi)*math.Erf(3), 1e-7) {
+					t.Errorf("value = %v", data["value"])
+				}
+				if data["iterations"].(float64) == 0 {
+					t.Errorf("expected subdivisions to be counted")
+				}
+			},
+		},
+		{
+			name:           "integrate does not converge",
+			handler:        IntegralHandler,
+			method:         http.MethodPost,
+			body:           `{"expression": "sin(1/x)", "lower": 1e-6, "upper": 1, "tolerance": 1e-14, "max_iterations": 3}`,
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedCode:   "DOMAIN_ERROR",
+		},
+		{
+			name:           "integrand outside domain",
+			handler:        IntegralHandler,
+			method:         http.MethodPost,
+			body:           `{"expression": "ln(x)", "lower": -1, "upper": 1}`,
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedCode:   "DOMAIN_ERROR",
+		},
+		{
+			name:           "invalid expression",
+			handler:        IntegralHandler,
+			method:         http.MethodPost,
+			body:           `{"expression": "x +", "lower": 0, "upper": 1}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_ERROR",
+		},
+		{
+			name:           "first derivative",
+			handler:        DerivativeHandler,
+			method:         http.MethodPost,
+			body:           `{"expression": "x^3", "x": 2}`,
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				if !floatEquals(data["value"].(float64), 12, 1e-8) || data["order"].(float64) != 1 {
+					t.Errorf("value = %v, order = %v", data["value"], data["order"])
+				}
+				if data["iterations"].(float64) == 0 {
+					t.Errorf("expected iterations to be counted")
+				}
+			},
+		},
+		{
+			name:           "second derivative",
+			handler:        DerivativeHandler,
+			method:         http.MethodPost,
+			body:           `{"expression": "sin(x)", "x": 0.5, "order": 2}`,
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				if !floatEquals(data["value"].(float64), -math.Sin(0.5), 1e-7) {
+					t.Errorf("value = %v, want %v", data["value"], -math.Sin(0.5))
+				}
+			},
+		},
+		{
+			name:           "derivative missing x",
+			handler:        DerivativeHandler,
+			method:         http.MethodPost,
+			body:           `{"expression": "x^2"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_ERROR",
+		},
+		{
+			name:           "root with brent",
+			handler:        RootHandler,
+			method:         http.MethodPost,
+			body:           `{"expression": "x^2 - 2", "lower": 0, "upper": 2}`,
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				if !floatEquals(data["root"].(float64), math.Sqrt2, 1e-10) || data["method"] != "brent" {
+					t.Errorf("root = %v, method = %v", data["root"], data["method"])
+				}
+			},
+		},
+		{
+			name:           "root with bisection",
+			handler:        RootHandler,
+			method:         http.MethodPost,
+			body:           `{"expression": "cos(x) - x", "method": "bisection", "lower": 0, "upper": 1, "tolerance": 1e-12}`,
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				if !floatEquals(data["root"].(float64), 0.7390851332151607, 1e-11) {
+					t.Errorf("root = %v", data["root"])
+				}
+				if data["error_estimate"].(float64) > 1e-12 {
+					t.Errorf("error_estimate = %v", data["error_estimate"])
+				}
+			},
+		},
+		{
+			name:           "root with newton",
+			handler:        RootHandler,
+			method:         http.MethodPost,
+			body:           `{"expression": "x^3 - 2x - 5", "method": "newton", "x0": 2}`,
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				if !floatEquals(data["root"].(float64), 2.0945514815423265, 1e-10) {
+					t.Errorf("root = %v", data["root"])
+				}
+				if math.Abs(data["residual"].(float64)) > 1e-9 {
+					t.Errorf("residual = %v", data["residual"])
+				}
+			},
+		},
+		{
+			name:           "root not bracketed",
+			handler:        RootHandler,
+			method:         http.MethodPost,
+			body:           `{"expression": "x^2 + 1", "lower": -1, "upper": 1}`,
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedCode:   "DOMAIN_ERROR",
+		},
+		{
+			name:           "newton requires x0",
+			handler:        RootHandler,
+			method:         http.MethodPost,
+			body:           `{"expression": "x - 1", "method": "newton", "lower": 0, "upper": 2}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_ERROR",
+		},
+		{
+			name:           "method not allowed",
+			handler:        RootHandler,
+			method:         http.MethodGet,
+			body:           `{}`,
+			expectedStatus: http.StatusMethodNotAllowed,
+			expectedCode:   "METHOD_NOT_ALLOWED",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, "/api/math/calculus", bytes.NewReader([]byte(tt.body)))
+			ctx := context.WithValue(req.Context(), middleware.RequestIDKey, "test-123")
+			req = req.WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			tt.handler(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("status = %d, want %d: %s", w.Code, tt.expectedStatus, w.Body.String())
+			}
+
+			if tt.expectedCode != "" {
+				var resp models.APIErrorResponse
+				json.NewDecoder(w.Body).Decode(&resp)
+				if resp.Code != tt.expectedCode {
+					t.Errorf("error code = %s, want %s", resp.Code, tt.expectedCode)
+				}
+				return
+			}
+
+			var resp models.SuccessResponse
+			json.NewDecoder(w.Body).Decode(&resp)
+			data, ok := resp.Data.(map[string]interface{})
+			if !ok {
+				t.Fatalf("expected data in response")
+			}
+			tt.checkData(t, data)
+		})
+	}
+}
+
+func TestIntegralHandlerNoConvergenceMeta(t *testing.T) {
+	body := `{"expression": "sin(1/x)", "lower": 1e-6, "upper": 1, "tolerance": 1e-14, "max_iterations": 3}`
+	req := httptest.NewRequest(http.MethodPost, "/api/math/integrate", strings.NewReader(body))
+	w := httptest.NewRecorder()
+
+	IntegralHandler(w, req)
+
+	var resp models.APIErrorResponse
+	json.NewDecoder(w.Body).Decode(&resp)
+	if resp.Meta["iterations"] != float64(3) {
+		t.Errorf("meta.iterations = %v, want 3", resp.Meta["iterations"])
+	}
+	if _, ok := resp.Meta["value"]; !ok {
+		t.Errorf("expected partial value in meta, got %v", resp.Meta)
+	}
+}
+
+func TestIntegralHandlerCancelled(t *testing.T) {
+	// A slow integrand that cannot converge; only cancellation stops it early
+	expression := strings.TrimSuffix(strings.Repeat("sin(1/x)+", 100), "+")
+	body := `{"expression": "` + expression + `", "lower": 0, "upper": 1, "tolerance": 1e-15, "max_iterations": 10000}`
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	req := httptest.NewRequest(http.MethodPost, "/api/math/integrate", strings.NewReader(body)).WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	start := time.Now()
+	IntegralHandler(w, req)
+
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("handler ran for %v after cancellation", elapsed)
+	}
+	if w.Body.Len() != 0 {
+		t.Errorf("expected no response after cancellation, got %s", w.Body.String())
+	}
+}
+
+func TestIntegralHandlerTimeout(t *testing.T) {
+	handler := middleware.TimeoutMiddleware(20 * time.Millisecond)(http.HandlerFunc(IntegralHandler))
+
+	expression := strings.TrimSuffix(strings.Repeat("sin(1/x)+", 100), "+")
+	body := `{"expression": "` + expression + `", "lower": 1e-9, "upper": 1, "tolerance": 1e-15, "max_iterations": 10000}`
+	req := httptest.NewRequest(http.MethodPost, "/api/math/integrate", strings.NewReader(body))
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, req)
+
+	if w.Code != http.StatusInternalServerError {
+		t.Errorf("status = %d, want %d", w.Code, http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,108 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	apierrors "github.com/m-szczepanski/gocalc-api/internal/errors"
+	"github.com/m-szczepanski/gocalc-api/internal/models"
+	"github.com/m-szczepanski/gocalc-api/internal/validation"
+	"github.com/m-szczepanski/gocalc-api/pkg/calculations"
+)
+
+func ComplexHandler(w http.ResponseWriter, r *http.Request) {
+	if err := validation.ValidateMethod(r.Method, http.MethodPost); err != nil {
+		writeErrorWithDetails(w, r, err)
+		return
+	}
+
+	var req models.ComplexRequest
+	rounding, apiErr := decodeJSONBody(r, &req)
+	if apiErr != nil {
+		writeErrorWithDetails(w, r, apiErr)
+		return
+	}
+
+	if err := validation.ValidateComplexRequest(&req); err != nil {
+		writeErrorWithDetails(w, r, err)
+		return
+	}
+
+	op := calculations.ComplexOperation(strings.ToLower(strings.TrimSpace(req.Operation)))
+	mode, _ := calculations.ParseAngleMode(req.AngleMode)
+
+	var a, b complex128
+	if req.A != nil {
+		a = toComplex128(*req.A)
+	}
+	if req.B != nil {
+		b = toComplex128(*req.B)
+	}
+
+	response := models.ComplexResponse{Operation: string(op)}
+
+	var result complex128
+	var err error
+	switch op {
+	case calculations.ComplexAdd, calculations.ComplexSub, calculations.ComplexMul, calculations.ComplexDiv:
+		result, err = calculations.ComplexArithmetic(op, a, b)
+	case calculations.ComplexConjugate:
+		result = complex(real(a), -imag(a))
+	case calculations.ComplexExp:
+		result, err = calculations.ComplexExponential(a)
+	case calculations.ComplexLog:
+		result, err = calculations.ComplexLogarithm(a)
+	case calculations.ComplexPow:
+		result, err = calculations.ComplexPower(a, b)
+	case calculations.ComplexRect:
+		result = calculations.FromPolar(req.Polar.Modulus, req.Polar.Argument, mode)
+		response.AngleMode = string(mode)
+	case calculations.ComplexModulus:
+		var modulus float64
+		modulus, err = calculations.Modulus(a)
+		response.Value = &modulus
+	case calculations.ComplexArgument:
+		argument := calculations.Argument(a, mode)
+		response.Value = &argument
+		response.AngleMode = string(mode)
+	case calculations.ComplexPolar:
+		var modulus, argument float64
+		modulus, argument, err = calculations.ToPolar(a, mode)
+		response.Polar = &models.PolarForm{Modulus: modulus, Argument: argument}
+		response.AngleMode = string(mode)
+	case calculations.ComplexRoots:
+		var roots []complex128
+		roots, err = calculations.NthRoots(a, *req.N)
+		for _, root := range roots {
+			response.Roots = append(response.Roots, fromComplex128(root))
+		}
+	}
+
+	if errors.Is(err, calculations.ErrComplexDivisionByZero) {
+		writeErrorWithDetails(w, r, apierrors.DivisionByZero())
+		return
+	}
+	if err != nil {
+		writeErrorWithDetails(w, r, calculationError(err))
+		return
+	}
+
+	if response.Value == nil && response.Polar == nil && response.Roots == nil {
+		c := fromComplex128(result)
+		response.Result = &c
+	}
+
+	if err := writeSuccessResponse(w, r, response, rounding); err != nil {
+		// Error already logged, headers likely already sent
+		return
+	}
+}
+
+func toComplex128(c models.Complex) complex128 {
+	return complex(c.Re, c.Im)
+}
+
+func fromComplex128(z complex128) models.Complex {
+	return models.Complex{Re: real(z), Im: imag(z)}
+}
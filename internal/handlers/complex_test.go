@@ -0,0 +1,147 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/m-szczepanski/gocalc-api/internal/middleware"
+	"github.com/m-szczepanski/gocalc-api/internal/models"
+)
+
+func TestComplexHandler(t *testing.T) {
+	n := 4
+
+	tests := []struct {
+		name           string
+		method         string
+		body           *models.ComplexRequest
+		expectedStatus int
+		expectedCode   string
+		checkData      func(t *testing.T, data map[string]interface{})
+	}{
+		{
+			name:   "parallel impedance product",
+			method: http.MethodPost,
+			body: &models.ComplexRequest{
+				Operation: "mul",
+				A:         &models.Complex{Re: 3, Im: 4},
+				B:         &models.Complex{Re: 1, Im: -2},
+			},
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				result := data["result"].(map[string]interface{})
+				if result["re"].(float64) != 11 || result["im"].(float64) != -2 {
+					t.Errorf("result = %v, want {11 -2}", result)
+				}
+			},
+		},
+		{
+			name:   "polar in degrees",
+			method: http.MethodPost,
+			body: &models.ComplexRequest{
+				Operation: "polar",
+				A:         &models.Complex{Re: 0, Im: 2},
+				AngleMode: "degrees",
+			},
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				polar := data["polar"].(map[string]interface{})
+				if polar["modulus"].(float64) != 2 || polar["argument"].(float64) != 90 {
+					t.Errorf("polar = %v, want {2 90}", polar)
+				}
+				if data["angle_mode"] != "degrees" {
+					t.Errorf("angle_mode = %v, want degrees", data["angle_mode"])
+				}
+			},
+		},
+		{
+			name:           "modulus",
+			method:         http.MethodPost,
+			body:           &models.ComplexRequest{Operation: "modulus", A: &models.Complex{Re: 3, Im: 4}},
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				if data["value"].(float64) != 5 {
+					t.Errorf("value = %v, want 5", data["value"])
+				}
+			},
+		},
+		{
+			name:           "all fourth roots",
+			method:         http.MethodPost,
+			body:           &models.ComplexRequest{Operation: "roots", A: &models.Complex{Re: 16}, N: &n},
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				roots := data["roots"].([]interface{})
+				if len(roots) != 4 {
+					t.Fatalf("expected 4 roots, got %d", len(roots))
+				}
+				second := roots[1].(map[string]interface{})
+				if second["re"].(float64) != 0 || second["im"].(float64) != 2 {
+					t.Errorf("second root = %v, want {0 2}", second)
+				}
+			},
+		},
+		{
+			name:           "log of zero",
+			method:         http.MethodPost,
+			body:           &models.ComplexRequest{Operation: "log", A: &models.Complex{}},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedCode:   "DOMAIN_ERROR",
+		},
+		{
+			name:   "division by zero",
+			method: http.MethodPost,
+			body: &models.ComplexRequest{
+				Operation: "div",
+				A:         &models.Complex{Re: 1},
+				B:         &models.Complex{},
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "DIVISION_BY_ZERO",
+		},
+		{
+			name:           "method not allowed",
+			method:         http.MethodGet,
+			body:           &models.ComplexRequest{},
+			expectedStatus: http.StatusMethodNotAllowed,
+			expectedCode:   "METHOD_NOT_ALLOWED",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, _ := json.Marshal(tt.body)
+			req := httptest.NewRequest(tt.method, "/api/math/complex", bytes.NewReader(body))
+			ctx := context.WithValue(req.Context(), middleware.RequestIDKey, "test-123")
+			req = req.WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			ComplexHandler(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("status = %d, want %d", w.Code, tt.expectedStatus)
+			}
+
+			if tt.expectedCode != "" {
+				var resp models.APIErrorResponse
+				json.NewDecoder(w.Body).Decode(&resp)
+				if resp.Code != tt.expectedCode {
+					t.Errorf("error code = %s, want %s", resp.Code, tt.expectedCode)
+				}
+				return
+			}
+
+			var resp models.SuccessResponse
+			json.NewDecoder(w.Body).Decode(&resp)
+			data, ok := resp.Data.(map[string]interface{})
+			if !ok {
+				t.Fatalf("expected data in response")
+			}
+			tt.checkData(t, data)
+		})
+	}
+}
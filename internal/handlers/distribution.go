@@ -0,0 +1,113 @@
+package handlers
+
+import (
+	"errors"
+	"math"
+	"net/http"
+
+	apierrors "github.com/m-szczepanski/gocalc-api/internal/errors"
+	"github.com/m-szczepanski/gocalc-api/internal/models"
+	"github.com/m-szczepanski/gocalc-api/internal/validation"
+	"github.com/m-szczepanski/gocalc-api/pkg/calculations/distribution"
+)
+
+func DistributionHandler(w http.ResponseWriter, r *http.Request) {
+	if err := validation.ValidateMethod(r.Method, http.MethodPost); err != nil {
+		writeErrorWithDetails(w, r, err)
+		return
+	}
+
+	var req models.DistributionRequest
+	rounding, apiErr := decodeJSONBody(r, &req)
+	if apiErr != nil {
+		writeErrorWithDetails(w, r, apiErr)
+		return
+	}
+
+	if err := validation.ValidateDistributionRequest(r.PathValue("name"), &req); err != nil {
+		writeErrorWithDetails(w, r, err)
+		return
+	}
+
+	name, _ := distribution.ParseName(r.PathValue("name"))
+	d, err := distribution.New(name, distributionParams(&req))
+	if err != nil {
+		writeErrorWithDetails(w, r, calculationError(err))
+		return
+	}
+
+	response := models.DistributionResponse{
+		Distribution: string(name),
+		Discrete:     d.Discrete(),
+		Mean:         finitePtr(d.Mean()),
+		Variance:     finitePtr(d.Variance()),
+	}
+
+	var values []float64
+	if req.X != nil {
+		x := *req.X
+		pdf, cdf, sf := d.PDF(x), d.CDF(x), d.SF(x)
+		values = append(values, pdf, cdf, sf)
+		response.X = req.X
+		// An unbounded density, such as chi2 with df < 2 at 0, is omitted.
+		response.PDF = finitePtr(pdf)
+		response.CDF = &cdf
+		response.SF = &sf
+	}
+	if req.Probability != nil {
+		quantile, err := d.Quantile(*req.Probability)
+		if err != nil {
+			writeErrorWithDetails(w, r, distributionError(err))
+			return
+		}
+		values = append(values, quantile)
+		response.Probability = req.Probability
+		response.Quantile = &quantile
+	}
+
+	for _, v := range values {
+		if math.IsNaN(v) {
+			writeErrorWithDetails(w, r, distributionError(distribution.ErrNoConvergence))
+			return
+		}
+	}
+	if err := writeSuccessResponse(w, r, response, rounding); err != nil {
+		// Error already logged, headers likely already sent
+		return
+	}
+}
+
+func distributionParams(req *models.DistributionRequest) distribution.Params {
+	p := distribution.Params{Mean: 0, SD: 1}
+	for _, field := range []struct {
+		value *float64
+		dst   *float64
+	}{
+		{req.Mean, &p.Mean}, {req.SD, &p.SD}, {req.DF, &p.DF}, {req.DF1, &p.DF1}, {req.DF2, &p.DF2},
+		{req.P, &p.P}, {req.Lambda, &p.Lambda}, {req.Rate, &p.Rate}, {req.Min, &p.Min}, {req.Max, &p.Max},
+		{req.Alpha, &p.Alpha}, {req.Beta, &p.Beta},
+	} {
+		if field.value != nil {
+			*field.dst = *field.value
+		}
+	}
+	if req.N != nil {
+		p.N = *req.N
+	}
+	return p
+}
+
+func distributionError(err error) *apierrors.APIError {
+	if errors.Is(err, distribution.ErrNoConvergence) {
+		return apierrors.DomainError("method did not converge").WithDetails("the parameters are too extreme to evaluate accurately")
+	}
+	return calculationError(err)
+}
+
+// finitePtr returns a pointer to v, or nil if v is NaN or infinite.
+func finitePtr(v float64) *float64 {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return nil
+	}
+	return &v
+}
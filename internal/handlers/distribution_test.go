@@ -0,0 +1,147 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/m-szczepanski/gocalc-api/internal/middleware"
+	"github.com/m-szczepanski/gocalc-api/internal/models"
+)
+
+func TestDistributionHandler(t *testing.T) {
+	tests := []struct {
+		name           string
+		distribution   string
+		method         string
+		body           string
+		expectedStatus int
+		expectedCode   string
+		checkData      func(t *testing.T, data map[string]interface{})
+	}{
+		{
+			name:           "standard normal",
+			distribution:   "normal",
+			method:         http.MethodPost,
+			body:           `{"x": 1.96, "probability": 0.975}`,
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				if !floatEquals(data["cdf"].(float64), 0.9750021048517795, 1e-15) || !floatEquals(data["sf"].(float64), 0.024997895148220435, 1e-15) {
+					t.Errorf("cdf = %v, sf = %v", data["cdf"], data["sf"])
+				}
+				if !floatEquals(data["quantile"].(float64), 1.959963984540054, 1e-14) {
+					t.Errorf("quantile = %v", data["quantile"])
+				}
+				if data["mean"] != float64(0) || data["variance"] != float64(1) || data["discrete"] != false {
+					t.Errorf("data = %v", data)
+				}
+			},
+		},
+		{
+			name:           "binomial mass",
+			distribution:   "binomial",
+			method:         http.MethodPost,
+			body:           `{"x": 5, "n": 10, "p": 0.5}`,
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				if !floatEquals(data["pdf"].(float64), 0.24609375, 1e-15) || data["discrete"] != true {
+					t.Errorf("data = %v", data)
+				}
+				if _, ok := data["quantile"]; ok {
+					t.Errorf("unexpected quantile %v", data["quantile"])
+				}
+			},
+		},
+		{
+			name:           "undefined moments and unbounded density are omitted",
+			distribution:   "chi2",
+			method:         http.MethodPost,
+			body:           `{"x": 0, "df": 1}`,
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				if _, ok := data["pdf"]; ok || data["cdf"] != float64(0) {
+					t.Errorf("data = %v", data)
+				}
+			},
+		},
+		{
+			name:           "t has no mean for one degree of freedom",
+			distribution:   "t",
+			method:         http.MethodPost,
+			body:           `{"probability": 0.75, "df": 1}`,
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				if _, ok := data["mean"]; ok || !floatEquals(data["quantile"].(float64), 1, 1e-13) {
+					t.Errorf("data = %v", data)
+				}
+			},
+		},
+		{
+			name:           "invalid parameter",
+			distribution:   "beta",
+			method:         http.MethodPost,
+			body:           `{"x": 0.5, "alpha": -1, "beta": 2}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_ERROR",
+		},
+		{
+			name:           "missing parameter",
+			distribution:   "poisson",
+			method:         http.MethodPost,
+			body:           `{"x": 2}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_ERROR",
+		},
+		{
+			name:           "unknown distribution",
+			distribution:   "weibull",
+			method:         http.MethodPost,
+			body:           `{"x": 2}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_ERROR",
+		},
+		{
+			name:           "wrong method",
+			distribution:   "normal",
+			method:         http.MethodGet,
+			expectedStatus: http.StatusMethodNotAllowed,
+			expectedCode:   "METHOD_NOT_ALLOWED",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, "/api/math/distribution/"+tt.distribution, bytes.NewReader([]byte(tt.body)))
+			req.SetPathValue("name", tt.distribution)
+			ctx := context.WithValue(req.Context(), middleware.RequestIDKey, "test-123")
+			req = req.WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			DistributionHandler(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("status = %d, want %d: %s", w.Code, tt.expectedStatus, w.Body.String())
+			}
+
+			if tt.expectedCode != "" {
+				var resp models.APIErrorResponse
+				json.NewDecoder(w.Body).Decode(&resp)
+				if resp.Code != tt.expectedCode {
+					t.Errorf("error code = %s, want %s", resp.Code, tt.expectedCode)
+				}
+				return
+			}
+
+			var resp models.SuccessResponse
+			json.NewDecoder(w.Body).Decode(&resp)
+			data, ok := resp.Data.(map[string]interface{})
+			if !ok {
+				t.Fatalf("expected data in response")
+			}
+			tt.checkData(t, data)
+		})
+	}
+}
@@ -16,8 +16,9 @@ func VATHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	var req models.VATRequest
-	if err := decodeJSONBody(r.Body, &req); err != nil {
-		writeErrorWithDetails(w, r, apierrors.InvalidInput("invalid request body").WithError(err))
+	rounding, apiErr := decodeJSONBody(r, &req)
+	if apiErr != nil {
+		writeErrorWithDetails(w, r, apiErr)
 		return
 	}
 
@@ -38,7 +39,7 @@ func VATHandler(w http.ResponseWriter, r *http.Request) {
 		GrossAmount: grossAmount,
 	}
 
-	if err := writeSuccessResponse(w, r, response); err != nil {
+	if err := writeSuccessResponse(w, r, response, rounding); err != nil {
 		// Error already logged, headers likely already sent
 		return
 	}
@@ -51,8 +52,9 @@ func CompoundInterestHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	var req models.CompoundInterestRequest
-	if err := decodeJSONBody(r.Body, &req); err != nil {
-		writeErrorWithDetails(w, r, apierrors.InvalidInput("invalid request body").WithError(err))
+	rounding, apiErr := decodeJSONBody(r, &req)
+	if apiErr != nil {
+		writeErrorWithDetails(w, r, apiErr)
 		return
 	}
 
@@ -66,6 +68,7 @@ func CompoundInterestHandler(w http.ResponseWriter, r *http.Request) {
 		req.Rate,
 		req.Time,
 		req.CompoundFrequency,
+		roundingOr(rounding, calculations.CurrencyRounding),
 	)
 	if err != nil {
 		writeErrorWithDetails(w, r, apierrors.InternalError("compound interest calculation failed").WithError(err))
@@ -77,7 +80,7 @@ func CompoundInterestHandler(w http.ResponseWriter, r *http.Request) {
 		InterestEarned: interestEarned,
 	}
 
-	if err := writeSuccessResponse(w, r, response); err != nil {
+	if err := writeSuccessResponse(w, r, response, rounding); err != nil {
 		// Error already logged, headers likely already sent
 		return
 	}
@@ -90,8 +93,9 @@ func LoanPaymentHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	var req models.LoanPaymentRequest
-	if err := decodeJSONBody(r.Body, &req); err != nil {
-		writeErrorWithDetails(w, r, apierrors.InvalidInput("invalid request body").WithError(err))
+	rounding, apiErr := decodeJSONBody(r, &req)
+	if apiErr != nil {
+		writeErrorWithDetails(w, r, apiErr)
 		return
 	}
 
@@ -105,6 +109,7 @@ func LoanPaymentHandler(w http.ResponseWriter, r *http.Request) {
 		req.AnnualRate,
 		req.Years,
 		req.PaymentsPerYear,
+		roundingOr(rounding, calculations.CurrencyRounding),
 	)
 	if err != nil {
 		writeErrorWithDetails(w, r, apierrors.InternalError("loan payment calculation failed").WithError(err))
@@ -117,7 +122,7 @@ func LoanPaymentHandler(w http.ResponseWriter, r *http.Request) {
 		TotalInterest: totalInterest,
 	}
 
-	if err := writeSuccessResponse(w, r, response); err != nil {
+	if err := writeSuccessResponse(w, r, response, rounding); err != nil {
 		// Error already logged, headers likely already sent
 		return
 	}
@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	apierrors "github.com/m-szczepanski/gocalc-api/internal/errors"
+	"github.com/m-szczepanski/gocalc-api/internal/models"
+	"github.com/m-szczepanski/gocalc-api/internal/validation"
+	"github.com/m-szczepanski/gocalc-api/pkg/calculations"
+	"github.com/m-szczepanski/gocalc-api/pkg/calculations/geodesy"
+)
+
+// NewGeoDistanceHandler returns a handler for /api/utils/geo-distance. A batch
+// request may hold at most maxPairs pairs.
+func NewGeoDistanceHandler(maxPairs int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if err := validation.ValidateMethod(r.Method, http.MethodPost); err != nil {
+			writeErrorWithDetails(w, r, err)
+			return
+		}
+
+		var req models.GeoDistanceRequest
+		rounding, apiErr := decodeJSONBody(r, &req)
+		if apiErr != nil {
+			writeErrorWithDetails(w, r, apiErr)
+			return
+		}
+
+		if err := validation.ValidateGeoDistanceRequest(&req, maxPairs); err != nil {
+			writeErrorWithDetails(w, r, err)
+			return
+		}
+
+		unit := string(calculations.DistanceMeter)
+		if strings.TrimSpace(req.Unit) != "" {
+			resolved, _ := calculations.ResolveDistanceUnit(req.Unit)
+			unit = resolved.Symbol
+		}
+		// metres is the number of metres in one unit.
+		metres, _ := calculations.DistanceScale(unit, string(calculations.DistanceMeter))
+
+		var response interface{}
+		switch {
+		case len(req.Pairs) > 0:
+			results := make([]models.GeoDistance, len(req.Pairs))
+			for i, pair := range req.Pairs {
+				results[i] = geoDistance(pair.From, pair.To, metres)
+			}
+			response = models.GeoDistanceBatchResponse{Results: results, Unit: unit}
+		case req.To != nil:
+			response = models.GeoDistanceResponse{GeoDistance: geoDistance(*req.From, *req.To, metres), Unit: unit}
+		default:
+			destination := geoDestination(*req.From, *req.Bearing, *req.Distance, metres, unit)
+			if !finite(destination.Haversine.Point.Lat, destination.Haversine.Point.Lon) {
+				writeErrorWithDetails(w, r, apierrors.DomainError("distance outside representable range").
+					WithDetails("distance is too large to locate a destination"))
+				return
+			}
+			response = destination
+		}
+
+		if err := writeSuccessResponse(w, r, response, rounding); err != nil {
+			// Error already logged, headers likely already sent
+			return
+		}
+	}
+}
+
+func geoDistance(from, to models.GeoPoint, metres float64) models.GeoDistance {
+	p, q := geodesy.Point{Lat: from.Lat, Lon: from.Lon}, geodesy.Point{Lat: to.Lat, Lon: to.Lon}
+	midpoint := geodesy.Midpoint(p, q)
+
+	result := models.GeoDistance{
+		From:      from,
+		To:        to,
+		Haversine: geoPath(geodesy.Haversine(p, q), metres),
+		Midpoint:  models.GeoPoint{Lat: midpoint.Lat, Lon: midpoint.Lon},
+	}
+	if path, err := geodesy.Vincenty(p, q); err == nil {
+		vincenty := geoPath(path, metres)
+		result.Vincenty = &vincenty
+	}
+	return result
+}
+
+func geoPath(path geodesy.Path, metres float64) models.GeoPath {
+	return models.GeoPath{
+		Distance:       path.Distance / metres,
+		InitialBearing: path.InitialBearing,
+		FinalBearing:   path.FinalBearing,
+	}
+}
+
+func geoDestination(from models.GeoPoint, bearing, distance, metres float64, unit string) models.GeoDestinationResponse {
+	p := geodesy.Point{Lat: from.Lat, Lon: from.Lon}
+	dest, final := geodesy.Destination(p, bearing, distance*metres)
+
+	response := models.GeoDestinationResponse{
+		From:     from,
+		Bearing:  bearing,
+		Distance: distance,
+		Haversine: models.GeoDestination{
+			Point:        models.GeoPoint{Lat: dest.Lat, Lon: dest.Lon},
+			FinalBearing: final,
+		},
+		Unit: unit,
+	}
+	if dest, final, err := geodesy.VincentyDestination(p, bearing, distance*metres); err == nil && finite(dest.Lat, dest.Lon) {
+		response.Vincenty = &models.GeoDestination{
+			Point:        models.GeoPoint{Lat: dest.Lat, Lon: dest.Lon},
+			FinalBearing: final,
+		}
+	}
+	return response
+}
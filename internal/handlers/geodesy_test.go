@@ -0,0 +1,161 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/m-szczepanski/gocalc-api/internal/middleware"
+	"github.com/m-szczepanski/gocalc-api/internal/models"
+)
+
+func TestGeoDistanceHandler(t *testing.T) {
+	handler := NewGeoDistanceHandler(10)
+
+	tests := []struct {
+		name           string
+		method         string
+		body           string
+		expectedStatus int
+		expectedCode   string
+		checkData      func(t *testing.T, data map[string]interface{})
+	}{
+		{
+			name:           "distance in kilometres",
+			method:         http.MethodPost,
+			body:           `{"from": {"lat": 0, "lon": 0}, "to": {"lat": 0, "lon": 1}, "unit": "km"}`,
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				vincenty := data["vincenty"].(map[string]interface{})
+				if !floatEquals(vincenty["distance"].(float64), 111.31949079327357, 1e-9) || vincenty["initial_bearing"] != float64(90) {
+					t.Errorf("vincenty = %v", vincenty)
+				}
+				haversine := data["haversine"].(map[string]interface{})
+				if !floatEquals(haversine["distance"].(float64), 111.1950802335329, 1e-9) {
+					t.Errorf("haversine = %v", haversine)
+				}
+				midpoint := data["midpoint"].(map[string]interface{})
+				if !floatEquals(midpoint["lon"].(float64), 0.5, 1e-12) || data["unit"] != "km" {
+					t.Errorf("data = %v", data)
+				}
+			},
+		},
+		{
+			name:           "prefixed unit keeps its case",
+			method:         http.MethodPost,
+			body:           `{"from": {"lat": 0, "lon": 0}, "to": {"lat": 0, "lon": 1}, "unit": "Mm"}`,
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				vincenty := data["vincenty"].(map[string]interface{})
+				if !floatEquals(vincenty["distance"].(float64), 0.11131949079327357, 1e-12) || data["unit"] != "Mm" {
+					t.Errorf("vincenty = %v, unit = %v", vincenty, data["unit"])
+				}
+			},
+		},
+		{
+			name:           "unit named in words",
+			method:         http.MethodPost,
+			body:           `{"from": {"lat": 0, "lon": 0}, "to": {"lat": 0, "lon": 1}, "unit": "kilometres"}`,
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				if data["unit"] != "km" {
+					t.Errorf("unit = %v, want km", data["unit"])
+				}
+			},
+		},
+		{
+			name:           "nearly antipodal points",
+			method:         http.MethodPost,
+			body:           `{"from": {"lat": 0, "lon": 0}, "to": {"lat": 0.5, "lon": 179.7}}`,
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				if data["vincenty"] != nil || data["unit"] != "m" {
+					t.Errorf("vincenty = %v, unit = %v", data["vincenty"], data["unit"])
+				}
+			},
+		},
+		{
+			name:           "batch of pairs",
+			method:         http.MethodPost,
+			body:           `{"pairs": [{"from": {"lat": 0, "lon": 0}, "to": {"lat": 0, "lon": 90}}, {"from": {"lat": 10, "lon": 10}, "to": {"lat": 10, "lon": 10}}], "unit": "mi"}`,
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				results := data["results"].([]interface{})
+				if len(results) != 2 {
+					t.Fatalf("results = %v", results)
+				}
+				second := results[1].(map[string]interface{})["vincenty"].(map[string]interface{})
+				if second["distance"] != float64(0) {
+					t.Errorf("coincident distance = %v", second["distance"])
+				}
+			},
+		},
+		{
+			name:           "destination",
+			method:         http.MethodPost,
+			body:           `{"from": {"lat": 0, "lon": 0}, "bearing": 90, "distance": 111.31949079327357, "unit": "km"}`,
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				point := data["vincenty"].(map[string]interface{})["point"].(map[string]interface{})
+				if !floatEquals(point["lat"].(float64), 0, 1e-12) || !floatEquals(point["lon"].(float64), 1, 1e-9) {
+					t.Errorf("vincenty point = %v", point)
+				}
+			},
+		},
+		{
+			name:           "destination too far away",
+			method:         http.MethodPost,
+			body:           `{"from": {"lat": 0, "lon": 0}, "bearing": 90, "distance": 1e308, "unit": "mi"}`,
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedCode:   "DOMAIN_ERROR",
+		},
+		{
+			name:           "latitude out of range",
+			method:         http.MethodPost,
+			body:           `{"from": {"lat": 95, "lon": 0}, "to": {"lat": 0, "lon": 0}}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_ERROR",
+		},
+		{
+			name:           "wrong method",
+			method:         http.MethodGet,
+			expectedStatus: http.StatusMethodNotAllowed,
+			expectedCode:   "METHOD_NOT_ALLOWED",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, "/api/utils/geo-distance", bytes.NewReader([]byte(tt.body)))
+			ctx := context.WithValue(req.Context(), middleware.RequestIDKey, "test-123")
+			req = req.WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			handler(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("status = %d, want %d: %s", w.Code, tt.expectedStatus, w.Body.String())
+			}
+
+			if tt.expectedCode != "" {
+				var resp models.APIErrorResponse
+				json.NewDecoder(w.Body).Decode(&resp)
+				if resp.Code != tt.expectedCode {
+					t.Errorf("error code = %s, want %s", resp.Code, tt.expectedCode)
+				}
+				return
+			}
+
+			var resp models.SuccessResponse
+			json.NewDecoder(w.Body).Decode(&resp)
+			data, ok := resp.Data.(map[string]interface{})
+			if !ok {
+				t.Fatalf("expected data in response")
+			}
+			tt.checkData(t, data)
+		})
+	}
+}
@@ -0,0 +1,357 @@
+package handlers
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strings"
+
+	apierrors "github.com/m-szczepanski/gocalc-api/internal/errors"
+	"github.com/m-szczepanski/gocalc-api/internal/models"
+	"github.com/m-szczepanski/gocalc-api/internal/validation"
+	"github.com/m-szczepanski/gocalc-api/pkg/calculations"
+	"github.com/m-szczepanski/gocalc-api/pkg/calculations/geometry"
+)
+
+var vectorOperations = []string{"dot", "cross", "norm", "angle", "projection", "distance"}
+
+var geometryShapes = []string{"polygon", "circle", "triangle", "solid"}
+
+// outOfRange reports a result that overflows when converted to the output
+// unit.
+func outOfRange() *apierrors.APIError {
+	return apierrors.DomainError("result outside representable range").
+		WithDetails("the result cannot be represented in the requested output unit")
+}
+
+// lengthScale returns the factor that converts lengths in the request to the
+// response unit, and the name of that unit.
+func lengthScale(u models.LengthUnits) (float64, string) {
+	if u.Unit == "" {
+		return 1, ""
+	}
+	unit := u.OutputUnit
+	if unit == "" {
+		unit = u.Unit
+	}
+	scale, _ := calculations.DistanceScale(u.Unit, unit)
+	resolved, _ := calculations.ResolveDistanceUnit(unit)
+	return scale, resolved.Symbol
+}
+
+func finite(values ...float64) bool {
+	for _, v := range values {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return false
+		}
+	}
+	return true
+}
+
+func scaleVector(v geometry.Vector, scale float64) []float64 {
+	scaled := make([]float64, len(v))
+	for i, x := range v {
+		scaled[i] = x * scale
+	}
+	return scaled
+}
+
+func VectorHandler(w http.ResponseWriter, r *http.Request) {
+	if err := validation.ValidateMethod(r.Method, http.MethodPost); err != nil {
+		writeErrorWithDetails(w, r, err)
+		return
+	}
+
+	operation := r.PathValue("operation")
+	if !isVectorOperation(operation) {
+		writeErrorWithDetails(w, r, apierrors.ValidationError(
+			"invalid operation",
+			fmt.Sprintf("operation must be one of %v, got %q", vectorOperations, operation),
+		))
+		return
+	}
+
+	var req models.VectorRequest
+	rounding, apiErr := decodeJSONBody(r, &req)
+	if apiErr != nil {
+		writeErrorWithDetails(w, r, apiErr)
+		return
+	}
+
+	if err := validation.ValidateVectorRequest(&req, operation != "norm"); err != nil {
+		writeErrorWithDetails(w, r, err)
+		return
+	}
+
+	a, b := geometry.Vector(req.A), geometry.Vector(req.B)
+	scale, unit := lengthScale(req.LengthUnits)
+	response := models.VectorResponse{Operation: operation, Unit: unit}
+
+	var value float64
+	var vector geometry.Vector
+	var err error
+	switch operation {
+	case "dot":
+		value, err = geometry.Dot(a, b)
+		value *= scale * scale
+	case "cross":
+		vector, err = geometry.Cross(a, b)
+		vector = scaleVector(vector, scale*scale)
+	case "norm":
+		value = geometry.Norm(a) * scale
+	case "angle":
+		mode, _ := calculations.ParseAngleMode(req.AngleMode)
+		value, err = geometry.Angle(a, b)
+		value = calculations.FromRadians(value, mode)
+		response.AngleMode = string(mode)
+		response.Unit = ""
+	case "projection":
+		vector, value, err = geometry.Project(a, b)
+		vector = scaleVector(vector, scale)
+		value *= scale
+	case "distance":
+		value, err = geometry.Distance(a, b)
+		value *= scale
+	}
+
+	if err != nil {
+		writeErrorWithDetails(w, r, calculationError(err))
+		return
+	}
+	if !finite(value) || !finite(vector...) {
+		writeErrorWithDetails(w, r, outOfRange())
+		return
+	}
+
+	if operation != "cross" {
+		response.Value = &value
+	}
+	if vector != nil {
+		response.Vector = vector
+	}
+	if err := writeSuccessResponse(w, r, response, rounding); err != nil {
+		// Error already logged, headers likely already sent
+		return
+	}
+}
+
+func isVectorOperation(operation string) bool {
+	for _, op := range vectorOperations {
+		if op == operation {
+			return true
+		}
+	}
+	return false
+}
+
+// NewGeometryHandler returns a handler for /api/math/geometry/{shape}. A
+// polygon may have at most maxPoints vertices.
+func NewGeometryHandler(maxPoints int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if err := validation.ValidateMethod(r.Method, http.MethodPost); err != nil {
+			writeErrorWithDetails(w, r, err)
+			return
+		}
+
+		switch shape := r.PathValue("shape"); shape {
+		case "polygon":
+			polygon(w, r, maxPoints)
+		case "circle":
+			circle(w, r)
+		case "triangle":
+			triangle(w, r)
+		case "solid":
+			solid(w, r)
+		default:
+			writeErrorWithDetails(w, r, apierrors.ValidationError(
+				"invalid shape",
+				fmt.Sprintf("shape must be one of %v, got %q", geometryShapes, shape),
+			))
+		}
+	}
+}
+
+func polygon(w http.ResponseWriter, r *http.Request, maxPoints int) {
+	var req models.PolygonRequest
+	rounding, apiErr := decodeJSONBody(r, &req)
+	if apiErr != nil {
+		writeErrorWithDetails(w, r, apiErr)
+		return
+	}
+
+	if err := validation.ValidatePolygonRequest(&req, maxPoints); err != nil {
+		writeErrorWithDetails(w, r, err)
+		return
+	}
+
+	vertices := make([]geometry.Vector, len(req.Points))
+	for i, p := range req.Points {
+		vertices[i] = p
+	}
+	measures, err := geometry.Polygon(vertices)
+	if err != nil {
+		writeErrorWithDetails(w, r, calculationError(err))
+		return
+	}
+
+	scale, unit := lengthScale(req.LengthUnits)
+	response := models.PolygonResponse{
+		Area:        measures.Area * scale * scale,
+		Perimeter:   measures.Perimeter * scale,
+		Orientation: string(measures.Orientation),
+		Vertices:    len(vertices),
+		Unit:        unit,
+	}
+	if !finite(response.Area, response.Perimeter) {
+		writeErrorWithDetails(w, r, outOfRange())
+		return
+	}
+	if err := writeSuccessResponse(w, r, response, rounding); err != nil {
+		// Error already logged, headers likely already sent
+		return
+	}
+}
+
+func circle(w http.ResponseWriter, r *http.Request) {
+	var req models.CircleRequest
+	rounding, apiErr := decodeJSONBody(r, &req)
+	if apiErr != nil {
+		writeErrorWithDetails(w, r, apiErr)
+		return
+	}
+
+	if err := validation.ValidateCircleRequest(&req); err != nil {
+		writeErrorWithDetails(w, r, err)
+		return
+	}
+
+	scale, unit := lengthScale(req.LengthUnits)
+	radius := *req.Radius * scale
+	measures, err := geometry.Circle(radius)
+	if err != nil {
+		writeErrorWithDetails(w, r, calculationError(err))
+		return
+	}
+
+	response := models.CircleResponse{
+		Radius:        radius,
+		Diameter:      measures.Diameter,
+		Circumference: measures.Circumference,
+		Area:          measures.Area,
+		Unit:          unit,
+	}
+	if !finite(response.Diameter, response.Circumference, response.Area) {
+		writeErrorWithDetails(w, r, outOfRange())
+		return
+	}
+	if err := writeSuccessResponse(w, r, response, rounding); err != nil {
+		// Error already logged, headers likely already sent
+		return
+	}
+}
+
+func triangle(w http.ResponseWriter, r *http.Request) {
+	var req models.TriangleRequest
+	rounding, apiErr := decodeJSONBody(r, &req)
+	if apiErr != nil {
+		writeErrorWithDetails(w, r, apiErr)
+		return
+	}
+
+	if err := validation.ValidateTriangleRequest(&req); err != nil {
+		writeErrorWithDetails(w, r, err)
+		return
+	}
+
+	mode, _ := calculations.ParseAngleMode(req.AngleMode)
+	angle := func(x *float64) float64 { return calculations.ToRadians(*x, mode) }
+
+	method := strings.ToLower(strings.TrimSpace(req.Method))
+	var t geometry.Triangle
+	var err error
+	switch method {
+	case "sss":
+		t, err = geometry.SolveSSS(*req.A, *req.B, *req.C)
+	case "sas":
+		t, err = geometry.SolveSAS(*req.A, *req.B, angle(req.Gamma))
+	case "asa":
+		t, err = geometry.SolveASA(angle(req.Alpha), *req.C, angle(req.Beta))
+	}
+	if err != nil {
+		writeErrorWithDetails(w, r, calculationError(err))
+		return
+	}
+
+	scale, unit := lengthScale(req.LengthUnits)
+	response := models.TriangleResponse{
+		Method:    method,
+		A:         t.A * scale,
+		B:         t.B * scale,
+		C:         t.C * scale,
+		Alpha:     calculations.FromRadians(t.Alpha, mode),
+		Beta:      calculations.FromRadians(t.Beta, mode),
+		Gamma:     calculations.FromRadians(t.Gamma, mode),
+		Area:      t.Area * scale * scale,
+		Perimeter: t.Perimeter * scale,
+		AngleMode: string(mode),
+		Unit:      unit,
+	}
+	if !finite(response.A, response.B, response.C, response.Area, response.Perimeter) {
+		writeErrorWithDetails(w, r, outOfRange())
+		return
+	}
+	if err := writeSuccessResponse(w, r, response, rounding); err != nil {
+		// Error already logged, headers likely already sent
+		return
+	}
+}
+
+func solid(w http.ResponseWriter, r *http.Request) {
+	var req models.SolidRequest
+	rounding, apiErr := decodeJSONBody(r, &req)
+	if apiErr != nil {
+		writeErrorWithDetails(w, r, apiErr)
+		return
+	}
+
+	if err := validation.ValidateSolidRequest(&req); err != nil {
+		writeErrorWithDetails(w, r, err)
+		return
+	}
+
+	s, _ := geometry.ParseSolid(req.Solid)
+	var d geometry.Dimensions
+	for _, field := range []struct {
+		value *float64
+		dst   *float64
+	}{
+		{req.Radius, &d.Radius}, {req.MinorRadius, &d.MinorRadius}, {req.Side, &d.Side},
+		{req.Length, &d.Length}, {req.Width, &d.Width}, {req.Height, &d.Height},
+	} {
+		if field.value != nil {
+			*field.dst = *field.value
+		}
+	}
+
+	volume, surfaceArea, err := geometry.SolidMeasures(s, d)
+	if err != nil {
+		writeErrorWithDetails(w, r, calculationError(err))
+		return
+	}
+
+	scale, unit := lengthScale(req.LengthUnits)
+	response := models.SolidResponse{
+		Solid:       string(s),
+		Volume:      volume * scale * scale * scale,
+		SurfaceArea: surfaceArea * scale * scale,
+		Unit:        unit,
+	}
+	if !finite(response.Volume, response.SurfaceArea) {
+		writeErrorWithDetails(w, r, outOfRange())
+		return
+	}
+	if err := writeSuccessResponse(w, r, response, rounding); err != nil {
+		// Error already logged, headers likely already sent
+		return
+	}
+}
@@ -0,0 +1,261 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/m-szczepanski/gocalc-api/internal/middleware"
+	"github.com/m-szczepanski/gocalc-api/internal/models"
+)
+
+func TestGeometryHandlers(t *testing.T) {
+	tests := []struct {
+		name           string
+		handler        http.HandlerFunc
+		pathKey        string
+		pathValue      string
+		method         string
+		body           string
+		expectedStatus int
+		expectedCode   string
+		checkData      func(t *testing.T, data map[string]interface{})
+	}{
+		{
+			name:           "cross product of 2D vectors",
+			handler:        VectorHandler,
+			pathKey:        "operation",
+			pathValue:      "cross",
+			method:         http.MethodPost,
+			body:           `{"a": [2, 1], "b": [1, 3]}`,
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				vector := data["vector"].([]interface{})
+				if len(vector) != 3 || vector[2] != float64(5) {
+					t.Errorf("vector = %v, want [0 0 5]", vector)
+				}
+				if _, ok := data["value"]; ok {
+					t.Errorf("unexpected value %v", data["value"])
+				}
+			},
+		},
+		{
+			name:           "angle in degrees",
+			handler:        VectorHandler,
+			pathKey:        "operation",
+			pathValue:      "angle",
+			method:         http.MethodPost,
+			body:           `{"a": [1, 0, 0], "b": [1, 1, 0], "angle_mode": "degrees", "unit": "m"}`,
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				if !floatEquals(data["value"].(float64), 45, 1e-12) || data["angle_mode"] != "degrees" {
+					t.Errorf("data = %v, want 45 degrees", data)
+				}
+				if _, ok := data["unit"]; ok {
+					t.Errorf("angle should not report a unit, got %v", data["unit"])
+				}
+			},
+		},
+		{
+			name:           "projection",
+			handler:        VectorHandler,
+			pathKey:        "operation",
+			pathValue:      "projection",
+			method:         http.MethodPost,
+			body:           `{"a": [3, 4], "b": [2, 0]}`,
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				vector := data["vector"].([]interface{})
+				if data["value"] != float64(3) || vector[0] != float64(3) || vector[1] != float64(0) {
+					t.Errorf("data = %v", data)
+				}
+			},
+		},
+		{
+			name:           "distance converted to feet",
+			handler:        VectorHandler,
+			pathKey:        "operation",
+			pathValue:      "distance",
+			method:         http.MethodPost,
+			body:           `{"a": [0, 0], "b": [3, 4], "unit": "m", "output_unit": "ft"}`,
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				if !floatEquals(data["value"].(float64), 5/0.3048, 1e-9) || data["unit"] != "ft" {
+					t.Errorf("data = %v, want 16.4042 ft", data)
+				}
+			},
+		},
+		{
+			name:           "norm in prefixed unit keeps its case",
+			handler:        VectorHandler,
+			pathKey:        "operation",
+			pathValue:      "norm",
+			method:         http.MethodPost,
+			body:           `{"a": [3, 4], "unit": "m", "output_unit": "Mm"}`,
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				if !floatEquals(data["value"].(float64), 5e-6, 1e-15) || data["unit"] != "Mm" {
+					t.Errorf("data = %v, want 5e-06 Mm", data)
+				}
+			},
+		},
+		{
+			name:           "angle with zero vector",
+			handler:        VectorHandler,
+			pathKey:        "operation",
+			pathValue:      "angle",
+			method:         http.MethodPost,
+			body:           `{"a": [0, 0], "b": [1, 0]}`,
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedCode:   "DOMAIN_ERROR",
+		},
+		{
+			name:           "unknown vector operation",
+			handler:        VectorHandler,
+			pathKey:        "operation",
+			pathValue:      "curl",
+			method:         http.MethodPost,
+			body:           `{"a": [1, 0]}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_ERROR",
+		},
+		{
+			name:           "polygon area in square kilometres",
+			handler:        NewGeometryHandler(10),
+			pathKey:        "shape",
+			pathValue:      "polygon",
+			method:         http.MethodPost,
+			body:           `{"points": [[0, 0], [0, 1000], [2000, 1000], [2000, 0]], "unit": "m", "output_unit": "km"}`,
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				if !floatEquals(data["area"].(float64), 2, 1e-12) || !floatEquals(data["perimeter"].(float64), 6, 1e-12) {
+					t.Errorf("area = %v, perimeter = %v", data["area"], data["perimeter"])
+				}
+				if data["orientation"] != "clockwise" || data["vertices"] != float64(4) || data["unit"] != "km" {
+					t.Errorf("data = %v", data)
+				}
+			},
+		},
+		{
+			name:           "polygon over the vertex limit",
+			handler:        NewGeometryHandler(3),
+			pathKey:        "shape",
+			pathValue:      "polygon",
+			method:         http.MethodPost,
+			body:           `{"points": [[0, 0], [1, 0], [1, 1], [0, 1]]}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_ERROR",
+		},
+		{
+			name:           "circle",
+			handler:        NewGeometryHandler(10),
+			pathKey:        "shape",
+			pathValue:      "circle",
+			method:         http.MethodPost,
+			body:           `{"radius": 1}`,
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				if data["area"] != math.Pi || data["diameter"] != float64(2) {
+					t.Errorf("data = %v", data)
+				}
+			},
+		},
+		{
+			name:           "triangle from two sides and included angle",
+			handler:        NewGeometryHandler(10),
+			pathKey:        "shape",
+			pathValue:      "triangle",
+			method:         http.MethodPost,
+			body:           `{"method": "sas", "a": 3, "b": 4, "gamma": 90, "angle_mode": "degrees"}`,
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				if !floatEquals(data["c"].(float64), 5, 1e-12) || !floatEquals(data["area"].(float64), 6, 1e-12) {
+					t.Errorf("c = %v, area = %v", data["c"], data["area"])
+				}
+				if !floatEquals(data["alpha"].(float64)+data["beta"].(float64), 90, 1e-12) || data["angle_mode"] != "degrees" {
+					t.Errorf("data = %v", data)
+				}
+			},
+		},
+		{
+			name:           "impossible triangle",
+			handler:        NewGeometryHandler(10),
+			pathKey:        "shape",
+			pathValue:      "triangle",
+			method:         http.MethodPost,
+			body:           `{"method": "sss", "a": 1, "b": 2, "c": 3}`,
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedCode:   "DOMAIN_ERROR",
+		},
+		{
+			name:           "cube volume in cubic metres",
+			handler:        NewGeometryHandler(10),
+			pathKey:        "shape",
+			pathValue:      "solid",
+			method:         http.MethodPost,
+			body:           `{"solid": "cube", "side": 1, "unit": "km", "output_unit": "m"}`,
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				if data["volume"] != 1e9 || data["surface_area"] != 6e6 || data["unit"] != "m" {
+					t.Errorf("data = %v", data)
+				}
+			},
+		},
+		{
+			name:           "unknown shape",
+			handler:        NewGeometryHandler(10),
+			pathKey:        "shape",
+			pathValue:      "hexagon",
+			method:         http.MethodPost,
+			body:           `{}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_ERROR",
+		},
+		{
+			name:           "wrong method",
+			handler:        NewGeometryHandler(10),
+			pathKey:        "shape",
+			pathValue:      "circle",
+			method:         http.MethodGet,
+			expectedStatus: http.StatusMethodNotAllowed,
+			expectedCode:   "METHOD_NOT_ALLOWED",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, "/api/math/geometry/"+tt.pathValue, bytes.NewReader([]byte(tt.body)))
+			req.SetPathValue(tt.pathKey, tt.pathValue)
+			ctx := context.WithValue(req.Context(), middleware.RequestIDKey, "test-123")
+			req = req.WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			tt.handler(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("status = %d, want %d: %s", w.Code, tt.expectedStatus, w.Body.String())
+			}
+
+			if tt.expectedCode != "" {
+				var resp models.APIErrorResponse
+				json.NewDecoder(w.Body).Decode(&resp)
+				if resp.Code != tt.expectedCode {
+					t.Errorf("error code = %s, want %s", resp.Code, tt.expectedCode)
+				}
+				return
+			}
+
+			var resp models.SuccessResponse
+			json.NewDecoder(w.Body).Decode(&resp)
+			data, ok := resp.Data.(map[string]interface{})
+			if !ok {
+				t.Fatalf("expected data in response")
+			}
+			tt.checkData(t, data)
+		})
+	}
+}
@@ -40,7 +40,7 @@ func HealthHandler(w http.ResponseWriter, r *http.Request) {
 		Checks:    checks,
 	}
 
-	if err := writeSuccessResponse(w, r, response); err != nil {
+	if err := writeSuccessResponse(w, r, response, nil); err != nil {
 		return
 	}
 }
@@ -54,7 +54,7 @@ func ReadinessHandler(w http.ResponseWriter, r *http.Request) {
 		Status: "ready",
 	}
 
-	if err := writeSuccessResponse(w, r, response); err != nil {
+	if err := writeSuccessResponse(w, r, response, nil); err != nil {
 		return
 	}
 }
@@ -0,0 +1,159 @@
+package handlers
+
+import (
+	"math"
+	"net/http"
+	"strings"
+
+	"github.com/m-szczepanski/gocalc-api/internal/models"
+	"github.com/m-szczepanski/gocalc-api/internal/validation"
+	"github.com/m-szczepanski/gocalc-api/pkg/calculations/distribution"
+	"github.com/m-szczepanski/gocalc-api/pkg/calculations/hypothesis"
+)
+
+// NewHypothesisTestHandler returns a handler for /api/math/hypothesis-test/{test}
+// accepting samples of at most maxValues values.
+func NewHypothesisTestHandler(maxValues int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if err := validation.ValidateMethod(r.Method, http.MethodPost); err != nil {
+			writeErrorWithDetails(w, r, err)
+			return
+		}
+
+		var req models.HypothesisTestRequest
+		rounding, apiErr := decodeJSONBody(r, &req)
+		if apiErr != nil {
+			writeErrorWithDetails(w, r, apiErr)
+			return
+		}
+
+		if err := validation.ValidateHypothesisTestRequest(r.PathValue("test"), &req, maxValues); err != nil {
+			writeErrorWithDetails(w, r, err)
+			return
+		}
+
+		test, _ := hypothesis.ParseTest(r.PathValue("test"))
+		alt, _ := hypothesis.ParseAlternative(req.Alternative)
+		confidence := hypothesis.DefaultConfidence
+		if req.Confidence != nil {
+			confidence = *req.Confidence
+		}
+
+		var result *hypothesis.Result
+		var err error
+		switch test {
+		case hypothesis.OneSample:
+			result, err = hypothesis.OneSampleT(req.X, req.Mu, alt, confidence)
+		case hypothesis.TwoSample, hypothesis.Welch:
+			result, err = hypothesis.TwoSampleT(req.X, req.Y, req.Mu, test == hypothesis.Welch, alt, confidence)
+		case hypothesis.Paired:
+			result, err = hypothesis.PairedT(req.X, req.Y, req.Mu, alt, confidence)
+		case hypothesis.ChiSquareGoodnessOfFit:
+			result, err = hypothesis.ChiSquareGOF(req.Observed, req.Expected)
+		case hypothesis.ChiSquareIndependent:
+			result, err = hypothesis.ChiSquareIndependence(req.Table)
+		case hypothesis.TwoProportion:
+			result, err = hypothesis.TwoProportionZ(req.Successes[0], req.Trials[0], req.Successes[1], req.Trials[1], alt, confidence)
+		}
+		if err != nil {
+			writeErrorWithDetails(w, r, calculationError(err))
+			return
+		}
+		if math.IsNaN(result.PValue) {
+			writeErrorWithDetails(w, r, distributionError(distribution.ErrNoConvergence))
+			return
+		}
+
+		response := models.HypothesisTestResponse{
+			Test:       string(test),
+			Statistic:  result.Statistic,
+			DF:         finitePtr(result.DF),
+			PValue:     result.PValue,
+			EffectSize: models.EffectSize{Measure: result.Effect.Measure, Value: result.Effect.Value},
+			Estimate:   finitePtr(result.Estimate),
+			Warnings:   result.Warnings,
+		}
+		// Chi-square tests are upper-tailed by construction.
+		if !strings.HasPrefix(string(test), "chi-square") {
+			response.Alternative = string(alt)
+		}
+		if result.Interval != nil {
+			ci := confidenceInterval(*result.Interval)
+			response.ConfidenceInterval = &ci
+		}
+		if err := writeSuccessResponse(w, r, response, rounding); err != nil {
+			// Error already logged, headers likely already sent
+			return
+		}
+	}
+}
+
+// NewConfidenceIntervalHandler returns a handler for
+// /api/math/confidence-interval/{parameter} accepting samples of at most
+// maxValues values.
+func NewConfidenceIntervalHandler(maxValues int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if err := validation.ValidateMethod(r.Method, http.MethodPost); err != nil {
+			writeErrorWithDetails(w, r, err)
+			return
+		}
+
+		var req models.ConfidenceIntervalRequest
+		rounding, apiErr := decodeJSONBody(r, &req)
+		if apiErr != nil {
+			writeErrorWithDetails(w, r, apiErr)
+			return
+		}
+
+		if err := validation.ValidateConfidenceIntervalRequest(r.PathValue("parameter"), &req, maxValues); err != nil {
+			writeErrorWithDetails(w, r, err)
+			return
+		}
+
+		confidence := hypothesis.DefaultConfidence
+		if req.Confidence != nil {
+			confidence = *req.Confidence
+		}
+
+		parameter := strings.ToLower(strings.TrimSpace(r.PathValue("parameter")))
+		method := "t"
+		var estimate *hypothesis.Estimate
+		var err error
+		if parameter == "mean" {
+			estimate, err = hypothesis.MeanCI(req.X, confidence)
+		} else {
+			m, _ := hypothesis.ParseProportionMethod(req.Method)
+			method = string(m)
+			estimate, err = hypothesis.ProportionCI(*req.Successes, *req.Trials, confidence, m)
+		}
+		if err != nil {
+			writeErrorWithDetails(w, r, calculationError(err))
+			return
+		}
+		if math.IsNaN(estimate.Interval.Lower) || math.IsNaN(estimate.Interval.Upper) {
+			writeErrorWithDetails(w, r, distributionError(distribution.ErrNoConvergence))
+			return
+		}
+
+		if err := writeSuccessResponse(w, r, models.ConfidenceIntervalResponse{
+			Parameter:          parameter,
+			Method:             method,
+			Estimate:           estimate.Value,
+			StandardError:      estimate.StandardError,
+			DF:                 finitePtr(estimate.DF),
+			ConfidenceInterval: confidenceInterval(estimate.Interval),
+		}, rounding); err != nil {
+			// Error already logged, headers likely already sent
+			return
+		}
+	}
+}
+
+// confidenceInterval converts an interval, reporting infinite bounds as null.
+func confidenceInterval(in hypothesis.Interval) models.ConfidenceInterval {
+	return models.ConfidenceInterval{
+		Lower:      finitePtr(in.Lower),
+		Upper:      finitePtr(in.Upper),
+		Confidence: in.Confidence,
+	}
+}
@@ -0,0 +1,235 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/m-szczepanski/gocalc-api/internal/middleware"
+	"github.com/m-szczepanski/gocalc-api/internal/models"
+)
+
+const sleepData = `"x": [0.7, -1.6, -0.2, -1.2, -0.1, 3.4, 3.7, 0.8, 0.0, 2.0], "y": [1.9, 0.8, 1.1, 0.1, -0.1, 4.4, 5.5, 1.6, 4.6, 3.4]`
+
+func TestHypothesisTestHandler(t *testing.T) {
+	tests := []struct {
+		name           string
+		test           string
+		method         string
+		body           string
+		expectedStatus int
+		expectedCode   string
+		checkData      func(t *testing.T, data map[string]interface{})
+	}{
+		{
+			name:           "paired t-test",
+			test:           "paired-t",
+			method:         http.MethodPost,
+			body:           `{` + sleepData + `}`,
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				if !floatEquals(data["statistic"].(float64), -4.062128, 1e-6) || data["df"] != float64(9) {
+					t.Errorf("data = %v", data)
+				}
+				if !floatEquals(data["p_value"].(float64), 0.002832890, 1e-9) || data["alternative"] != "two-sided" {
+					t.Errorf("data = %v", data)
+				}
+				effect := data["effect_size"].(map[string]interface{})
+				if effect["measure"] != "cohens_dz" {
+					t.Errorf("effect_size = %v", effect)
+				}
+				ci := data["confidence_interval"].(map[string]interface{})
+				if !floatEquals(ci["lower"].(float64), -2.4598858, 1e-6) || !floatEquals(ci["upper"].(float64), -0.7001142, 1e-6) {
+					t.Errorf("confidence_interval = %v", ci)
+				}
+			},
+		},
+		{
+			name:           "one-sided interval has a null bound",
+			test:           "one-sample-t",
+			method:         http.MethodPost,
+			body:           `{"x": [1.9, 0.8, 1.1, 0.1, -0.1, 4.4, 5.5, 1.6, 4.6, 3.4], "mu": 1, "alternative": "greater", "confidence": 0.9}`,
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				ci := data["confidence_interval"].(map[string]interface{})
+				if ci["upper"] != nil || ci["lower"] == nil || ci["confidence"] != 0.9 {
+					t.Errorf("confidence_interval = %v", ci)
+				}
+			},
+		},
+		{
+			name:           "chi-square independence",
+			test:           "chi-square-independence",
+			method:         http.MethodPost,
+			body:           `{"table": [[10, 20], [30, 40]]}`,
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				if data["df"] != float64(1) || data["effect_size"].(map[string]interface{})["measure"] != "cramers_v" {
+					t.Errorf("data = %v", data)
+				}
+				for _, key := range []string{"alternative", "estimate", "confidence_interval"} {
+					if _, ok := data[key]; ok {
+						t.Errorf("unexpected %s in %v", key, data)
+					}
+				}
+			},
+		},
+		{
+			name:           "two-proportion z-test has no df",
+			test:           "two-proportion-z",
+			method:         http.MethodPost,
+			body:           `{"successes": [45, 30], "trials": [100, 100]}`,
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				if _, ok := data["df"]; ok || !floatEquals(data["estimate"].(float64), 0.15, 1e-12) {
+					t.Errorf("data = %v", data)
+				}
+			},
+		},
+		{
+			name:           "zero variance",
+			test:           "one-sample-t",
+			method:         http.MethodPost,
+			body:           `{"x": [2, 2, 2]}`,
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedCode:   "DOMAIN_ERROR",
+		},
+		{
+			name:           "unknown test",
+			test:           "anova",
+			method:         http.MethodPost,
+			body:           `{` + sleepData + `}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_ERROR",
+		},
+		{
+			name:           "wrong method",
+			test:           "welch-t",
+			method:         http.MethodGet,
+			expectedStatus: http.StatusMethodNotAllowed,
+			expectedCode:   "METHOD_NOT_ALLOWED",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, "/api/math/hypothesis-test/"+tt.test, bytes.NewReader([]byte(tt.body)))
+			req.SetPathValue("test", tt.test)
+			ctx := context.WithValue(req.Context(), middleware.RequestIDKey, "test-123")
+			req = req.WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			NewHypothesisTestHandler(100)(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("status = %d, want %d: %s", w.Code, tt.expectedStatus, w.Body.String())
+			}
+
+			if tt.expectedCode != "" {
+				var resp models.APIErrorResponse
+				json.NewDecoder(w.Body).Decode(&resp)
+				if resp.Code != tt.expectedCode {
+					t.Errorf("error code = %s, want %s", resp.Code, tt.expectedCode)
+				}
+				return
+			}
+
+			var resp models.SuccessResponse
+			json.NewDecoder(w.Body).Decode(&resp)
+			data, ok := resp.Data.(map[string]interface{})
+			if !ok {
+				t.Fatalf("expected data in response")
+			}
+			tt.checkData(t, data)
+		})
+	}
+}
+
+func TestConfidenceIntervalHandler(t *testing.T) {
+	tests := []struct {
+		name           string
+		parameter      string
+		body           string
+		expectedStatus int
+		expectedCode   string
+		checkData      func(t *testing.T, data map[string]interface{})
+	}{
+		{
+			name:           "mean",
+			parameter:      "mean",
+			body:           `{"x": [1, 2, 3, 4, 5]}`,
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				if data["method"] != "t" || data["estimate"] != float64(3) || data["df"] != float64(4) {
+					t.Errorf("data = %v", data)
+				}
+			},
+		},
+		{
+			name:           "wilson proportion",
+			parameter:      "proportion",
+			body:           `{"successes": 3, "trials": 10}`,
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				ci := data["confidence_interval"].(map[string]interface{})
+				if data["method"] != "wilson" || !floatEquals(ci["lower"].(float64), 0.10779126740630099, 1e-12) ||
+					!floatEquals(ci["upper"].(float64), 0.6032218525388546, 1e-12) {
+					t.Errorf("data = %v", data)
+				}
+				if _, ok := data["df"]; ok {
+					t.Errorf("unexpected df %v", data["df"])
+				}
+			},
+		},
+		{
+			name:           "successes exceed trials",
+			parameter:      "proportion",
+			body:           `{"successes": 11, "trials": 10}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_ERROR",
+		},
+		{
+			name:           "unknown parameter",
+			parameter:      "median",
+			body:           `{"x": [1, 2]}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_ERROR",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/api/math/confidence-interval/"+tt.parameter, bytes.NewReader([]byte(tt.body)))
+			req.SetPathValue("parameter", tt.parameter)
+			ctx := context.WithValue(req.Context(), middleware.RequestIDKey, "test-123")
+			req = req.WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			NewConfidenceIntervalHandler(100)(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("status = %d, want %d: %s", w.Code, tt.expectedStatus, w.Body.String())
+			}
+
+			if tt.expectedCode != "" {
+				var resp models.APIErrorResponse
+				json.NewDecoder(w.Body).Decode(&resp)
+				if resp.Code != tt.expectedCode {
+					t.Errorf("error code = %s, want %s", resp.Code, tt.expectedCode)
+				}
+				return
+			}
+
+			var resp models.SuccessResponse
+			json.NewDecoder(w.Body).Decode(&resp)
+			data, ok := resp.Data.(map[string]interface{})
+			if !ok {
+				t.Fatalf("expected data in response")
+			}
+			tt.checkData(t, data)
+		})
+	}
+}
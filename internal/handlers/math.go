@@ -1,9 +1,12 @@
 package handlers
 
 import (
+	"bytes"
 	"encoding/json"
 	"io"
+	"math/big"
 	"net/http"
+	"strings"
 
 	apierrors "github.com/m-szczepanski/gocalc-api/internal/errors"
 	"github.com/m-szczepanski/gocalc-api/internal/models"
@@ -18,8 +21,9 @@ func AddHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	var req models.MathRequest
-	if err := decodeJSONBody(r.Body, &req); err != nil {
-		writeErrorWithDetails(w, r, apierrors.InvalidInput("invalid request body").WithError(err))
+	rounding, apiErr := decodeJSONBody(r, &req)
+	if apiErr != nil {
+		writeErrorWithDetails(w, r, apiErr)
 		return
 	}
 
@@ -28,8 +32,20 @@ func AddHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if isFractionMode(&req) {
+		writeFractionResult(w, r, &req, rounding, func(a, b *big.Rat) (*big.Rat, error) {
+			return calculations.AddFractions(a, b), nil
+		})
+		return
+	}
+
+	if mode, ok := measuredMode(&req); ok {
+		writeMeasuredResult(w, r, &req, rounding, mode, calculations.AddUncertain, calculations.AddIntervals)
+		return
+	}
+
 	result := calculations.Add(req.A, req.B)
-	if err := writeSuccessResponse(w, r, models.MathResponse{Result: result}); err != nil {
+	if err := writeSuccessResponse(w, r, models.MathResponse{Result: result}, rounding); err != nil {
 		// Error already logged, headers likely already sent
 		return
 	}
@@ -42,8 +58,9 @@ func SubtractHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	var req models.MathRequest
-	if err := decodeJSONBody(r.Body, &req); err != nil {
-		writeErrorWithDetails(w, r, apierrors.InvalidInput("invalid request body").WithError(err))
+	rounding, apiErr := decodeJSONBody(r, &req)
+	if apiErr != nil {
+		writeErrorWithDetails(w, r, apiErr)
 		return
 	}
 
@@ -52,8 +69,20 @@ func SubtractHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if isFractionMode(&req) {
+		writeFractionResult(w, r, &req, rounding, func(a, b *big.Rat) (*big.Rat, error) {
+			return calculations.SubtractFractions(a, b), nil
+		})
+		return
+	}
+
+	if mode, ok := measuredMode(&req); ok {
+		writeMeasuredResult(w, r, &req, rounding, mode, calculations.SubtractUncertain, calculations.SubtractIntervals)
+		return
+	}
+
 	result := calculations.Subtract(req.A, req.B)
-	if err := writeSuccessResponse(w, r, models.MathResponse{Result: result}); err != nil {
+	if err := writeSuccessResponse(w, r, models.MathResponse{Result: result}, rounding); err != nil {
 		// Error already logged, headers likely already sent
 		return
 	}
@@ -66,8 +95,9 @@ func MultiplyHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	var req models.MathRequest
-	if err := decodeJSONBody(r.Body, &req); err != nil {
-		writeErrorWithDetails(w, r, apierrors.InvalidInput("invalid request body").WithError(err))
+	rounding, apiErr := decodeJSONBody(r, &req)
+	if apiErr != nil {
+		writeErrorWithDetails(w, r, apiErr)
 		return
 	}
 
@@ -76,8 +106,20 @@ func MultiplyHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if isFractionMode(&req) {
+		writeFractionResult(w, r, &req, rounding, func(a, b *big.Rat) (*big.Rat, error) {
+			return calculations.MultiplyFractions(a, b), nil
+		})
+		return
+	}
+
+	if mode, ok := measuredMode(&req); ok {
+		writeMeasuredResult(w, r, &req, rounding, mode, calculations.MultiplyUncertain, calculations.MultiplyIntervals)
+		return
+	}
+
 	result := calculations.Multiply(req.A, req.B)
-	if err := writeSuccessResponse(w, r, models.MathResponse{Result: result}); err != nil {
+	if err := writeSuccessResponse(w, r, models.MathResponse{Result: result}, rounding); err != nil {
 		// Error already logged, headers likely already sent
 		return
 	}
@@ -90,8 +132,9 @@ func DivideHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	var req models.MathRequest
-	if err := decodeJSONBody(r.Body, &req); err != nil {
-		writeErrorWithDetails(w, r, apierrors.InvalidInput("invalid request body").WithError(err))
+	rounding, apiErr := decodeJSONBody(r, &req)
+	if apiErr != nil {
+		writeErrorWithDetails(w, r, apiErr)
 		return
 	}
 
@@ -100,15 +143,142 @@ func DivideHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if isFractionMode(&req) {
+		writeFractionResult(w, r, &req, rounding, calculations.DivideFractions)
+		return
+	}
+
+	if mode, ok := measuredMode(&req); ok {
+		writeMeasuredResult(w, r, &req, rounding, mode, calculations.DivideUncertain, calculations.DivideIntervals)
+		return
+	}
+
 	result, _ := calculations.Divide(req.A, req.B)
 
-	if err := writeSuccessResponse(w, r, models.MathResponse{Result: result}); err != nil {
+	if err := writeSuccessResponse(w, r, models.MathResponse{Result: result}, rounding); err != nil {
 		// Error already logged, headers likely already sent
 		return
 	}
 }
 
-func decodeJSONBody(body io.ReadCloser, target interface{}) error {
-	defer body.Close()
-	return json.NewDecoder(body).Decode(target)
+func isFractionMode(req *models.MathRequest) bool {
+	mode, _ := calculations.ParseNumberMode(req.Mode)
+	return mode == calculations.ModeFraction
+}
+
+// writeFractionResult applies op to the exact operands of a fraction mode
+// request and responds with the fraction, mixed number and decimal forms.
+func writeFractionResult(w http.ResponseWriter, r *http.Request, req *models.MathRequest, rounding *calculations.Rounding, op func(a, b *big.Rat) (*big.Rat, error)) {
+	a, _ := calculations.ParseFraction(req.TextA)
+	b, _ := calculations.ParseFraction(req.TextB)
+
+	result, err := op(a, b)
+	if err != nil {
+		writeErrorWithDetails(w, r, calculationError(err))
+		return
+	}
+
+	decimal, err := calculations.FractionToFloat(result)
+	if err != nil {
+		writeErrorWithDetails(w, r, calculationError(err))
+		return
+	}
+
+	response := models.MathResponse{
+		Result:   decimal,
+		Fraction: calculations.FormatFraction(result),
+		Mixed:    calculations.FormatMixedNumber(result),
+	}
+
+	if err := writeSuccessResponse(w, r, response, rounding); err != nil {
+		// Error already logged, headers likely already sent
+		return
+	}
+}
+
+// measuredMode reports whether req uses uncertainty or interval mode.
+func measuredMode(req *models.MathRequest) (calculations.NumberMode, bool) {
+	mode, _ := calculations.ParseNumberMode(req.Mode)
+	return mode, mode == calculations.ModeUncertainty || mode == calculations.ModeInterval
+}
+
+// writeMeasuredResult applies the uncertainty or interval form of an
+// operation to the operands of req.
+func writeMeasuredResult(
+	w http.ResponseWriter,
+	r *http.Request,
+	req *models.MathRequest,
+	rounding *calculations.Rounding,
+	mode calculations.NumberMode,
+	uncertain func(a, b calculations.Measurement) (calculations.Measurement, error),
+	interval func(a, b calculations.Interval) (calculations.Interval, error),
+) {
+	a := measurementOperand(req.TextA, req.A, req.UncertaintyA)
+	b := measurementOperand(req.TextB, req.B, req.UncertaintyB)
+
+	result, bounds, err := evaluateMeasured(mode, a, b, uncertain, interval)
+	if err != nil {
+		writeErrorWithDetails(w, r, calculationError(err))
+		return
+	}
+
+	response := models.MathResponse{Result: result.Value, Uncertainty: &result.Uncertainty}
+	if bounds != nil {
+		response.Lower, response.Upper = &bounds.Lower, &bounds.Upper
+	}
+	if err := writeSuccessResponse(w, r, response, rounding); err != nil {
+		// Error already logged, headers likely already sent
+		return
+	}
+}
+
+// evaluateMeasured propagates uncertainties, or in interval mode bounds the
+// operation over a ± uncertainty and b ± uncertainty and also returns the bounds.
+func evaluateMeasured(
+	mode calculations.NumberMode,
+	a, b calculations.Measurement,
+	uncertain func(a, b calculations.Measurement) (calculations.Measurement, error),
+	interval func(a, b calculations.Interval) (calculations.Interval, error),
+) (calculations.Measurement, *calculations.Interval, error) {
+	if mode == calculations.ModeUncertainty {
+		result, err := uncertain(a, b)
+		return result, nil, err
+	}
+	bounds, err := interval(a.Bounds(), b.Bounds())
+	if err != nil {
+		return calculations.Measurement{}, nil, err
+	}
+	return bounds.Measurement(), &bounds, nil
+}
+
+// measurementOperand resolves an operand already checked by the validator: a
+// value/uncertainty object, or a number or string such as "12.3 ± 0.2".
+func measurementOperand(text string, value, uncertainty float64) calculations.Measurement {
+	if strings.HasPrefix(text, "{") {
+		return calculations.Measurement{Value: value, Uncertainty: uncertainty}
+	}
+	m, _ := calculations.ParseMeasurement(text)
+	return m
+}
+
+// decodeJSONBody decodes the request body into target and returns the
+// rounding requested by a top-level "rounding" field or, failing that, the
+// X-Rounding header. A nil rounding means none was requested.
+func decodeJSONBody(r *http.Request, target interface{}) (*calculations.Rounding, *apierrors.APIError) {
+	defer r.Body.Close()
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, apierrors.InvalidInput("invalid request body").WithError(err)
+	}
+	if err := json.NewDecoder(bytes.NewReader(data)).Decode(target); err != nil {
+		return nil, apierrors.InvalidInput("invalid request body").WithError(err)
+	}
+
+	// A body that is not an object has no options, and target has already
+	// rejected it if it had to be one.
+	var options struct {
+		Rounding json.RawMessage `json:"rounding"`
+	}
+	_ = json.NewDecoder(bytes.NewReader(data)).Decode(&options)
+	return roundingField(r, options.Rounding)
 }
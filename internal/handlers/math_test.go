@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"io"
+	"math"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -299,6 +300,218 @@ func TestDivideHandler(t *testing.T) {
 	}
 }
 
+func TestFractionMode(t *testing.T) {
+	tests := []struct {
+		name             string
+		handler          func(http.ResponseWriter, *http.Request)
+		body             string
+		expectedStatus   int
+		expectedFraction string
+		expectedMixed    string
+		expectedResult   float64
+		expectedCode     string
+	}{
+		{
+			name:             "one divided by three stays exact",
+			handler:          DivideHandler,
+			body:             `{"a": 1, "b": 3, "mode": "fraction"}`,
+			expectedStatus:   http.StatusOK,
+			expectedFraction: "1/3",
+			expectedMixed:    "1/3",
+			expectedResult:   1.0 / 3,
+		},
+		{
+			name:             "mixed numbers",
+			handler:          AddHandler,
+			body:             `{"a": "2 1/4", "b": "1/2", "mode": "fraction"}`,
+			expectedStatus:   http.StatusOK,
+			expectedFraction: "11/4",
+			expectedMixed:    "2 3/4",
+			expectedResult:   2.75,
+		},
+		{
+			name:             "decimal literal is exact",
+			handler:          SubtractHandler,
+			body:             `{"a": 0.3, "b": "1/10", "mode": "fraction"}`,
+			expectedStatus:   http.StatusOK,
+			expectedFraction: "1/5",
+			expectedMixed:    "1/5",
+			expectedResult:   0.2,
+		},
+		{
+			name:             "negative product",
+			handler:          MultiplyHandler,
+			body:             `{"a": "-3/4", "b": "10/3", "mode": "fraction"}`,
+			expectedStatus:   http.StatusOK,
+			expectedFraction: "-5/2",
+			expectedMixed:    "-2 1/2",
+			expectedResult:   -2.5,
+		},
+		{
+			name:           "division by zero fraction",
+			handler:        DivideHandler,
+			body:           `{"a": "1/2", "b": "0/7", "mode": "fraction"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "DIVISION_BY_ZERO",
+		},
+		{
+			name:           "invalid fraction",
+			handler:        AddHandler,
+			body:           `{"a": "1/0", "b": 1, "mode": "fraction"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_ERROR",
+		},
+		{
+			name:           "string operand without fraction mode",
+			handler:        AddHandler,
+			body:           `{"a": "1/3", "b": 1}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_ERROR",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/api/math/divide", bytes.NewReader([]byte(tt.body)))
+			ctx := context.WithValue(req.Context(), middleware.RequestIDKey, "test-123")
+			req = req.WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			tt.handler(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("status = %d, want %d", w.Code, tt.expectedStatus)
+			}
+
+			if tt.expectedCode != "" {
+				var resp models.APIErrorResponse
+				json.NewDecoder(w.Body).Decode(&resp)
+				if resp.Code != tt.expectedCode {
+					t.Errorf("error code = %s, want %s", resp.Code, tt.expectedCode)
+				}
+				return
+			}
+
+			var resp models.SuccessResponse
+			json.NewDecoder(w.Body).Decode(&resp)
+			data, ok := resp.Data.(map[string]interface{})
+			if !ok {
+				t.Fatalf("expected data in response")
+			}
+			if data["fraction"] != tt.expectedFraction || data["mixed"] != tt.expectedMixed {
+				t.Errorf("fraction = %v, mixed = %v, want %s and %s", data["fraction"], data["mixed"], tt.expectedFraction, tt.expectedMixed)
+			}
+			if data["result"].(float64) != tt.expectedResult {
+				t.Errorf("result = %v, want %v", data["result"], tt.expectedResult)
+			}
+		})
+	}
+}
+
+func TestUncertaintyMode(t *testing.T) {
+	tests := []struct {
+		name           string
+		handler        func(http.ResponseWriter, *http.Request)
+		body           string
+		expectedStatus int
+		expectedResult float64
+		expectedU      float64
+		expectedBounds []float64
+		expectedCode   string
+	}{
+		{
+			name:           "product propagates relative uncertainties",
+			handler:        MultiplyHandler,
+			body:           `{"a": "12.3 ± 0.2", "b": {"value": 4, "uncertainty": 0.1}, "mode": "uncertainty"}`,
+			expectedStatus: http.StatusOK,
+			expectedResult: 49.2,
+			expectedU:      math.Hypot(0.8, 1.23),
+		},
+		{
+			name:           "exact operand adds no uncertainty",
+			handler:        AddHandler,
+			body:           `{"a": "1 +/- 0.5", "b": 2, "mode": "uncertainty"}`,
+			expectedStatus: http.StatusOK,
+			expectedResult: 3,
+			expectedU:      0.5,
+		},
+		{
+			name:           "interval subtraction",
+			handler:        SubtractHandler,
+			body:           `{"a": "10 ± 1", "b": "4 ± 2", "mode": "interval"}`,
+			expectedStatus: http.StatusOK,
+			expectedResult: 6,
+			expectedU:      3,
+			expectedBounds: []float64{3, 9},
+		},
+		{
+			name:           "interval divisor containing zero",
+			handler:        DivideHandler,
+			body:           `{"a": 1, "b": "0.5 ± 1", "mode": "interval"}`,
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedCode:   "DOMAIN_ERROR",
+		},
+		{
+			name:           "zero measured divisor",
+			handler:        DivideHandler,
+			body:           `{"a": 1, "b": "0 ± 0.1", "mode": "uncertainty"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "DIVISION_BY_ZERO",
+		},
+		{
+			name:           "object operand without uncertainty mode",
+			handler:        AddHandler,
+			body:           `{"a": {"value": 1, "uncertainty": 0.1}, "b": 1}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_ERROR",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/api/math/add", bytes.NewReader([]byte(tt.body)))
+			ctx := context.WithValue(req.Context(), middleware.RequestIDKey, "test-123")
+			req = req.WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			tt.handler(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("status = %d, want %d: %s", w.Code, tt.expectedStatus, w.Body.String())
+			}
+
+			if tt.expectedCode != "" {
+				var resp models.APIErrorResponse
+				json.NewDecoder(w.Body).Decode(&resp)
+				if resp.Code != tt.expectedCode {
+					t.Errorf("error code = %s, want %s", resp.Code, tt.expectedCode)
+				}
+				return
+			}
+
+			var resp models.SuccessResponse
+			json.NewDecoder(w.Body).Decode(&resp)
+			data, ok := resp.Data.(map[string]interface{})
+			if !ok {
+				t.Fatalf("expected data in response")
+			}
+			if !floatEquals(data["result"].(float64), tt.expectedResult, 1e-12) || !floatEquals(data["uncertainty"].(float64), tt.expectedU, 1e-12) {
+				t.Errorf("result = %v ± %v, want %v ± %v", data["result"], data["uncertainty"], tt.expectedResult, tt.expectedU)
+			}
+			if tt.expectedBounds == nil {
+				if _, ok := data["lower"]; ok {
+					t.Errorf("unexpected bounds in %v", data)
+				}
+				return
+			}
+			lower, upper := data["lower"].(float64), data["upper"].(float64)
+			if lower > tt.expectedBounds[0] || upper < tt.expectedBounds[1] || !floatEquals(lower, tt.expectedBounds[0], 1e-12) || !floatEquals(upper, tt.expectedBounds[1], 1e-12) {
+				t.Errorf("bounds = [%v, %v], want just outside %v", lower, upper, tt.expectedBounds)
+			}
+		})
+	}
+}
+
 func TestMethodNotAllowed(t *testing.T) {
 	handlers := []func(http.ResponseWriter, *http.Request){
 		AddHandler,
@@ -0,0 +1,195 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	apierrors "github.com/m-szczepanski/gocalc-api/internal/errors"
+	"github.com/m-szczepanski/gocalc-api/internal/models"
+	"github.com/m-szczepanski/gocalc-api/internal/validation"
+	"github.com/m-szczepanski/gocalc-api/pkg/calculations/matrix"
+)
+
+var matrixOperations = []string{"add", "multiply", "transpose", "determinant", "inverse", "rank", "lu", "qr", "solve"}
+
+// NewMatrixHandler returns a handler for /api/math/matrix/{operation}. Each
+// matrix in a request may hold at most maxCells entries.
+func NewMatrixHandler(maxCells int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if err := validation.ValidateMethod(r.Method, http.MethodPost); err != nil {
+			writeErrorWithDetails(w, r, err)
+			return
+		}
+
+		operation := r.PathValue("operation")
+		if operation == "solve" {
+			solveLinearSystem(w, r, maxCells)
+			return
+		}
+
+		if !isMatrixOperation(operation) {
+			writeErrorWithDetails(w, r, apierrors.ValidationError(
+				"invalid operation",
+				fmt.Sprintf("operation must be one of %v, got %q", matrixOperations, operation),
+			))
+			return
+		}
+
+		var req models.MatrixRequest
+		rounding, apiErr := decodeJSONBody(r, &req)
+		if apiErr != nil {
+			writeErrorWithDetails(w, r, apiErr)
+			return
+		}
+
+		needsB := operation == "add" || operation == "multiply"
+		if err := validation.ValidateMatrixRequest(&req, needsB, maxCells); err != nil {
+			writeErrorWithDetails(w, r, err)
+			return
+		}
+
+		a, b := matrix.Matrix(req.A), matrix.Matrix(req.B)
+
+		var response interface{}
+		var err error
+		switch operation {
+		case "add":
+			var result matrix.Matrix
+			result, err = matrix.Add(a, b)
+			response = models.MatrixResponse{Operation: operation, Result: result}
+		case "multiply":
+			var result matrix.Matrix
+			result, err = matrix.Multiply(a, b)
+			response = models.MatrixResponse{Operation: operation, Result: result}
+		case "transpose":
+			response = models.MatrixResponse{Operation: operation, Result: matrix.Transpose(a)}
+		case "determinant":
+			var det float64
+			det, err = matrix.Determinant(a)
+			response = models.DeterminantResponse{Determinant: det}
+		case "inverse":
+			var inv matrix.Matrix
+			var cond float64
+			inv, cond, err = matrix.Inverse(a)
+			response = models.MatrixResponse{Operation: operation, Result: inv, ConditionNumber: &cond}
+		case "rank":
+			response = models.RankResponse{Rank: matrix.Rank(a)}
+		case "lu":
+			var lu *matrix.LUDecomposition
+			lu, err = matrix.LU(a)
+			if err == nil {
+				response = models.LUResponse{P: lu.P, L: lu.L, U: lu.U}
+			}
+		case "qr":
+			var qr *matrix.QRDecomposition
+			qr, err = matrix.QR(a)
+			if err == nil {
+				response = models.QRResponse{Q: qr.Q, R: qr.R}
+			}
+		}
+
+		if err != nil {
+			writeErrorWithDetails(w, r, matrixError(err))
+			return
+		}
+		if !matrixResultFinite(response) {
+			writeErrorWithDetails(w, r, apierrors.DomainError("result outside representable range").
+				WithDetails(fmt.Sprintf("the %s result overflows float64", operation)))
+			return
+		}
+
+		if err := writeSuccessResponse(w, r, response, rounding); err != nil {
+			// Error already logged, headers likely already sent
+			return
+		}
+	}
+}
+
+// matrixResultFinite reports whether every entry of a matrix result,
+// decomposition or solution, and any determinant or condition number, is
+// finite. Products, sums and norms of large entries can overflow.
+func matrixResultFinite(response interface{}) bool {
+	var matrices [][][]float64
+	switch resp := response.(type) {
+	case models.MatrixResponse:
+		if resp.ConditionNumber != nil && !finite(*resp.ConditionNumber) {
+			return false
+		}
+		matrices = append(matrices, resp.Result)
+	case models.DeterminantResponse:
+		return finite(resp.Determinant)
+	case models.LUResponse:
+		matrices = append(matrices, resp.P, resp.L, resp.U)
+	case models.QRResponse:
+		matrices = append(matrices, resp.Q, resp.R)
+	case models.LinearSystemResponse:
+		return finite(resp.ConditionNumber) && finite(resp.X...)
+	}
+	for _, m := range matrices {
+		for _, row := range m {
+			if !finite(row...) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func solveLinearSystem(w http.ResponseWriter, r *http.Request, maxCells int) {
+	var req models.LinearSystemRequest
+	rounding, apiErr := decodeJSONBody(r, &req)
+	if apiErr != nil {
+		writeErrorWithDetails(w, r, apiErr)
+		return
+	}
+
+	if err := validation.ValidateLinearSystemRequest(&req, maxCells); err != nil {
+		writeErrorWithDetails(w, r, err)
+		return
+	}
+
+	x, cond, err := matrix.Solve(req.A, req.B)
+	if err != nil {
+		writeErrorWithDetails(w, r, matrixError(err))
+		return
+	}
+
+	response := models.LinearSystemResponse{
+		X:               x,
+		ConditionNumber: cond,
+	}
+	if !matrixResultFinite(response) {
+		writeErrorWithDetails(w, r, apierrors.DomainError("result outside representable range").
+			WithDetails("the solve result overflows float64"))
+		return
+	}
+
+	if err := writeSuccessResponse(w, r, response, rounding); err != nil {
+		// Error already logged, headers likely already sent
+		return
+	}
+}
+
+// matrixError maps matrix package errors to API errors. Singular and
+// ill-conditioned matrices report their condition number in the error meta.
+func matrixError(err error) *apierrors.APIError {
+	var condErr *matrix.ConditionError
+	if errors.As(err, &condErr) {
+		apiErr := apierrors.SingularMatrix(condErr.Error()).WithMeta("singular", condErr.Singular())
+		if finite(condErr.ConditionNumber) {
+			apiErr.WithMeta("condition_number", condErr.ConditionNumber)
+		}
+		return apiErr
+	}
+	return apierrors.ValidationError("invalid matrix dimensions", err.Error())
+}
+
+func isMatrixOperation(operation string) bool {
+	for _, op := range matrixOperations {
+		if op == operation {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,232 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/m-szczepanski/gocalc-api/internal/middleware"
+	"github.com/m-szczepanski/gocalc-api/internal/models"
+)
+
+func TestMatrixHandler(t *testing.T) {
+	handler := NewMatrixHandler(100)
+
+	tests := []struct {
+		name           string
+		method         string
+		operation      string
+		body           interface{}
+		expectedStatus int
+		expectedCode   string
+		checkData      func(t *testing.T, data map[string]interface{})
+		checkMeta      func(t *testing.T, meta map[string]interface{})
+	}{
+		{
+			name:      "multiply",
+			method:    http.MethodPost,
+			operation: "multiply",
+			body: models.MatrixRequest{
+				A: [][]float64{{1, 2}, {3, 4}},
+				B: [][]float64{{5}, {6}},
+			},
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				result := data["result"].([]interface{})
+				if len(result) != 2 || result[0].([]interface{})[0].(float64) != 17 || result[1].([]interface{})[0].(float64) != 39 {
+					t.Errorf("result = %v, want [[17] [39]]", result)
+				}
+			},
+		},
+		{
+			name:           "determinant",
+			method:         http.MethodPost,
+			operation:      "determinant",
+			body:           models.MatrixRequest{A: [][]float64{{1, 2}, {3, 4}}},
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				if !floatEquals(data["determinant"].(float64), -2, 1e-12) {
+					t.Errorf("determinant = %v, want -2", data["determinant"])
+				}
+			},
+		},
+		{
+			name:           "determinant overflow",
+			method:         http.MethodPost,
+			operation:      "determinant",
+			body:           models.MatrixRequest{A: [][]float64{{1e200, 0}, {0, 1e200}}},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedCode:   "DOMAIN_ERROR",
+		},
+		{
+			name:           "multiply overflow",
+			method:         http.MethodPost,
+			operation:      "multiply",
+			body:           models.MatrixRequest{A: [][]float64{{1e200}}, B: [][]float64{{1e200}}},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedCode:   "DOMAIN_ERROR",
+		},
+		{
+			name:      "solve overflow",
+			method:    http.MethodPost,
+			operation: "solve",
+			body: models.LinearSystemRequest{
+				A: [][]float64{{1e-300}},
+				B: []float64{1e300},
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedCode:   "DOMAIN_ERROR",
+		},
+		{
+			name:           "qr overflow",
+			method:         http.MethodPost,
+			operation:      "qr",
+			body:           models.MatrixRequest{A: [][]float64{{1e300, 1e300}, {1e300, 1e300}}},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedCode:   "DOMAIN_ERROR",
+		},
+		{
+			name:           "inverse reports condition number",
+			method:         http.MethodPost,
+			operation:      "inverse",
+			body:           models.MatrixRequest{A: [][]float64{{4, 7}, {2, 6}}},
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				if !floatEquals(data["condition_number"].(float64), 14.3, 1e-9) {
+					t.Errorf("condition_number = %v, want 14.3", data["condition_number"])
+				}
+			},
+		},
+		{
+			name:      "solve",
+			method:    http.MethodPost,
+			operation: "solve",
+			body: models.LinearSystemRequest{
+				A: [][]float64{{2, 1}, {1, 3}},
+				B: []float64{3, 5},
+			},
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				x := data["x"].([]interface{})
+				if !floatEquals(x[0].(float64), 0.8, 1e-12) || !floatEquals(x[1].(float64), 1.4, 1e-12) {
+					t.Errorf("x = %v, want [0.8 1.4]", x)
+				}
+			},
+		},
+		{
+			name:           "singular inverse",
+			method:         http.MethodPost,
+			operation:      "inverse",
+			body:           models.MatrixRequest{A: [][]float64{{1, 2}, {2, 4}}},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedCode:   "SINGULAR_MATRIX",
+			checkMeta: func(t *testing.T, meta map[string]interface{}) {
+				if meta["singular"] != true {
+					t.Errorf("meta.singular = %v, want true", meta["singular"])
+				}
+				if _, ok := meta["condition_number"]; ok {
+					t.Errorf("meta.condition_number should be omitted for a singular matrix")
+				}
+			},
+		},
+		{
+			name:      "ill-conditioned solve",
+			method:    http.MethodPost,
+			operation: "solve",
+			body: models.LinearSystemRequest{
+				A: [][]float64{{1, 1}, {1, 1 + 1e-14}},
+				B: []float64{2, 2},
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedCode:   "SINGULAR_MATRIX",
+			checkMeta: func(t *testing.T, meta map[string]interface{}) {
+				if meta["singular"] != false {
+					t.Errorf("meta.singular = %v, want false", meta["singular"])
+				}
+				if cond, ok := meta["condition_number"].(float64); !ok || cond <= 1e12 {
+					t.Errorf("meta.condition_number = %v, want > 1e12", meta["condition_number"])
+				}
+			},
+		},
+		{
+			name:      "dimension mismatch",
+			method:    http.MethodPost,
+			operation: "add",
+			body: models.MatrixRequest{
+				A: [][]float64{{1, 2}},
+				B: [][]float64{{1}, {2}},
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_ERROR",
+		},
+		{
+			name:           "ragged matrix",
+			method:         http.MethodPost,
+			operation:      "transpose",
+			body:           models.MatrixRequest{A: [][]float64{{1, 2}, {3}}},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_ERROR",
+			checkMeta: func(t *testing.T, meta map[string]interface{}) {
+				if rows, ok := meta["row_errors"].([]interface{}); !ok || len(rows) != 1 {
+					t.Errorf("meta.row_errors = %v, want one entry", meta["row_errors"])
+				}
+			},
+		},
+		{
+			name:           "unknown operation",
+			method:         http.MethodPost,
+			operation:      "trace",
+			body:           models.MatrixRequest{A: [][]float64{{1}}},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_ERROR",
+		},
+		{
+			name:           "method not allowed",
+			method:         http.MethodGet,
+			operation:      "rank",
+			body:           models.MatrixRequest{},
+			expectedStatus: http.StatusMethodNotAllowed,
+			expectedCode:   "METHOD_NOT_ALLOWED",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, _ := json.Marshal(tt.body)
+			req := httptest.NewRequest(tt.method, "/api/math/matrix/"+tt.operation, bytes.NewReader(body))
+			req.SetPathValue("operation", tt.operation)
+			ctx := context.WithValue(req.Context(), middleware.RequestIDKey, "test-123")
+			req = req.WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			handler(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("status = %d, want %d", w.Code, tt.expectedStatus)
+			}
+
+			if tt.expectedCode != "" {
+				var resp models.APIErrorResponse
+				json.NewDecoder(w.Body).Decode(&resp)
+				if resp.Code != tt.expectedCode {
+					t.Errorf("error code = %s, want %s", resp.Code, tt.expectedCode)
+				}
+				if tt.checkMeta != nil {
+					tt.checkMeta(t, resp.Meta)
+				}
+				return
+			}
+
+			var resp models.SuccessResponse
+			json.NewDecoder(w.Body).Decode(&resp)
+			data, ok := resp.Data.(map[string]interface{})
+			if !ok {
+				t.Fatalf("expected data in response")
+			}
+			tt.checkData(t, data)
+		})
+	}
+}
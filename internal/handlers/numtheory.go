@@ -0,0 +1,281 @@
+package handlers
+
+import (
+	"errors"
+	"math/big"
+	"net/http"
+
+	apierrors "github.com/m-szczepanski/gocalc-api/internal/errors"
+	"github.com/m-szczepanski/gocalc-api/internal/models"
+	"github.com/m-szczepanski/gocalc-api/internal/validation"
+	"github.com/m-szczepanski/gocalc-api/pkg/calculations/numtheory"
+)
+
+// NewGCDHandler returns a handler for /api/math/gcd accepting up to maxValues integers.
+func NewGCDHandler(maxValues int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		values, ok := decodeIntegerList(w, r, maxValues)
+		if !ok {
+			return
+		}
+
+		response := models.IntegerResponse{Result: numtheory.GCD(values).String()}
+		if err := writeSuccessResponse(w, r, response, nil); err != nil {
+			// Error already logged, headers likely already sent
+			return
+		}
+	}
+}
+
+// NewLCMHandler returns a handler for /api/math/lcm accepting up to maxValues integers.
+func NewLCMHandler(maxValues int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		values, ok := decodeIntegerList(w, r, maxValues)
+		if !ok {
+			return
+		}
+
+		lcm, err := numtheory.LCM(values)
+		if err != nil {
+			writeErrorWithDetails(w, r, numberTheoryError(err))
+			return
+		}
+
+		if err := writeSuccessResponse(w, r, models.IntegerResponse{Result: lcm.String()}, nil); err != nil {
+			// Error already logged, headers likely already sent
+			return
+		}
+	}
+}
+
+func PrimalityHandler(w http.ResponseWriter, r *http.Request) {
+	n, ok := decodeInteger(w, r, 0)
+	if !ok {
+		return
+	}
+
+	prime, certain := numtheory.IsPrime(n)
+	response := models.PrimalityResponse{
+		N:       n.String(),
+		IsPrime: prime,
+		Certain: certain,
+	}
+
+	if err := writeSuccessResponse(w, r, response, nil); err != nil {
+		// Error already logged, headers likely already sent
+		return
+	}
+}
+
+func FactorizeHandler(w http.ResponseWriter, r *http.Request) {
+	n, ok := decodeInteger(w, r, 1)
+	if !ok {
+		return
+	}
+
+	factors, err := numtheory.Factorize(r.Context(), n)
+	if err != nil {
+		writeNumberTheoryError(w, r, err)
+		return
+	}
+
+	response := models.FactorizationResponse{
+		N:       n.String(),
+		Factors: make([]models.PrimeFactor, len(factors)),
+	}
+	for i, f := range factors {
+		response.Factors[i] = models.PrimeFactor{Prime: f.Prime.String(), Exponent: f.Exponent}
+	}
+
+	if err := writeSuccessResponse(w, r, response, nil); err != nil {
+		// Error already logged, headers likely already sent
+		return
+	}
+}
+
+func TotientHandler(w http.ResponseWriter, r *http.Request) {
+	n, ok := decodeInteger(w, r, 1)
+	if !ok {
+		return
+	}
+
+	phi, err := numtheory.Totient(r.Context(), n)
+	if err != nil {
+		writeNumberTheoryError(w, r, err)
+		return
+	}
+
+	if err := writeSuccessResponse(w, r, models.IntegerResponse{Result: phi.String()}, nil); err != nil {
+		// Error already logged, headers likely already sent
+		return
+	}
+}
+
+func PrimesHandler(w http.ResponseWriter, r *http.Request) {
+	if err := validation.ValidateMethod(r.Method, http.MethodPost); err != nil {
+		writeErrorWithDetails(w, r, err)
+		return
+	}
+
+	var req models.PrimeRangeRequest
+	rounding, apiErr := decodeJSONBody(r, &req)
+	if apiErr != nil {
+		writeErrorWithDetails(w, r, apiErr)
+		return
+	}
+
+	if err := validation.ValidatePrimeRangeRequest(&req); err != nil {
+		writeErrorWithDetails(w, r, err)
+		return
+	}
+
+	primes, err := numtheory.PrimesInRange(req.From, req.To)
+	if err != nil {
+		writeErrorWithDetails(w, r, numberTheoryError(err))
+		return
+	}
+
+	response := models.PrimeRangeResponse{
+		From:   req.From,
+		To:     req.To,
+		Count:  len(primes),
+		Primes: primes,
+	}
+
+	if err := writeSuccessResponse(w, r, response, rounding); err != nil {
+		// Error already logged, headers likely already sent
+		return
+	}
+}
+
+func ModPowHandler(w http.ResponseWriter, r *http.Request) {
+	if err := validation.ValidateMethod(r.Method, http.MethodPost); err != nil {
+		writeErrorWithDetails(w, r, err)
+		return
+	}
+
+	var req models.ModPowRequest
+	rounding, apiErr := decodeJSONBody(r, &req)
+	if apiErr != nil {
+		writeErrorWithDetails(w, r, apiErr)
+		return
+	}
+
+	if err := validation.ValidateModPowRequest(&req); err != nil {
+		writeErrorWithDetails(w, r, err)
+		return
+	}
+
+	base, _ := numtheory.ParseInteger(req.Base)
+	exponent, _ := numtheory.ParseInteger(req.Exponent)
+	modulus, _ := numtheory.ParseInteger(req.Modulus)
+
+	result, err := numtheory.ModPow(base, exponent, modulus)
+	if err != nil {
+		writeErrorWithDetails(w, r, numberTheoryError(err))
+		return
+	}
+
+	if err := writeSuccessResponse(w, r, models.IntegerResponse{Result: result.String()}, rounding); err != nil {
+		// Error already logged, headers likely already sent
+		return
+	}
+}
+
+func ModInverseHandler(w http.ResponseWriter, r *http.Request) {
+	if err := validation.ValidateMethod(r.Method, http.MethodPost); err != nil {
+		writeErrorWithDetails(w, r, err)
+		return
+	}
+
+	var req models.ModInverseRequest
+	rounding, apiErr := decodeJSONBody(r, &req)
+	if apiErr != nil {
+		writeErrorWithDetails(w, r, apiErr)
+		return
+	}
+
+	if err := validation.ValidateModInverseRequest(&req); err != nil {
+		writeErrorWithDetails(w, r, err)
+		return
+	}
+
+	a, _ := numtheory.ParseInteger(req.A)
+	modulus, _ := numtheory.ParseInteger(req.Modulus)
+
+	result, err := numtheory.ModInverse(a, modulus)
+	if err != nil {
+		writeErrorWithDetails(w, r, numberTheoryError(err))
+		return
+	}
+
+	if err := writeSuccessResponse(w, r, models.IntegerResponse{Result: result.String()}, rounding); err != nil {
+		// Error already logged, headers likely already sent
+		return
+	}
+}
+
+// decodeIntegerList decodes and validates an IntegerListRequest, writing an
+// error response and returning false if it is invalid.
+func decodeIntegerList(w http.ResponseWriter, r *http.Request, maxValues int) ([]*big.Int, bool) {
+	if err := validation.ValidateMethod(r.Method, http.MethodPost); err != nil {
+		writeErrorWithDetails(w, r, err)
+		return nil, false
+	}
+
+	var req models.IntegerListRequest
+	_, apiErr := decodeJSONBody(r, &req)
+	if apiErr != nil {
+		writeErrorWithDetails(w, r, apiErr)
+		return nil, false
+	}
+
+	if err := validation.ValidateIntegerListRequest(&req, maxValues); err != nil {
+		writeErrorWithDetails(w, r, err)
+		return nil, false
+	}
+
+	values := make([]*big.Int, len(req.Values))
+	for i, v := range req.Values {
+		values[i], _ = numtheory.ParseInteger(v)
+	}
+	return values, true
+}
+
+// decodeInteger decodes and validates an IntegerRequest whose n must be >= minimum.
+func decodeInteger(w http.ResponseWriter, r *http.Request, minimum int64) (*big.Int, bool) {
+	if err := validation.ValidateMethod(r.Method, http.MethodPost); err != nil {
+		writeErrorWithDetails(w, r, err)
+		return nil, false
+	}
+
+	var req models.IntegerRequest
+	_, apiErr := decodeJSONBody(r, &req)
+	if apiErr != nil {
+		writeErrorWithDetails(w, r, apiErr)
+		return nil, false
+	}
+
+	if err := validation.ValidateIntegerRequest(&req, minimum); err != nil {
+		writeErrorWithDetails(w, r, err)
+		return nil, false
+	}
+
+	n, _ := numtheory.ParseInteger(req.N)
+	return n, true
+}
+
+// writeNumberTheoryError writes err unless the request context ended.
+func writeNumberTheoryError(w http.ResponseWriter, r *http.Request, err error) {
+	if requestEnded(err) {
+		return
+	}
+	writeErrorWithDetails(w, r, numberTheoryError(err))
+}
+
+func numberTheoryError(err error) *apierrors.APIError {
+	if errors.Is(err, numtheory.ErrNoInverse) {
+		return apierrors.DomainError("no modular inverse exists").WithDetails(err.Error())
+	}
+	return apierrors.ValidationError("calculation error", err.Error())
+}
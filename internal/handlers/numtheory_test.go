@@ -0,0 +1,207 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/m-szczepanski/gocalc-api/internal/middleware"
+	"github.com/m-szczepanski/gocalc-api/internal/models"
+)
+
+func TestNumberTheoryHandlers(t *testing.T) {
+	tests := []struct {
+		name           string
+		handler        http.HandlerFunc
+		method         string
+		body           interface{}
+		expectedStatus int
+		expectedCode   string
+		checkData      func(t *testing.T, data map[string]interface{})
+	}{
+		{
+			name:           "gcd of big integers",
+			handler:        NewGCDHandler(10),
+			method:         http.MethodPost,
+			body:           models.IntegerListRequest{Values: []string{"123456789012345678901234567890", "987654321098765432109876543210"}},
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				if data["result"] != "9000000000900000000090" {
+					t.Errorf("result = %v, want 9000000000900000000090", data["result"])
+				}
+			},
+		},
+		{
+			name:           "lcm",
+			handler:        NewLCMHandler(10),
+			method:         http.MethodPost,
+			body:           models.IntegerListRequest{Values: []string{"4", "6", "10"}},
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				if data["result"] != "60" {
+					t.Errorf("result = %v, want 60", data["result"])
+				}
+			},
+		},
+		{
+			name:           "lcm list too long",
+			handler:        NewLCMHandler(2),
+			method:         http.MethodPost,
+			body:           models.IntegerListRequest{Values: []string{"4", "6", "10"}},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_ERROR",
+		},
+		{
+			name:           "probable prime above 2^64",
+			handler:        PrimalityHandler,
+			method:         http.MethodPost,
+			body:           models.IntegerRequest{N: "170141183460469231731687303715884105727"},
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				if data["is_prime"] != true || data["certain"] != false {
+					t.Errorf("got is_prime=%v certain=%v, want true/false", data["is_prime"], data["certain"])
+				}
+			},
+		},
+		{
+			name:           "factorize",
+			handler:        FactorizeHandler,
+			method:         http.MethodPost,
+			body:           models.IntegerRequest{N: "360"},
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				factors := data["factors"].([]interface{})
+				if len(factors) != 3 {
+					t.Fatalf("factors = %v, want 3 entries", factors)
+				}
+				first := factors[0].(map[string]interface{})
+				if first["prime"] != "2" || first["exponent"].(float64) != 3 {
+					t.Errorf("first factor = %v, want 2^3", first)
+				}
+			},
+		},
+		{
+			name:           "factorize zero",
+			handler:        FactorizeHandler,
+			method:         http.MethodPost,
+			body:           models.IntegerRequest{N: "0"},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_ERROR",
+		},
+		{
+			name:           "totient",
+			handler:        TotientHandler,
+			method:         http.MethodPost,
+			body:           models.IntegerRequest{N: "36"},
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				if data["result"] != "12" {
+					t.Errorf("result = %v, want 12", data["result"])
+				}
+			},
+		},
+		{
+			name:           "primes in range",
+			handler:        PrimesHandler,
+			method:         http.MethodPost,
+			body:           models.PrimeRangeRequest{From: 10, To: 30},
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				if data["count"].(float64) != 6 {
+					t.Errorf("count = %v, want 6", data["count"])
+				}
+			},
+		},
+		{
+			name:           "modpow",
+			handler:        ModPowHandler,
+			method:         http.MethodPost,
+			body:           models.ModPowRequest{Base: "4", Exponent: "13", Modulus: "497"},
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				if data["result"] != "445" {
+					t.Errorf("result = %v, want 445", data["result"])
+				}
+			},
+		},
+		{
+			name:           "modinverse",
+			handler:        ModInverseHandler,
+			method:         http.MethodPost,
+			body:           models.ModInverseRequest{A: "17", Modulus: "3120"},
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				if data["result"] != "2753" {
+					t.Errorf("result = %v, want 2753", data["result"])
+				}
+			},
+		},
+		{
+			name:           "modinverse does not exist",
+			handler:        ModInverseHandler,
+			method:         http.MethodPost,
+			body:           models.ModInverseRequest{A: "6", Modulus: "9"},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedCode:   "DOMAIN_ERROR",
+		},
+		{
+			name:           "method not allowed",
+			handler:        PrimalityHandler,
+			method:         http.MethodGet,
+			body:           models.IntegerRequest{},
+			expectedStatus: http.StatusMethodNotAllowed,
+			expectedCode:   "METHOD_NOT_ALLOWED",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, _ := json.Marshal(tt.body)
+			req := httptest.NewRequest(tt.method, "/api/math/numtheory", bytes.NewReader(body))
+			ctx := context.WithValue(req.Context(), middleware.RequestIDKey, "test-123")
+			req = req.WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			tt.handler(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("status = %d, want %d", w.Code, tt.expectedStatus)
+			}
+
+			if tt.expectedCode != "" {
+				var resp models.APIErrorResponse
+				json.NewDecoder(w.Body).Decode(&resp)
+				if resp.Code != tt.expectedCode {
+					t.Errorf("error code = %s, want %s", resp.Code, tt.expectedCode)
+				}
+				return
+			}
+
+			var resp models.SuccessResponse
+			json.NewDecoder(w.Body).Decode(&resp)
+			data, ok := resp.Data.(map[string]interface{})
+			if !ok {
+				t.Fatalf("expected data in response")
+			}
+			tt.checkData(t, data)
+		})
+	}
+}
+
+func TestFactorizeHandlerTimeout(t *testing.T) {
+	handler := middleware.TimeoutMiddleware(20 * time.Millisecond)(http.HandlerFunc(FactorizeHandler))
+
+	body, _ := json.Marshal(models.IntegerRequest{N: "340282366920938460843936948965011886881"})
+	req := httptest.NewRequest(http.MethodPost, "/api/math/factorize", bytes.NewReader(body))
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, req)
+
+	if w.Code != http.StatusInternalServerError {
+		t.Errorf("status = %d, want %d", w.Code, http.StatusInternalServerError)
+	}
+}
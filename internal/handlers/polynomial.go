@@ -0,0 +1,143 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"math/cmplx"
+	"net/http"
+
+	apierrors "github.com/m-szczepanski/gocalc-api/internal/errors"
+	"github.com/m-szczepanski/gocalc-api/internal/models"
+	"github.com/m-szczepanski/gocalc-api/internal/validation"
+	"github.com/m-szczepanski/gocalc-api/pkg/calculations"
+	"github.com/m-szczepanski/gocalc-api/pkg/calculations/matrix"
+	"github.com/m-szczepanski/gocalc-api/pkg/calculations/polynomial"
+)
+
+func PolynomialRootsHandler(w http.ResponseWriter, r *http.Request) {
+	if err := validation.ValidateMethod(r.Method, http.MethodPost); err != nil {
+		writeErrorWithDetails(w, r, err)
+		return
+	}
+
+	var req models.PolynomialRootsRequest
+	rounding, apiErr := decodeJSONBody(r, &req)
+	if apiErr != nil {
+		writeErrorWithDetails(w, r, apiErr)
+		return
+	}
+
+	if err := validation.ValidatePolynomialRootsRequest(&req); err != nil {
+		writeErrorWithDetails(w, r, err)
+		return
+	}
+
+	var coefficients []*big.Rat
+	var variable string
+	if len(req.Coefficients) == 0 {
+		var err error
+		if coefficients, variable, err = polynomial.ParsePolynomial(req.Expression); err != nil {
+			writeErrorWithDetails(w, r, apierrors.ValidationError("invalid expression", err.Error()))
+			return
+		}
+	} else {
+		for i, c := range req.Coefficients {
+			value, err := calculations.ParseFraction(string(c))
+			if err != nil {
+				writeErrorWithDetails(w, r, apierrors.ValidationError("invalid coefficients", fmt.Sprintf("coefficients[%d]: %v", i, err)))
+				return
+			}
+			coefficients = append(coefficients, value)
+		}
+	}
+
+	result, err := polynomial.Roots(r.Context(), coefficients)
+	if err != nil {
+		switch {
+		case requestEnded(err):
+		case errors.Is(err, matrix.ErrEigenNoConvergence):
+			writeErrorWithDetails(w, r, apierrors.DomainError("method did not converge").WithDetails(err.Error()))
+		default:
+			writeErrorWithDetails(w, r, calculationError(err))
+		}
+		return
+	}
+
+	response := models.PolynomialRootsResponse{
+		Variable: variable,
+		Degree:   result.Degree,
+		Method:   string(result.Method),
+		Roots:    make([]models.PolynomialRoot, len(result.Roots)),
+	}
+	// Leading zero coefficients do not count towards the degree.
+	for _, c := range coefficients[len(coefficients)-1-result.Degree:] {
+		response.Coefficients = append(response.Coefficients, c.RatString())
+	}
+	for i, root := range result.Roots {
+		if cmplx.IsNaN(root.Value) || cmplx.IsInf(root.Value) {
+			writeErrorWithDetails(w, r, apierrors.DomainError("result outside representable range"))
+			return
+		}
+		response.Roots[i] = models.PolynomialRoot{
+			Re:           real(root.Value),
+			Im:           imag(root.Value),
+			Exact:        root.Exact,
+			Multiplicity: root.Multiplicity,
+		}
+	}
+	if err := writeSuccessResponse(w, r, response, rounding); err != nil {
+		// Error already logged, headers likely already sent
+		return
+	}
+}
+
+func EquationsHandler(w http.ResponseWriter, r *http.Request) {
+	if err := validation.ValidateMethod(r.Method, http.MethodPost); err != nil {
+		writeErrorWithDetails(w, r, err)
+		return
+	}
+
+	var req models.EquationsRequest
+	rounding, apiErr := decodeJSONBody(r, &req)
+	if apiErr != nil {
+		writeErrorWithDetails(w, r, apiErr)
+		return
+	}
+
+	if err := validation.ValidateEquationsRequest(&req); err != nil {
+		writeErrorWithDetails(w, r, err)
+		return
+	}
+
+	system, err := polynomial.SolveLinear(req.Equations)
+	if err != nil {
+		writeErrorWithDetails(w, r, calculationError(err))
+		return
+	}
+
+	response := models.EquationsResponse{
+		Variables:     system.Variables,
+		Status:        string(system.Status),
+		Rank:          system.Rank,
+		FreeVariables: system.FreeVariables,
+	}
+	for i, value := range system.Solution {
+		f, _ := value.Float64()
+		if math.IsInf(f, 0) {
+			writeErrorWithDetails(w, r, apierrors.DomainError("result outside representable range").
+				WithDetails(fmt.Sprintf("%s does not fit in a float64", system.Variables[i])))
+			return
+		}
+		response.Solution = append(response.Solution, models.VariableValue{
+			Variable: system.Variables[i],
+			Value:    f,
+			Exact:    value.RatString(),
+		})
+	}
+	if err := writeSuccessResponse(w, r, response, rounding); err != nil {
+		// Error already logged, headers likely already sent
+		return
+	}
+}
@@ -0,0 +1,220 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/m-szczepanski/gocalc-api/internal/middleware"
+	"github.com/m-szczepanski/gocalc-api/internal/models"
+)
+
+func TestPolynomialHandlers(t *testing.T) {
+	tests := []struct {
+		name           string
+		handler        http.HandlerFunc
+		method         string
+		body           string
+		expectedStatus int
+		expectedCode   string
+		checkData      func(t *testing.T, data map[string]interface{})
+	}{
+		{
+			name:           "quadratic surds from coefficients",
+			handler:        PolynomialRootsHandler,
+			method:         http.MethodPost,
+			body:           `{"coefficients": [0, 1, -3, 1]}`,
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				if data["degree"] != float64(2) || data["method"] != "closed-form" {
+					t.Errorf("degree = %v, method = %v", data["degree"], data["method"])
+				}
+				coefficients := data["coefficients"].([]interface{})
+				if len(coefficients) != 3 || coefficients[1] != "-3" {
+					t.Errorf("coefficients = %v", coefficients)
+				}
+				roots := data["roots"].([]interface{})
+				first := roots[0].(map[string]interface{})
+				if first["exact"] != "3/2 - √5/2" || !floatEquals(first["re"].(float64), (3-math.Sqrt(5))/2, 1e-12) {
+					t.Errorf("roots = %v", roots)
+				}
+			},
+		},
+		{
+			name:           "equation with complex and repeated roots",
+			handler:        PolynomialRootsHandler,
+			method:         http.MethodPost,
+			body:           `{"expression": "(t - 1)^2 (t^2 + 4) = 0"}`,
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				if data["variable"] != "t" || data["degree"] != float64(4) {
+					t.Errorf("variable = %v, degree = %v", data["variable"], data["degree"])
+				}
+				roots := data["roots"].([]interface{})
+				if len(roots) != 3 {
+					t.Fatalf("roots = %v", roots)
+				}
+				repeated := roots[0].(map[string]interface{})
+				if repeated["exact"] != "1" || repeated["multiplicity"] != float64(2) {
+					t.Errorf("root 0 = %v", repeated)
+				}
+				if imaginary := roots[2].(map[string]interface{}); imaginary["exact"] != "2i" || imaginary["im"] != float64(2) {
+					t.Errorf("root 2 = %v", imaginary)
+				}
+			},
+		},
+		{
+			name:           "quintic uses the companion matrix",
+			handler:        PolynomialRootsHandler,
+			method:         http.MethodPost,
+			body:           `{"coefficients": [1, 0, 0, 0, -1, -1]}`,
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				if data["method"] != "companion-matrix" || len(data["roots"].([]interface{})) != 5 {
+					t.Errorf("method = %v, roots = %v", data["method"], data["roots"])
+				}
+			},
+		},
+		{
+			name:           "both coefficients and expression",
+			handler:        PolynomialRootsHandler,
+			method:         http.MethodPost,
+			body:           `{"coefficients": [1, 2], "expression": "x + 2"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_ERROR",
+		},
+		{
+			name:           "not a polynomial",
+			handler:        PolynomialRootsHandler,
+			method:         http.MethodPost,
+			body:           `{"expression": "sin(x)"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_ERROR",
+		},
+		{
+			name:           "binary exponent coefficient",
+			handler:        PolynomialRootsHandler,
+			method:         http.MethodPost,
+			body:           `{"coefficients": ["1p200000", "1", "-3"]}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_ERROR",
+		},
+		{
+			name:           "coefficient too large",
+			handler:        PolynomialRootsHandler,
+			method:         http.MethodPost,
+			body:           `{"expression": "x + (10^100)^20"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_ERROR",
+		},
+		{
+			name:           "root overflows",
+			handler:        PolynomialRootsHandler,
+			method:         http.MethodPost,
+			body:           `{"coefficients": ["1e-160", "-1e160"]}`,
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedCode:   "DOMAIN_ERROR",
+		},
+		{
+			name:           "wrong method",
+			handler:        PolynomialRootsHandler,
+			method:         http.MethodGet,
+			expectedStatus: http.StatusMethodNotAllowed,
+			expectedCode:   "METHOD_NOT_ALLOWED",
+		},
+		{
+			name:           "unique solution",
+			handler:        EquationsHandler,
+			method:         http.MethodPost,
+			body:           `{"equations": ["2x + 3y = 5", "x - y = 1"]}`,
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				if data["status"] != "unique" || data["rank"] != float64(2) {
+					t.Errorf("status = %v, rank = %v", data["status"], data["rank"])
+				}
+				solution := data["solution"].([]interface{})
+				x := solution[0].(map[string]interface{})
+				if x["variable"] != "x" || x["exact"] != "8/5" || x["value"] != 1.6 {
+					t.Errorf("solution = %v", solution)
+				}
+			},
+		},
+		{
+			name:           "infinitely many solutions",
+			handler:        EquationsHandler,
+			method:         http.MethodPost,
+			body:           `{"equations": ["a + b = 2", "2a + 2b = 4"]}`,
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				free := data["free_variables"].([]interface{})
+				if data["status"] != "infinite" || len(free) != 1 || free[0] != "b" {
+					t.Errorf("status = %v, free variables = %v", data["status"], free)
+				}
+			},
+		},
+		{
+			name:           "inconsistent",
+			handler:        EquationsHandler,
+			method:         http.MethodPost,
+			body:           `{"equations": ["x + y = 1", "x + y = 2"]}`,
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				if _, ok := data["solution"]; ok || data["status"] != "inconsistent" {
+					t.Errorf("data = %v", data)
+				}
+			},
+		},
+		{
+			name:           "solution overflows",
+			handler:        EquationsHandler,
+			method:         http.MethodPost,
+			body:           `{"equations": ["x = 1e300*1e300"]}`,
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedCode:   "DOMAIN_ERROR",
+		},
+		{
+			name:           "nonlinear equation",
+			handler:        EquationsHandler,
+			method:         http.MethodPost,
+			body:           `{"equations": ["x^2 + y = 1"]}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_ERROR",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, "/api/math/polynomial-roots", bytes.NewReader([]byte(tt.body)))
+			ctx := context.WithValue(req.Context(), middleware.RequestIDKey, "test-123")
+			req = req.WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			tt.handler(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("status = %d, want %d: %s", w.Code, tt.expectedStatus, w.Body.String())
+			}
+
+			if tt.expectedCode != "" {
+				var resp models.APIErrorResponse
+				json.NewDecoder(w.Body).Decode(&resp)
+				if resp.Code != tt.expectedCode {
+					t.Errorf("error code = %s, want %s", resp.Code, tt.expectedCode)
+				}
+				return
+			}
+
+			var resp models.SuccessResponse
+			json.NewDecoder(w.Body).Decode(&resp)
+			data, ok := resp.Data.(map[string]interface{})
+			if !ok {
+				t.Fatalf("expected data in response")
+			}
+			tt.checkData(t, data)
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/m-szczepanski/gocalc-api/internal/models"
+	"github.com/m-szczepanski/gocalc-api/internal/validation"
+	"github.com/m-szczepanski/gocalc-api/pkg/calculations/random"
+)
+
+// NewRandomHandler returns a handler for /api/math/random drawing at most
+// maxValues values. Requests with a seed are reproducible; the rest use
+// crypto/rand.
+func NewRandomHandler(maxValues int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if err := validation.ValidateMethod(r.Method, http.MethodPost); err != nil {
+			writeErrorWithDetails(w, r, err)
+			return
+		}
+
+		var req models.RandomRequest
+		rounding, apiErr := decodeJSONBody(r, &req)
+		if apiErr != nil {
+			writeErrorWithDetails(w, r, apiErr)
+			return
+		}
+
+		if err := validation.ValidateRandomRequest(&req, maxValues); err != nil {
+			writeErrorWithDetails(w, r, err)
+			return
+		}
+
+		g := random.NewCrypto()
+		if req.Seed != nil {
+			g = random.NewSeeded(*req.Seed)
+		}
+		op, _ := random.ParseOperation(req.Operation)
+		count := 1
+		if req.Count != nil {
+			count = *req.Count
+		}
+
+		response := models.RandomResponse{
+			Operation: string(op),
+			Source:    string(g.Source),
+			Seed:      req.Seed,
+		}
+
+		var err error
+		switch op {
+		case random.UniformDraw:
+			response.Values, err = g.Uniform(valueOr(req.Min, 0), valueOr(req.Max, 1), count)
+		case random.NormalDraw:
+			response.Values, err = g.Normal(valueOr(req.Mean, 0), valueOr(req.SD, 1), count)
+		case random.IntegerDraw:
+			response.Values, err = g.Integers(int64(*req.Min), int64(*req.Max), count)
+		case random.ShuffleDraw:
+			response.Indices = g.Shuffle(len(req.Items))
+		case random.SampleDraw:
+			response.Indices, err = g.Sample(len(req.Items), count, req.Replace)
+		case random.ChoiceDraw:
+			weights := req.Weights
+			if weights == nil {
+				weights = make([]float64, len(req.Items))
+				for i := range weights {
+					weights[i] = 1
+				}
+			}
+			response.Indices, err = g.WeightedChoice(weights, count)
+		}
+		if err != nil {
+			writeErrorWithDetails(w, r, calculationError(err))
+			return
+		}
+
+		if random.UsesItems(op) {
+			items := make([]json.RawMessage, len(response.Indices))
+			for i, index := range response.Indices {
+				items[i] = req.Items[index]
+			}
+			response.Values = items
+		}
+		if err := writeSuccessResponse(w, r, response, rounding); err != nil {
+			// Error already logged, headers likely already sent
+			return
+		}
+	}
+}
+
+func valueOr(v *float64, fallback float64) float64 {
+	if v == nil {
+		return fallback
+	}
+	return *v
+}
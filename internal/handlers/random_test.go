@@ -0,0 +1,161 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/m-szczepanski/gocalc-api/internal/middleware"
+	"github.com/m-szczepanski/gocalc-api/internal/models"
+)
+
+func TestRandomHandler(t *testing.T) {
+	tests := []struct {
+		name           string
+		method         string
+		body           string
+		expectedStatus int
+		expectedCode   string
+		checkData      func(t *testing.T, data map[string]interface{})
+	}{
+		{
+			name:           "crypto source without seed",
+			method:         http.MethodPost,
+			body:           `{"operation": "uniform", "min": 5, "max": 6, "count": 3}`,
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				values := data["values"].([]interface{})
+				if data["source"] != "crypto" || len(values) != 3 {
+					t.Errorf("data = %v", data)
+				}
+				for _, v := range values {
+					if v.(float64) < 5 || v.(float64) >= 6 {
+						t.Errorf("value %v outside [5, 6)", v)
+					}
+				}
+				if _, ok := data["seed"]; ok {
+					t.Errorf("unexpected seed %v", data["seed"])
+				}
+			},
+		},
+		{
+			name:           "seeded integers",
+			method:         http.MethodPost,
+			body:           `{"operation": "integer", "min": 1, "max": 6, "count": 4, "seed": 42}`,
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				if data["source"] != "seeded" || data["seed"] != float64(42) {
+					t.Errorf("data = %v", data)
+				}
+				for _, v := range data["values"].([]interface{}) {
+					if v.(float64) < 1 || v.(float64) > 6 || v.(float64) != float64(int(v.(float64))) {
+						t.Errorf("value %v is not a die roll", v)
+					}
+				}
+			},
+		},
+		{
+			name:           "shuffle returns the items as sent",
+			method:         http.MethodPost,
+			body:           `{"operation": "shuffle", "items": ["a", {"b": 1}, 3], "seed": 1}`,
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				items := []interface{}{"a", map[string]interface{}{"b": float64(1)}, float64(3)}
+				values := data["values"].([]interface{})
+				for i, index := range data["indices"].([]interface{}) {
+					if !reflect.DeepEqual(values[i], items[int(index.(float64))]) {
+						t.Errorf("values[%d] = %v, want items[%v]", i, values[i], index)
+					}
+				}
+			},
+		},
+		{
+			name:           "weighted choice never picks a zero weight",
+			method:         http.MethodPost,
+			body:           `{"operation": "choice", "items": ["x", "y"], "weights": [0, 1], "count": 20}`,
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				for _, v := range data["values"].([]interface{}) {
+					if v != "y" {
+						t.Fatalf("values = %v", data["values"])
+					}
+				}
+			},
+		},
+		{
+			name:           "sample more than the population",
+			method:         http.MethodPost,
+			body:           `{"operation": "sample", "items": [1, 2], "count": 3}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_ERROR",
+		},
+		{
+			name:           "uniform range too wide",
+			method:         http.MethodPost,
+			body:           `{"operation": "uniform", "min": -1.7e308, "max": 1.7e308}`,
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedCode:   "DOMAIN_ERROR",
+		},
+		{
+			name:           "wrong method",
+			method:         http.MethodGet,
+			expectedStatus: http.StatusMethodNotAllowed,
+			expectedCode:   "METHOD_NOT_ALLOWED",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, "/api/math/random", bytes.NewReader([]byte(tt.body)))
+			ctx := context.WithValue(req.Context(), middleware.RequestIDKey, "test-123")
+			req = req.WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			NewRandomHandler(100)(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("status = %d, want %d: %s", w.Code, tt.expectedStatus, w.Body.String())
+			}
+
+			if tt.expectedCode != "" {
+				var resp models.APIErrorResponse
+				json.NewDecoder(w.Body).Decode(&resp)
+				if resp.Code != tt.expectedCode {
+					t.Errorf("error code = %s, want %s", resp.Code, tt.expectedCode)
+				}
+				return
+			}
+
+			var resp models.SuccessResponse
+			json.NewDecoder(w.Body).Decode(&resp)
+			data, ok := resp.Data.(map[string]interface{})
+			if !ok {
+				t.Fatalf("expected data in response")
+			}
+			tt.checkData(t, data)
+		})
+	}
+}
+
+func TestRandomHandlerSeedIsReproducible(t *testing.T) {
+	draw := func() string {
+		body := `{"operation": "normal", "count": 5, "seed": 7}`
+		req := httptest.NewRequest(http.MethodPost, "/api/math/random", bytes.NewReader([]byte(body)))
+		req = req.WithContext(context.WithValue(req.Context(), middleware.RequestIDKey, "test-123"))
+		w := httptest.NewRecorder()
+		NewRandomHandler(100)(w, req)
+
+		var resp models.SuccessResponse
+		json.NewDecoder(w.Body).Decode(&resp)
+		values, _ := json.Marshal(resp.Data.(map[string]interface{})["values"])
+		return string(values)
+	}
+
+	if first, second := draw(), draw(); first != second {
+		t.Errorf("seeded draws differ: %s and %s", first, second)
+	}
+}
@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strings"
+
+	apierrors "github.com/m-szczepanski/gocalc-api/internal/errors"
+	"github.com/m-szczepanski/gocalc-api/internal/models"
+	"github.com/m-szczepanski/gocalc-api/internal/validation"
+	"github.com/m-szczepanski/gocalc-api/pkg/calculations"
+)
+
+// NewRegressionHandler returns a curve-fitting handler accepting at most
+// maxDatasetSize points.
+func NewRegressionHandler(maxDatasetSize int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if err := validation.ValidateMethod(r.Method, http.MethodPost); err != nil {
+			writeErrorWithDetails(w, r, err)
+			return
+		}
+
+		var req models.RegressionRequest
+		rounding, apiErr := decodeJSONBody(r, &req)
+		if apiErr != nil {
+			writeErrorWithDetails(w, r, apiErr)
+			return
+		}
+
+		if err := validation.ValidateRegressionRequest(&req, maxDatasetSize); err != nil {
+			writeErrorWithDetails(w, r, err)
+			return
+		}
+
+		xs := make([]float64, len(req.Points))
+		ys := make([]float64, len(req.Points))
+		for i, p := range req.Points {
+			xs[i], ys[i] = p.X, p.Y
+		}
+
+		model := calculations.RegressionModel(strings.ToLower(strings.TrimSpace(req.Model)))
+		result, err := calculations.FitRegression(xs, ys, model, req.Degree)
+		if err != nil {
+			writeErrorWithDetails(w, r, calculationError(err))
+			return
+		}
+
+		response := models.RegressionResponse{
+			Model:        string(result.Model),
+			Coefficients: result.Coefficients,
+			Equation:     result.Equation,
+			RSquared:     result.RSquared,
+			Residuals:    result.Residuals,
+		}
+		if model == calculations.RegressionPolynomial {
+			response.Degree = result.Degree
+		}
+
+		for _, x := range req.Predict {
+			y := result.Predict(x)
+			if math.IsNaN(y) || math.IsInf(y, 0) {
+				writeErrorWithDetails(w, r, apierrors.DomainError("prediction outside representable range").
+					WithDetails(fmt.Sprintf("fitted curve overflows at x = %v", x)))
+				return
+			}
+			response.Predictions = append(response.Predictions, models.Point{X: x, Y: y})
+		}
+
+		if err := writeSuccessResponse(w, r, response, rounding); err != nil {
+			// Error already logged, headers likely already sent
+			return
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/m-szczepanski/gocalc-api/internal/middleware"
+	"github.com/m-szczepanski/gocalc-api/internal/models"
+)
+
+func TestRegressionHandler(t *testing.T) {
+	handler := NewRegressionHandler(100)
+
+	tests := []struct {
+		name           string
+		method         string
+		body           *models.RegressionRequest
+		expectedStatus int
+		expectedCode   string
+		checkData      func(t *testing.T, data map[string]interface{})
+	}{
+		{
+			name:   "linear fit with predictions",
+			method: http.MethodPost,
+			body: &models.RegressionRequest{
+				Points:  []models.Point{{X: 0, Y: 1}, {X: 1, Y: 3}, {X: 2, Y: 5}},
+				Model:   "linear",
+				Predict: []float64{10},
+			},
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				coefficients := data["coefficients"].([]interface{})
+				if !floatEquals(coefficients[0].(float64), 1, 1e-9) || !floatEquals(coefficients[1].(float64), 2, 1e-9) {
+					t.Errorf("coefficients = %v, want [1 2]", coefficients)
+				}
+				if !floatEquals(data["r_squared"].(float64), 1, 1e-9) {
+					t.Errorf("r_squared = %v, want 1", data["r_squared"])
+				}
+				predictions := data["predictions"].([]interface{})
+				prediction := predictions[0].(map[string]interface{})
+				if !floatEquals(prediction["y"].(float64), 21, 1e-9) {
+					t.Errorf("prediction at x=10 = %v, want 21", prediction["y"])
+				}
+			},
+		},
+		{
+			name:   "polynomial fit reports degree",
+			method: http.MethodPost,
+			body: &models.RegressionRequest{
+				Points: []models.Point{{X: -1, Y: 1}, {X: 0, Y: 0}, {X: 1, Y: 1}, {X: 2, Y: 4}},
+				Model:  "polynomial",
+				Degree: 2,
+			},
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				if data["degree"].(float64) != 2 {
+					t.Errorf("degree = %v, want 2", data["degree"])
+				}
+				if len(data["residuals"].([]interface{})) != 4 {
+					t.Errorf("expected 4 residuals, got %v", data["residuals"])
+				}
+			},
+		},
+		{
+			name:   "exponential fit with non-positive y",
+			method: http.MethodPost,
+			body: &models.RegressionRequest{
+				Points: []models.Point{{X: 0, Y: 1}, {X: 1, Y: -2}},
+				Model:  "exponential",
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_ERROR",
+		},
+		{
+			name:   "prediction overflow",
+			method: http.MethodPost,
+			body: &models.RegressionRequest{
+				Points:  []models.Point{{X: 0, Y: 1}, {X: 1, Y: 10}},
+				Model:   "exponential",
+				Predict: []float64{1000},
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedCode:   "DOMAIN_ERROR",
+		},
+		{
+			name:   "fit overflow",
+			method: http.MethodPost,
+			body: &models.RegressionRequest{
+				Points: []models.Point{{X: 0, Y: 1e308}, {X: 1, Y: -1e308}, {X: 2, Y: 1e308}},
+				Model:  "linear",
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedCode:   "DOMAIN_ERROR",
+		},
+		{
+			name:   "unknown model",
+			method: http.MethodPost,
+			body: &models.RegressionRequest{
+				Points: []models.Point{{X: 0, Y: 1}, {X: 1, Y: 2}},
+				Model:  "spline",
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_ERROR",
+		},
+		{
+			name:           "method not allowed",
+			method:         http.MethodGet,
+			body:           &models.RegressionRequest{},
+			expectedStatus: http.StatusMethodNotAllowed,
+			expectedCode:   "METHOD_NOT_ALLOWED",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, _ := json.Marshal(tt.body)
+			req := httptest.NewRequest(tt.method, "/api/math/regression", bytes.NewReader(body))
+			ctx := context.WithValue(req.Context(), middleware.RequestIDKey, "test-123")
+			req = req.WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			handler(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("status = %d, want %d", w.Code, tt.expectedStatus)
+			}
+
+			if tt.expectedCode != "" {
+				var resp models.APIErrorResponse
+				json.NewDecoder(w.Body).Decode(&resp)
+				if resp.Code != tt.expectedCode {
+					t.Errorf("error code = %s, want %s", resp.Code, tt.expectedCode)
+				}
+				return
+			}
+
+			var resp models.SuccessResponse
+			json.NewDecoder(w.Body).Decode(&resp)
+			data, ok := resp.Data.(map[string]interface{})
+			if !ok {
+				t.Fatalf("expected data in response")
+			}
+			tt.checkData(t, data)
+		})
+	}
+}
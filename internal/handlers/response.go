@@ -1,13 +1,16 @@
 package handlers
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"log/slog"
 	"net/http"
 
 	apierrors "github.com/m-szczepanski/gocalc-api/internal/errors"
 	"github.com/m-szczepanski/gocalc-api/internal/middleware"
 	"github.com/m-szczepanski/gocalc-api/internal/models"
+	"github.com/m-szczepanski/gocalc-api/pkg/calculations"
 )
 
 func writeJSON(w http.ResponseWriter, statusCode int, data interface{}) error {
@@ -21,6 +24,7 @@ func writeErrorWithDetails(w http.ResponseWriter, r *http.Request, apiErr *apier
 	statusCode := apiErr.HTTPStatus()
 
 	resp := models.NewAPIErrorResponse(apiErr.Code, apiErr.Message, apiErr.Details, requestID)
+	resp.Meta = apiErr.Meta
 	if err := writeJSON(w, statusCode, resp); err != nil {
 		slog.Error("failed to encode error response",
 			"error", err,
@@ -30,8 +34,13 @@ func writeErrorWithDetails(w http.ResponseWriter, r *http.Request, apiErr *apier
 	}
 }
 
-func writeSuccessResponse(w http.ResponseWriter, r *http.Request, data interface{}) error {
+// writeSuccessResponse writes data as a success envelope, first rounding its
+// floats if a rounding is given.
+func writeSuccessResponse(w http.ResponseWriter, r *http.Request, data interface{}, rounding *calculations.Rounding) error {
 	requestID := middleware.ExtractRequestID(r.Context())
+	if rounding != nil {
+		data = roundFloats(data, *rounding)
+	}
 
 	resp := models.NewSuccessResponse(data, requestID)
 	if err := writeJSON(w, http.StatusOK, resp); err != nil {
@@ -43,3 +52,18 @@ func writeSuccessResponse(w http.ResponseWriter, r *http.Request, data interface
 	}
 	return nil
 }
+
+// calculationError maps an error returned by pkg/calculations to an API error,
+// reporting domain violations as DOMAIN_ERROR rather than generic validation failures.
+func calculationError(err error) *apierrors.APIError {
+	if errors.Is(err, calculations.ErrDomain) {
+		return apierrors.DomainError("input outside function domain").WithDetails(err.Error())
+	}
+	return apierrors.ValidationError("calculation error", err.Error())
+}
+
+// requestEnded reports whether err stems from the request context ending, in
+// which case TimeoutMiddleware has already written a response.
+func requestEnded(err error) bool {
+	return errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled)
+}
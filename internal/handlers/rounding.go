@@ -0,0 +1,135 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+
+	apierrors "github.com/m-szczepanski/gocalc-api/internal/errors"
+	"github.com/m-szczepanski/gocalc-api/pkg/calculations"
+)
+
+// RoundingHeader carries a request-level precision such as "2dp" or
+// "4sf half-even". A top-level "rounding" body field takes precedence.
+const RoundingHeader = "X-Rounding"
+
+// validateRounding parses a rounding spec taken from the X-Rounding header
+// or the "rounding" body field.
+func validateRounding(spec string) (*calculations.Rounding, *apierrors.APIError) {
+	rounding, err := calculations.ParseRounding(spec)
+	if err != nil {
+		return nil, apierrors.ValidationError("invalid rounding", err.Error())
+	}
+	return &rounding, nil
+}
+
+// requestRounding returns the rounding requested by the X-Rounding header, or
+// nil if there is none.
+func requestRounding(r *http.Request) (*calculations.Rounding, *apierrors.APIError) {
+	spec := r.Header.Get(RoundingHeader)
+	if spec == "" {
+		return nil, nil
+	}
+	return validateRounding(spec)
+}
+
+// roundingField returns the rounding requested by a raw "rounding" body
+// field, which must be a string, falling back to the X-Rounding header when
+// the field is absent or null.
+func roundingField(r *http.Request, field json.RawMessage) (*calculations.Rounding, *apierrors.APIError) {
+	var spec *string
+	if len(field) > 0 {
+		if err := json.Unmarshal(field, &spec); err != nil {
+			return nil, apierrors.ValidationError(
+				"invalid rounding",
+				`rounding must be a string such as "2dp" or "3sf half-even"`,
+			)
+		}
+	}
+	if spec == nil {
+		return requestRounding(r)
+	}
+	return validateRounding(*spec)
+}
+
+// roundingOr returns rounding, or fallback for calculations that round by
+// default when none was requested.
+func roundingOr(rounding *calculations.Rounding, fallback calculations.Rounding) calculations.Rounding {
+	if rounding != nil {
+		return *rounding
+	}
+	return fallback
+}
+
+// roundFloats returns a copy of data with every floating-point value rounded,
+// however deeply it is nested in structs, pointers, slices, maps and
+// interfaces. Referenced values are copied rather than modified, since they
+// may be shared with the caller or with package-level tables.
+func roundFloats(data interface{}, rounding calculations.Rounding) interface{} {
+	if data == nil {
+		return nil
+	}
+	v := reflect.ValueOf(data)
+	copied := reflect.New(v.Type()).Elem()
+	copied.Set(v)
+	roundValue(copied, rounding)
+	return copied.Interface()
+}
+
+// roundValue rounds the floats reachable from v, which must be settable.
+func roundValue(v reflect.Value, rounding calculations.Rounding) {
+	switch v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(rounding.Round(v.Float()))
+	case reflect.Pointer:
+		if v.IsNil() {
+			return
+		}
+		p := reflect.New(v.Type().Elem())
+		p.Elem().Set(v.Elem())
+		roundValue(p.Elem(), rounding)
+		v.Set(p)
+	case reflect.Interface:
+		if v.IsNil() {
+			return
+		}
+		inner := reflect.New(v.Elem().Type()).Elem()
+		inner.Set(v.Elem())
+		roundValue(inner, rounding)
+		v.Set(inner)
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if field := v.Field(i); field.CanSet() {
+				roundValue(field, rounding)
+			}
+		}
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			roundValue(v.Index(i), rounding)
+		}
+	case reflect.Slice:
+		// Byte slices such as json.RawMessage hold no floats.
+		if v.IsNil() || v.Type().Elem().Kind() == reflect.Uint8 {
+			return
+		}
+		s := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		reflect.Copy(s, v)
+		for i := 0; i < s.Len(); i++ {
+			roundValue(s.Index(i), rounding)
+		}
+		v.Set(s)
+	case reflect.Map:
+		if v.IsNil() {
+			return
+		}
+		m := reflect.MakeMapWithSize(v.Type(), v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			value := reflect.New(iter.Value().Type()).Elem()
+			value.Set(iter.Value())
+			roundValue(value, rounding)
+			m.SetMapIndex(iter.Key(), value)
+		}
+		v.Set(m)
+	}
+}
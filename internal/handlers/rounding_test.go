@@ -0,0 +1,214 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/m-szczepanski/gocalc-api/internal/middleware"
+	"github.com/m-szczepanski/gocalc-api/internal/models"
+	"github.com/m-szczepanski/gocalc-api/pkg/calculations"
+)
+
+func TestRequestRounding(t *testing.T) {
+	tests := []struct {
+		name           string
+		handler        http.HandlerFunc
+		body           string
+		header         string
+		expectedStatus int
+		expectedCode   string
+		expected       map[string]interface{}
+	}{
+		{
+			name:           "header rounds decimal places",
+			handler:        DivideHandler,
+			body:           `{"a": 2, "b": 3}`,
+			header:         "3dp",
+			expectedStatus: http.StatusOK,
+			expected:       map[string]interface{}{"result": 0.667},
+		},
+		{
+			name:           "field rounds significant figures",
+			handler:        DivideHandler,
+			body:           `{"a": 20000, "b": 3, "rounding": "2sf"}`,
+			expectedStatus: http.StatusOK,
+			expected:       map[string]interface{}{"result": float64(6700)},
+		},
+		{
+			name:           "field overrides header",
+			handler:        DivideHandler,
+			body:           `{"a": 2, "b": 3, "rounding": "1dp floor"}`,
+			header:         "5dp",
+			expectedStatus: http.StatusOK,
+			expected:       map[string]interface{}{"result": 0.6},
+		},
+		{
+			name:           "no rounding by default",
+			handler:        DivideHandler,
+			body:           `{"a": 1, "b": 4}`,
+			expectedStatus: http.StatusOK,
+			expected:       map[string]interface{}{"result": 0.25},
+		},
+		{
+			name:           "half-even replaces the default currency rounding",
+			handler:        LoanPaymentHandler,
+			body:           `{"principal": 1000.25, "annual_rate": 0, "years": 1, "payments_per_year": 2, "rounding": "1dp half-even"}`,
+			expectedStatus: http.StatusOK,
+			expected: map[string]interface{}{
+				"payment_amount": 500.1,
+				"total_payment":  1000.2,
+				"total_interest": float64(0),
+			},
+		},
+		{
+			name:           "finance keeps cents without rounding option",
+			handler:        LoanPaymentHandler,
+			body:           `{"principal": 1000.25, "annual_rate": 0, "years": 1, "payments_per_year": 2}`,
+			expectedStatus: http.StatusOK,
+			expected: map[string]interface{}{
+				"payment_amount": 500.13,
+				"total_payment":  1000.25,
+				"total_interest": float64(0),
+			},
+		},
+		{
+			name:           "conversion rounds with request precision",
+			handler:        UnitConversionHandler,
+			body:           `{"value": 1, "from_unit": "mi", "to_unit": "km", "unit_type": "distance"}`,
+			header:         "3sf ceil",
+			expectedStatus: http.StatusOK,
+			expected: map[string]interface{}{
+				"result":    1.61,
+				"from_unit": "mi",
+				"to_unit":   "km",
+				"unit_type": "distance",
+				"dimension": "length (L)",
+				"compound":  "1 km 610 m",
+			},
+		},
+		{
+			name:           "invalid header",
+			handler:        DivideHandler,
+			body:           `{"a": 2, "b": 3}`,
+			header:         "3 decimals",
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_ERROR",
+		},
+		{
+			name:           "invalid field mode",
+			handler:        DivideHandler,
+			body:           `{"a": 2, "b": 3, "rounding": "2dp bankers"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_ERROR",
+		},
+		{
+			name:           "non-string field",
+			handler:        DivideHandler,
+			body:           `{"a": 2, "b": 3, "rounding": 5}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_ERROR",
+		},
+		{
+			name:           "null field falls back to header",
+			handler:        DivideHandler,
+			body:           `{"a": 2, "b": 3, "rounding": null}`,
+			header:         "2dp",
+			expectedStatus: http.StatusOK,
+			expected:       map[string]interface{}{"result": 0.67},
+		},
+		{
+			name:           "field out of range",
+			handler:        DivideHandler,
+			body:           `{"a": 2, "b": 3, "rounding": "0sf"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_ERROR",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader([]byte(tt.body)))
+			if tt.header != "" {
+				req.Header.Set(RoundingHeader, tt.header)
+			}
+			ctx := context.WithValue(req.Context(), middleware.RequestIDKey, "test-123")
+			req = req.WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			tt.handler(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("status = %d, want %d: %s", w.Code, tt.expectedStatus, w.Body.String())
+			}
+
+			if tt.expectedCode != "" {
+				var resp models.APIErrorResponse
+				json.NewDecoder(w.Body).Decode(&resp)
+				if resp.Code != tt.expectedCode {
+					t.Errorf("error code = %s, want %s", resp.Code, tt.expectedCode)
+				}
+				return
+			}
+
+			var resp models.SuccessResponse
+			json.NewDecoder(w.Body).Decode(&resp)
+			if !reflect.DeepEqual(resp.Data, tt.expected) {
+				t.Errorf("data = %v, want %v", resp.Data, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDecodeJSONBodyLeavesHeadersAlone(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader([]byte(`{"a": 2, "b": 3, "rounding": "1dp"}`)))
+	req.Header.Set(RoundingHeader, "5dp")
+
+	var body models.MathRequest
+	rounding, err := decodeJSONBody(req, &body)
+	if err != nil {
+		t.Fatalf("decodeJSONBody() unexpected error: %v", err)
+	}
+	if rounding == nil || *rounding != calculations.DecimalPlaces(1) {
+		t.Errorf("rounding = %v, want 1dp", rounding)
+	}
+	if got := req.Header.Get(RoundingHeader); got != "5dp" {
+		t.Errorf("%s header = %q, want it unchanged", RoundingHeader, got)
+	}
+}
+
+func TestRoundFloatsCopiesSharedValues(t *testing.T) {
+	shared := []float64{1.2345, 2.3456}
+	uncertainty := 0.04321
+	data := struct {
+		Values      []float64
+		Uncertainty *float64
+		Meta        map[string]interface{}
+		Count       int
+		Label       string
+	}{
+		Values:      shared,
+		Uncertainty: &uncertainty,
+		Meta:        map[string]interface{}{"mean": 1.79005, "nested": []interface{}{0.5555}},
+		Count:       12345,
+		Label:       "1.2345",
+	}
+
+	rounded := roundFloats(data, calculations.DecimalPlaces(2))
+
+	expected := data
+	expected.Values = []float64{1.23, 2.35}
+	roundedUncertainty := 0.04
+	expected.Uncertainty = &roundedUncertainty
+	expected.Meta = map[string]interface{}{"mean": 1.79, "nested": []interface{}{0.56}}
+	if !reflect.DeepEqual(rounded, expected) {
+		t.Errorf("roundFloats() = %+v, want %+v", rounded, expected)
+	}
+	if shared[0] != 1.2345 || uncertainty != 0.04321 || data.Meta["mean"] != 1.79005 {
+		t.Errorf("roundFloats() modified its input: %v, %v, %v", shared, uncertainty, data.Meta)
+	}
+}
@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/m-szczepanski/gocalc-api/internal/models"
+	"github.com/m-szczepanski/gocalc-api/internal/validation"
+	"github.com/m-szczepanski/gocalc-api/pkg/calculations"
+)
+
+func FunctionHandler(w http.ResponseWriter, r *http.Request) {
+	if err := validation.ValidateMethod(r.Method, http.MethodPost); err != nil {
+		writeErrorWithDetails(w, r, err)
+		return
+	}
+
+	var req models.FunctionRequest
+	rounding, apiErr := decodeJSONBody(r, &req)
+	if apiErr != nil {
+		writeErrorWithDetails(w, r, apiErr)
+		return
+	}
+
+	if err := validation.ValidateFunctionRequest(&req); err != nil {
+		writeErrorWithDetails(w, r, err)
+		return
+	}
+
+	fn := calculations.ScientificFunction(strings.ToLower(strings.TrimSpace(req.Function)))
+	mode, _ := calculations.ParseAngleMode(req.AngleMode)
+
+	var y float64
+	if req.Y != nil {
+		y = *req.Y
+	}
+
+	var response models.FunctionResponse
+	if numberMode, _ := calculations.ParseNumberMode(req.Mode); numberMode != calculations.ModeDecimal {
+		x := measurementOperand(req.TextX, req.X, req.UncertaintyX)
+		var ym calculations.Measurement
+		if req.TextY != "" {
+			ym = measurementOperand(req.TextY, y, req.UncertaintyY)
+		}
+		result, bounds, err := evaluateMeasured(numberMode, x, ym,
+			func(a, b calculations.Measurement) (calculations.Measurement, error) {
+				return calculations.EvaluateUncertain(fn, a, b, mode)
+			},
+			func(a, b calculations.Interval) (calculations.Interval, error) {
+				return calculations.EvaluateInterval(fn, a, b, mode)
+			},
+		)
+		if err != nil {
+			writeErrorWithDetails(w, r, calculationError(err))
+			return
+		}
+		response = models.FunctionResponse{Result: result.Value, Uncertainty: &result.Uncertainty}
+		if bounds != nil {
+			response.Lower, response.Upper = &bounds.Lower, &bounds.Upper
+		}
+	} else {
+		result, err := calculations.EvaluateFunction(fn, req.X, y, mode)
+		if err != nil {
+			writeErrorWithDetails(w, r, calculationError(err))
+			return
+		}
+		response.Result = result
+	}
+
+	response.Function = string(fn)
+	if isAngular(fn) {
+		response.AngleMode = string(mode)
+	}
+
+	if err := writeSuccessResponse(w, r, response, rounding); err != nil {
+		// Error already logged, headers likely already sent
+		return
+	}
+}
+
+func isAngular(fn calculations.ScientificFunction) bool {
+	switch fn {
+	case calculations.FuncSin, calculations.FuncCos, calculations.FuncTan,
+		calculations.FuncAsin, calculations.FuncAcos, calculations.FuncAtan:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,199 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/m-szczepanski/gocalc-api/internal/middleware"
+	"github.com/m-szczepanski/gocalc-api/internal/models"
+)
+
+func TestFunctionHandler(t *testing.T) {
+	two := 2.0
+	tests := []struct {
+		name           string
+		method         string
+		body           interface{}
+		expectedStatus int
+		expectedResult float64
+		expectedCode   string
+	}{
+		{
+			name:           "sin in degrees",
+			method:         http.MethodPost,
+			body:           models.FunctionRequest{Function: "sin", X: 90, AngleMode: "degrees"},
+			expectedStatus: http.StatusOK,
+			expectedResult: 1,
+		},
+		{
+			name:           "log base 2",
+			method:         http.MethodPost,
+			body:           models.FunctionRequest{Function: "log", X: 8, Y: &two},
+			expectedStatus: http.StatusOK,
+			expectedResult: 3,
+		},
+		{
+			name:           "log of negative is a domain error",
+			method:         http.MethodPost,
+			body:           models.FunctionRequest{Function: "log", X: -8, Y: &two},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedCode:   "DOMAIN_ERROR",
+		},
+		{
+			name:           "asin(2) is a domain error",
+			method:         http.MethodPost,
+			body:           models.FunctionRequest{Function: "asin", X: 2},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedCode:   "DOMAIN_ERROR",
+		},
+		{
+			name:           "even root of negative is a domain error",
+			method:         http.MethodPost,
+			body:           models.FunctionRequest{Function: "root", X: -4, Y: &two},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedCode:   "DOMAIN_ERROR",
+		},
+		{
+			name:           "missing second operand",
+			method:         http.MethodPost,
+			body:           models.FunctionRequest{Function: "pow", X: 2},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_ERROR",
+		},
+		{
+			name:           "unknown function",
+			method:         http.MethodPost,
+			body:           models.FunctionRequest{Function: "gamma", X: 2},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_ERROR",
+		},
+		{
+			name:           "invalid JSON",
+			method:         http.MethodPost,
+			body:           "not json",
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "INVALID_INPUT",
+		},
+		{
+			name:           "method not allowed",
+			method:         http.MethodGet,
+			body:           models.FunctionRequest{Function: "exp", X: 1},
+			expectedStatus: http.StatusMethodNotAllowed,
+			expectedCode:   "METHOD_NOT_ALLOWED",
+		},
+		{
+			name:           "string operand requires a measurement mode",
+			method:         http.MethodPost,
+			body:           `{"function": "sin", "x": "30 ± 1"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_ERROR",
+		},
+		{
+			name:           "tan over an asymptote in interval mode",
+			method:         http.MethodPost,
+			body:           `{"function": "tan", "x": "90 ± 5", "angle_mode": "degrees", "mode": "interval"}`,
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedCode:   "DOMAIN_ERROR",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var body []byte
+			if s, ok := tt.body.(string); ok {
+				body = []byte(s)
+			} else {
+				body, _ = json.Marshal(tt.body)
+			}
+			req := httptest.NewRequest(tt.method, "/api/math/function", bytes.NewReader(body))
+			ctx := context.WithValue(req.Context(), middleware.RequestIDKey, "test-123")
+			req = req.WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			FunctionHandler(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("status = %d, want %d", w.Code, tt.expectedStatus)
+			}
+
+			if tt.expectedCode != "" {
+				var resp models.APIErrorResponse
+				json.NewDecoder(w.Body).Decode(&resp)
+				if resp.Code != tt.expectedCode {
+					t.Errorf("error code = %s, want %s", resp.Code, tt.expectedCode)
+				}
+				return
+			}
+
+			var resp models.SuccessResponse
+			json.NewDecoder(w.Body).Decode(&resp)
+			data, ok := resp.Data.(map[string]interface{})
+			if !ok {
+				t.Fatalf("expected data in response")
+			}
+			if result, ok := data["result"].(float64); !ok || !floatEquals(result, tt.expectedResult, 1e-9) {
+				t.Errorf("result = %v, want %v", data["result"], tt.expectedResult)
+			}
+		})
+	}
+}
+
+func TestFunctionHandlerMeasuredModes(t *testing.T) {
+	tests := []struct {
+		name   string
+		body   string
+		result float64
+		u      float64
+		bounds []float64
+	}{
+		{
+			name:   "sin propagates in degrees",
+			body:   `{"function": "sin", "x": "30 ± 1", "angle_mode": "degrees", "mode": "uncertainty"}`,
+			result: 0.5,
+			u:      math.Cos(math.Pi/6) * math.Pi / 180,
+		},
+		{
+			name:   "pow with an uncertain exponent",
+			body:   `{"function": "pow", "x": {"value": 2, "uncertainty": 0.1}, "y": "3 ± 0.01", "mode": "uncertainty"}`,
+			result: 8,
+			u:      math.Hypot(1.2, 8*math.Ln2*0.01),
+		},
+		{
+			name:   "cos reaches its peak inside the interval",
+			body:   `{"function": "cos", "x": "0 ± 60", "angle_mode": "degrees", "mode": "interval"}`,
+			result: 0.75,
+			u:      0.25,
+			bounds: []float64{0.5, 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/api/math/function", bytes.NewReader([]byte(tt.body)))
+			ctx := context.WithValue(req.Context(), middleware.RequestIDKey, "test-123")
+			req = req.WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			FunctionHandler(w, req)
+
+			if w.Code != http.StatusOK {
+				t.Fatalf("status = %d, want %d: %s", w.Code, http.StatusOK, w.Body.String())
+			}
+
+			var resp models.SuccessResponse
+			json.NewDecoder(w.Body).Decode(&resp)
+			data := resp.Data.(map[string]interface{})
+			if !floatEquals(data["result"].(float64), tt.result, 1e-12) || !floatEquals(data["uncertainty"].(float64), tt.u, 1e-12) {
+				t.Errorf("result = %v ± %v, want %v ± %v", data["result"], data["uncertainty"], tt.result, tt.u)
+			}
+			if tt.bounds != nil && (!floatEquals(data["lower"].(float64), tt.bounds[0], 1e-12) || data["upper"] != tt.bounds[1]) {
+				t.Errorf("bounds = [%v, %v], want %v", data["lower"], data["upper"], tt.bounds)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/m-szczepanski/gocalc-api/internal/models"
+	"github.com/m-szczepanski/gocalc-api/internal/validation"
+	"github.com/m-szczepanski/gocalc-api/pkg/calculations"
+)
+
+// NewStatisticsHandler returns a handler computing descriptive statistics for
+// datasets of at most maxDatasetSize values.
+func NewStatisticsHandler(maxDatasetSize int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if err := validation.ValidateMethod(r.Method, http.MethodPost); err != nil {
+			writeErrorWithDetails(w, r, err)
+			return
+		}
+
+		var req models.StatisticsRequest
+		rounding, apiErr := decodeJSONBody(r, &req)
+		if apiErr != nil {
+			writeErrorWithDetails(w, r, apiErr)
+			return
+		}
+
+		if err := validation.ValidateStatisticsRequest(&req, maxDatasetSize); err != nil {
+			writeErrorWithDetails(w, r, err)
+			return
+		}
+
+		stats, err := calculations.Describe(req.Values)
+		if err != nil {
+			writeErrorWithDetails(w, r, calculationError(err))
+			return
+		}
+
+		response := models.StatisticsResponse{
+			Count:              stats.Count,
+			Sum:                stats.Sum,
+			Mean:               stats.Mean,
+			Median:             stats.Median,
+			Modes:              stats.Modes,
+			PopulationVariance: stats.PopulationVariance,
+			SampleVariance:     stats.SampleVariance,
+			PopulationStdDev:   stats.PopulationStdDev,
+			SampleStdDev:       stats.SampleStdDev,
+			Min:                stats.Min,
+			Max:                stats.Max,
+			Range:              stats.Range,
+			Q1:                 stats.Q1,
+			Q3:                 stats.Q3,
+			IQR:                stats.IQR,
+			Skewness:           stats.Skewness,
+			Kurtosis:           stats.Kurtosis,
+		}
+
+		if req.Weights != nil {
+			weightedMean, err := calculations.WeightedMean(req.Values, req.Weights)
+			if err != nil {
+				writeErrorWithDetails(w, r, calculationError(err))
+				return
+			}
+			response.WeightedMean = &weightedMean
+		}
+
+		if len(req.Percentiles) > 0 {
+			values, err := calculations.Percentiles(req.Values, req.Percentiles)
+			if err != nil {
+				writeErrorWithDetails(w, r, calculationError(err))
+				return
+			}
+			response.Percentiles = make(map[string]float64, len(values))
+			for i, p := range req.Percentiles {
+				response.Percentiles[strconv.FormatFloat(p, 'f', -1, 64)] = values[i]
+			}
+		}
+
+		if err := writeSuccessResponse(w, r, response, rounding); err != nil {
+			// Error already logged, headers likely already sent
+			return
+		}
+	}
+}
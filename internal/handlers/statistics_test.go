@@ -0,0 +1,144 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/m-szczepanski/gocalc-api/internal/middleware"
+	"github.com/m-szczepanski/gocalc-api/internal/models"
+)
+
+func TestStatisticsHandler(t *testing.T) {
+	handler := NewStatisticsHandler(5)
+
+	tests := []struct {
+		name           string
+		method         string
+		body           *models.StatisticsRequest
+		expectedStatus int
+		expectedCode   string
+		checkData      func(t *testing.T, data map[string]interface{})
+	}{
+		{
+			name:           "descriptive statistics",
+			method:         http.MethodPost,
+			body:           &models.StatisticsRequest{Values: []float64{1, 2, 2, 3, 7}},
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				if data["count"].(float64) != 5 {
+					t.Errorf("count = %v, want 5", data["count"])
+				}
+				if data["mean"].(float64) != 3 {
+					t.Errorf("mean = %v, want 3", data["mean"])
+				}
+				if data["median"].(float64) != 2 {
+					t.Errorf("median = %v, want 2", data["median"])
+				}
+				if modes := data["modes"].([]interface{}); len(modes) != 1 || modes[0].(float64) != 2 {
+					t.Errorf("modes = %v, want [2]", modes)
+				}
+				if _, ok := data["weighted_mean"]; ok {
+					t.Errorf("weighted_mean should be omitted without weights")
+				}
+			},
+		},
+		{
+			name:   "weighted mean and percentiles",
+			method: http.MethodPost,
+			body: &models.StatisticsRequest{
+				Values:      []float64{10, 20},
+				Weights:     []float64{3, 1},
+				Percentiles: []float64{50, 90},
+			},
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				if data["weighted_mean"].(float64) != 12.5 {
+					t.Errorf("weighted_mean = %v, want 12.5", data["weighted_mean"])
+				}
+				percentiles := data["percentiles"].(map[string]interface{})
+				if percentiles["50"].(float64) != 15 {
+					t.Errorf("percentiles[50] = %v, want 15", percentiles["50"])
+				}
+				if !floatEquals(percentiles["90"].(float64), 19, 1e-9) {
+					t.Errorf("percentiles[90] = %v, want 19", percentiles["90"])
+				}
+			},
+		},
+		{
+			name:           "single value has null sample statistics",
+			method:         http.MethodPost,
+			body:           &models.StatisticsRequest{Values: []float64{4}},
+			expectedStatus: http.StatusOK,
+			checkData: func(t *testing.T, data map[string]interface{}) {
+				if data["sample_variance"] != nil {
+					t.Errorf("sample_variance = %v, want null", data["sample_variance"])
+				}
+			},
+		},
+		{
+			name:           "dataset exceeds configured limit",
+			method:         http.MethodPost,
+			body:           &models.StatisticsRequest{Values: []float64{1, 2, 3, 4, 5, 6}},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_ERROR",
+		},
+		{
+			name:           "empty dataset",
+			method:         http.MethodPost,
+			body:           &models.StatisticsRequest{},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_ERROR",
+		},
+		{
+			name:           "statistics overflow",
+			method:         http.MethodPost,
+			body:           &models.StatisticsRequest{Values: []float64{1e308, 1e308}},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedCode:   "DOMAIN_ERROR",
+		},
+		{
+			name:           "method not allowed",
+			method:         http.MethodGet,
+			body:           &models.StatisticsRequest{Values: []float64{1}},
+			expectedStatus: http.StatusMethodNotAllowed,
+			expectedCode:   "METHOD_NOT_ALLOWED",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, _ := json.Marshal(tt.body)
+			req := httptest.NewRequest(tt.method, "/api/math/statistics", bytes.NewReader(body))
+			ctx := context.WithValue(req.Context(), middleware.RequestIDKey, "test-123")
+			req = req.WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			handler(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("status = %d, want %d", w.Code, tt.expectedStatus)
+			}
+
+			if tt.expectedCode != "" {
+				var resp models.APIErrorResponse
+				json.NewDecoder(w.Body).Decode(&resp)
+				if resp.Code != tt.expectedCode {
+					t.Errorf("error code = %s, want %s", resp.Code, tt.expectedCode)
+				}
+				return
+			}
+
+			var resp models.SuccessResponse
+			json.NewDecoder(w.Body).Decode(&resp)
+			data, ok := resp.Data.(map[string]interface{})
+			if !ok {
+				t.Fatalf("expected data in response")
+			}
+			tt.checkData(t, data)
+		})
+	}
+}
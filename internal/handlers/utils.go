@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"net/http"
+	"strings"
 
 	apierrors "github.com/m-szczepanski/gocalc-api/internal/errors"
 	"github.com/m-szczepanski/gocalc-api/internal/models"
@@ -16,8 +17,9 @@ func BMIHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	var req models.BMIRequest
-	if err := decodeJSONBody(r.Body, &req); err != nil {
-		writeErrorWithDetails(w, r, apierrors.InvalidInput("invalid request body").WithError(err))
+	rounding, apiErr := decodeJSONBody(r, &req)
+	if apiErr != nil {
+		writeErrorWithDetails(w, r, apiErr)
 		return
 	}
 
@@ -26,7 +28,8 @@ func BMIHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	result, err := calculations.CalculateBMI(req.Weight, req.WeightUnit, req.Height, req.HeightUnit)
+	result, err := calculations.CalculateBMI(req.Weight, req.WeightUnit, req.Height, req.HeightUnit,
+		roundingOr(rounding, calculations.BMIRounding))
 	if err != nil {
 		writeErrorWithDetails(w, r, apierrors.ValidationError("calculation error", err.Error()))
 		return
@@ -37,7 +40,7 @@ func BMIHandler(w http.ResponseWriter, r *http.Request) {
 		Category: string(result.Category),
 	}
 
-	if err := writeSuccessResponse(w, r, response); err != nil {
+	if err := writeSuccessResponse(w, r, response, rounding); err != nil {
 		// Error already logged, headers likely already sent
 		return
 	}
@@ -50,8 +53,9 @@ func UnitConversionHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	var req models.UnitConversionRequest
-	if err := decodeJSONBody(r.Body, &req); err != nil {
-		writeErrorWithDetails(w, r, apierrors.InvalidInput("invalid request body").WithError(err))
+	rounding, apiErr := decodeJSONBody(r, &req)
+	if apiErr != nil {
+		writeErrorWithDetails(w, r, apiErr)
 		return
 	}
 
@@ -60,21 +64,127 @@ func UnitConversionHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	result, err := calculations.ConvertUnit(req.Value, req.FromUnit, req.ToUnit, req.UnitType)
+	value, fromUnit := req.Value, req.FromUnit
+	var parsedValue *float64
+	if req.Input != "" {
+		var separator rune
+		if req.DecimalSeparator != "" {
+			separator = rune(req.DecimalSeparator[0])
+		}
+		quantity, err := calculations.ParseQuantity(req.Input, separator)
+		if err != nil {
+			writeErrorWithDetails(w, r, apierrors.ValidationError("invalid input", err.Error()))
+			return
+		}
+		value, fromUnit = quantity.Value, quantity.Unit.Symbol
+		parsedValue = &value
+	}
+
+	precision := roundingOr(rounding, calculations.ConversionRounding)
+	toUnit := req.ToUnit
+	var result float64
+	var err error
+	if strings.EqualFold(strings.TrimSpace(req.ToUnit), calculations.AutoUnit) {
+		result, toUnit, err = calculations.ConvertUnitAuto(value, fromUnit, req.UnitType, precision)
+	} else {
+		result, err = calculations.ConvertUnit(value, fromUnit, req.ToUnit, req.UnitType, precision)
+	}
 	if err != nil {
 		writeErrorWithDetails(w, r, apierrors.ValidationError("conversion error", err.Error()))
 		return
 	}
 
+	unit, _ := calculations.ResolveUnit(fromUnit)
+	compound, _ := calculations.FormatCompound(result, toUnit, precision)
 	response := models.UnitConversionResponse{
-		Result:   result,
-		FromUnit: req.FromUnit,
-		ToUnit:   req.ToUnit,
-		UnitType: req.UnitType,
+		Value:     parsedValue,
+		Result:    result,
+		FromUnit:  fromUnit,
+		ToUnit:    toUnit,
+		UnitType:  req.UnitType,
+		Dimension: unit.Dimension.Describe(),
+		Compound:  compound,
 	}
 
-	if err := writeSuccessResponse(w, r, response); err != nil {
+	if err := writeSuccessResponse(w, r, response, rounding); err != nil {
 		// Error already logged, headers likely already sent
 		return
 	}
 }
+
+// UnitListHandler lists every unit type with the units offered under it.
+func UnitListHandler(w http.ResponseWriter, r *http.Request) {
+	if err := validation.ValidateMethod(r.Method, http.MethodGet); err != nil {
+		writeErrorWithDetails(w, r, err)
+		return
+	}
+
+	rounding, apiErr := requestRounding(r)
+	if apiErr != nil {
+		writeErrorWithDetails(w, r, apiErr)
+		return
+	}
+
+	listings := calculations.ListAllUnits()
+	response := models.UnitListResponse{UnitTypes: make([]models.UnitTypeInfo, len(listings))}
+	for i, listing := range listings {
+		response.UnitTypes[i] = unitTypeInfo(listing)
+	}
+
+	if err := writeSuccessResponse(w, r, response, rounding); err != nil {
+		// Error already logged, headers likely already sent
+		return
+	}
+}
+
+// UnitTypeHandler lists the units offered under the unit type in the path.
+func UnitTypeHandler(w http.ResponseWriter, r *http.Request) {
+	if err := validation.ValidateMethod(r.Method, http.MethodGet); err != nil {
+		writeErrorWithDetails(w, r, err)
+		return
+	}
+
+	rounding, apiErr := requestRounding(r)
+	if apiErr != nil {
+		writeErrorWithDetails(w, r, apiErr)
+		return
+	}
+
+	listing, err := calculations.ListUnits(r.PathValue("type"))
+	if err != nil {
+		writeErrorWithDetails(w, r, apierrors.ValidationError("invalid unit type", err.Error()))
+		return
+	}
+
+	if err := writeSuccessResponse(w, r, unitTypeInfo(listing), rounding); err != nil {
+		// Error already logged, headers likely already sent
+		return
+	}
+}
+
+func unitTypeInfo(listing calculations.UnitTypeListing) models.UnitTypeInfo {
+	info := models.UnitTypeInfo{
+		Type:      string(listing.Type),
+		Dimension: listing.Dimension.Describe(),
+		BaseUnit:  listing.Base.Symbol,
+		Units:     make([]models.UnitInfo, len(listing.Units)),
+	}
+	for i, u := range listing.Units {
+		systems := make([]string, len(u.Unit.Systems))
+		for j, system := range u.Unit.Systems {
+			systems[j] = string(system)
+		}
+		info.Units[i] = models.UnitInfo{
+			Symbol:     u.Unit.Symbol,
+			Name:       u.Unit.Name,
+			Plural:     u.Unit.PluralName(),
+			Aliases:    append([]string{}, u.Unit.Aliases...),
+			Systems:    systems,
+			Factor:     u.Factor,
+			Offset:     u.Offset,
+			Inverse:    u.Unit.Inverse,
+			SIPrefixes: u.Unit.Metric,
+		}
+	}
+	return info
+}
@@ -7,10 +7,16 @@ import (
 	"math"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"slices"
+	"strings"
 	"testing"
 
+	"gopkg.in/yaml.v3"
+
 	"github.com/m-szczepanski/gocalc-api/internal/middleware"
 	"github.com/m-szczepanski/gocalc-api/internal/models"
+	"github.com/m-szczepanski/gocalc-api/pkg/calculations"
 )
 
 func TestBMIHandler(t *testing.T) {
@@ -188,12 +194,15 @@ func TestBMIHandler(t *testing.T) {
 
 func TestUnitConversionHandler(t *testing.T) {
 	tests := []struct {
-		name           string
-		method         string
-		body           *models.UnitConversionRequest
-		expectedStatus int
-		expectedResult float64
-		expectError    bool
+		name             string
+		method           string
+		body             *models.UnitConversionRequest
+		expectedStatus   int
+		expectedResult   float64
+		expectedFromUnit string // defaults to the requested from_unit
+		expectedToUnit   string // defaults to the requested to_unit
+		expectedCompound string
+		expectError      bool
 	}{
 		{
 			name:   "valid weight conversion - kg to lb",
@@ -267,6 +276,129 @@ func TestUnitConversionHandler(t *testing.T) {
 			expectedStatus: http.StatusMethodNotAllowed,
 			expectError:    true,
 		},
+		{
+			name:   "compound units without unit type",
+			method: http.MethodPost,
+			body: &models.UnitConversionRequest{
+				Value:    36,
+				FromUnit: "km/h",
+				ToUnit:   "m/s",
+			},
+			expectedStatus: http.StatusOK,
+			expectedResult: 10,
+			expectError:    false,
+		},
+		{
+			name:   "derived unit from expression",
+			method: http.MethodPost,
+			body: &models.UnitConversionRequest{
+				Value:    2,
+				FromUnit: "kg·m/s²",
+				ToUnit:   "N",
+			},
+			expectedStatus: http.StatusOK,
+			expectedResult: 2,
+			expectError:    false,
+		},
+		{
+			name:   "auto picks the readable prefix",
+			method: http.MethodPost,
+			body: &models.UnitConversionRequest{
+				Value:    0.0025,
+				FromUnit: "Mm",
+				ToUnit:   "auto",
+				UnitType: "distance",
+			},
+			expectedStatus: http.StatusOK,
+			expectedResult: 2.5,
+			expectedToUnit: "km",
+			expectError:    false,
+		},
+		{
+			name:   "fuel economy converts by reciprocal",
+			method: http.MethodPost,
+			body: &models.UnitConversionRequest{
+				Value:    5,
+				FromUnit: "L/100km",
+				ToUnit:   "km/L",
+				UnitType: "fuel_economy",
+			},
+			expectedStatus: http.StatusOK,
+			expectedResult: 20,
+			expectError:    false,
+		},
+		{
+			name:   "negative fuel economy",
+			method: http.MethodPost,
+			body: &models.UnitConversionRequest{
+				Value:    -5,
+				FromUnit: "mpg",
+				ToUnit:   "L/100km",
+				UnitType: "fuel_economy",
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectError:    true,
+		},
+		{
+			name:   "compound input and output",
+			method: http.MethodPost,
+			body: &models.UnitConversionRequest{
+				Input:  "5 ft 10 in",
+				ToUnit: "m",
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResult:   1.778,
+			expectedFromUnit: "in",
+			expectedCompound: "1 m 77.8 cm",
+			expectError:      false,
+		},
+		{
+			name:   "input with a decimal comma",
+			method: http.MethodPost,
+			body: &models.UnitConversionRequest{
+				Input:    "1,5 km",
+				ToUnit:   "ft",
+				UnitType: "distance",
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResult:   4921.259843,
+			expectedFromUnit: "km",
+			expectedCompound: "4921 ft 3.118116 in",
+			expectError:      false,
+		},
+		{
+			name:   "input with a degree sign",
+			method: http.MethodPost,
+			body: &models.UnitConversionRequest{
+				Input:  "72°F",
+				ToUnit: "C",
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResult:   22.222222,
+			expectedFromUnit: "F",
+			expectError:      false,
+		},
+		{
+			name:   "unparseable input",
+			method: http.MethodPost,
+			body: &models.UnitConversionRequest{
+				Input:  "five feet",
+				ToUnit: "m",
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectError:    true,
+		},
+		{
+			name:   "incompatible dimensions",
+			method: http.MethodPost,
+			body: &models.UnitConversionRequest{
+				Value:    1,
+				FromUnit: "kWh",
+				ToUnit:   "kg",
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectError:    true,
+		},
 		{
 			name:   "invalid unit type",
 			method: http.MethodPost,
@@ -372,12 +504,24 @@ func TestUnitConversionHandler(t *testing.T) {
 				t.Errorf("expected result %.6f, got %.6f", tt.expectedResult, convResp.Result)
 			}
 
-			if convResp.FromUnit != tt.body.FromUnit {
-				t.Errorf("expected from_unit %s, got %s", tt.body.FromUnit, convResp.FromUnit)
+			expectedFromUnit := tt.body.FromUnit
+			if tt.expectedFromUnit != "" {
+				expectedFromUnit = tt.expectedFromUnit
+			}
+			if convResp.FromUnit != expectedFromUnit {
+				t.Errorf("expected from_unit %s, got %s", expectedFromUnit, convResp.FromUnit)
+			}
+
+			if tt.expectedCompound != "" && convResp.Compound != tt.expectedCompound {
+				t.Errorf("expected compound %q, got %q", tt.expectedCompound, convResp.Compound)
 			}
 
-			if convResp.ToUnit != tt.body.ToUnit {
-				t.Errorf("expected to_unit %s, got %s", tt.body.ToUnit, convResp.ToUnit)
+			expectedToUnit := tt.body.ToUnit
+			if tt.expectedToUnit != "" {
+				expectedToUnit = tt.expectedToUnit
+			}
+			if convResp.ToUnit != expectedToUnit {
+				t.Errorf("expected to_unit %s, got %s", expectedToUnit, convResp.ToUnit)
 			}
 
 			if convResp.UnitType != tt.body.UnitType {
@@ -387,7 +531,200 @@ func TestUnitConversionHandler(t *testing.T) {
 	}
 }
 
+func TestUnitListHandler(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/api/utils/units", nil)
+	ctx := context.WithValue(req.Context(), middleware.RequestIDKey, "test-123")
+	req = req.WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	UnitListHandler(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d: %s", w.Code, http.StatusOK, w.Body.String())
+	}
+
+	var resp struct {
+		Data models.UnitListResponse `json:"data"`
+	}
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if len(resp.Data.UnitTypes) != 19 {
+		t.Fatalf("got %d unit types, want 19", len(resp.Data.UnitTypes))
+	}
+	for _, info := range resp.Data.UnitTypes {
+		if len(info.Units) == 0 || info.BaseUnit == "" || info.Dimension == "" {
+			t.Errorf("unit type %s = %+v, want a base unit, dimension and units", info.Type, info)
+		}
+		for _, unit := range info.Units {
+			if unit.Aliases == nil || unit.Systems == nil {
+				t.Errorf("%s unit %s has null aliases or systems", info.Type, unit.Symbol)
+			}
+		}
+	}
+}
+
+func TestUnitTypeHandler(t *testing.T) {
+	tests := []struct {
+		name           string
+		unitType       string
+		method         string
+		expectedStatus int
+		expectedCode   string
+		expectedBase   string
+		expectedUnit   models.UnitInfo // a unit the listing must contain
+	}{
+		{
+			name:           "height",
+			unitType:       "height",
+			method:         http.MethodGet,
+			expectedStatus: http.StatusOK,
+			expectedBase:   "m",
+			expectedUnit: models.UnitInfo{Symbol: "ft", Name: "foot", Plural: "feet",
+				Systems: []string{"imperial", "us_customary"}, Factor: 0.3048},
+		},
+		{
+			name:           "temperature offset",
+			unitType:       "Temperature",
+			method:         http.MethodGet,
+			expectedStatus: http.StatusOK,
+			expectedBase:   "K",
+			expectedUnit: models.UnitInfo{Symbol: "C", Name: "degree Celsius", Plural: "degrees Celsius",
+				Systems: []string{"metric"}, Factor: 1, Offset: 273.15},
+		},
+		{
+			name:           "inverse fuel economy",
+			unitType:       "fuel_economy",
+			method:         http.MethodGet,
+			expectedStatus: http.StatusOK,
+			expectedBase:   "km/L",
+			expectedUnit: models.UnitInfo{Symbol: "L/100km", Name: "liter per 100 kilometers", Plural: "liters per 100 kilometers",
+				Systems: []string{"metric"}, Factor: 100, Inverse: true},
+		},
+		{
+			name:           "unit outside every system",
+			unitType:       "speed",
+			method:         http.MethodGet,
+			expectedStatus: http.StatusOK,
+			expectedBase:   "m/s",
+			expectedUnit: models.UnitInfo{Symbol: "kn", Name: "knot", Plural: "knots",
+				Systems: []string{}, Factor: 1852.0 / 3600},
+		},
+		{
+			name:           "unknown type",
+			unitType:       "parsec",
+			method:         http.MethodGet,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_ERROR",
+		},
+		{
+			name:           "wrong method",
+			unitType:       "height",
+			method:         http.MethodPost,
+			expectedStatus: http.StatusMethodNotAllowed,
+			expectedCode:   "METHOD_NOT_ALLOWED",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, "/api/utils/units/"+tt.unitType, nil)
+			req.SetPathValue("type", tt.unitType)
+			ctx := context.WithValue(req.Context(), middleware.RequestIDKey, "test-123")
+			req = req.WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			UnitTypeHandler(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("status = %d, want %d: %s", w.Code, tt.expectedStatus, w.Body.String())
+			}
+
+			if tt.expectedCode != "" {
+				var resp models.APIErrorResponse
+				json.NewDecoder(w.Body).Decode(&resp)
+				if resp.Code != tt.expectedCode {
+					t.Errorf("error code = %s, want %s", resp.Code, tt.expectedCode)
+				}
+				return
+			}
+
+			var resp struct {
+				Data models.UnitTypeInfo `json:"data"`
+			}
+			if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if resp.Data.BaseUnit != tt.expectedBase {
+				t.Errorf("base_unit = %s, want %s", resp.Data.BaseUnit, tt.expectedBase)
+			}
+
+			want := tt.expectedUnit
+			for _, unit := range resp.Data.Units {
+				if unit.Symbol != want.Symbol {
+					continue
+				}
+				if unit.Name != want.Name || unit.Plural != want.Plural || !floatEquals(unit.Factor, want.Factor, 1e-12) ||
+					!floatEquals(unit.Offset, want.Offset, 1e-12) || unit.Inverse != want.Inverse ||
+					strings.Join(unit.Systems, ",") != strings.Join(want.Systems, ",") {
+					t.Errorf("unit %s = %+v, want %+v", unit.Symbol, unit, want)
+				}
+				return
+			}
+			t.Errorf("units %+v do not include %s", resp.Data.Units, want.Symbol)
+		})
+	}
+}
+
 // floatEquals checks if two float64 values are approximately equal within a tolerance
 func floatEquals(a, b, tolerance float64) bool {
 	return math.Abs(a-b) <= tolerance
 }
+
+// TestOpenAPIUnitTypes keeps the unit type enums in docs/openapi.yaml in step
+// with calculations.ValidUnitTypes.
+func TestOpenAPIUnitTypes(t *testing.T) {
+	data, err := os.ReadFile("../../docs/openapi.yaml")
+	if err != nil {
+		t.Fatalf("reading the OpenAPI spec: %v", err)
+	}
+	var spec struct {
+		Paths map[string]struct {
+			Get struct {
+				Parameters []struct {
+					Name   string `yaml:"name"`
+					Schema struct {
+						Enum []string `yaml:"enum"`
+					} `yaml:"schema"`
+				} `yaml:"parameters"`
+			} `yaml:"get"`
+		} `yaml:"paths"`
+		Components struct {
+			Schemas map[string]struct {
+				Properties map[string]struct {
+					Enum []string `yaml:"enum"`
+				} `yaml:"properties"`
+			} `yaml:"schemas"`
+		} `yaml:"components"`
+	}
+	if err := yaml.Unmarshal(data, &spec); err != nil {
+		t.Fatalf("parsing the OpenAPI spec: %v", err)
+	}
+
+	var want []string
+	for _, unitType := range calculations.ValidUnitTypes() {
+		want = append(want, string(unitType))
+	}
+	if got := spec.Components.Schemas["UnitConversionRequest"].Properties["unit_type"].Enum; !slices.Equal(got, want) {
+		t.Errorf("UnitConversionRequest.unit_type enum = %v, want %v", got, want)
+	}
+	var pathEnum []string
+	for _, param := range spec.Paths["/api/utils/units/{type}"].Get.Parameters {
+		if param.Name == "type" {
+			pathEnum = param.Schema.Enum
+		}
+	}
+	if !slices.Equal(pathEnum, want) {
+		t.Errorf("/api/utils/units/{type} type enum = %v, want %v", pathEnum, want)
+	}
+}
@@ -123,6 +123,7 @@ func writeErrorResponse(w http.ResponseWriter, apiErr *apierrors.APIError, r *ht
 	w.WriteHeader(statusCode)
 
 	resp := models.NewAPIErrorResponse(apiErr.Code, apiErr.Message, apiErr.Details, requestID)
+	resp.Meta = apiErr.Meta
 	if err := encodeJSON(w, resp); err != nil {
 		slog.Error("failed to encode error response",
 			"error", err,
@@ -0,0 +1,66 @@
+package models
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+// NumeralBase names a numeral system: an integer radix from 2 to 36 or
+// "roman". It decodes from either a JSON number or a string.
+type NumeralBase string
+
+func (b *NumeralBase) UnmarshalJSON(data []byte) error {
+	var n int
+	if err := json.Unmarshal(data, &n); err == nil {
+		*b = NumeralBase(strconv.Itoa(n))
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	*b = NumeralBase(s)
+	return nil
+}
+
+type BaseConvertRequest struct {
+	Value     string      `json:"value"`               // e.g. "ff", "-101.01", "MCMXCIV"
+	FromBase  NumeralBase `json:"from_base"`           // 2-36 or "roman"; defaults to 10
+	ToBase    NumeralBase `json:"to_base"`             // 2-36 or "roman"; defaults to 10
+	BitWidth  int         `json:"bit_width,omitempty"` // Also return the two's complement pattern at this width
+	Precision *int        `json:"precision,omitempty"` // Maximum fractional digits in the output; defaults to 20
+}
+
+type TwosComplement struct {
+	BitWidth int    `json:"bit_width"`
+	Binary   string `json:"binary"`
+	Hex      string `json:"hex"`
+	Unsigned string `json:"unsigned"` // the bit pattern read as an unsigned decimal integer
+}
+
+type FloatLayout struct {
+	Bits             string `json:"bits"`
+	Hex              string `json:"hex"`
+	Sign             int    `json:"sign"`
+	ExponentBits     string `json:"exponent_bits"`
+	MantissaBits     string `json:"mantissa_bits"`
+	BiasedExponent   int    `json:"biased_exponent"`
+	UnbiasedExponent int    `json:"unbiased_exponent"`
+	Class            string `json:"class"`  // zero, subnormal, normal, infinity or nan
+	Stored           string `json:"stored"` // exact decimal value of the stored number
+}
+
+type IEEE754Layouts struct {
+	Float32 FloatLayout `json:"float32"`
+	Float64 FloatLayout `json:"float64"`
+}
+
+type BaseConvertResponse struct {
+	Value          string          `json:"value"`
+	FromBase       string          `json:"from_base"`
+	ToBase         string          `json:"to_base"`
+	Decimal        string          `json:"decimal"`
+	Exact          bool            `json:"exact"` // false if the fractional expansion was cut off at precision
+	TwosComplement *TwosComplement `json:"twos_complement,omitempty"`
+	IEEE754        IEEE754Layouts  `json:"ieee754"`
+}
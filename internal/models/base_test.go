@@ -0,0 +1,58 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestBaseConvertRequestJSON(t *testing.T) {
+	tests := []struct {
+		name         string
+		json         string
+		expectedFrom NumeralBase
+		expectedTo   NumeralBase
+		wantErr      bool
+	}{
+		{
+			name:         "numeric bases",
+			json:         `{"value": "ff", "from_base": 16, "to_base": 2}`,
+			expectedFrom: "16",
+			expectedTo:   "2",
+		},
+		{
+			name:         "string bases",
+			json:         `{"value": "1994", "from_base": "10", "to_base": "roman"}`,
+			expectedFrom: "10",
+			expectedTo:   "roman",
+		},
+		{
+			name: "bases omitted",
+			json: `{"value": "12"}`,
+		},
+		{
+			name:    "invalid base type",
+			json:    `{"value": "12", "from_base": true}`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var req BaseConvertRequest
+			err := json.Unmarshal([]byte(tt.json), &req)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if req.FromBase != tt.expectedFrom || req.ToBase != tt.expectedTo {
+				t.Errorf("got bases %q -> %q, want %q -> %q", req.FromBase, req.ToBase, tt.expectedFrom, tt.expectedTo)
+			}
+		})
+	}
+}
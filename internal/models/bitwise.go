@@ -0,0 +1,22 @@
+package models
+
+type BitwiseRequest struct {
+	Operation string `json:"operation"`          // e.g. "and", "shl", "popcount", "mask"
+	Type      string `json:"type,omitempty"`     // int8..int64 or uint8..uint64 (default int64)
+	Overflow  string `json:"overflow,omitempty"` // "wrap" (default), "saturate" or "error"
+	A         string `json:"a,omitempty"`        // Decimal, 0x hex, 0b binary or 0o octal literal (all operations except mask)
+	B         string `json:"b,omitempty"`        // Second operand for and, or, xor, add, sub and mul
+	N         *int   `json:"n,omitempty"`        // Shift or rotate amount, or mask width
+	Offset    int    `json:"offset,omitempty"`   // Lowest bit of the mask
+}
+
+type BitwiseResponse struct {
+	Operation  string `json:"operation"`
+	Type       string `json:"type"`
+	Overflow   string `json:"overflow"`
+	Result     string `json:"result,omitempty"` // Decimal value; omitted for popcount, clz and ctz
+	Hex        string `json:"hex,omitempty"`
+	Binary     string `json:"binary,omitempty"`
+	Count      *int   `json:"count,omitempty"` // popcount, clz and ctz
+	Overflowed bool   `json:"overflowed"`      // an operand or the result did not fit and was wrapped or saturated
+}
@@ -0,0 +1,51 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestBitwiseRequestJSON(t *testing.T) {
+	var req BitwiseRequest
+	err := json.Unmarshal([]byte(`{"operation": "shl", "type": "uint8", "overflow": "saturate", "a": "0x0f", "n": 0}`), &req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if req.A != "0x0f" || req.Type != "uint8" || req.Overflow != "saturate" {
+		t.Errorf("unexpected request: %+v", req)
+	}
+	if req.N == nil || *req.N != 0 {
+		t.Errorf("expected n = 0 to be present, got %v", req.N)
+	}
+}
+
+func TestBitwiseResponseJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		response BitwiseResponse
+		expected string
+	}{
+		{
+			name:     "value result",
+			response: BitwiseResponse{Operation: "not", Type: "uint8", Overflow: "wrap", Result: "240", Hex: "0xf0", Binary: "0b11110000"},
+			expected: `{"operation":"not","type":"uint8","overflow":"wrap","result":"240","hex":"0xf0","binary":"0b11110000","overflowed":false}`,
+		},
+		{
+			name:     "zero count is not omitted",
+			response: BitwiseResponse{Operation: "popcount", Type: "int8", Overflow: "wrap", Count: new(int)},
+			expected: `{"operation":"popcount","type":"int8","overflow":"wrap","count":0,"overflowed":false}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.response)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if string(data) != tt.expected {
+				t.Errorf("got %s, want %s", data, tt.expected)
+			}
+		})
+	}
+}
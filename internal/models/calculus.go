@@ -0,0 +1,59 @@
+package models
+
+type IntegralRequest struct {
+	Expression    string   `json:"expression"`               // f(x), e.g. "x^2 * sin(x)"
+	Lower         *float64 `json:"lower"`                    // Lower limit of integration
+	Upper         *float64 `json:"upper"`                    // Upper limit of integration
+	Method        string   `json:"method,omitempty"`         // "gauss-kronrod" (default) or "simpson"
+	Tolerance     *float64 `json:"tolerance,omitempty"`      // Absolute error target (default 1e-10)
+	MaxIterations *int     `json:"max_iterations,omitempty"` // Maximum interval subdivisions (default 1000)
+}
+
+type DerivativeRequest struct {
+	Expression    string   `json:"expression"`
+	X             *float64 `json:"x"`                        // Point at which to differentiate
+	Order         int      `json:"order,omitempty"`          // 1 (default) or 2
+	Tolerance     *float64 `json:"tolerance,omitempty"`      // Error target at which extrapolation stops early (default 1e-10)
+	MaxIterations *int     `json:"max_iterations,omitempty"` // Maximum step refinements (default 10, at most 30)
+}
+
+type RootRequest struct {
+	Expression    string   `json:"expression"`
+	Method        string   `json:"method,omitempty"`         // "brent" (default), "bisection" or "newton"
+	Lower         *float64 `json:"lower,omitempty"`          // Bracket for bisection and brent
+	Upper         *float64 `json:"upper,omitempty"`          // Bracket for bisection and brent
+	X0            *float64 `json:"x0,omitempty"`             // Starting point for newton
+	Tolerance     *float64 `json:"tolerance,omitempty"`      // Tolerance on x (default 1e-10)
+	MaxIterations *int     `json:"max_iterations,omitempty"` // default 100
+}
+
+type IntegralResponse struct {
+	Expression    string  `json:"expression"`
+	Method        string  `json:"method"`
+	Lower         float64 `json:"lower"`
+	Upper         float64 `json:"upper"`
+	Value         float64 `json:"value"`
+	ErrorEstimate float64 `json:"error_estimate"`
+	Iterations    int     `json:"iterations"`  // Interval subdivisions
+	Evaluations   int     `json:"evaluations"` // Function evaluations
+}
+
+type DerivativeResponse struct {
+	Expression    string  `json:"expression"`
+	X             float64 `json:"x"`
+	Order         int     `json:"order"`
+	Value         float64 `json:"value"`
+	ErrorEstimate float64 `json:"error_estimate"`
+	Iterations    int     `json:"iterations"`
+	Evaluations   int     `json:"evaluations"`
+}
+
+type RootResponse struct {
+	Expression    string  `json:"expression"`
+	Method        string  `json:"method"`
+	Root          float64 `json:"root"`
+	Residual      float64 `json:"residual"` // f(root)
+	ErrorEstimate float64 `json:"error_estimate"`
+	Iterations    int     `json:"iterations"`
+	Evaluations   int     `json:"evaluations"`
+}
@@ -0,0 +1,35 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestRootRequestJSON(t *testing.T) {
+	var req RootRequest
+	err := json.Unmarshal([]byte(`{"expression": "x^2 - 2", "method": "newton", "x0": 0, "max_iterations": 20}`), &req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if req.X0 == nil || *req.X0 != 0 {
+		t.Errorf("expected x0 = 0 to be present, got %v", req.X0)
+	}
+	if req.Lower != nil || req.Upper != nil || req.Tolerance != nil {
+		t.Errorf("expected lower, upper and tolerance to be absent")
+	}
+	if req.MaxIterations == nil || *req.MaxIterations != 20 {
+		t.Errorf("expected max_iterations = 20, got %v", req.MaxIterations)
+	}
+}
+
+func TestIntegralResponseJSON(t *testing.T) {
+	resp := IntegralResponse{Expression: "x", Method: "simpson", Lower: 0, Upper: 1, Value: 0.5, Iterations: 0, Evaluations: 5}
+	data, err := json.Marshal(resp)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := `{"expression":"x","method":"simpson","lower":0,"upper":1,"value":0.5,"error_estimate":0,"iterations":0,"evaluations":5}`
+	if string(data) != expected {
+		t.Errorf("got %s, want %s", data, expected)
+	}
+}
@@ -0,0 +1,29 @@
+package models
+
+type Complex struct {
+	Re float64 `json:"re"`
+	Im float64 `json:"im"`
+}
+
+type PolarForm struct {
+	Modulus  float64 `json:"modulus"`
+	Argument float64 `json:"argument"`
+}
+
+type ComplexRequest struct {
+	Operation string     `json:"operation"`            // e.g. "add", "div", "polar", "roots"
+	A         *Complex   `json:"a,omitempty"`          // Primary operand (all operations except rect)
+	B         *Complex   `json:"b,omitempty"`          // Second operand (add, sub, mul, div) or exponent (pow)
+	Polar     *PolarForm `json:"polar,omitempty"`      // Input for rect
+	N         *int       `json:"n,omitempty"`          // Root degree for roots
+	AngleMode string     `json:"angle_mode,omitempty"` // "radians" (default) or "degrees" for arg, polar and rect
+}
+
+type ComplexResponse struct {
+	Operation string     `json:"operation"`
+	Result    *Complex   `json:"result,omitempty"`
+	Value     *float64   `json:"value,omitempty"` // modulus and arg
+	Polar     *PolarForm `json:"polar,omitempty"`
+	Roots     []Complex  `json:"roots,omitempty"`
+	AngleMode string     `json:"angle_mode,omitempty"`
+}
@@ -0,0 +1,59 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestComplexRequestJSON(t *testing.T) {
+	var req ComplexRequest
+	err := json.Unmarshal([]byte(`{"operation": "div", "a": {"re": 3, "im": 4}, "b": {"re": 1, "im": -2}}`), &req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if req.A == nil || *req.A != (Complex{Re: 3, Im: 4}) {
+		t.Errorf("expected a = {3 4}, got %v", req.A)
+	}
+	if req.B == nil || *req.B != (Complex{Re: 1, Im: -2}) {
+		t.Errorf("expected b = {1 -2}, got %v", req.B)
+	}
+	if req.Polar != nil || req.N != nil {
+		t.Errorf("expected polar and n to be absent")
+	}
+}
+
+func TestComplexResponseJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		response ComplexResponse
+		expected string
+	}{
+		{
+			name:     "complex result",
+			response: ComplexResponse{Operation: "conjugate", Result: &Complex{Re: 1, Im: -2}},
+			expected: `{"operation":"conjugate","result":{"re":1,"im":-2}}`,
+		},
+		{
+			name:     "zero modulus is not omitted",
+			response: ComplexResponse{Operation: "modulus", Value: new(float64)},
+			expected: `{"operation":"modulus","value":0}`,
+		},
+		{
+			name:     "roots",
+			response: ComplexResponse{Operation: "roots", Roots: []Complex{{Re: 1}, {Re: -1}}},
+			expected: `{"operation":"roots","roots":[{"re":1,"im":0},{"re":-1,"im":0}]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.response)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if string(data) != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, string(data))
+			}
+		})
+	}
+}
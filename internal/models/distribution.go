@@ -0,0 +1,35 @@
+package models
+
+// DistributionRequest evaluates a distribution at X, finds the quantile of
+// Probability, or both. Only the parameters of the chosen distribution are
+// used.
+type DistributionRequest struct {
+	X           *float64 `json:"x,omitempty"`           // Point at which to evaluate the pdf, cdf and sf
+	Probability *float64 `json:"probability,omitempty"` // Strictly between 0 and 1
+	Mean        *float64 `json:"mean,omitempty"`        // normal; defaults to 0
+	SD          *float64 `json:"sd,omitempty"`          // normal; defaults to 1
+	DF          *float64 `json:"df,omitempty"`          // t and chi2
+	DF1         *float64 `json:"df1,omitempty"`         // f numerator
+	DF2         *float64 `json:"df2,omitempty"`         // f denominator
+	N           *int64   `json:"n,omitempty"`           // binomial trials
+	P           *float64 `json:"p,omitempty"`           // binomial success probability
+	Lambda      *float64 `json:"lambda,omitempty"`      // poisson mean
+	Rate        *float64 `json:"rate,omitempty"`        // exponential
+	Min         *float64 `json:"min,omitempty"`         // uniform
+	Max         *float64 `json:"max,omitempty"`         // uniform
+	Alpha       *float64 `json:"alpha,omitempty"`       // beta
+	Beta        *float64 `json:"beta,omitempty"`        // beta
+}
+
+type DistributionResponse struct {
+	Distribution string   `json:"distribution"`
+	Discrete     bool     `json:"discrete"`
+	X            *float64 `json:"x,omitempty"`
+	PDF          *float64 `json:"pdf,omitempty"` // Probability mass for discrete distributions
+	CDF          *float64 `json:"cdf,omitempty"` // P(X ≤ x)
+	SF           *float64 `json:"sf,omitempty"`  // P(X > x), accurate in the upper tail
+	Probability  *float64 `json:"probability,omitempty"`
+	Quantile     *float64 `json:"quantile,omitempty"` // Smallest x with cdf(x) ≥ probability
+	Mean         *float64 `json:"mean,omitempty"`     // Omitted where undefined or infinite
+	Variance     *float64 `json:"variance,omitempty"` // Omitted where undefined or infinite
+}
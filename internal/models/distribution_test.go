@@ -0,0 +1,33 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestDistributionRequestJSON(t *testing.T) {
+	var req DistributionRequest
+	if err := json.Unmarshal([]byte(`{"x": 3, "n": 10, "p": 0.5}`), &req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if req.X == nil || *req.X != 3 || req.N == nil || *req.N != 10 || req.P == nil || req.Probability != nil {
+		t.Errorf("got %+v", req)
+	}
+
+	if err := json.Unmarshal([]byte(`{"n": 2.5}`), &req); err == nil {
+		t.Error("expected error for a fractional n")
+	}
+}
+
+func TestDistributionResponseJSON(t *testing.T) {
+	p, q := 0.975, 1.959963984540054
+	resp := DistributionResponse{Distribution: "normal", Probability: &p, Quantile: &q}
+	data, err := json.Marshal(resp)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := `{"distribution":"normal","discrete":false,"probability":0.975,"quantile":1.959963984540054}`
+	if string(data) != expected {
+		t.Errorf("got %s, want %s", data, expected)
+	}
+}
@@ -0,0 +1,61 @@
+package models
+
+type GeoPoint struct {
+	Lat float64 `json:"lat"` // Degrees, -90 to 90
+	Lon float64 `json:"lon"` // Degrees, -180 to 180
+}
+
+type GeoPair struct {
+	From GeoPoint `json:"from"`
+	To   GeoPoint `json:"to"`
+}
+
+// GeoDistanceRequest measures the path from From to To, or every path in
+// Pairs. Given Bearing and Distance instead of To, it finds the destination
+// reached from From.
+type GeoDistanceRequest struct {
+	From     *GeoPoint `json:"from,omitempty"`
+	To       *GeoPoint `json:"to,omitempty"`
+	Pairs    []GeoPair `json:"pairs,omitempty"`    // Batch alternative to from and to
+	Bearing  *float64  `json:"bearing,omitempty"`  // Degrees clockwise from true north
+	Distance *float64  `json:"distance,omitempty"` // In unit
+	Unit     string    `json:"unit,omitempty"`     // Distance unit (m, km, mi, ft, yd); defaults to "m"
+}
+
+type GeoPath struct {
+	Distance       float64 `json:"distance"`
+	InitialBearing float64 `json:"initial_bearing"` // Degrees clockwise from true north, 0 to 360
+	FinalBearing   float64 `json:"final_bearing"`   // Direction of travel on arrival
+}
+
+type GeoDistance struct {
+	From      GeoPoint `json:"from"`
+	To        GeoPoint `json:"to"`
+	Haversine GeoPath  `json:"haversine"` // Great circle on a sphere of mean Earth radius
+	Vincenty  *GeoPath `json:"vincenty"`  // Geodesic on the WGS-84 ellipsoid; null for nearly antipodal points
+	Midpoint  GeoPoint `json:"midpoint"`  // Great-circle midpoint
+}
+
+type GeoDistanceResponse struct {
+	GeoDistance
+	Unit string `json:"unit"`
+}
+
+type GeoDistanceBatchResponse struct {
+	Results []GeoDistance `json:"results"`
+	Unit    string        `json:"unit"`
+}
+
+type GeoDestination struct {
+	Point        GeoPoint `json:"point"`
+	FinalBearing float64  `json:"final_bearing"`
+}
+
+type GeoDestinationResponse struct {
+	From      GeoPoint        `json:"from"`
+	Bearing   float64         `json:"bearing"`
+	Distance  float64         `json:"distance"`
+	Haversine GeoDestination  `json:"haversine"` // Along a great circle
+	Vincenty  *GeoDestination `json:"vincenty"`  // Along a geodesic of the WGS-84 ellipsoid
+	Unit      string          `json:"unit"`
+}
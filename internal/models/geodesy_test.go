@@ -0,0 +1,37 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestGeoDistanceRequestJSON(t *testing.T) {
+	var req GeoDistanceRequest
+	err := json.Unmarshal([]byte(`{"from": {"lat": 51.5, "lon": -0.12}, "bearing": 90, "distance": 10, "unit": "km"}`), &req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if req.From == nil || req.From.Lat != 51.5 || req.To != nil || req.Bearing == nil || *req.Distance != 10 || req.Unit != "km" {
+		t.Errorf("got %+v", req)
+	}
+}
+
+func TestGeoDistanceResponseJSON(t *testing.T) {
+	resp := GeoDistanceResponse{
+		GeoDistance: GeoDistance{
+			From:      GeoPoint{Lat: 0, Lon: 0},
+			To:        GeoPoint{Lat: 0, Lon: 180},
+			Haversine: GeoPath{Distance: 20015.1, InitialBearing: 0, FinalBearing: 180},
+			Midpoint:  GeoPoint{Lat: 90, Lon: 0},
+		},
+		Unit: "km",
+	}
+	data, err := json.Marshal(resp)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := `{"from":{"lat":0,"lon":0},"to":{"lat":0,"lon":180},"haversine":{"distance":20015.1,"initial_bearing":0,"final_bearing":180},"vincenty":null,"midpoint":{"lat":90,"lon":0},"unit":"km"}`
+	if string(data) != expected {
+		t.Errorf("got %s, want %s", data, expected)
+	}
+}
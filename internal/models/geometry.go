@@ -0,0 +1,98 @@
+package models
+
+// LengthUnits is embedded in geometry requests. Unit names the distance unit
+// of every length in the request (m, km, mi, ft or yd); results are reported
+// in OutputUnit, which defaults to Unit. Without a unit lengths are unitless.
+type LengthUnits struct {
+	Unit       string `json:"unit,omitempty"`
+	OutputUnit string `json:"output_unit,omitempty"`
+}
+
+type VectorRequest struct {
+	A         []float64 `json:"a"`                    // 2 or 3 components
+	B         []float64 `json:"b,omitempty"`          // Required by every operation except norm
+	AngleMode string    `json:"angle_mode,omitempty"` // "radians" (default) or "degrees" for angle
+	LengthUnits
+}
+
+type VectorResponse struct {
+	Operation string    `json:"operation"`
+	Value     *float64  `json:"value,omitempty"`  // dot, norm, angle and distance; the scalar projection for projection
+	Vector    []float64 `json:"vector,omitempty"` // cross and projection
+	AngleMode string    `json:"angle_mode,omitempty"`
+	Unit      string    `json:"unit,omitempty"` // Dot and cross products are in square units
+}
+
+type PolygonRequest struct {
+	Points [][]float64 `json:"points"` // Vertices in order, e.g. [[0, 0], [4, 0], [4, 3]]
+	LengthUnits
+}
+
+type PolygonResponse struct {
+	Area        float64 `json:"area"`
+	Perimeter   float64 `json:"perimeter"`
+	Orientation string  `json:"orientation"` // "counterclockwise", "clockwise" or "degenerate"
+	Vertices    int     `json:"vertices"`
+	Unit        string  `json:"unit,omitempty"` // Area is in square units
+}
+
+type CircleRequest struct {
+	Radius *float64 `json:"radius"`
+	LengthUnits
+}
+
+type CircleResponse struct {
+	Radius        float64 `json:"radius"`
+	Diameter      float64 `json:"diameter"`
+	Circumference float64 `json:"circumference"`
+	Area          float64 `json:"area"`
+	Unit          string  `json:"unit,omitempty"`
+}
+
+// TriangleRequest gives three parts of a triangle. Side a is opposite angle
+// alpha, b opposite beta and c opposite gamma. Method "sss" needs a, b and c;
+// "sas" needs a, b and the included angle gamma; "asa" needs alpha, beta and
+// the included side c.
+type TriangleRequest struct {
+	Method    string   `json:"method"` // "sss", "sas" or "asa"
+	A         *float64 `json:"a,omitempty"`
+	B         *float64 `json:"b,omitempty"`
+	C         *float64 `json:"c,omitempty"`
+	Alpha     *float64 `json:"alpha,omitempty"`
+	Beta      *float64 `json:"beta,omitempty"`
+	Gamma     *float64 `json:"gamma,omitempty"`
+	AngleMode string   `json:"angle_mode,omitempty"` // "radians" (default) or "degrees"
+	LengthUnits
+}
+
+type TriangleResponse struct {
+	Method    string  `json:"method"`
+	A         float64 `json:"a"`
+	B         float64 `json:"b"`
+	C         float64 `json:"c"`
+	Alpha     float64 `json:"alpha"`
+	Beta      float64 `json:"beta"`
+	Gamma     float64 `json:"gamma"`
+	Area      float64 `json:"area"`
+	Perimeter float64 `json:"perimeter"`
+	AngleMode string  `json:"angle_mode"`
+	Unit      string  `json:"unit,omitempty"`
+}
+
+type SolidRequest struct {
+	Solid       string   `json:"solid"`                  // "sphere", "cube", "cuboid", "cylinder", "cone", "pyramid" or "torus"
+	Radius      *float64 `json:"radius,omitempty"`       // sphere, cylinder, cone; major radius of a torus
+	MinorRadius *float64 `json:"minor_radius,omitempty"` // torus tube radius
+	Side        *float64 `json:"side,omitempty"`         // cube
+	Length      *float64 `json:"length,omitempty"`       // cuboid and pyramid base
+	Width       *float64 `json:"width,omitempty"`        // cuboid and pyramid base
+	Height      *float64 `json:"height,omitempty"`       // cuboid, cylinder, cone and pyramid
+	LengthUnits
+}
+
+type SolidResponse struct {
+	Solid       string  `json:"solid"`
+	Volume      float64 `json:"volume"`       // Cubic units
+	SurfaceArea float64 `json:"surface_area"` // Square units
+	Unit        string  `json:"unit,omitempty"`
+}
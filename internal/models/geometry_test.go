@@ -0,0 +1,67 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestLengthUnitsJSON(t *testing.T) {
+	var req CircleRequest
+	if err := json.Unmarshal([]byte(`{"radius": 2, "unit": "ft", "output_unit": "m"}`), &req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if req.Radius == nil || *req.Radius != 2 || req.Unit != "ft" || req.OutputUnit != "m" {
+		t.Errorf("got %+v", req)
+	}
+
+	data, err := json.Marshal(PolygonRequest{Points: [][]float64{{0, 0}, {1, 0}, {0, 1}}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := `{"points":[[0,0],[1,0],[0,1]]}`; string(data) != expected {
+		t.Errorf("got %s, want %s", data, expected)
+	}
+}
+
+func TestVectorResponseJSON(t *testing.T) {
+	value := 5.0
+	tests := []struct {
+		name     string
+		resp     VectorResponse
+		expected string
+	}{
+		{
+			name:     "scalar",
+			resp:     VectorResponse{Operation: "norm", Value: &value, Unit: "m"},
+			expected: `{"operation":"norm","value":5,"unit":"m"}`,
+		},
+		{
+			name:     "vector",
+			resp:     VectorResponse{Operation: "cross", Vector: []float64{0, 0, 1}},
+			expected: `{"operation":"cross","vector":[0,0,1]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.resp)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if string(data) != tt.expected {
+				t.Errorf("got %s, want %s", data, tt.expected)
+			}
+		})
+	}
+}
+
+func TestTriangleRequestJSON(t *testing.T) {
+	var req TriangleRequest
+	err := json.Unmarshal([]byte(`{"method": "sas", "a": 3, "b": 4, "gamma": 90, "angle_mode": "degrees"}`), &req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if req.A == nil || req.B == nil || req.Gamma == nil || req.C != nil || *req.Gamma != 90 {
+		t.Errorf("got %+v", req)
+	}
+}
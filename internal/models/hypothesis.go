@@ -0,0 +1,56 @@
+package models
+
+// HypothesisTestRequest carries the data for every test; each test uses only
+// its own fields.
+type HypothesisTestRequest struct {
+	X           []float64   `json:"x,omitempty"`           // t-tests: the sample, or the first sample
+	Y           []float64   `json:"y,omitempty"`           // two-sample, Welch and paired t-tests: the second sample
+	Mu          float64     `json:"mu,omitempty"`          // t-tests: hypothesized mean or mean difference; defaults to 0
+	Observed    []float64   `json:"observed,omitempty"`    // chi-square-gof: observed counts
+	Expected    []float64   `json:"expected,omitempty"`    // chi-square-gof: expected counts or proportions; defaults to equal
+	Table       [][]float64 `json:"table,omitempty"`       // chi-square-independence: contingency table of counts
+	Successes   []int64     `json:"successes,omitempty"`   // two-proportion-z: successes in each of the two groups
+	Trials      []int64     `json:"trials,omitempty"`      // two-proportion-z: trials in each of the two groups
+	Alternative string      `json:"alternative,omitempty"` // "two-sided" (default), "less" or "greater"
+	Confidence  *float64    `json:"confidence,omitempty"`  // Level of the confidence interval; defaults to 0.95
+}
+
+type EffectSize struct {
+	Measure string  `json:"measure"` // "cohens_d", "cohens_dz", "cohens_h", "cohens_w" or "cramers_v"
+	Value   float64 `json:"value"`
+}
+
+type ConfidenceInterval struct {
+	Lower      *float64 `json:"lower"` // null when unbounded, for one-sided alternatives
+	Upper      *float64 `json:"upper"`
+	Confidence float64  `json:"confidence"`
+}
+
+type HypothesisTestResponse struct {
+	Test               string              `json:"test"`
+	Alternative        string              `json:"alternative,omitempty"` // Chi-square tests are always upper-tailed
+	Statistic          float64             `json:"statistic"`             // t, χ² or z
+	DF                 *float64            `json:"df,omitempty"`          // Omitted for the z-test
+	PValue             float64             `json:"p_value"`
+	EffectSize         EffectSize          `json:"effect_size"`
+	Estimate           *float64            `json:"estimate,omitempty"` // Mean, mean difference or difference in proportions
+	ConfidenceInterval *ConfidenceInterval `json:"confidence_interval,omitempty"`
+	Warnings           []string            `json:"warnings,omitempty"`
+}
+
+type ConfidenceIntervalRequest struct {
+	X          []float64 `json:"x,omitempty"`          // mean: the sample
+	Successes  *int64    `json:"successes,omitempty"`  // proportion
+	Trials     *int64    `json:"trials,omitempty"`     // proportion
+	Method     string    `json:"method,omitempty"`     // proportion: "wilson" (default), "wald" or "exact"
+	Confidence *float64  `json:"confidence,omitempty"` // Defaults to 0.95
+}
+
+type ConfidenceIntervalResponse struct {
+	Parameter          string             `json:"parameter"`
+	Method             string             `json:"method"` // "t" for means
+	Estimate           float64            `json:"estimate"`
+	StandardError      float64            `json:"standard_error"`
+	DF                 *float64           `json:"df,omitempty"`
+	ConfidenceInterval ConfidenceInterval `json:"confidence_interval"`
+}
@@ -0,0 +1,37 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestHypothesisTestRequestJSON(t *testing.T) {
+	var req HypothesisTestRequest
+	err := json.Unmarshal([]byte(`{"successes": [45, 30], "trials": [100, 100], "alternative": "greater", "confidence": 0.9}`), &req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(req.Successes) != 2 || req.Trials[1] != 100 || req.Alternative != "greater" || req.Confidence == nil || *req.Confidence != 0.9 {
+		t.Errorf("got %+v", req)
+	}
+}
+
+func TestHypothesisTestResponseJSON(t *testing.T) {
+	upper := 0.2
+	resp := HypothesisTestResponse{
+		Test:               "two-proportion-z",
+		Alternative:        "less",
+		Statistic:          2.5,
+		PValue:             0.99,
+		EffectSize:         EffectSize{Measure: "cohens_h", Value: 0.3},
+		ConfidenceInterval: &ConfidenceInterval{Upper: &upper, Confidence: 0.95},
+	}
+	data, err := json.Marshal(resp)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := `{"test":"two-proportion-z","alternative":"less","statistic":2.5,"p_value":0.99,"effect_size":{"measure":"cohens_h","value":0.3},"confidence_interval":{"lower":null,"upper":0.2,"confidence":0.95}}`
+	if string(data) != expected {
+		t.Errorf("got %s, want %s", data, expected)
+	}
+}
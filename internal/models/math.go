@@ -1,6 +1,10 @@
 package models
 
-import "time"
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
 
 type TimeProvider interface {
 	Now() time.Time
@@ -19,20 +23,140 @@ func SetTimeProvider(tp TimeProvider) {
 }
 
 type MathRequest struct {
-	A float64 `json:"a"`
-	B float64 `json:"b"`
+	A    float64 `json:"a"`
+	B    float64 `json:"b"`
+	Mode string  `json:"mode,omitempty"` // "decimal" (default), "fraction", "uncertainty" or "interval"
+
+	// Operand text as sent, kept for fraction mode: the literal of a JSON
+	// number, or a string such as "1/3", "2 1/4" or, in uncertainty and
+	// interval modes, "12.3 ± 0.2". Empty if absent.
+	TextA string `json:"-"`
+	TextB string `json:"-"`
+
+	// Uncertainties of object operands such as {"value": 12.3,
+	// "uncertainty": 0.2}, which are only valid in uncertainty and interval modes.
+	UncertaintyA float64 `json:"-"`
+	UncertaintyB float64 `json:"-"`
+
+	stringOperands bool
+	objectOperands bool
+}
+
+// UnmarshalJSON accepts a and b as JSON numbers, strings or value/uncertainty
+// objects. String operands leave A and B at zero and are only valid in
+// fraction, uncertainty and interval modes.
+func (r *MathRequest) UnmarshalJSON(data []byte) error {
+	var aux struct {
+		A    json.RawMessage `json:"a"`
+		B    json.RawMessage `json:"b"`
+		Mode string          `json:"mode"`
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	a, err := decodeOperand(aux.A)
+	if err != nil {
+		return fmt.Errorf("a: %w", err)
+	}
+	b, err := decodeOperand(aux.B)
+	if err != nil {
+		return fmt.Errorf("b: %w", err)
+	}
+
+	*r = MathRequest{
+		A:              a.value,
+		B:              b.value,
+		Mode:           aux.Mode,
+		TextA:          a.text,
+		TextB:          b.text,
+		UncertaintyA:   a.uncertainty,
+		UncertaintyB:   b.uncertainty,
+		stringOperands: a.quoted || b.quoted,
+		objectOperands: a.object || b.object,
+	}
+	return nil
+}
+
+// StringOperands reports whether a or b was sent as a JSON string.
+func (r *MathRequest) StringOperands() bool {
+	return r.stringOperands
+}
+
+// ObjectOperands reports whether a or b was sent as a value/uncertainty object.
+func (r *MathRequest) ObjectOperands() bool {
+	return r.objectOperands
+}
+
+type operand struct {
+	value       float64
+	uncertainty float64
+	text        string
+	quoted      bool
+	object      bool
+}
+
+func decodeOperand(raw json.RawMessage) (operand, error) {
+	var op operand
+	if len(raw) == 0 || string(raw) == "null" {
+		return op, nil
+	}
+
+	switch raw[0] {
+	case '"':
+		op.quoted = true
+		err := json.Unmarshal(raw, &op.text)
+		return op, err
+	case '{':
+		var m struct {
+			Value       *float64 `json:"value"`
+			Uncertainty float64  `json:"uncertainty"`
+		}
+		if err := json.Unmarshal(raw, &m); err != nil {
+			return op, err
+		}
+		if m.Value == nil {
+			return op, fmt.Errorf("value is required")
+		}
+		op.value, op.uncertainty, op.object = *m.Value, m.Uncertainty, true
+	default:
+		if err := json.Unmarshal(raw, &op.value); err != nil {
+			return op, err
+		}
+	}
+	op.text = string(raw)
+	return op, nil
 }
 
 type MathResponse struct {
-	Result float64 `json:"result"`
+	Result      float64  `json:"result"`                // Decimal approximation in fraction mode, midpoint in interval mode
+	Fraction    string   `json:"fraction,omitempty"`    // Fraction mode only, in lowest terms, e.g. "9/4"
+	Mixed       string   `json:"mixed,omitempty"`       // Fraction mode only, e.g. "2 1/4"
+	Uncertainty *float64 `json:"uncertainty,omitempty"` // Uncertainty mode: propagated standard uncertainty; interval mode: half-width
+	Lower       *float64 `json:"lower,omitempty"`       // Interval mode only: guaranteed lower bound
+	Upper       *float64 `json:"upper,omitempty"`       // Interval mode only: guaranteed upper bound
+}
+
+// AggregateRequest is the body of /api/math/sum, product, min, max and mean.
+// Handlers stream values instead of decoding the whole body into this struct,
+// so long arrays are never held in memory.
+type AggregateRequest struct {
+	Values []float64 `json:"values"`
+}
+
+type AggregateResponse struct {
+	Operation string  `json:"operation"` // "sum", "product", "min", "max" or "mean"
+	Result    float64 `json:"result"`
+	Count     int     `json:"count"` // Number of values aggregated
 }
 
 type APIErrorResponse struct {
-	Code      string `json:"code"`
-	Message   string `json:"message"`
-	Details   string `json:"details,omitempty"`
-	RequestID string `json:"request_id,omitempty"`
-	Timestamp string `json:"timestamp"`
+	Code      string                 `json:"code"`
+	Message   string                 `json:"message"`
+	Details   string                 `json:"details,omitempty"`
+	Meta      map[string]interface{} `json:"meta,omitempty"`
+	RequestID string                 `json:"request_id,omitempty"`
+	Timestamp string                 `json:"timestamp"`
 }
 
 func NewAPIErrorResponse(code, message, details, requestID string) *APIErrorResponse {
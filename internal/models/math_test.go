@@ -1,6 +1,7 @@
 package models
 
 import (
+	"encoding/json"
 	"testing"
 	"time"
 )
@@ -100,3 +101,93 @@ func TestMathResponse(t *testing.T) {
 		t.Errorf("expected Result=8.7, got %f", resp.Result)
 	}
 }
+
+func TestAggregateResponseJSON(t *testing.T) {
+	data, err := json.Marshal(AggregateResponse{Operation: "sum", Result: 6.5, Count: 3})
+	if err != nil {
+		t.Fatalf("Marshal() unexpected error: %v", err)
+	}
+	expected := `{"operation":"sum","result":6.5,"count":3}`
+	if string(data) != expected {
+		t.Errorf("Marshal() = %s, want %s", data, expected)
+	}
+}
+
+func TestMathRequestJSON(t *testing.T) {
+	tests := []struct {
+		name          string
+		json          string
+		expectedA     float64
+		expectedTextA string
+		expectedTextB string
+		stringOperand bool
+		objectOperand bool
+		uncertaintyA  float64
+		wantErr       bool
+	}{
+		{
+			name:          "numbers keep their literal text",
+			json:          `{"a": 0.1, "b": 2}`,
+			expectedA:     0.1,
+			expectedTextA: "0.1",
+			expectedTextB: "2",
+		},
+		{
+			name:          "string operands",
+			json:          `{"a": "2 1/4", "b": "1/3", "mode": "fraction"}`,
+			expectedTextA: "2 1/4",
+			expectedTextB: "1/3",
+			stringOperand: true,
+		},
+		{
+			name:          "value and uncertainty object",
+			json:          `{"a": {"value": 12.3, "uncertainty": 0.2}, "b": 4, "mode": "uncertainty"}`,
+			expectedA:     12.3,
+			expectedTextA: `{"value": 12.3, "uncertainty": 0.2}`,
+			expectedTextB: "4",
+			objectOperand: true,
+			uncertaintyA:  0.2,
+		},
+		{
+			name: "missing operands",
+			json: `{}`,
+		},
+		{
+			name:    "object without value",
+			json:    `{"a": {"uncertainty": 0.2}, "b": 1}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid operand type",
+			json:    `{"a": true, "b": 1}`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var req MathRequest
+			err := json.Unmarshal([]byte(tt.json), &req)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if req.A != tt.expectedA || req.TextA != tt.expectedTextA || req.TextB != tt.expectedTextB {
+				t.Errorf("got A=%v TextA=%q TextB=%q, want %v %q %q", req.A, req.TextA, req.TextB, tt.expectedA, tt.expectedTextA, tt.expectedTextB)
+			}
+			if req.StringOperands() != tt.stringOperand {
+				t.Errorf("StringOperands() = %v, want %v", req.StringOperands(), tt.stringOperand)
+			}
+			if req.ObjectOperands() != tt.objectOperand || req.UncertaintyA != tt.uncertaintyA {
+				t.Errorf("ObjectOperands() = %v, UncertaintyA = %v, want %v %v", req.ObjectOperands(), req.UncertaintyA, tt.objectOperand, tt.uncertaintyA)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package models
+
+// MatrixRequest carries row-major matrices. B is only used by the add and
+// multiply operations.
+type MatrixRequest struct {
+	A [][]float64 `json:"a"`
+	B [][]float64 `json:"b,omitempty"`
+}
+
+// LinearSystemRequest describes the square system Ax = b.
+type LinearSystemRequest struct {
+	A [][]float64 `json:"a"`
+	B []float64   `json:"b"`
+}
+
+type MatrixResponse struct {
+	Operation       string      `json:"operation"`
+	Result          [][]float64 `json:"result"`
+	ConditionNumber *float64    `json:"condition_number,omitempty"`
+}
+
+type DeterminantResponse struct {
+	Determinant float64 `json:"determinant"`
+}
+
+type RankResponse struct {
+	Rank int `json:"rank"`
+}
+
+type LUResponse struct {
+	P [][]float64 `json:"p"`
+	L [][]float64 `json:"l"`
+	U [][]float64 `json:"u"`
+}
+
+type QRResponse struct {
+	Q [][]float64 `json:"q"`
+	R [][]float64 `json:"r"`
+}
+
+type LinearSystemResponse struct {
+	X               []float64 `json:"x"`
+	ConditionNumber float64   `json:"condition_number"`
+}
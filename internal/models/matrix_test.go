@@ -0,0 +1,81 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestMatrixRequestJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    string
+		rowsA   int
+		rowsB   int
+		wantErr bool
+	}{
+		{
+			name:  "single matrix",
+			json:  `{"a": [[1, 2], [3, 4]]}`,
+			rowsA: 2,
+		},
+		{
+			name:  "two matrices",
+			json:  `{"a": [[1, 2, 3]], "b": [[1], [2], [3]]}`,
+			rowsA: 1,
+			rowsB: 3,
+		},
+		{
+			name:    "invalid json",
+			json:    `{"a": [1, 2]}`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var req MatrixRequest
+			err := json.Unmarshal([]byte(tt.json), &req)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("expected error but got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(req.A) != tt.rowsA {
+				t.Errorf("expected %d rows in a, got %d", tt.rowsA, len(req.A))
+			}
+			if len(req.B) != tt.rowsB {
+				t.Errorf("expected %d rows in b, got %d", tt.rowsB, len(req.B))
+			}
+		})
+	}
+}
+
+func TestLinearSystemRequestJSON(t *testing.T) {
+	var req LinearSystemRequest
+	if err := json.Unmarshal([]byte(`{"a": [[2, 0], [0, 4]], "b": [2, 8]}`), &req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(req.A) != 2 || len(req.B) != 2 || req.B[1] != 8 {
+		t.Errorf("unexpected request: %+v", req)
+	}
+}
+
+func TestMatrixResponseJSON(t *testing.T) {
+	response := MatrixResponse{Operation: "transpose", Result: [][]float64{{1, 3}, {2, 4}}}
+
+	data, err := json.Marshal(response)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := `{"operation":"transpose","result":[[1,3],[2,4]]}`
+	if string(data) != expected {
+		t.Errorf("expected %s, got %s", expected, string(data))
+	}
+}
@@ -0,0 +1,54 @@
+package models
+
+// Integers are exchanged as base-10 strings so values beyond 2^53 survive JSON.
+
+type IntegerListRequest struct {
+	Values []string `json:"values"`
+}
+
+type IntegerRequest struct {
+	N string `json:"n"`
+}
+
+type ModPowRequest struct {
+	Base     string `json:"base"`
+	Exponent string `json:"exponent"` // May be negative when base is invertible modulo modulus
+	Modulus  string `json:"modulus"`
+}
+
+type ModInverseRequest struct {
+	A       string `json:"a"`
+	Modulus string `json:"modulus"`
+}
+
+type PrimeRangeRequest struct {
+	From uint64 `json:"from"`
+	To   uint64 `json:"to"`
+}
+
+type IntegerResponse struct {
+	Result string `json:"result"`
+}
+
+type PrimalityResponse struct {
+	N       string `json:"n"`
+	IsPrime bool   `json:"is_prime"`
+	Certain bool   `json:"certain"` // false for probable primes above 2^64
+}
+
+type PrimeFactor struct {
+	Prime    string `json:"prime"`
+	Exponent int    `json:"exponent"`
+}
+
+type FactorizationResponse struct {
+	N       string        `json:"n"`
+	Factors []PrimeFactor `json:"factors"`
+}
+
+type PrimeRangeResponse struct {
+	From   uint64   `json:"from"`
+	To     uint64   `json:"to"`
+	Count  int      `json:"count"`
+	Primes []uint64 `json:"primes"`
+}
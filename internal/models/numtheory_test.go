@@ -0,0 +1,38 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestIntegerListRequestJSON(t *testing.T) {
+	var req IntegerListRequest
+	err := json.Unmarshal([]byte(`{"values": ["12", "123456789012345678901234567890"]}`), &req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(req.Values) != 2 || req.Values[1] != "123456789012345678901234567890" {
+		t.Errorf("expected big integer to be preserved, got %v", req.Values)
+	}
+
+	if err := json.Unmarshal([]byte(`{"values": [12]}`), &req); err == nil {
+		t.Errorf("expected error for numeric values")
+	}
+}
+
+func TestFactorizationResponseJSON(t *testing.T) {
+	response := FactorizationResponse{
+		N:       "12",
+		Factors: []PrimeFactor{{Prime: "2", Exponent: 2}, {Prime: "3", Exponent: 1}},
+	}
+
+	data, err := json.Marshal(response)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := `{"n":"12","factors":[{"prime":"2","exponent":2},{"prime":"3","exponent":1}]}`
+	if string(data) != expected {
+		t.Errorf("expected %s, got %s", expected, string(data))
+	}
+}
@@ -0,0 +1,66 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Coefficient is an exact rational coefficient. It decodes from a JSON number,
+// kept as written so that 0.1 means exactly 1/10, or from a string such as
+// "1/3" or "2 1/4".
+type Coefficient string
+
+func (c *Coefficient) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		*c = Coefficient(s)
+		return nil
+	}
+	var n json.Number
+	if err := json.Unmarshal(data, &n); err != nil {
+		return fmt.Errorf("coefficient must be a number or a string: %w", err)
+	}
+	*c = Coefficient(n)
+	return nil
+}
+
+type PolynomialRootsRequest struct {
+	Coefficients []Coefficient `json:"coefficients,omitempty"` // Highest degree first, e.g. [1, -3, 2] for x^2 - 3x + 2
+	Expression   string        `json:"expression,omitempty"`   // Alternative to coefficients, e.g. "x^2 - 3x + 2" or "x^3 = 8"
+}
+
+type PolynomialRoot struct {
+	Re           float64 `json:"re"`
+	Im           float64 `json:"im"`
+	Exact        string  `json:"exact,omitempty"` // Rational or surd form, e.g. "3/2 + √5/2"; omitted if none exists
+	Multiplicity int     `json:"multiplicity"`
+}
+
+type PolynomialRootsResponse struct {
+	Variable     string           `json:"variable,omitempty"` // Variable of the expression, if one was given
+	Coefficients []string         `json:"coefficients"`       // Exact coefficients, highest degree first
+	Degree       int              `json:"degree"`
+	Method       string           `json:"method"` // "closed-form" up to degree 4, otherwise "companion-matrix"
+	Roots        []PolynomialRoot `json:"roots"`  // Real roots first, in increasing order
+}
+
+type EquationsRequest struct {
+	Equations []string `json:"equations"` // e.g. ["2x + 3y = 5", "x - y = 1"]
+}
+
+type VariableValue struct {
+	Variable string  `json:"variable"`
+	Value    float64 `json:"value"`
+	Exact    string  `json:"exact"` // Fraction in lowest terms, e.g. "8/5"
+}
+
+type EquationsResponse struct {
+	Variables     []string        `json:"variables"` // In order of first appearance
+	Status        string          `json:"status"`    // "unique", "infinite" or "inconsistent"
+	Rank          int             `json:"rank"`
+	Solution      []VariableValue `json:"solution,omitempty"`       // For "infinite", the solution with every free variable set to 0
+	FreeVariables []string        `json:"free_variables,omitempty"` // Variables that may take any value
+}
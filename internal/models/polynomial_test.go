@@ -0,0 +1,53 @@
+package models
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestPolynomialRootsRequestJSON(t *testing.T) {
+	var req PolynomialRootsRequest
+	err := json.Unmarshal([]byte(`{"coefficients": [1, 0.1, "-1/3", 2e3]}`), &req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []Coefficient{"1", "0.1", "-1/3", "2e3"}
+	if !reflect.DeepEqual(req.Coefficients, expected) {
+		t.Errorf("coefficients = %v, want %v", req.Coefficients, expected)
+	}
+
+	if err := json.Unmarshal([]byte(`{"coefficients": [true]}`), &req); err == nil {
+		t.Error("expected error for a boolean coefficient")
+	}
+}
+
+func TestPolynomialRootsResponseJSON(t *testing.T) {
+	resp := PolynomialRootsResponse{
+		Variable:     "x",
+		Coefficients: []string{"1", "0", "1"},
+		Degree:       2,
+		Method:       "closed-form",
+		Roots:        []PolynomialRoot{{Re: 0, Im: -1, Exact: "-i", Multiplicity: 1}, {Re: 0.5, Multiplicity: 1}},
+	}
+	data, err := json.Marshal(resp)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := `{"variable":"x","coefficients":["1","0","1"],"degree":2,"method":"closed-form","roots":[{"re":0,"im":-1,"exact":"-i","multiplicity":1},{"re":0.5,"im":0,"multiplicity":1}]}`
+	if string(data) != expected {
+		t.Errorf("got %s, want %s", data, expected)
+	}
+}
+
+func TestEquationsResponseJSON(t *testing.T) {
+	resp := EquationsResponse{Variables: []string{"x", "y"}, Status: "inconsistent", Rank: 1}
+	data, err := json.Marshal(resp)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := `{"variables":["x","y"],"status":"inconsistent","rank":1}`
+	if string(data) != expected {
+		t.Errorf("got %s, want %s", data, expected)
+	}
+}
@@ -0,0 +1,24 @@
+package models
+
+import "encoding/json"
+
+type RandomRequest struct {
+	Operation string            `json:"operation"`         // uniform, normal, integer, shuffle, sample or choice
+	Count     *int              `json:"count,omitempty"`   // Number of draws; defaults to 1 (ignored by shuffle)
+	Min       *float64          `json:"min,omitempty"`     // uniform (default 0) and integer (required, whole)
+	Max       *float64          `json:"max,omitempty"`     // uniform (default 1, exclusive) and integer (required, inclusive)
+	Mean      *float64          `json:"mean,omitempty"`    // normal; defaults to 0
+	SD        *float64          `json:"sd,omitempty"`      // normal; defaults to 1
+	Items     []json.RawMessage `json:"items,omitempty"`   // shuffle, sample and choice: any JSON values, returned as sent
+	Replace   bool              `json:"replace,omitempty"` // sample: draw with replacement
+	Weights   []float64         `json:"weights,omitempty"` // choice: relative weight of each item; defaults to equal
+	Seed      *uint64           `json:"seed,omitempty"`    // Makes the draws reproducible; omit to use crypto/rand
+}
+
+type RandomResponse struct {
+	Operation string      `json:"operation"`
+	Source    string      `json:"source"` // "seeded" or "crypto"
+	Seed      *uint64     `json:"seed,omitempty"`
+	Values    interface{} `json:"values"`            // Numbers, or the drawn items
+	Indices   []int       `json:"indices,omitempty"` // shuffle, sample and choice: position of each value in items
+}
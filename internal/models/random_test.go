@@ -0,0 +1,34 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestRandomRequestJSON(t *testing.T) {
+	var req RandomRequest
+	err := json.Unmarshal([]byte(`{"operation": "choice", "items": ["ann", 2, {"id": 3}], "weights": [1, 2, 3], "seed": 18446744073709551615}`), &req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(req.Items) != 3 || string(req.Items[2]) != `{"id": 3}` || req.Seed == nil || *req.Seed != 1<<64-1 {
+		t.Errorf("got %+v", req)
+	}
+}
+
+func TestRandomResponseJSON(t *testing.T) {
+	resp := RandomResponse{
+		Operation: "sample",
+		Source:    "crypto",
+		Values:    []json.RawMessage{json.RawMessage(`"ann"`)},
+		Indices:   []int{0},
+	}
+	data, err := json.Marshal(resp)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := `{"operation":"sample","source":"crypto","values":["ann"],"indices":[0]}`
+	if string(data) != expected {
+		t.Errorf("got %s, want %s", data, expected)
+	}
+}
@@ -0,0 +1,23 @@
+package models
+
+type Point struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+}
+
+type RegressionRequest struct {
+	Points  []Point   `json:"points"`
+	Model   string    `json:"model"`             // linear, polynomial, exponential, logarithmic or power
+	Degree  int       `json:"degree,omitempty"`  // Polynomial degree (required for the polynomial model)
+	Predict []float64 `json:"predict,omitempty"` // Optional x values to evaluate the fitted curve at
+}
+
+type RegressionResponse struct {
+	Model        string    `json:"model"`
+	Degree       int       `json:"degree,omitempty"`
+	Coefficients []float64 `json:"coefficients"`
+	Equation     string    `json:"equation"`
+	RSquared     float64   `json:"r_squared"`
+	Residuals    []float64 `json:"residuals"`
+	Predictions  []Point   `json:"predictions,omitempty"`
+}
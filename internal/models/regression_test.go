@@ -0,0 +1,48 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestRegressionRequestJSON(t *testing.T) {
+	input := `{"points": [{"x": 1, "y": 2}, {"x": 2, "y": 4.5}], "model": "polynomial", "degree": 2, "predict": [3]}`
+
+	var req RegressionRequest
+	if err := json.Unmarshal([]byte(input), &req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(req.Points) != 2 || req.Points[1].X != 2 || req.Points[1].Y != 4.5 {
+		t.Errorf("unexpected points: %+v", req.Points)
+	}
+	if req.Model != "polynomial" {
+		t.Errorf("expected model polynomial, got %s", req.Model)
+	}
+	if req.Degree != 2 {
+		t.Errorf("expected degree 2, got %d", req.Degree)
+	}
+	if len(req.Predict) != 1 || req.Predict[0] != 3 {
+		t.Errorf("unexpected predict: %v", req.Predict)
+	}
+}
+
+func TestRegressionResponseJSON(t *testing.T) {
+	response := RegressionResponse{
+		Model:        "linear",
+		Coefficients: []float64{1, 2},
+		Equation:     "y = 1 + 2*x",
+		RSquared:     1,
+		Residuals:    []float64{0, 0},
+	}
+
+	data, err := json.Marshal(response)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := `{"model":"linear","coefficients":[1,2],"equation":"y = 1 + 2*x","r_squared":1,"residuals":[0,0]}`
+	if string(data) != expected {
+		t.Errorf("expected %s, got %s", expected, string(data))
+	}
+}
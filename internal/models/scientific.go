@@ -0,0 +1,85 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type FunctionRequest struct {
+	Function  string   `json:"function"`             // e.g. "sin", "log", "pow"
+	X         float64  `json:"x"`                    // Primary operand
+	Y         *float64 `json:"y,omitempty"`          // Exponent (pow), degree (root), base (log) or decimal places (round)
+	AngleMode string   `json:"angle_mode,omitempty"` // "radians" (default) or "degrees" for trigonometric functions
+	Mode      string   `json:"mode,omitempty"`       // "decimal" (default), "uncertainty" or "interval"
+
+	// Operand text and object uncertainties, as for MathRequest. A string y
+	// leaves Y nil.
+	TextX        string  `json:"-"`
+	TextY        string  `json:"-"`
+	UncertaintyX float64 `json:"-"`
+	UncertaintyY float64 `json:"-"`
+
+	stringOperands bool
+	objectOperands bool
+}
+
+// UnmarshalJSON accepts x and y as JSON numbers, strings or value/uncertainty
+// objects. String operands leave X at zero and Y nil and are only valid in
+// uncertainty and interval modes.
+func (r *FunctionRequest) UnmarshalJSON(data []byte) error {
+	var aux struct {
+		Function  string          `json:"function"`
+		X         json.RawMessage `json:"x"`
+		Y         json.RawMessage `json:"y"`
+		AngleMode string          `json:"angle_mode"`
+		Mode      string          `json:"mode"`
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	x, err := decodeOperand(aux.X)
+	if err != nil {
+		return fmt.Errorf("x: %w", err)
+	}
+	y, err := decodeOperand(aux.Y)
+	if err != nil {
+		return fmt.Errorf("y: %w", err)
+	}
+
+	*r = FunctionRequest{
+		Function:       aux.Function,
+		X:              x.value,
+		AngleMode:      aux.AngleMode,
+		Mode:           aux.Mode,
+		TextX:          x.text,
+		TextY:          y.text,
+		UncertaintyX:   x.uncertainty,
+		UncertaintyY:   y.uncertainty,
+		stringOperands: x.quoted || y.quoted,
+		objectOperands: x.object || y.object,
+	}
+	if y.text != "" && !y.quoted {
+		r.Y = &y.value
+	}
+	return nil
+}
+
+// StringOperands reports whether x or y was sent as a JSON string.
+func (r *FunctionRequest) StringOperands() bool {
+	return r.stringOperands
+}
+
+// ObjectOperands reports whether x or y was sent as a value/uncertainty object.
+func (r *FunctionRequest) ObjectOperands() bool {
+	return r.objectOperands
+}
+
+type FunctionResponse struct {
+	Function    string   `json:"function"`
+	Result      float64  `json:"result"` // Midpoint in interval mode
+	AngleMode   string   `json:"angle_mode,omitempty"`
+	Uncertainty *float64 `json:"uncertainty,omitempty"` // As for MathResponse
+	Lower       *float64 `json:"lower,omitempty"`
+	Upper       *float64 `json:"upper,omitempty"`
+}
@@ -0,0 +1,80 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestFunctionRequestJSON(t *testing.T) {
+	tests := []struct {
+		name      string
+		json      string
+		expectedY *float64
+		wantErr   bool
+	}{
+		{
+			name:      "unary function without y",
+			json:      `{"function": "sin", "x": 30, "angle_mode": "degrees"}`,
+			expectedY: nil,
+		},
+		{
+			name:      "binary function with y",
+			json:      `{"function": "log", "x": 8, "y": 2}`,
+			expectedY: func() *float64 { v := 2.0; return &v }(),
+		},
+		{
+			name:      "measurement operands",
+			json:      `{"function": "pow", "x": "2 ± 0.1", "y": {"value": 3, "uncertainty": 0.01}, "mode": "uncertainty"}`,
+			expectedY: func() *float64 { v := 3.0; return &v }(),
+		},
+		{
+			name:    "invalid json",
+			json:    `{"function": "sin", "x": [30]}`,
+			wantErr: true,
+		},
+		{
+			name:    "object operand without value",
+			json:    `{"function": "sin", "x": {"uncertainty": 0.1}}`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var req FunctionRequest
+			err := json.Unmarshal([]byte(tt.json), &req)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("expected error but got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if (req.Y == nil) != (tt.expectedY == nil) {
+				t.Fatalf("expected y %v, got %v", tt.expectedY, req.Y)
+			}
+			if req.Y != nil && *req.Y != *tt.expectedY {
+				t.Errorf("expected y %v, got %v", *tt.expectedY, *req.Y)
+			}
+		})
+	}
+}
+
+func TestFunctionResponseJSON(t *testing.T) {
+	response := FunctionResponse{Function: "ln", Result: 1}
+
+	data, err := json.Marshal(response)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := `{"function":"ln","result":1}`
+	if string(data) != expected {
+		t.Errorf("expected %s, got %s", expected, string(data))
+	}
+}
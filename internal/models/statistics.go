@@ -0,0 +1,29 @@
+package models
+
+type StatisticsRequest struct {
+	Values      []float64 `json:"values"`
+	Weights     []float64 `json:"weights,omitempty"`     // Optional weights for a weighted mean; must match values length
+	Percentiles []float64 `json:"percentiles,omitempty"` // Optional percentiles to compute, each between 0 and 100
+}
+
+type StatisticsResponse struct {
+	Count              int                `json:"count"`
+	Sum                float64            `json:"sum"`
+	Mean               float64            `json:"mean"`
+	WeightedMean       *float64           `json:"weighted_mean,omitempty"`
+	Median             float64            `json:"median"`
+	Modes              []float64          `json:"modes"`
+	PopulationVariance float64            `json:"population_variance"`
+	SampleVariance     *float64           `json:"sample_variance"`
+	PopulationStdDev   float64            `json:"population_std_dev"`
+	SampleStdDev       *float64           `json:"sample_std_dev"`
+	Min                float64            `json:"min"`
+	Max                float64            `json:"max"`
+	Range              float64            `json:"range"`
+	Q1                 float64            `json:"q1"`
+	Q3                 float64            `json:"q3"`
+	IQR                float64            `json:"iqr"`
+	Skewness           *float64           `json:"skewness"`
+	Kurtosis           *float64           `json:"kurtosis"` // Excess kurtosis (0 for a normal distribution)
+	Percentiles        map[string]float64 `json:"percentiles,omitempty"`
+}
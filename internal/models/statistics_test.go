@@ -0,0 +1,83 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestStatisticsRequestJSON(t *testing.T) {
+	tests := []struct {
+		name           string
+		json           string
+		expectedValues int
+		expectedWeight int
+		wantErr        bool
+	}{
+		{
+			name:           "values only",
+			json:           `{"values": [1, 2, 3.5]}`,
+			expectedValues: 3,
+		},
+		{
+			name:           "values with weights and percentiles",
+			json:           `{"values": [1, 2], "weights": [0.25, 0.75], "percentiles": [90]}`,
+			expectedValues: 2,
+			expectedWeight: 2,
+		},
+		{
+			name:    "invalid json",
+			json:    `{"values": "1,2,3"}`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var req StatisticsRequest
+			err := json.Unmarshal([]byte(tt.json), &req)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("expected error but got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(req.Values) != tt.expectedValues {
+				t.Errorf("expected %d values, got %d", tt.expectedValues, len(req.Values))
+			}
+			if len(req.Weights) != tt.expectedWeight {
+				t.Errorf("expected %d weights, got %d", tt.expectedWeight, len(req.Weights))
+			}
+		})
+	}
+}
+
+func TestStatisticsResponseJSONUndefinedFields(t *testing.T) {
+	response := StatisticsResponse{Count: 1, Modes: []float64{}}
+
+	data, err := json.Marshal(response)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, field := range []string{"sample_variance", "sample_std_dev", "skewness", "kurtosis"} {
+		value, ok := decoded[field]
+		if !ok {
+			t.Errorf("expected %s to be present", field)
+		} else if value != nil {
+			t.Errorf("expected %s to be null, got %v", field, value)
+		}
+	}
+	if _, ok := decoded["weighted_mean"]; ok {
+		t.Errorf("expected weighted_mean to be omitted")
+	}
+}
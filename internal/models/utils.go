@@ -13,15 +13,46 @@ type BMIResponse struct {
 }
 
 type UnitConversionRequest struct {
-	Value    float64 `json:"value"`
-	FromUnit string  `json:"from_unit"`
-	ToUnit   string  `json:"to_unit"`
-	UnitType string  `json:"unit_type"`
+	Value            float64 `json:"value"`
+	FromUnit         string  `json:"from_unit"`         // A symbol or an expression such as "km/h" or "kg/m3"
+	Input            string  `json:"input"`             // Replaces value and from_unit, e.g. "5 ft 10 in" or "1,5 km"
+	DecimalSeparator string  `json:"decimal_separator"` // Optional "." or "," for input; inferred by default
+	ToUnit           string  `json:"to_unit"`
+	UnitType         string  `json:"unit_type"` // Optional; when set, both units must belong to it
 }
 
 type UnitConversionResponse struct {
-	Result   float64 `json:"result"`
-	FromUnit string  `json:"from_unit"`
-	ToUnit   string  `json:"to_unit"`
-	UnitType string  `json:"unit_type"`
+	Value     *float64 `json:"value,omitempty"` // The value parsed from input
+	Result    float64  `json:"result"`
+	FromUnit  string   `json:"from_unit"`
+	ToUnit    string   `json:"to_unit"`
+	UnitType  string   `json:"unit_type"`
+	Dimension string   `json:"dimension,omitempty"` // e.g. "speed (L·T⁻¹)"
+	Compound  string   `json:"compound,omitempty"`  // e.g. "5 ft 10 in", for units written in parts
+}
+
+// UnitInfo describes a unit offered under a unit type. A value v in the unit
+// is Factor*v + Offset in the type's base unit, or Factor/v when Inverse is
+// set (L/100km against km/L).
+type UnitInfo struct {
+	Symbol     string   `json:"symbol"`
+	Name       string   `json:"name"`
+	Plural     string   `json:"plural"`
+	Aliases    []string `json:"aliases"`
+	Systems    []string `json:"systems"` // "metric", "imperial" and/or "us_customary"
+	Factor     float64  `json:"factor"`
+	Offset     float64  `json:"offset,omitempty"`
+	Inverse    bool     `json:"inverse,omitempty"`
+	SIPrefixes bool     `json:"si_prefixes,omitempty"` // Accepts SI prefixes such as k or µ
+}
+
+type UnitTypeInfo struct {
+	Type      string     `json:"type"`
+	Dimension string     `json:"dimension"`
+	BaseUnit  string     `json:"base_unit"`
+	Units     []UnitInfo `json:"units"`
+}
+
+type UnitListResponse struct {
+	UnitTypes []UnitTypeInfo `json:"unit_types"`
 }
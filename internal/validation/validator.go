@@ -3,9 +3,21 @@ package validation
 import (
 	"fmt"
 	"math"
+	"math/big"
+	"strings"
 
 	"github.com/m-szczepanski/gocalc-api/internal/errors"
 	"github.com/m-szczepanski/gocalc-api/internal/models"
+	"github.com/m-szczepanski/gocalc-api/pkg/calculations"
+	"github.com/m-szczepanski/gocalc-api/pkg/calculations/calculus"
+	"github.com/m-szczepanski/gocalc-api/pkg/calculations/distribution"
+	"github.com/m-szczepanski/gocalc-api/pkg/calculations/expr"
+	"github.com/m-szczepanski/gocalc-api/pkg/calculations/geodesy"
+	"github.com/m-szczepanski/gocalc-api/pkg/calculations/geometry"
+	"github.com/m-szczepanski/gocalc-api/pkg/calculations/hypothesis"
+	"github.com/m-szczepanski/gocalc-api/pkg/calculations/numtheory"
+	"github.com/m-szczepanski/gocalc-api/pkg/calculations/polynomial"
+	"github.com/m-szczepanski/gocalc-api/pkg/calculations/random"
 )
 
 func ValidateMathRequest(req *models.MathRequest) *errors.APIError {
@@ -13,6 +25,40 @@ func ValidateMathRequest(req *models.MathRequest) *errors.APIError {
 		return errors.InvalidInput("request body is required")
 	}
 
+	mode, err := calculations.ParseNumberMode(req.Mode)
+	if err != nil {
+		return errors.ValidationError("invalid mode", err.Error())
+	}
+
+	if mode == calculations.ModeUncertainty || mode == calculations.ModeInterval {
+		if _, apiErr := measurementOperand("a", req.TextA, req.A, req.UncertaintyA, mode); apiErr != nil {
+			return apiErr
+		}
+		_, apiErr := measurementOperand("b", req.TextB, req.B, req.UncertaintyB, mode)
+		return apiErr
+	}
+
+	if req.ObjectOperands() {
+		return errors.ValidationError(
+			"invalid operand",
+			`value/uncertainty operands require mode "uncertainty" or "interval"`,
+		)
+	}
+
+	if mode == calculations.ModeFraction {
+		if apiErr := validateFractionOperand("a", req.TextA); apiErr != nil {
+			return apiErr
+		}
+		return validateFractionOperand("b", req.TextB)
+	}
+
+	if req.StringOperands() {
+		return errors.ValidationError(
+			"invalid operand",
+			`a and b must be numbers; string operands such as "1/3" or "12.3 ± 0.2" require mode "fraction", "uncertainty" or "interval"`,
+		)
+	}
+
 	if math.IsNaN(req.A) || math.IsInf(req.A, 0) {
 		return errors.ValidationError(
 			"invalid a",
@@ -35,6 +81,21 @@ func ValidateDivisionRequest(req *models.MathRequest) *errors.APIError {
 		return apiErr
 	}
 
+	switch mode, _ := calculations.ParseNumberMode(req.Mode); mode {
+	case calculations.ModeFraction:
+		if b, _ := calculations.ParseFraction(req.TextB); b.Sign() == 0 {
+			return errors.DivisionByZero()
+		}
+		return nil
+	case calculations.ModeUncertainty, calculations.ModeInterval:
+		// An interval divisor that merely contains zero is reported by the
+		// division itself.
+		if b, _ := measurementOperand("b", req.TextB, req.B, req.UncertaintyB, mode); b.Value == 0 {
+			return errors.DivisionByZero()
+		}
+		return nil
+	}
+
 	// Check for division by zero
 	// Simple equality check is sufficient because:
 	// 1. ValidateMathRequest already checked for Inf and NaN
@@ -47,6 +108,44 @@ func ValidateDivisionRequest(req *models.MathRequest) *errors.APIError {
 	return nil
 }
 
+func validateFractionOperand(field, text string) *errors.APIError {
+	if text == "" {
+		return errors.ValidationError(
+			"invalid "+field,
+			fmt.Sprintf("%s is required in fraction mode", field),
+		)
+	}
+	if _, err := calculations.ParseFraction(text); err != nil {
+		return errors.ValidationError("invalid "+field, err.Error())
+	}
+	return nil
+}
+
+// measurementOperand resolves an operand in uncertainty or interval mode: a
+// value/uncertainty object, or a number or string such as "12.3 ± 0.2".
+func measurementOperand(field, text string, value, uncertainty float64, mode calculations.NumberMode) (calculations.Measurement, *errors.APIError) {
+	if text == "" {
+		return calculations.Measurement{}, errors.ValidationError(
+			"invalid "+field,
+			fmt.Sprintf("%s is required in %s mode", field, mode),
+		)
+	}
+	if strings.HasPrefix(text, "{") {
+		if uncertainty < 0 {
+			return calculations.Measurement{}, errors.ValidationError(
+				"invalid "+field,
+				fmt.Sprintf("%s uncertainty must be >= 0, got %v", field, uncertainty),
+			)
+		}
+		return calculations.Measurement{Value: value, Uncertainty: uncertainty}, nil
+	}
+	m, err := calculations.ParseMeasurement(text)
+	if err != nil {
+		return calculations.Measurement{}, errors.ValidationError("invalid "+field, err.Error())
+	}
+	return m, nil
+}
+
 func ValidateMethod(method, allowedMethod string) *errors.APIError {
 	if method != allowedMethod {
 		return errors.MethodNotAllowed(method)
@@ -266,10 +365,24 @@ func ValidateUnitConversionRequest(req *models.UnitConversionRequest) *errors.AP
 		)
 	}
 
-	if req.FromUnit == "" {
+	if req.Input != "" {
+		if req.FromUnit != "" || req.Value != 0 {
+			return errors.ValidationError(
+				"invalid input",
+				"input replaces value and from_unit, which must be omitted",
+			)
+		}
+	} else if req.FromUnit == "" {
 		return errors.ValidationError(
 			"invalid from_unit",
-			"from_unit is required",
+			"from_unit or input is required",
+		)
+	}
+
+	if req.DecimalSeparator != "" && req.DecimalSeparator != "." && req.DecimalSeparator != "," {
+		return errors.ValidationError(
+			"invalid decimal_separator",
+			fmt.Sprintf("decimal_separator must be \".\" or \",\", got %q", req.DecimalSeparator),
 		)
 	}
 
@@ -280,12 +393,1418 @@ func ValidateUnitConversionRequest(req *models.UnitConversionRequest) *errors.AP
 		)
 	}
 
-	if req.UnitType == "" {
+	return nil
+}
+
+func ValidateFunctionRequest(req *models.FunctionRequest) *errors.APIError {
+	if req == nil {
+		return errors.InvalidInput("request body is required")
+	}
+
+	if !calculations.IsValidScientificFunction(req.Function) {
+		return errors.ValidationError(
+			"invalid function",
+			fmt.Sprintf("function must be one of %v, got %q", calculations.ValidScientificFunctions(), req.Function),
+		)
+	}
+
+	mode, err := calculations.ParseNumberMode(req.Mode)
+	if err != nil || mode == calculations.ModeFraction {
+		return errors.ValidationError(
+			"invalid mode",
+			fmt.Sprintf("mode must be decimal, uncertainty or interval, got %q", req.Mode),
+		)
+	}
+
+	fn := calculations.ScientificFunction(strings.ToLower(strings.TrimSpace(req.Function)))
+	x, y := req.X, req.Y
+	if mode == calculations.ModeDecimal {
+		if req.StringOperands() || req.ObjectOperands() {
+			return errors.ValidationError(
+				"invalid operand",
+				`x and y must be numbers; operands such as "12.3 ± 0.2" require mode "uncertainty" or "interval"`,
+			)
+		}
+	} else {
+		mx, apiErr := measurementOperand("x", req.TextX, req.X, req.UncertaintyX, mode)
+		if apiErr != nil {
+			return apiErr
+		}
+		x, y = mx.Value, nil
+		if req.TextY != "" {
+			var value float64
+			if req.Y != nil {
+				value = *req.Y
+			}
+			my, apiErr := measurementOperand("y", req.TextY, value, req.UncertaintyY, mode)
+			if apiErr != nil {
+				return apiErr
+			}
+			if fn == calculations.FuncRound && my.Uncertainty != 0 {
+				return errors.ValidationError("invalid y", "y must be an exact number of decimal places for round")
+			}
+			y = &my.Value
+		}
+	}
+
+	if math.IsNaN(x) || math.IsInf(x, 0) {
+		return errors.ValidationError(
+			"invalid x",
+			fmt.Sprintf("x must be a valid number, got %v", x),
+		)
+	}
+
+	if calculations.RequiresSecondOperand(fn) {
+		if y == nil {
+			return errors.ValidationError(
+				"invalid y",
+				fmt.Sprintf("y is required for function %s", fn),
+			)
+		}
+		if math.IsNaN(*y) || math.IsInf(*y, 0) {
+			return errors.ValidationError(
+				"invalid y",
+				fmt.Sprintf("y must be a valid number, got %v", *y),
+			)
+		}
+		if fn == calculations.FuncRound && (*y != math.Trunc(*y) || *y < -15 || *y > 15) {
+			return errors.ValidationError(
+				"invalid y",
+				"y must be an integer number of decimal places between -15 and 15 for round",
+			)
+		}
+	}
+
+	if _, err := calculations.ParseAngleMode(req.AngleMode); err != nil {
+		return errors.ValidationError("invalid angle_mode", err.Error())
+	}
+
+	return nil
+}
+
+func ValidateStatisticsRequest(req *models.StatisticsRequest, maxValues int) *errors.APIError {
+	if req == nil {
+		return errors.InvalidInput("request body is required")
+	}
+
+	if apiErr := validateDataset("values", req.Values, maxValues); apiErr != nil {
+		return apiErr
+	}
+
+	if req.Weights != nil {
+		if len(req.Weights) != len(req.Values) {
+			return errors.ValidationError(
+				"invalid weights",
+				fmt.Sprintf("weights must have the same length as values (%d), got %d", len(req.Values), len(req.Weights)),
+			)
+		}
+		allZero := true
+		for i, w := range req.Weights {
+			if math.IsNaN(w) || math.IsInf(w, 0) || w < 0 {
+				return errors.ValidationError(
+					"invalid weights",
+					fmt.Sprintf("weights[%d] must be a non-negative number, got %v", i, w),
+				)
+			}
+			if w != 0 {
+				allZero = false
+			}
+		}
+		if allZero {
+			return errors.ValidationError(
+				"invalid weights",
+				"weights must not all be zero",
+			)
+		}
+	}
+
+	if len(req.Percentiles) > maxValues {
+		return errors.ValidationError(
+			"invalid percentiles",
+			fmt.Sprintf("at most %d percentiles may be requested, got %d", maxValues, len(req.Percentiles)),
+		)
+	}
+	for i, p := range req.Percentiles {
+		if math.IsNaN(p) || p < 0 || p > 100 {
+			return errors.ValidationError(
+				"invalid percentiles",
+				fmt.Sprintf("percentiles[%d] must be between 0 and 100, got %v", i, p),
+			)
+		}
+	}
+
+	return nil
+}
+
+// ValidateStreamedCount checks how many values have been read from a streamed
+// array. It is called after each value, when the final length is not yet
+// known, and once more when the array ends.
+func ValidateStreamedCount(field string, count, maxValues int) *errors.APIError {
+	if count == 0 {
+		return errors.ValidationError(
+			"invalid "+field,
+			field+" must contain at least one number",
+		)
+	}
+
+	if count > maxValues {
+		return errors.ValidationError(
+			"invalid "+field,
+			fmt.Sprintf("%s cannot contain more than %d numbers", field, maxValues),
+		)
+	}
+
+	return nil
+}
+
+// validateDataset checks that a numeric array is non-empty, within the
+// configured size limit and contains only finite numbers.
+func validateDataset(field string, values []float64, maxValues int) *errors.APIError {
+	if len(values) == 0 {
+		return errors.ValidationError(
+			"invalid "+field,
+			field+" must contain at least one number",
+		)
+	}
+
+	if len(values) > maxValues {
+		return errors.ValidationError(
+			"invalid "+field,
+			fmt.Sprintf("%s cannot contain more than %d numbers, got %d", field, maxValues, len(values)),
+		)
+	}
+
+	for i, v := range values {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return errors.ValidationError(
+				"invalid "+field,
+				fmt.Sprintf("%s[%d] must be a valid number, got %v", field, i, v),
+			)
+		}
+	}
+
+	return nil
+}
+
+func ValidateRegressionRequest(req *models.RegressionRequest, maxPoints int) *errors.APIError {
+	if req == nil {
+		return errors.InvalidInput("request body is required")
+	}
+
+	if !calculations.IsValidRegressionModel(req.Model) {
+		return errors.ValidationError(
+			"invalid model",
+			fmt.Sprintf("model must be one of %v, got %q", calculations.ValidRegressionModels(), req.Model),
+		)
+	}
+
+	model := calculations.RegressionModel(strings.ToLower(strings.TrimSpace(req.Model)))
+	degree := 1
+	if model == calculations.RegressionPolynomial {
+		if req.Degree < 1 || req.Degree > calculations.MaxPolynomialDegree {
+			return errors.ValidationError(
+				"invalid degree",
+				fmt.Sprintf("degree must be between 1 and %d for polynomial regression, got %d", calculations.MaxPolynomialDegree, req.Degree),
+			)
+		}
+		degree = req.Degree
+	}
+
+	if len(req.Points) < degree+1 {
+		return errors.ValidationError(
+			"invalid points",
+			fmt.Sprintf("at least %d points are required for this model, got %d", degree+1, len(req.Points)),
+		)
+	}
+
+	if len(req.Points) > maxPoints {
+		return errors.ValidationError(
+			"invalid points",
+			fmt.Sprintf("points cannot contain more than %d entries, got %d", maxPoints, len(req.Points)),
+		)
+	}
+
+	for i, p := range req.Points {
+		if math.IsNaN(p.X) || math.IsInf(p.X, 0) || math.IsNaN(p.Y) || math.IsInf(p.Y, 0) {
+			return errors.ValidationError(
+				"invalid points",
+				fmt.Sprintf("points[%d] must have valid numeric x and y", i),
+			)
+		}
+	}
+
+	if len(req.Predict) > maxPoints {
+		return errors.ValidationError(
+			"invalid predict",
+			fmt.Sprintf("predict cannot contain more than %d values, got %d", maxPoints, len(req.Predict)),
+		)
+	}
+
+	for i, x := range req.Predict {
+		if math.IsNaN(x) || math.IsInf(x, 0) {
+			return errors.ValidationError(
+				"invalid predict",
+				fmt.Sprintf("predict[%d] must be a valid number, got %v", i, x),
+			)
+		}
+		if (model == calculations.RegressionLogarithmic || model == calculations.RegressionPower) && x <= 0 {
+			return errors.ValidationError(
+				"invalid predict",
+				fmt.Sprintf("predict[%d] must be positive for %s regression, got %v", i, model, x),
+			)
+		}
+	}
+
+	return nil
+}
+
+// RowError describes a problem with a single row of a matrix.
+type RowError struct {
+	Row   int    `json:"row"`
+	Error string `json:"error"`
+}
+
+// ValidateMatrix checks that a row-major matrix is non-empty, rectangular,
+// holds at most maxCells entries and contains only finite numbers. Every
+// offending row is reported in the error's "row_errors" meta entry.
+func ValidateMatrix(field string, m [][]float64, maxCells int) *errors.APIError {
+	if len(m) == 0 || len(m[0]) == 0 {
+		return errors.ValidationError(
+			"invalid "+field,
+			field+" must have at least one row and one column",
+		)
+	}
+
+	cols := len(m[0])
+	if len(m)*cols > maxCells {
+		return errors.ValidationError(
+			"invalid "+field,
+			fmt.Sprintf("%s cannot contain more than %d entries, got %dx%d", field, maxCells, len(m), cols),
+		)
+	}
+
+	var rowErrors []RowError
+	for i, row := range m {
+		if len(row) != cols {
+			rowErrors = append(rowErrors, RowError{
+				Row:   i,
+				Error: fmt.Sprintf("expected %d columns, got %d", cols, len(row)),
+			})
+			continue
+		}
+		for j, v := range row {
+			if math.IsNaN(v) || math.IsInf(v, 0) {
+				rowErrors = append(rowErrors, RowError{
+					Row:   i,
+					Error: fmt.Sprintf("column %d must be a valid number, got %v", j, v),
+				})
+				break
+			}
+		}
+	}
+
+	if len(rowErrors) > 0 {
+		return errors.ValidationError(
+			"invalid "+field,
+			fmt.Sprintf("%s has %d invalid row(s); first: row %d: %s", field, len(rowErrors), rowErrors[0].Row, rowErrors[0].Error),
+		).WithMeta("row_errors", rowErrors)
+	}
+
+	return nil
+}
+
+func ValidateMatrixRequest(req *models.MatrixRequest, needsB bool, maxCells int) *errors.APIError {
+	if req == nil {
+		return errors.InvalidInput("request body is required")
+	}
+
+	if apiErr := ValidateMatrix("a", req.A, maxCells); apiErr != nil {
+		return apiErr
+	}
+
+	if needsB {
+		if apiErr := ValidateMatrix("b", req.B, maxCells); apiErr != nil {
+			return apiErr
+		}
+	}
+
+	return nil
+}
+
+func ValidateLinearSystemRequest(req *models.LinearSystemRequest, maxCells int) *errors.APIError {
+	if req == nil {
+		return errors.InvalidInput("request body is required")
+	}
+
+	if apiErr := ValidateMatrix("a", req.A, maxCells); apiErr != nil {
+		return apiErr
+	}
+
+	if len(req.A) != len(req.A[0]) {
+		return errors.ValidationError(
+			"invalid a",
+			fmt.Sprintf("a must be square, got %dx%d", len(req.A), len(req.A[0])),
+		)
+	}
+
+	if len(req.B) != len(req.A) {
+		return errors.ValidationError(
+			"invalid b",
+			fmt.Sprintf("b must have %d entries to match the rows of a, got %d", len(req.A), len(req.B)),
+		)
+	}
+
+	for i, v := range req.B {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return errors.ValidationError(
+				"invalid b",
+				fmt.Sprintf("b[%d] must be a valid number, got %v", i, v),
+			)
+		}
+	}
+
+	return nil
+}
+
+func ValidateComplexRequest(req *models.ComplexRequest) *errors.APIError {
+	if req == nil {
+		return errors.InvalidInput("request body is required")
+	}
+
+	if !calculations.IsValidComplexOperation(req.Operation) {
+		return errors.ValidationError(
+			"invalid operation",
+			fmt.Sprintf("operation must be one of %v, got %q", calculations.ValidComplexOperations(), req.Operation),
+		)
+	}
+
+	op := calculations.ComplexOperation(strings.ToLower(strings.TrimSpace(req.Operation)))
+	if op == calculations.ComplexRect {
+		if req.Polar == nil {
+			return errors.ValidationError("invalid polar", "polar is required for operation rect")
+		}
+		if math.IsNaN(req.Polar.Modulus) || math.IsInf(req.Polar.Modulus, 0) || math.IsNaN(req.Polar.Argument) || math.IsInf(req.Polar.Argument, 0) {
+			return errors.ValidationError(
+				"invalid polar",
+				fmt.Sprintf("polar modulus and argument must be valid numbers, got %v and %v", req.Polar.Modulus, req.Polar.Argument),
+			)
+		}
+		if req.Polar.Modulus < 0 {
+			return errors.ValidationError(
+				"invalid polar",
+				fmt.Sprintf("polar modulus must be >= 0, got %v", req.Polar.Modulus),
+			)
+		}
+	} else if apiErr := validateComplex("a", req.A, op); apiErr != nil {
+		return apiErr
+	}
+
+	if calculations.RequiresSecondComplexOperand(op) {
+		if apiErr := validateComplex("b", req.B, op); apiErr != nil {
+			return apiErr
+		}
+		if op == calculations.ComplexDiv && req.B.Re == 0 && req.B.Im == 0 {
+			return errors.DivisionByZero()
+		}
+	}
+
+	if op == calculations.ComplexRoots {
+		if req.N == nil {
+			return errors.ValidationError("invalid n", "n is required for operation roots")
+		}
+		if *req.N < 1 || *req.N > calculations.MaxRootCount {
+			return errors.ValidationError(
+				"invalid n",
+				fmt.Sprintf("n must be between 1 and %d, got %d", calculations.MaxRootCount, *req.N),
+			)
+		}
+	}
+
+	if _, err := calculations.ParseAngleMode(req.AngleMode); err != nil {
+		return errors.ValidationError("invalid angle_mode", err.Error())
+	}
+
+	return nil
+}
+
+func validateComplex(field string, z *models.Complex, op calculations.ComplexOperation) *errors.APIError {
+	if z == nil {
+		return errors.ValidationError(
+			"invalid "+field,
+			fmt.Sprintf("%s is required for operation %s", field, op),
+		)
+	}
+	if math.IsNaN(z.Re) || math.IsInf(z.Re, 0) || math.IsNaN(z.Im) || math.IsInf(z.Im, 0) {
+		return errors.ValidationError(
+			"invalid "+field,
+			fmt.Sprintf("%s.re and %s.im must be valid numbers, got %v and %v", field, field, z.Re, z.Im),
+		)
+	}
+	return nil
+}
+
+func ValidateIntegerListRequest(req *models.IntegerListRequest, maxValues int) *errors.APIError {
+	if req == nil {
+		return errors.InvalidInput("request body is required")
+	}
+
+	if len(req.Values) == 0 {
+		return errors.ValidationError("invalid values", "values must contain at least one integer")
+	}
+
+	if len(req.Values) > maxValues {
 		return errors.ValidationError(
-			"invalid unit_type",
-			"unit_type is required (valid types: weight, height, temperature, distance, volume)",
+			"invalid values",
+			fmt.Sprintf("values must contain at most %d integers, got %d", maxValues, len(req.Values)),
 		)
 	}
 
+	for i, v := range req.Values {
+		if apiErr := validateInteger(fmt.Sprintf("values[%d]", i), v); apiErr != nil {
+			return apiErr
+		}
+	}
+
+	return nil
+}
+
+// ValidateIntegerRequest checks n, which must be >= minimum.
+func ValidateIntegerRequest(req *models.IntegerRequest, minimum int64) *errors.APIError {
+	if req == nil {
+		return errors.InvalidInput("request body is required")
+	}
+
+	return validateIntegerAtLeast("n", req.N, minimum)
+}
+
+func ValidateModPowRequest(req *models.ModPowRequest) *errors.APIError {
+	if req == nil {
+		return errors.InvalidInput("request body is required")
+	}
+
+	if apiErr := validateInteger("base", req.Base); apiErr != nil {
+		return apiErr
+	}
+
+	if apiErr := validateInteger("exponent", req.Exponent); apiErr != nil {
+		return apiErr
+	}
+
+	return validateIntegerAtLeast("modulus", req.Modulus, 1)
+}
+
+func ValidateModInverseRequest(req *models.ModInverseRequest) *errors.APIError {
+	if req == nil {
+		return errors.InvalidInput("request body is required")
+	}
+
+	if apiErr := validateInteger("a", req.A); apiErr != nil {
+		return apiErr
+	}
+
+	return validateIntegerAtLeast("modulus", req.Modulus, 1)
+}
+
+func ValidatePrimeRangeRequest(req *models.PrimeRangeRequest) *errors.APIError {
+	if req == nil {
+		return errors.InvalidInput("request body is required")
+	}
+
+	if req.From > req.To {
+		return errors.ValidationError(
+			"invalid range",
+			fmt.Sprintf("from (%d) must not exceed to (%d)", req.From, req.To),
+		)
+	}
+
+	if req.To > numtheory.MaxSieveLimit {
+		return errors.ValidationError(
+			"invalid to",
+			fmt.Sprintf("to must not exceed %d, got %d", uint64(numtheory.MaxSieveLimit), req.To),
+		)
+	}
+
+	if req.To-req.From >= numtheory.MaxSieveSpan {
+		return errors.ValidationError(
+			"invalid range",
+			fmt.Sprintf("range must span fewer than %d numbers, got %d", numtheory.MaxSieveSpan, req.To-req.From+1),
+		)
+	}
+
+	return nil
+}
+
+func validateInteger(field, value string) *errors.APIError {
+	if _, err := numtheory.ParseInteger(value); err != nil {
+		return errors.ValidationError(
+			"invalid "+field,
+			fmt.Sprintf("%s must be a base-10 integer string: %v", field, err),
+		)
+	}
+	return nil
+}
+
+func validateIntegerAtLeast(field, value string, minimum int64) *errors.APIError {
+	if apiErr := validateInteger(field, value); apiErr != nil {
+		return apiErr
+	}
+	n, _ := numtheory.ParseInteger(value)
+	if n.Cmp(big.NewInt(minimum)) < 0 {
+		return errors.ValidationError(
+			"invalid "+field,
+			fmt.Sprintf("%s must be >= %d, got %s", field, minimum, n),
+		)
+	}
+	return nil
+}
+
+func ValidateBaseConvertRequest(req *models.BaseConvertRequest) *errors.APIError {
+	if req == nil {
+		return errors.InvalidInput("request body is required")
+	}
+
+	fromBase, fromRoman, err := calculations.ParseNumeralBase(string(req.FromBase))
+	if err != nil {
+		return errors.ValidationError("invalid from_base", err.Error())
+	}
+
+	if _, _, err := calculations.ParseNumeralBase(string(req.ToBase)); err != nil {
+		return errors.ValidationError("invalid to_base", err.Error())
+	}
+
+	if strings.TrimSpace(req.Value) == "" {
+		return errors.ValidationError("invalid value", "value is required")
+	}
+
+	if fromRoman {
+		if _, err := calculations.FromRoman(req.Value); err != nil {
+			return errors.ValidationError("invalid value", err.Error())
+		}
+	} else if _, err := calculations.ParseInBase(req.Value, fromBase); err != nil {
+		return errors.ValidationError("invalid value", err.Error())
+	}
+
+	if req.BitWidth != 0 && (req.BitWidth < 2 || req.BitWidth > calculations.MaxBitWidth) {
+		return errors.ValidationError(
+			"invalid bit_width",
+			fmt.Sprintf("bit_width must be between 2 and %d, got %d", calculations.MaxBitWidth, req.BitWidth),
+		)
+	}
+
+	if req.Precision != nil && (*req.Precision < 0 || *req.Precision > calculations.MaxFractionDigits) {
+		return errors.ValidationError(
+			"invalid precision",
+			fmt.Sprintf("precision must be between 0 and %d, got %d", calculations.MaxFractionDigits, *req.Precision),
+		)
+	}
+
+	return nil
+}
+
+func ValidateBitwiseRequest(req *models.BitwiseRequest) *errors.APIError {
+	if req == nil {
+		return errors.InvalidInput("request body is required")
+	}
+
+	if !calculations.IsValidBitwiseOperation(req.Operation) {
+		return errors.ValidationError(
+			"invalid operation",
+			fmt.Sprintf("operation must be one of %v, got %q", calculations.ValidBitwiseOperations(), req.Operation),
+		)
+	}
+	op := calculations.BitwiseOperation(strings.ToLower(strings.TrimSpace(req.Operation)))
+
+	intType, err := calculations.ParseIntType(req.Type)
+	if err != nil {
+		return errors.ValidationError("invalid type", err.Error())
+	}
+
+	if _, err := calculations.ParseOverflowMode(req.Overflow); err != nil {
+		return errors.ValidationError("invalid overflow", err.Error())
+	}
+
+	if op != calculations.BitwiseMask {
+		if apiErr := validateIntegerLiteral("a", req.A, op); apiErr != nil {
+			return apiErr
+		}
+	}
+
+	if calculations.RequiresSecondBitwiseOperand(op) {
+		if apiErr := validateIntegerLiteral("b", req.B, op); apiErr != nil {
+			return apiErr
+		}
+	}
+
+	if calculations.RequiresBitCount(op) {
+		if req.N == nil {
+			return errors.ValidationError("invalid n", fmt.Sprintf("n is required for operation %s", op))
+		}
+		if *req.N < 0 || *req.N > intType.Bits {
+			return errors.ValidationError(
+				"invalid n",
+				fmt.Sprintf("n must be between 0 and %d for %s, got %d", intType.Bits, intType, *req.N),
+			)
+		}
+	}
+
+	if op == calculations.BitwiseMask && (req.Offset < 0 || req.Offset+*req.N > intType.Bits) {
+		return errors.ValidationError(
+			"invalid offset",
+			fmt.Sprintf("a mask of %d bits at offset %d does not fit in %s", *req.N, req.Offset, intType),
+		)
+	}
+
+	return nil
+}
+
+func validateIntegerLiteral(field, literal string, op calculations.BitwiseOperation) *errors.APIError {
+	if strings.TrimSpace(literal) == "" {
+		return errors.ValidationError(
+			"invalid "+field,
+			fmt.Sprintf("%s is required for operation %s", field, op),
+		)
+	}
+	if _, _, err := calculations.ParseIntegerLiteral(literal); err != nil {
+		return errors.ValidationError("invalid "+field, err.Error())
+	}
+	return nil
+}
+
+func ValidateIntegralRequest(req *models.IntegralRequest) *errors.APIError {
+	if req == nil {
+		return errors.InvalidInput("request body is required")
+	}
+
+	if apiErr := validateExpression(req.Expression); apiErr != nil {
+		return apiErr
+	}
+
+	if _, err := calculus.ParseIntegrationMethod(req.Method); err != nil {
+		return errors.ValidationError("invalid method", err.Error())
+	}
+
+	if apiErr := validateRequiredNumber("lower", req.Lower, "integrate"); apiErr != nil {
+		return apiErr
+	}
+	if apiErr := validateRequiredNumber("upper", req.Upper, "integrate"); apiErr != nil {
+		return apiErr
+	}
+
+	return validateConvergence(req.Tolerance, req.MaxIterations, calculus.MaxIterations)
+}
+
+func ValidateDerivativeRequest(req *models.DerivativeRequest) *errors.APIError {
+	if req == nil {
+		return errors.InvalidInput("request body is required")
+	}
+
+	if apiErr := validateExpression(req.Expression); apiErr != nil {
+		return apiErr
+	}
+
+	if apiErr := validateRequiredNumber("x", req.X, "derivative"); apiErr != nil {
+		return apiErr
+	}
+
+	if req.Order != 0 && (req.Order < 1 || req.Order > calculus.MaxDerivativeOrder) {
+		return errors.ValidationError(
+			"invalid order",
+			fmt.Sprintf("order must be between 1 and %d, got %d", calculus.MaxDerivativeOrder, req.Order),
+		)
+	}
+
+	return validateConvergence(req.Tolerance, req.MaxIterations, calculus.MaxDerivativeIterations)
+}
+
+func ValidateRootRequest(req *models.RootRequest) *errors.APIError {
+	if req == nil {
+		return errors.InvalidInput("request body is required")
+	}
+
+	if apiErr := validateExpression(req.Expression); apiErr != nil {
+		return apiErr
+	}
+
+	method, err := calculus.ParseRootMethod(req.Method)
+	if err != nil {
+		return errors.ValidationError("invalid method", err.Error())
+	}
+
+	if method == calculus.Newton {
+		if apiErr := validateRequiredNumber("x0", req.X0, string(method)); apiErr != nil {
+			return apiErr
+		}
+	} else {
+		if apiErr := validateRequiredNumber("lower", req.Lower, string(method)); apiErr != nil {
+			return apiErr
+		}
+		if apiErr := validateRequiredNumber("upper", req.Upper, string(method)); apiErr != nil {
+			return apiErr
+		}
+	}
+
+	return validateConvergence(req.Tolerance, req.MaxIterations, calculus.MaxIterations)
+}
+
+func validateExpression(expression string) *errors.APIError {
+	if _, err := expr.Parse(expression, "x"); err != nil {
+		return errors.ValidationError("invalid expression", err.Error())
+	}
+	return nil
+}
+
+func validateRequiredNumber(field string, value *float64, operation string) *errors.APIError {
+	if value == nil {
+		return errors.ValidationError(
+			"invalid "+field,
+			fmt.Sprintf("%s is required for %s", field, operation),
+		)
+	}
+	if math.IsNaN(*value) || math.IsInf(*value, 0) {
+		return errors.ValidationError(
+			"invalid "+field,
+			fmt.Sprintf("%s must be a valid number, got %v", field, *value),
+		)
+	}
+	return nil
+}
+
+func validateConvergence(tolerance *float64, maxIterations *int, limit int) *errors.APIError {
+	if tolerance != nil && (math.IsNaN(*tolerance) || *tolerance <= 0 || *tolerance > 1) {
+		return errors.ValidationError(
+			"invalid tolerance",
+			fmt.Sprintf("tolerance must be > 0 and <= 1, got %v", *tolerance),
+		)
+	}
+
+	if maxIterations != nil && (*maxIterations < 1 || *maxIterations > limit) {
+		return errors.ValidationError(
+			"invalid max_iterations",
+			fmt.Sprintf("max_iterations must be between 1 and %d, got %d", limit, *maxIterations),
+		)
+	}
+
+	return nil
+}
+
+func ValidatePolynomialRootsRequest(req *models.PolynomialRootsRequest) *errors.APIError {
+	if req == nil {
+		return errors.InvalidInput("request body is required")
+	}
+
+	hasCoefficients, hasExpression := len(req.Coefficients) > 0, strings.TrimSpace(req.Expression) != ""
+	if hasCoefficients == hasExpression {
+		return errors.ValidationError(
+			"invalid polynomial",
+			"provide either coefficients or expression, but not both",
+		)
+	}
+
+	var degree int
+	if hasExpression {
+		coefficients, _, err := polynomial.ParsePolynomial(req.Expression)
+		if err != nil {
+			return errors.ValidationError("invalid expression", err.Error())
+		}
+		for _, c := range coefficients {
+			if !coefficientInRange(c) {
+				return errors.ValidationError(
+					"invalid expression",
+					fmt.Sprintf("coefficients must have numerators and denominators of at most %d bits", polynomial.MaxCoefficientBits),
+				)
+			}
+		}
+		degree = len(coefficients) - 1
+	} else {
+		if len(req.Coefficients) > polynomial.MaxDegree+1 {
+			return errors.ValidationError(
+				"invalid coefficients",
+				fmt.Sprintf("at most %d coefficients are supported (degree %d), got %d", polynomial.MaxDegree+1, polynomial.MaxDegree, len(req.Coefficients)),
+			)
+		}
+		degree = -1
+		for i, c := range req.Coefficients {
+			r, err := calculations.ParseFraction(string(c))
+			if err != nil {
+				return errors.ValidationError("invalid coefficients", fmt.Sprintf("coefficients[%d]: %v", i, err))
+			}
+			if !coefficientInRange(r) {
+				return errors.ValidationError(
+					"invalid coefficients",
+					fmt.Sprintf("coefficients[%d]: numerator and denominator must be at most %d bits", i, polynomial.MaxCoefficientBits),
+				)
+			}
+			if degree < 0 && r.Sign() != 0 {
+				degree = len(req.Coefficients) - 1 - i
+			}
+		}
+	}
+
+	if degree < 1 {
+		return errors.ValidationError(
+			"invalid polynomial",
+			"polynomial must have degree at least 1",
+		)
+	}
+	return nil
+}
+
+// coefficientInRange reports whether r is small enough for the numeric root
+// finders.
+func coefficientInRange(r *big.Rat) bool {
+	return r.Num().BitLen() <= polynomial.MaxCoefficientBits && r.Denom().BitLen() <= polynomial.MaxCoefficientBits
+}
+
+func ValidateEquationsRequest(req *models.EquationsRequest) *errors.APIError {
+	if req == nil {
+		return errors.InvalidInput("request body is required")
+	}
+
+	if len(req.Equations) == 0 || len(req.Equations) > polynomial.MaxEquations {
+		return errors.ValidationError(
+			"invalid equations",
+			fmt.Sprintf("between 1 and %d equations are required, got %d", polynomial.MaxEquations, len(req.Equations)),
+		)
+	}
+
+	variables, err := polynomial.Variables(req.Equations)
+	if err != nil {
+		return errors.ValidationError("invalid equations", err.Error())
+	}
+	if len(variables) == 0 {
+		return errors.ValidationError("invalid equations", "the equations contain no variables")
+	}
+
+	for i, equation := range req.Equations {
+		if _, _, err := polynomial.ParseEquation(equation, variables); err != nil {
+			return errors.ValidationError("invalid equations", fmt.Sprintf("equations[%d]: %v", i, err))
+		}
+	}
+	return nil
+}
+
+func validateLengthUnits(u models.LengthUnits) *errors.APIError {
+	if u.Unit == "" {
+		if u.OutputUnit != "" {
+			return errors.ValidationError("invalid output_unit", "output_unit requires unit")
+		}
+		return nil
+	}
+
+	if _, err := calculations.DistanceScale(u.Unit, u.Unit); err != nil {
+		return errors.ValidationError("invalid unit", err.Error())
+	}
+	if u.OutputUnit != "" {
+		if _, err := calculations.DistanceScale(u.OutputUnit, u.OutputUnit); err != nil {
+			return errors.ValidationError("invalid output_unit", err.Error())
+		}
+	}
+	return nil
+}
+
+func validateVector(field string, v []float64) *errors.APIError {
+	if err := geometry.Validate(v); err != nil {
+		return errors.ValidationError("invalid "+field, fmt.Sprintf("%s: %v", field, err))
+	}
+	return nil
+}
+
+// ValidateVectorRequest checks a and, if needsB is set, b. Both must have the
+// same number of components.
+func ValidateVectorRequest(req *models.VectorRequest, needsB bool) *errors.APIError {
+	if req == nil {
+		return errors.InvalidInput("request body is required")
+	}
+
+	if apiErr := validateVector("a", req.A); apiErr != nil {
+		return apiErr
+	}
+	if needsB {
+		if apiErr := validateVector("b", req.B); apiErr != nil {
+			return apiErr
+		}
+		if len(req.A) != len(req.B) {
+			return errors.ValidationError(
+				"invalid b",
+				fmt.Sprintf("b must have %d components to match a, got %d", len(req.A), len(req.B)),
+			)
+		}
+	}
+
+	if _, err := calculations.ParseAngleMode(req.AngleMode); err != nil {
+		return errors.ValidationError("invalid angle_mode", err.Error())
+	}
+
+	return validateLengthUnits(req.LengthUnits)
+}
+
+func ValidatePolygonRequest(req *models.PolygonRequest, maxPoints int) *errors.APIError {
+	if req == nil {
+		return errors.InvalidInput("request body is required")
+	}
+
+	if len(req.Points) < 3 || len(req.Points) > maxPoints {
+		return errors.ValidationError(
+			"invalid points",
+			fmt.Sprintf("points must contain between 3 and %d vertices, got %d", maxPoints, len(req.Points)),
+		)
+	}
+
+	for i, p := range req.Points {
+		if len(p) != 2 {
+			return errors.ValidationError(
+				"invalid points",
+				fmt.Sprintf("points[%d] must have 2 coordinates, got %d", i, len(p)),
+			)
+		}
+		if math.IsNaN(p[0]) || math.IsInf(p[0], 0) || math.IsNaN(p[1]) || math.IsInf(p[1], 0) {
+			return errors.ValidationError(
+				"invalid points",
+				fmt.Sprintf("points[%d] must contain valid numbers, got %v", i, p),
+			)
+		}
+	}
+
+	return validateLengthUnits(req.LengthUnits)
+}
+
+func validateLength(field string, x *float64) *errors.APIError {
+	if x == nil {
+		return errors.ValidationError("invalid "+field, field+" is required")
+	}
+	if math.IsNaN(*x) || math.IsInf(*x, 0) || *x <= 0 {
+		return errors.ValidationError(
+			"invalid "+field,
+			fmt.Sprintf("%s must be a positive number, got %v", field, *x),
+		)
+	}
+	return nil
+}
+
+func ValidateCircleRequest(req *models.CircleRequest) *errors.APIError {
+	if req == nil {
+		return errors.InvalidInput("request body is required")
+	}
+
+	if apiErr := validateLength("radius", req.Radius); apiErr != nil {
+		return apiErr
+	}
+
+	return validateLengthUnits(req.LengthUnits)
+}
+
+func ValidateTriangleRequest(req *models.TriangleRequest) *errors.APIError {
+	if req == nil {
+		return errors.InvalidInput("request body is required")
+	}
+
+	mode, err := calculations.ParseAngleMode(req.AngleMode)
+	if err != nil {
+		return errors.ValidationError("invalid angle_mode", err.Error())
+	}
+	straight, unit := math.Pi, "π radians"
+	if mode == calculations.AngleDegrees {
+		straight, unit = 180, "180 degrees"
+	}
+	validateAngle := func(field string, x *float64) *errors.APIError {
+		if x == nil {
+			return errors.ValidationError("invalid "+field, fmt.Sprintf("%s is required for method %s", field, req.Method))
+		}
+		if math.IsNaN(*x) || *x <= 0 || *x >= straight {
+			return errors.ValidationError(
+				"invalid "+field,
+				fmt.Sprintf("%s must be strictly between 0 and %s, got %v", field, unit, *x),
+			)
+		}
+		return nil
+	}
+
+	var lengths, angles []string
+	switch strings.ToLower(strings.TrimSpace(req.Method)) {
+	case "sss":
+		lengths = []string{"a", "b", "c"}
+	case "sas":
+		lengths, angles = []string{"a", "b"}, []string{"gamma"}
+	case "asa":
+		lengths, angles = []string{"c"}, []string{"alpha", "beta"}
+	default:
+		return errors.ValidationError(
+			"invalid method",
+			fmt.Sprintf("method must be one of [sss sas asa], got %q", req.Method),
+		)
+	}
+
+	fields := map[string]*float64{
+		"a": req.A, "b": req.B, "c": req.C,
+		"alpha": req.Alpha, "beta": req.Beta, "gamma": req.Gamma,
+	}
+	for _, field := range lengths {
+		if fields[field] == nil {
+			return errors.ValidationError("invalid "+field, fmt.Sprintf("%s is required for method %s", field, req.Method))
+		}
+		if apiErr := validateLength(field, fields[field]); apiErr != nil {
+			return apiErr
+		}
+	}
+	for _, field := range angles {
+		if apiErr := validateAngle(field, fields[field]); apiErr != nil {
+			return apiErr
+		}
+	}
+
+	return validateLengthUnits(req.LengthUnits)
+}
+
+func ValidateSolidRequest(req *models.SolidRequest) *errors.APIError {
+	if req == nil {
+		return errors.InvalidInput("request body is required")
+	}
+
+	solid, err := geometry.ParseSolid(req.Solid)
+	if err != nil {
+		return errors.ValidationError("invalid solid", err.Error())
+	}
+
+	fields := map[string]*float64{
+		"radius": req.Radius, "minor_radius": req.MinorRadius, "side": req.Side,
+		"length": req.Length, "width": req.Width, "height": req.Height,
+	}
+	for _, field := range geometry.RequiredDimensions(solid) {
+		if fields[field] == nil {
+			return errors.ValidationError("invalid "+field, fmt.Sprintf("%s is required for a %s", field, solid))
+		}
+		if apiErr := validateLength(field, fields[field]); apiErr != nil {
+			return apiErr
+		}
+	}
+
+	return validateLengthUnits(req.LengthUnits)
+}
+
+func validateGeoPoint(field string, p *models.GeoPoint) *errors.APIError {
+	if p == nil {
+		return errors.ValidationError("invalid "+field, field+" is required")
+	}
+	if err := (geodesy.Point{Lat: p.Lat, Lon: p.Lon}).Validate(); err != nil {
+		return errors.ValidationError("invalid "+field, fmt.Sprintf("%s: %v", field, err))
+	}
+	return nil
+}
+
+// ValidateGeoDistanceRequest checks that exactly one of to, pairs, or bearing
+// with distance is given. Pairs may hold at most maxPairs entries.
+func ValidateGeoDistanceRequest(req *models.GeoDistanceRequest, maxPairs int) *errors.APIError {
+	if req == nil {
+		return errors.InvalidInput("request body is required")
+	}
+
+	if req.Unit != "" {
+		if _, err := calculations.DistanceScale(req.Unit, req.Unit); err != nil {
+			return errors.ValidationError("invalid unit", err.Error())
+		}
+	}
+
+	if len(req.Pairs) > 0 {
+		if req.From != nil || req.To != nil || req.Bearing != nil || req.Distance != nil {
+			return errors.ValidationError(
+				"invalid pairs",
+				"pairs cannot be combined with from, to, bearing or distance",
+			)
+		}
+		if len(req.Pairs) > maxPairs {
+			return errors.ValidationError(
+				"invalid pairs",
+				fmt.Sprintf("pairs must contain at most %d entries, got %d", maxPairs, len(req.Pairs)),
+			)
+		}
+		for i := range req.Pairs {
+			if apiErr := validateGeoPoint(fmt.Sprintf("pairs[%d].from", i), &req.Pairs[i].From); apiErr != nil {
+				return apiErr
+			}
+			if apiErr := validateGeoPoint(fmt.Sprintf("pairs[%d].to", i), &req.Pairs[i].To); apiErr != nil {
+				return apiErr
+			}
+		}
+		return nil
+	}
+
+	if apiErr := validateGeoPoint("from", req.From); apiErr != nil {
+		return apiErr
+	}
+
+	if req.To != nil {
+		if req.Bearing != nil || req.Distance != nil {
+			return errors.ValidationError(
+				"invalid to",
+				"provide either to, or bearing and distance, but not both",
+			)
+		}
+		return validateGeoPoint("to", req.To)
+	}
+
+	if req.Bearing == nil || req.Distance == nil {
+		return errors.ValidationError(
+			"invalid request",
+			"either to, or both bearing and distance, are required",
+		)
+	}
+	if math.IsNaN(*req.Bearing) || math.IsInf(*req.Bearing, 0) {
+		return errors.ValidationError(
+			"invalid bearing",
+			fmt.Sprintf("bearing must be a valid number, got %v", *req.Bearing),
+		)
+	}
+	if math.IsNaN(*req.Distance) || math.IsInf(*req.Distance, 0) || *req.Distance < 0 {
+		return errors.ValidationError(
+			"invalid distance",
+			fmt.Sprintf("distance must be a number >= 0, got %v", *req.Distance),
+		)
+	}
+
+	return nil
+}
+
+// ValidateDistributionRequest checks that x or probability is given and that
+// the parameters the distribution needs are present. Parameter ranges are
+// checked when the distribution is built.
+func ValidateDistributionRequest(name string, req *models.DistributionRequest) *errors.APIError {
+	if req == nil {
+		return errors.InvalidInput("request body is required")
+	}
+
+	n, err := distribution.ParseName(name)
+	if err != nil {
+		return errors.ValidationError("invalid distribution", err.Error())
+	}
+
+	if req.X == nil && req.Probability == nil {
+		return errors.ValidationError("invalid request", "at least one of x and probability is required")
+	}
+	if req.X != nil && (math.IsNaN(*req.X) || math.IsInf(*req.X, 0)) {
+		return errors.ValidationError("invalid x", fmt.Sprintf("x must be a valid number, got %v", *req.X))
+	}
+	if req.Probability != nil && !(*req.Probability > 0 && *req.Probability < 1) {
+		return errors.ValidationError(
+			"invalid probability",
+			fmt.Sprintf("probability must be strictly between 0 and 1, got %v", *req.Probability),
+		)
+	}
+
+	present := map[string]bool{
+		"df": req.DF != nil, "df1": req.DF1 != nil, "df2": req.DF2 != nil,
+		"n": req.N != nil, "p": req.P != nil, "lambda": req.Lambda != nil, "rate": req.Rate != nil,
+		"min": req.Min != nil, "max": req.Max != nil, "alpha": req.Alpha != nil, "beta": req.Beta != nil,
+	}
+	for _, param := range distribution.RequiredParams(n) {
+		if !present[param] {
+			return errors.ValidationError("invalid "+param, fmt.Sprintf("%s is required for the %s distribution", param, n))
+		}
+	}
+
+	return nil
+}
+
+func validateSample(field string, x []float64, maxValues int) *errors.APIError {
+	if len(x) < 2 || len(x) > maxValues {
+		return errors.ValidationError(
+			"invalid "+field,
+			fmt.Sprintf("%s must contain between 2 and %d values, got %d", field, maxValues, len(x)),
+		)
+	}
+	for i, v := range x {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return errors.ValidationError("invalid "+field, fmt.Sprintf("%s[%d] must be a valid number, got %v", field, i, v))
+		}
+	}
+	return nil
+}
+
+func validateConfidence(confidence *float64) *errors.APIError {
+	if confidence != nil && !(*confidence > 0 && *confidence < 1) {
+		return errors.ValidationError(
+			"invalid confidence",
+			fmt.Sprintf("confidence must be strictly between 0 and 1, got %v", *confidence),
+		)
+	}
+	return nil
+}
+
+// ValidateHypothesisTestRequest checks that the fields the test needs are
+// present, finite and within maxValues. Counts are checked by the test itself.
+func ValidateHypothesisTestRequest(test string, req *models.HypothesisTestRequest, maxValues int) *errors.APIError {
+	if req == nil {
+		return errors.InvalidInput("request body is required")
+	}
+
+	t, err := hypothesis.ParseTest(test)
+	if err != nil {
+		return errors.ValidationError("invalid test", err.Error())
+	}
+	if _, err := hypothesis.ParseAlternative(req.Alternative); err != nil {
+		return errors.ValidationError("invalid alternative", err.Error())
+	}
+	if apiErr := validateConfidence(req.Confidence); apiErr != nil {
+		return apiErr
+	}
+	if math.IsNaN(req.Mu) || math.IsInf(req.Mu, 0) {
+		return errors.ValidationError("invalid mu", fmt.Sprintf("mu must be a valid number, got %v", req.Mu))
+	}
+
+	switch t {
+	case hypothesis.OneSample:
+		return validateSample("x", req.X, maxValues)
+	case hypothesis.TwoSample, hypothesis.Welch, hypothesis.Paired:
+		if apiErr := validateSample("x", req.X, maxValues); apiErr != nil {
+			return apiErr
+		}
+		if apiErr := validateSample("y", req.Y, maxValues); apiErr != nil {
+			return apiErr
+		}
+		if t == hypothesis.Paired && len(req.X) != len(req.Y) {
+			return errors.ValidationError(
+				"invalid y",
+				fmt.Sprintf("paired samples must have the same length, got %d and %d", len(req.X), len(req.Y)),
+			)
+		}
+	case hypothesis.ChiSquareGoodnessOfFit:
+		if apiErr := validateSample("observed", req.Observed, maxValues); apiErr != nil {
+			return apiErr
+		}
+		if req.Expected != nil && len(req.Expected) != len(req.Observed) {
+			return errors.ValidationError(
+				"invalid expected",
+				fmt.Sprintf("expected must have the same length as observed (%d), got %d", len(req.Observed), len(req.Expected)),
+			)
+		}
+		for i, v := range req.Expected {
+			if math.IsNaN(v) || math.IsInf(v, 0) {
+				return errors.ValidationError("invalid expected", fmt.Sprintf("expected[%d] must be a valid number, got %v", i, v))
+			}
+		}
+	case hypothesis.ChiSquareIndependent:
+		if len(req.Table) < 2 || len(req.Table[0]) < 2 {
+			return errors.ValidationError("invalid table", "table must have at least 2 rows and 2 columns")
+		}
+		if cells := len(req.Table) * len(req.Table[0]); cells > maxValues {
+			return errors.ValidationError(
+				"invalid table",
+				fmt.Sprintf("table must contain at most %d cells, got %d", maxValues, cells),
+			)
+		}
+		for i, row := range req.Table {
+			if len(row) != len(req.Table[0]) {
+				return errors.ValidationError(
+					"invalid table",
+					fmt.Sprintf("table[%d] must have %d columns, got %d", i, len(req.Table[0]), len(row)),
+				)
+			}
+			for j, v := range row {
+				if math.IsNaN(v) || math.IsInf(v, 0) {
+					return errors.ValidationError("invalid table", fmt.Sprintf("table[%d][%d] must be a valid number, got %v", i, j, v))
+				}
+			}
+		}
+	case hypothesis.TwoProportion:
+		if len(req.Successes) != 2 || len(req.Trials) != 2 {
+			return errors.ValidationError("invalid request", "successes and trials must each contain exactly 2 counts")
+		}
+	}
+
+	return nil
+}
+
+// ValidateConfidenceIntervalRequest checks the sample for a mean, or the counts
+// and method for a proportion.
+func ValidateConfidenceIntervalRequest(parameter string, req *models.ConfidenceIntervalRequest, maxValues int) *errors.APIError {
+	if req == nil {
+		return errors.InvalidInput("request body is required")
+	}
+	if apiErr := validateConfidence(req.Confidence); apiErr != nil {
+		return apiErr
+	}
+
+	switch strings.ToLower(strings.TrimSpace(parameter)) {
+	case "mean":
+		return validateSample("x", req.X, maxValues)
+	case "proportion":
+		if req.Successes == nil || req.Trials == nil {
+			return errors.ValidationError("invalid request", "successes and trials are required for a proportion")
+		}
+		if _, err := hypothesis.ParseProportionMethod(req.Method); err != nil {
+			return errors.ValidationError("invalid method", err.Error())
+		}
+		return nil
+	default:
+		return errors.ValidationError(
+			"invalid parameter",
+			fmt.Sprintf("invalid parameter %q (valid parameters: mean, proportion)", parameter),
+		)
+	}
+}
+
+// maxExactInteger is the largest integer that JSON numbers decoded into
+// float64 represent exactly.
+const maxExactInteger = 1 << 53
+
+// ValidateRandomRequest checks the operation's fields and that count and
+// items stay within maxValues. Ranges are checked by the generator.
+func ValidateRandomRequest(req *models.RandomRequest, maxValues int) *errors.APIError {
+	if req == nil {
+		return errors.InvalidInput("request body is required")
+	}
+
+	op, err := random.ParseOperation(req.Operation)
+	if err != nil {
+		return errors.ValidationError("invalid operation", err.Error())
+	}
+
+	if req.Count != nil && (*req.Count < 1 || *req.Count > maxValues) {
+		return errors.ValidationError(
+			"invalid count",
+			fmt.Sprintf("count must be between 1 and %d, got %d", maxValues, *req.Count),
+		)
+	}
+
+	for _, field := range []struct {
+		name  string
+		value *float64
+	}{{"min", req.Min}, {"max", req.Max}, {"mean", req.Mean}, {"sd", req.SD}} {
+		if field.value != nil && (math.IsNaN(*field.value) || math.IsInf(*field.value, 0)) {
+			return errors.ValidationError("invalid "+field.name, fmt.Sprintf("%s must be a valid number, got %v", field.name, *field.value))
+		}
+	}
+
+	if op == random.IntegerDraw {
+		for _, field := range []struct {
+			name  string
+			value *float64
+		}{{"min", req.Min}, {"max", req.Max}} {
+			if field.value == nil {
+				return errors.ValidationError("invalid "+field.name, field.name+" is required for integer draws")
+			}
+			if *field.value != math.Trunc(*field.value) || math.Abs(*field.value) > maxExactInteger {
+				return errors.ValidationError(
+					"invalid "+field.name,
+					fmt.Sprintf("%s must be a whole number between -2^53 and 2^53, got %v", field.name, *field.value),
+				)
+			}
+		}
+	}
+
+	if !random.UsesItems(op) {
+		return nil
+	}
+	if len(req.Items) == 0 || len(req.Items) > maxValues {
+		return errors.ValidationError(
+			"invalid items",
+			fmt.Sprintf("items must contain between 1 and %d values, got %d", maxValues, len(req.Items)),
+		)
+	}
+	if op == random.ChoiceDraw && req.Weights != nil && len(req.Weights) != len(req.Items) {
+		return errors.ValidationError(
+			"invalid weights",
+			fmt.Sprintf("weights must have the same length as items (%d), got %d", len(req.Items), len(req.Weights)),
+		)
+	}
 	return nil
 }
@@ -1,6 +1,7 @@
 package validation
 
 import (
+	"encoding/json"
 	"math"
 	"testing"
 
@@ -65,6 +66,57 @@ func TestValidateMathRequest(t *testing.T) {
 			expectError:  true,
 			expectedCode: errors.ErrCodeValidationError,
 		},
+		{
+			name:        "fraction mode",
+			req:         &models.MathRequest{Mode: "fraction", TextA: "2 1/4", TextB: "1/3"},
+			expectError: false,
+		},
+		{
+			name:         "fraction mode missing operand",
+			req:          &models.MathRequest{Mode: "fraction", TextA: "1/3"},
+			expectError:  true,
+			expectedCode: errors.ErrCodeValidationError,
+		},
+		{
+			name:         "fraction mode invalid operand",
+			req:          &models.MathRequest{Mode: "fraction", TextA: "1/3", TextB: "x/4"},
+			expectError:  true,
+			expectedCode: errors.ErrCodeValidationError,
+		},
+		{
+			name:        "uncertainty mode",
+			req:         &models.MathRequest{Mode: "uncertainty", TextA: "12.3 ± 0.2", TextB: "4"},
+			expectError: false,
+		},
+		{
+			name:        "interval mode with object operand",
+			req:         &models.MathRequest{Mode: "interval", A: 2, UncertaintyA: 0.1, TextA: `{"value": 2, "uncertainty": 0.1}`, TextB: "1 +/- 1"},
+			expectError: false,
+		},
+		{
+			name:         "uncertainty mode missing operand",
+			req:          &models.MathRequest{Mode: "uncertainty", TextA: "1 ± 0.1"},
+			expectError:  true,
+			expectedCode: errors.ErrCodeValidationError,
+		},
+		{
+			name:         "negative uncertainty",
+			req:          &models.MathRequest{Mode: "uncertainty", TextA: "1 ± -0.1", TextB: "2"},
+			expectError:  true,
+			expectedCode: errors.ErrCodeValidationError,
+		},
+		{
+			name:         "negative object uncertainty",
+			req:          &models.MathRequest{Mode: "interval", A: 1, UncertaintyA: -1, TextA: `{"value": 1, "uncertainty": -1}`, TextB: "2"},
+			expectError:  true,
+			expectedCode: errors.ErrCodeValidationError,
+		},
+		{
+			name:         "unknown mode",
+			req:          &models.MathRequest{A: 1, B: 2, Mode: "symbolic"},
+			expectError:  true,
+			expectedCode: errors.ErrCodeValidationError,
+		},
 	}
 
 	for _, tt := range tests {
@@ -115,6 +167,23 @@ func TestValidateDivisionRequest(t *testing.T) {
 			expectError:  true,
 			expectedCode: errors.ErrCodeValidationError,
 		},
+		{
+			name:         "zero fraction divisor",
+			req:          &models.MathRequest{Mode: "fraction", TextA: "1/2", TextB: "0/3"},
+			expectError:  true,
+			expectedCode: errors.ErrCodeDivisionByZero,
+		},
+		{
+			name:         "zero measured divisor",
+			req:          &models.MathRequest{Mode: "uncertainty", TextA: "1", TextB: "0 ± 0.1"},
+			expectError:  true,
+			expectedCode: errors.ErrCodeDivisionByZero,
+		},
+		{
+			name:        "interval divisor containing zero is left to the division",
+			req:         &models.MathRequest{Mode: "interval", TextA: "1", TextB: "0.5 ± 1"},
+			expectError: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -821,13 +890,51 @@ func TestValidateUnitConversionRequest(t *testing.T) {
 			expectedCode: errors.ErrCodeValidationError,
 		},
 		{
-			name: "empty unit_type",
+			name: "empty unit_type is inferred",
 			req: &models.UnitConversionRequest{
 				Value:    10,
-				FromUnit: "kg",
-				ToUnit:   "lb",
+				FromUnit: "km/h",
+				ToUnit:   "m/s",
 				UnitType: "",
 			},
+			expectError: false,
+		},
+		{
+			name: "input instead of value and from_unit",
+			req: &models.UnitConversionRequest{
+				Input:            "1,5 km",
+				DecimalSeparator: ",",
+				ToUnit:           "m",
+			},
+			expectError: false,
+		},
+		{
+			name: "input with from_unit",
+			req: &models.UnitConversionRequest{
+				Input:    "5 ft 10 in",
+				FromUnit: "ft",
+				ToUnit:   "cm",
+			},
+			expectError:  true,
+			expectedCode: errors.ErrCodeValidationError,
+		},
+		{
+			name: "input with value",
+			req: &models.UnitConversionRequest{
+				Value:  5,
+				Input:  "5 ft 10 in",
+				ToUnit: "cm",
+			},
+			expectError:  true,
+			expectedCode: errors.ErrCodeValidationError,
+		},
+		{
+			name: "invalid decimal_separator",
+			req: &models.UnitConversionRequest{
+				Input:            "1 500 m",
+				DecimalSeparator: " ",
+				ToUnit:           "km",
+			},
 			expectError:  true,
 			expectedCode: errors.ErrCodeValidationError,
 		},
@@ -848,3 +955,1163 @@ func TestValidateUnitConversionRequest(t *testing.T) {
 		})
 	}
 }
+
+func floatPtr(v float64) *float64 {
+	return &v
+}
+
+func TestValidateFunctionRequest(t *testing.T) {
+	tests := []struct {
+		name         string
+		req          *models.FunctionRequest
+		expectError  bool
+		expectedCode string
+	}{
+		{
+			name:        "valid unary function",
+			req:         &models.FunctionRequest{Function: "sin", X: 30, AngleMode: "degrees"},
+			expectError: false,
+		},
+		{
+			name:        "valid binary function",
+			req:         &models.FunctionRequest{Function: "log", X: 8, Y: floatPtr(2)},
+			expectError: false,
+		},
+		{
+			name:        "function name is case-insensitive",
+			req:         &models.FunctionRequest{Function: "LN", X: 1},
+			expectError: false,
+		},
+		{
+			name:         "nil request",
+			req:          nil,
+			expectError:  true,
+			expectedCode: errors.ErrCodeInvalidInput,
+		},
+		{
+			name:         "unknown function",
+			req:          &models.FunctionRequest{Function: "gamma", X: 1},
+			expectError:  true,
+			expectedCode: errors.ErrCodeValidationError,
+		},
+		{
+			name:         "NaN x",
+			req:          &models.FunctionRequest{Function: "exp", X: math.NaN()},
+			expectError:  true,
+			expectedCode: errors.ErrCodeValidationError,
+		},
+		{
+			name:         "missing y for pow",
+			req:          &models.FunctionRequest{Function: "pow", X: 2},
+			expectError:  true,
+			expectedCode: errors.ErrCodeValidationError,
+		},
+		{
+			name:         "Inf y",
+			req:          &models.FunctionRequest{Function: "root", X: 2, Y: floatPtr(math.Inf(1))},
+			expectError:  true,
+			expectedCode: errors.ErrCodeValidationError,
+		},
+		{
+			name:         "fractional decimal places for round",
+			req:          &models.FunctionRequest{Function: "round", X: 2.5, Y: floatPtr(1.5)},
+			expectError:  true,
+			expectedCode: errors.ErrCodeValidationError,
+		},
+		{
+			name:        "uncertainty mode",
+			req:         &models.FunctionRequest{Function: "pow", Mode: "uncertainty", TextX: "2 ± 0.1", TextY: "3"},
+			expectError: false,
+		},
+		{
+			name:         "fraction mode is not supported",
+			req:          &models.FunctionRequest{Function: "sin", X: 1, Mode: "fraction"},
+			expectError:  true,
+			expectedCode: errors.ErrCodeValidationError,
+		},
+		{
+			name:         "interval mode missing y",
+			req:          &models.FunctionRequest{Function: "log", Mode: "interval", TextX: "8 ± 1"},
+			expectError:  true,
+			expectedCode: errors.ErrCodeValidationError,
+		},
+		{
+			name:         "uncertain decimal places for round",
+			req:          &models.FunctionRequest{Function: "round", Mode: "uncertainty", TextX: "2.5", TextY: "1 ± 1"},
+			expectError:  true,
+			expectedCode: errors.ErrCodeValidationError,
+		},
+		{
+			name:         "invalid angle mode",
+			req:          &models.FunctionRequest{Function: "cos", X: 1, AngleMode: "gradians"},
+			expectError:  true,
+			expectedCode: errors.ErrCodeValidationError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateFunctionRequest(tt.req)
+
+			if (err != nil) != tt.expectError {
+				t.Errorf("ValidateFunctionRequest() error = %v, expectError %v", err, tt.expectError)
+				return
+			}
+
+			if tt.expectError && err.Code != tt.expectedCode {
+				t.Errorf("ValidateFunctionRequest() error code = %v, expected %v", err.Code, tt.expectedCode)
+			}
+		})
+	}
+}
+
+func TestValidateStreamedCount(t *testing.T) {
+	tests := []struct {
+		name        string
+		count       int
+		expectError bool
+	}{
+		{"none read", 0, true},
+		{"within limit", 3, false},
+		{"at limit", 10, false},
+		{"past limit", 11, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateStreamedCount("values", tt.count, 10)
+			if (err != nil) != tt.expectError {
+				t.Errorf("ValidateStreamedCount(%d) error = %v, expectError %v", tt.count, err, tt.expectError)
+			}
+			if err != nil && err.Code != errors.ErrCodeValidationError {
+				t.Errorf("ValidateStreamedCount(%d) code = %s, want %s", tt.count, err.Code, errors.ErrCodeValidationError)
+			}
+		})
+	}
+}
+
+func TestValidateStatisticsRequest(t *testing.T) {
+	tests := []struct {
+		name         string
+		req          *models.StatisticsRequest
+		maxValues    int
+		expectError  bool
+		expectedCode string
+	}{
+		{
+			name:        "valid values",
+			req:         &models.StatisticsRequest{Values: []float64{1, 2, 3}},
+			maxValues:   10,
+			expectError: false,
+		},
+		{
+			name: "valid with weights and percentiles",
+			req: &models.StatisticsRequest{
+				Values:      []float64{1, 2, 3},
+				Weights:     []float64{0, 1, 2},
+				Percentiles: []float64{0, 50, 100},
+			},
+			maxValues:   10,
+			expectError: false,
+		},
+		{
+			name:         "nil request",
+			req:          nil,
+			maxValues:    10,
+			expectError:  true,
+			expectedCode: errors.ErrCodeInvalidInput,
+		},
+		{
+			name:         "empty values",
+			req:          &models.StatisticsRequest{Values: []float64{}},
+			maxValues:    10,
+			expectError:  true,
+			expectedCode: errors.ErrCodeValidationError,
+		},
+		{
+			name:         "too many values",
+			req:          &models.StatisticsRequest{Values: []float64{1, 2, 3}},
+			maxValues:    2,
+			expectError:  true,
+			expectedCode: errors.ErrCodeValidationError,
+		},
+		{
+			name:         "NaN value",
+			req:          &models.StatisticsRequest{Values: []float64{1, math.NaN()}},
+			maxValues:    10,
+			expectError:  true,
+			expectedCode: errors.ErrCodeValidationError,
+		},
+		{
+			name:         "weights length mismatch",
+			req:          &models.StatisticsRequest{Values: []float64{1, 2}, Weights: []float64{1}},
+			maxValues:    10,
+			expectError:  true,
+			expectedCode: errors.ErrCodeValidationError,
+		},
+		{
+			name:         "negative weight",
+			req:          &models.StatisticsRequest{Values: []float64{1, 2}, Weights: []float64{1, -1}},
+			maxValues:    10,
+			expectError:  true,
+			expectedCode: errors.ErrCodeValidationError,
+		},
+		{
+			name:         "all zero weights",
+			req:          &models.StatisticsRequest{Values: []float64{1, 2}, Weights: []float64{0, 0}},
+			maxValues:    10,
+			expectError:  true,
+			expectedCode: errors.ErrCodeValidationError,
+		},
+		{
+			name:         "percentile out of range",
+			req:          &models.StatisticsRequest{Values: []float64{1, 2}, Percentiles: []float64{150}},
+			maxValues:    10,
+			expectError:  true,
+			expectedCode: errors.ErrCodeValidationError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateStatisticsRequest(tt.req, tt.maxValues)
+
+			if (err != nil) != tt.expectError {
+				t.Errorf("ValidateStatisticsRequest() error = %v, expectError %v", err, tt.expectError)
+				return
+			}
+
+			if tt.expectError && err.Code != tt.expectedCode {
+				t.Errorf("ValidateStatisticsRequest() error code = %v, expected %v", err.Code, tt.expectedCode)
+			}
+		})
+	}
+}
+
+func TestValidateRegressionRequest(t *testing.T) {
+	points := []models.Point{{X: 1, Y: 2}, {X: 2, Y: 3}, {X: 3, Y: 5}}
+
+	tests := []struct {
+		name         string
+		req          *models.RegressionRequest
+		expectError  bool
+		expectedCode string
+	}{
+		{
+			name:        "valid linear",
+			req:         &models.RegressionRequest{Points: points, Model: "linear", Predict: []float64{4}},
+			expectError: false,
+		},
+		{
+			name:        "valid polynomial",
+			req:         &models.RegressionRequest{Points: points, Model: "Polynomial", Degree: 2},
+			expectError: false,
+		},
+		{
+			name:         "nil request",
+			req:          nil,
+			expectError:  true,
+			expectedCode: errors.ErrCodeInvalidInput,
+		},
+		{
+			name:         "unknown model",
+			req:          &models.RegressionRequest{Points: points, Model: "spline"},
+			expectError:  true,
+			expectedCode: errors.ErrCodeValidationError,
+		},
+		{
+			name:         "missing polynomial degree",
+			req:          &models.RegressionRequest{Points: points, Model: "polynomial"},
+			expectError:  true,
+			expectedCode: errors.ErrCodeValidationError,
+		},
+		{
+			name:         "too few points for degree",
+			req:          &models.RegressionRequest{Points: points, Model: "polynomial", Degree: 3},
+			expectError:  true,
+			expectedCode: errors.ErrCodeValidationError,
+		},
+		{
+			name:         "too many points",
+			req:          &models.RegressionRequest{Points: append(points, points...), Model: "linear"},
+			expectError:  true,
+			expectedCode: errors.ErrCodeValidationError,
+		},
+		{
+			name:         "NaN point",
+			req:          &models.RegressionRequest{Points: []models.Point{{X: 1, Y: 1}, {X: math.NaN(), Y: 2}}, Model: "linear"},
+			expectError:  true,
+			expectedCode: errors.ErrCodeValidationError,
+		},
+		{
+			name:         "non-positive prediction for power model",
+			req:          &models.RegressionRequest{Points: points, Model: "power", Predict: []float64{0}},
+			expectError:  true,
+			expectedCode: errors.ErrCodeValidationError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateRegressionRequest(tt.req, 5)
+
+			if (err != nil) != tt.expectError {
+				t.Errorf("ValidateRegressionRequest() error = %v, expectError %v", err, tt.expectError)
+				return
+			}
+
+			if tt.expectError && err.Code != tt.expectedCode {
+				t.Errorf("ValidateRegressionRequest() error code = %v, expected %v", err.Code, tt.expectedCode)
+			}
+		})
+	}
+}
+
+func TestValidateMatrix(t *testing.T) {
+	tests := []struct {
+		name         string
+		m            [][]float64
+		expectError  bool
+		expectedCode string
+		expectedRows []int
+	}{
+		{
+			name:        "valid matrix",
+			m:           [][]float64{{1, 2}, {3, 4}},
+			expectError: false,
+		},
+		{
+			name:         "empty matrix",
+			m:            [][]float64{},
+			expectError:  true,
+			expectedCode: errors.ErrCodeValidationError,
+		},
+		{
+			name:         "too many cells",
+			m:            [][]float64{{1, 2, 3}, {4, 5, 6}},
+			expectError:  true,
+			expectedCode: errors.ErrCodeValidationError,
+		},
+		{
+			name:         "ragged rows reported individually",
+			m:            [][]float64{{1, 2}, {3}, {4, 5}, {6, 7, 8}},
+			expectError:  true,
+			expectedCode: errors.ErrCodeValidationError,
+			expectedRows: []int{1, 3},
+		},
+		{
+			name:         "NaN entry",
+			m:            [][]float64{{1, 2}, {math.NaN(), 4}},
+			expectError:  true,
+			expectedCode: errors.ErrCodeValidationError,
+			expectedRows: []int{1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			maxCells := 5
+			if tt.expectedRows != nil {
+				maxCells = 100
+			}
+			err := ValidateMatrix("a", tt.m, maxCells)
+
+			if (err != nil) != tt.expectError {
+				t.Fatalf("ValidateMatrix() error = %v, expectError %v", err, tt.expectError)
+			}
+			if !tt.expectError {
+				return
+			}
+			if err.Code != tt.expectedCode {
+				t.Errorf("ValidateMatrix() error code = %v, expected %v", err.Code, tt.expectedCode)
+			}
+			if tt.expectedRows == nil {
+				return
+			}
+			rowErrors, ok := err.Meta["row_errors"].([]RowError)
+			if !ok {
+				t.Fatalf("expected row_errors meta, got %v", err.Meta)
+			}
+			if len(rowErrors) != len(tt.expectedRows) {
+				t.Fatalf("row_errors = %v, want rows %v", rowErrors, tt.expectedRows)
+			}
+			for i, row := range tt.expectedRows {
+				if rowErrors[i].Row != row {
+					t.Errorf("row_errors[%d].Row = %d, want %d", i, rowErrors[i].Row, row)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateLinearSystemRequest(t *testing.T) {
+	tests := []struct {
+		name         string
+		req          *models.LinearSystemRequest
+		expectError  bool
+		expectedCode string
+	}{
+		{
+			name:        "valid system",
+			req:         &models.LinearSystemRequest{A: [][]float64{{2, 1}, {1, 3}}, B: []float64{3, 5}},
+			expectError: false,
+		},
+		{
+			name:         "nil request",
+			req:          nil,
+			expectError:  true,
+			expectedCode: errors.ErrCodeInvalidInput,
+		},
+		{
+			name:         "non-square matrix",
+			req:          &models.LinearSystemRequest{A: [][]float64{{1, 2, 3}, {4, 5, 6}}, B: []float64{1, 2}},
+			expectError:  true,
+			expectedCode: errors.ErrCodeValidationError,
+		},
+		{
+			name:         "rhs length mismatch",
+			req:          &models.LinearSystemRequest{A: [][]float64{{1, 0}, {0, 1}}, B: []float64{1}},
+			expectError:  true,
+			expectedCode: errors.ErrCodeValidationError,
+		},
+		{
+			name:         "NaN in rhs",
+			req:          &models.LinearSystemRequest{A: [][]float64{{1, 0}, {0, 1}}, B: []float64{1, math.NaN()}},
+			expectError:  true,
+			expectedCode: errors.ErrCodeValidationError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateLinearSystemRequest(tt.req, 100)
+
+			if (err != nil) != tt.expectError {
+				t.Errorf("ValidateLinearSystemRequest() error = %v, expectError %v", err, tt.expectError)
+				return
+			}
+
+			if tt.expectError && err.Code != tt.expectedCode {
+				t.Errorf("ValidateLinearSystemRequest() error code = %v, expected %v", err.Code, tt.expectedCode)
+			}
+		})
+	}
+}
+
+func intPtr(v int) *int {
+	return &v
+}
+
+func TestValidateComplexRequest(t *testing.T) {
+	tests := []struct {
+		name         string
+		req          *models.ComplexRequest
+		expectError  bool
+		expectedCode string
+	}{
+		{
+			name:        "valid binary operation",
+			req:         &models.ComplexRequest{Operation: "mul", A: &models.Complex{Re: 1, Im: 2}, B: &models.Complex{Re: 3}},
+			expectError: false,
+		},
+		{
+			name:        "valid rect",
+			req:         &models.ComplexRequest{Operation: "rect", Polar: &models.PolarForm{Modulus: 2, Argument: 90}, AngleMode: "degrees"},
+			expectError: false,
+		},
+		{
+			name:        "valid roots",
+			req:         &models.ComplexRequest{Operation: "roots", A: &models.Complex{Re: -8}, N: intPtr(3)},
+			expectError: false,
+		},
+		{
+			name:         "nil request",
+			req:          nil,
+			expectError:  true,
+			expectedCode: errors.ErrCodeInvalidInput,
+		},
+		{
+			name:         "unknown operation",
+			req:          &models.ComplexRequest{Operation: "sqrt", A: &models.Complex{Re: 1}},
+			expectError:  true,
+			expectedCode: errors.ErrCodeValidationError,
+		},
+		{
+			name:         "missing a",
+			req:          &models.ComplexRequest{Operation: "conjugate"},
+			expectError:  true,
+			expectedCode: errors.ErrCodeValidationError,
+		},
+		{
+			name:         "missing b for pow",
+			req:          &models.ComplexRequest{Operation: "pow", A: &models.Complex{Re: 1}},
+			expectError:  true,
+			expectedCode: errors.ErrCodeValidationError,
+		},
+		{
+			name:         "NaN component",
+			req:          &models.ComplexRequest{Operation: "exp", A: &models.Complex{Re: 1, Im: math.NaN()}},
+			expectError:  true,
+			expectedCode: errors.ErrCodeValidationError,
+		},
+		{
+			name:         "division by zero",
+			req:          &models.ComplexRequest{Operation: "div", A: &models.Complex{Re: 1}, B: &models.Complex{}},
+			expectError:  true,
+			expectedCode: errors.ErrCodeDivisionByZero,
+		},
+		{
+			name:         "negative polar modulus",
+			req:          &models.ComplexRequest{Operation: "rect", Polar: &models.PolarForm{Modulus: -1}},
+			expectError:  true,
+			expectedCode: errors.ErrCodeValidationError,
+		},
+		{
+			name:         "missing n for roots",
+			req:          &models.ComplexRequest{Operation: "roots", A: &models.Complex{Re: 1}},
+			expectError:  true,
+			expectedCode: errors.ErrCodeValidationError,
+		},
+		{
+			name:         "zero n for roots",
+			req:          &models.ComplexRequest{Operation: "roots", A: &models.Complex{Re: 1}, N: intPtr(0)},
+			expectError:  true,
+			expectedCode: errors.ErrCodeValidationError,
+		},
+		{
+			name:         "invalid angle mode",
+			req:          &models.ComplexRequest{Operation: "arg", A: &models.Complex{Re: 1}, AngleMode: "gradians"},
+			expectError:  true,
+			expectedCode: errors.ErrCodeValidationError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateComplexRequest(tt.req)
+
+			if (err != nil) != tt.expectError {
+				t.Errorf("ValidateComplexRequest() error = %v, expectError %v", err, tt.expectError)
+				return
+			}
+
+			if tt.expectError && err.Code != tt.expectedCode {
+				t.Errorf("ValidateComplexRequest() error code = %v, expected %v", err.Code, tt.expectedCode)
+			}
+		})
+	}
+}
+
+func TestValidateIntegerListRequest(t *testing.T) {
+	tests := []struct {
+		name         string
+		req          *models.IntegerListRequest
+		expectError  bool
+		expectedCode string
+	}{
+		{
+			name:        "valid big integers",
+			req:         &models.IntegerListRequest{Values: []string{"12", "-123456789012345678901234567890"}},
+			expectError: false,
+		},
+		{
+			name:         "nil request",
+			req:          nil,
+			expectError:  true,
+			expectedCode: errors.ErrCodeInvalidInput,
+		},
+		{
+			name:         "empty list",
+			req:          &models.IntegerListRequest{},
+			expectError:  true,
+			expectedCode: errors.ErrCodeValidationError,
+		},
+		{
+			name:         "too many values",
+			req:          &models.IntegerListRequest{Values: []string{"1", "2", "3", "4"}},
+			expectError:  true,
+			expectedCode: errors.ErrCodeValidationError,
+		},
+		{
+			name:         "non-integer value",
+			req:          &models.IntegerListRequest{Values: []string{"12", "1.5"}},
+			expectError:  true,
+			expectedCode: errors.ErrCodeValidationError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateIntegerListRequest(tt.req, 3)
+
+			if (err != nil) != tt.expectError {
+				t.Errorf("ValidateIntegerListRequest() error = %v, expectError %v", err, tt.expectError)
+				return
+			}
+
+			if tt.expectError && err.Code != tt.expectedCode {
+				t.Errorf("ValidateIntegerListRequest() error code = %v, expected %v", err.Code, tt.expectedCode)
+			}
+		})
+	}
+}
+
+func TestValidateIntegerRequest(t *testing.T) {
+	tests := []struct {
+		name        string
+		req         *models.IntegerRequest
+		minimum     int64
+		expectError bool
+	}{
+		{"valid", &models.IntegerRequest{N: "360"}, 1, false},
+		{"negative allowed", &models.IntegerRequest{N: "-7"}, math.MinInt64, false},
+		{"below minimum", &models.IntegerRequest{N: "0"}, 1, true},
+		{"empty", &models.IntegerRequest{}, 1, true},
+		{"not an integer", &models.IntegerRequest{N: "ten"}, 1, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateIntegerRequest(tt.req, tt.minimum)
+			if (err != nil) != tt.expectError {
+				t.Errorf("ValidateIntegerRequest() error = %v, expectError %v", err, tt.expectError)
+			}
+		})
+	}
+}
+
+func TestValidateModularRequests(t *testing.T) {
+	if err := ValidateModPowRequest(&models.ModPowRequest{Base: "4", Exponent: "-13", Modulus: "497"}); err != nil {
+		t.Errorf("ValidateModPowRequest() unexpected error: %v", err)
+	}
+	if err := ValidateModPowRequest(&models.ModPowRequest{Base: "4", Exponent: "13", Modulus: "0"}); err == nil {
+		t.Errorf("ValidateModPowRequest() expected error for zero modulus")
+	}
+	if err := ValidateModPowRequest(&models.ModPowRequest{Base: "4", Modulus: "7"}); err == nil {
+		t.Errorf("ValidateModPowRequest() expected error for missing exponent")
+	}
+	if err := ValidateModInverseRequest(&models.ModInverseRequest{A: "3", Modulus: "11"}); err != nil {
+		t.Errorf("ValidateModInverseRequest() unexpected error: %v", err)
+	}
+	if err := ValidateModInverseRequest(&models.ModInverseRequest{A: "3", Modulus: "-11"}); err == nil {
+		t.Errorf("ValidateModInverseRequest() expected error for negative modulus")
+	}
+}
+
+func TestValidatePrimeRangeRequest(t *testing.T) {
+	tests := []struct {
+		name        string
+		req         *models.PrimeRangeRequest
+		expectError bool
+	}{
+		{"valid", &models.PrimeRangeRequest{From: 0, To: 100}, false},
+		{"single number", &models.PrimeRangeRequest{From: 7, To: 7}, false},
+		{"reversed", &models.PrimeRangeRequest{From: 10, To: 5}, true},
+		{"beyond limit", &models.PrimeRangeRequest{From: 2_000_000_000_000, To: 2_000_000_000_001}, true},
+		{"span too wide", &models.PrimeRangeRequest{From: 0, To: 5_000_000}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidatePrimeRangeRequest(tt.req)
+			if (err != nil) != tt.expectError {
+				t.Errorf("ValidatePrimeRangeRequest() error = %v, expectError %v", err, tt.expectError)
+			}
+		})
+	}
+}
+
+func TestValidateBaseConvertRequest(t *testing.T) {
+	tests := []struct {
+		name        string
+		req         *models.BaseConvertRequest
+		expectError bool
+	}{
+		{"valid", &models.BaseConvertRequest{Value: "ff.8", FromBase: "16", ToBase: "2"}, false},
+		{"default bases", &models.BaseConvertRequest{Value: "42"}, false},
+		{"roman input", &models.BaseConvertRequest{Value: "XIV", FromBase: "roman"}, false},
+		{"missing value", &models.BaseConvertRequest{FromBase: "16"}, true},
+		{"invalid from_base", &models.BaseConvertRequest{Value: "1", FromBase: "40"}, true},
+		{"invalid to_base", &models.BaseConvertRequest{Value: "1", ToBase: "octal"}, true},
+		{"invalid digit", &models.BaseConvertRequest{Value: "129", FromBase: "8"}, true},
+		{"invalid roman", &models.BaseConvertRequest{Value: "IIII", FromBase: "roman"}, true},
+		{"bit width too large", &models.BaseConvertRequest{Value: "1", BitWidth: 256}, true},
+		{"negative precision", &models.BaseConvertRequest{Value: "1", Precision: intPtr(-1)}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateBaseConvertRequest(tt.req)
+			if (err != nil) != tt.expectError {
+				t.Errorf("ValidateBaseConvertRequest() error = %v, expectError %v", err, tt.expectError)
+			}
+		})
+	}
+}
+
+func TestValidateBitwiseRequest(t *testing.T) {
+	tests := []struct {
+		name        string
+		req         *models.BitwiseRequest
+		expectError bool
+	}{
+		{"valid and", &models.BitwiseRequest{Operation: "and", A: "0xff", B: "0b1"}, false},
+		{"valid shift", &models.BitwiseRequest{Operation: "shl", Type: "uint8", A: "1", N: intPtr(8)}, false},
+		{"valid mask", &models.BitwiseRequest{Operation: "mask", Type: "uint16", N: intPtr(4), Offset: 12}, false},
+		{"invalid operation", &models.BitwiseRequest{Operation: "nand", A: "1", B: "1"}, true},
+		{"invalid type", &models.BitwiseRequest{Operation: "not", Type: "int128", A: "1"}, true},
+		{"invalid overflow", &models.BitwiseRequest{Operation: "not", Overflow: "clamp", A: "1"}, true},
+		{"missing a", &models.BitwiseRequest{Operation: "not"}, true},
+		{"missing b", &models.BitwiseRequest{Operation: "or", A: "1"}, true},
+		{"invalid literal", &models.BitwiseRequest{Operation: "not", A: "0b12"}, true},
+		{"missing n", &models.BitwiseRequest{Operation: "rotl", A: "1"}, true},
+		{"n beyond width", &models.BitwiseRequest{Operation: "shr", Type: "int8", A: "1", N: intPtr(9)}, true},
+		{"mask beyond width", &models.BitwiseRequest{Operation: "mask", Type: "uint8", N: intPtr(4), Offset: 6}, true},
+		{"negative offset", &models.BitwiseRequest{Operation: "mask", N: intPtr(4), Offset: -1}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateBitwiseRequest(tt.req)
+			if (err != nil) != tt.expectError {
+				t.Errorf("ValidateBitwiseRequest() error = %v, expectError %v", err, tt.expectError)
+			}
+		})
+	}
+}
+
+func TestValidateIntegralRequest(t *testing.T) {
+	tests := []struct {
+		name        string
+		req         *models.IntegralRequest
+		expectError bool
+	}{
+		{"valid", &models.IntegralRequest{Expression: "x^2", Lower: floatPtr(0), Upper: floatPtr(1)}, false},
+		{"valid simpson", &models.IntegralRequest{Expression: "sin(x)", Lower: floatPtr(0), Upper: floatPtr(1), Method: "simpson", Tolerance: floatPtr(1e-6), MaxIterations: intPtr(50)}, false},
+		{"empty expression", &models.IntegralRequest{Lower: floatPtr(0), Upper: floatPtr(1)}, true},
+		{"unknown variable", &models.IntegralRequest{Expression: "y^2", Lower: floatPtr(0), Upper: floatPtr(1)}, true},
+		{"invalid method", &models.IntegralRequest{Expression: "x", Lower: floatPtr(0), Upper: floatPtr(1), Method: "trapezoid"}, true},
+		{"missing lower", &models.IntegralRequest{Expression: "x", Upper: floatPtr(1)}, true},
+		{"infinite upper", &models.IntegralRequest{Expression: "x", Lower: floatPtr(0), Upper: floatPtr(math.Inf(1))}, true},
+		{"zero tolerance", &models.IntegralRequest{Expression: "x", Lower: floatPtr(0), Upper: floatPtr(1), Tolerance: floatPtr(0)}, true},
+		{"too many iterations", &models.IntegralRequest{Expression: "x", Lower: floatPtr(0), Upper: floatPtr(1), MaxIterations: intPtr(10001)}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateIntegralRequest(tt.req)
+			if (err != nil) != tt.expectError {
+				t.Errorf("ValidateIntegralRequest() error = %v, expectError %v", err, tt.expectError)
+			}
+		})
+	}
+}
+
+func TestValidateDerivativeRequest(t *testing.T) {
+	tests := []struct {
+		name        string
+		req         *models.DerivativeRequest
+		expectError bool
+	}{
+		{"valid", &models.DerivativeRequest{Expression: "x^2", X: floatPtr(1)}, false},
+		{"valid second order", &models.DerivativeRequest{Expression: "x^2", X: floatPtr(1), Order: 2, MaxIterations: intPtr(30)}, false},
+		{"missing x", &models.DerivativeRequest{Expression: "x^2"}, true},
+		{"order too high", &models.DerivativeRequest{Expression: "x^2", X: floatPtr(1), Order: 3}, true},
+		{"negative order", &models.DerivativeRequest{Expression: "x^2", X: floatPtr(1), Order: -1}, true},
+		{"too many iterations", &models.DerivativeRequest{Expression: "x^2", X: floatPtr(1), MaxIterations: intPtr(31)}, true},
+		{"syntax error", &models.DerivativeRequest{Expression: "sin(x", X: floatPtr(1)}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateDerivativeRequest(tt.req)
+			if (err != nil) != tt.expectError {
+				t.Errorf("ValidateDerivativeRequest() error = %v, expectError %v", err, tt.expectError)
+			}
+		})
+	}
+}
+
+func TestValidateRootRequest(t *testing.T) {
+	tests := []struct {
+		name        string
+		req         *models.RootRequest
+		expectError bool
+	}{
+		{"valid brent", &models.RootRequest{Expression: "x - 1", Lower: floatPtr(0), Upper: floatPtr(2)}, false},
+		{"valid newton", &models.RootRequest{Expression: "x - 1", Method: "newton", X0: floatPtr(0)}, false},
+		{"bisection missing upper", &models.RootRequest{Expression: "x - 1", Method: "bisection", Lower: floatPtr(0)}, true},
+		{"newton missing x0", &models.RootRequest{Expression: "x - 1", Method: "newton", Lower: floatPtr(0), Upper: floatPtr(2)}, true},
+		{"invalid method", &models.RootRequest{Expression: "x - 1", Method: "secant", X0: floatPtr(0)}, true},
+		{"tolerance above 1", &models.RootRequest{Expression: "x - 1", Lower: floatPtr(0), Upper: floatPtr(2), Tolerance: floatPtr(2)}, true},
+		{"zero iterations", &models.RootRequest{Expression: "x - 1", Lower: floatPtr(0), Upper: floatPtr(2), MaxIterations: intPtr(0)}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateRootRequest(tt.req)
+			if (err != nil) != tt.expectError {
+				t.Errorf("ValidateRootRequest() error = %v, expectError %v", err, tt.expectError)
+			}
+		})
+	}
+}
+
+func TestValidatePolynomialRootsRequest(t *testing.T) {
+	tests := []struct {
+		name        string
+		req         *models.PolynomialRootsRequest
+		expectError bool
+	}{
+		{"valid coefficients", &models.PolynomialRootsRequest{Coefficients: []models.Coefficient{"1", "-3", "2"}}, false},
+		{"fraction coefficients", &models.PolynomialRootsRequest{Coefficients: []models.Coefficient{"1/2", "0.25", "-1"}}, false},
+		{"leading zeros", &models.PolynomialRootsRequest{Coefficients: []models.Coefficient{"0", "2", "1"}}, false},
+		{"valid expression", &models.PolynomialRootsRequest{Expression: "x^2 = 2"}, false},
+		{"nil request", nil, true},
+		{"neither", &models.PolynomialRootsRequest{}, true},
+		{"both", &models.PolynomialRootsRequest{Coefficients: []models.Coefficient{"1", "1"}, Expression: "x + 1"}, true},
+		{"constant coefficients", &models.PolynomialRootsRequest{Coefficients: []models.Coefficient{"0", "5"}}, true},
+		{"invalid coefficient", &models.PolynomialRootsRequest{Coefficients: []models.Coefficient{"1", "abc"}}, true},
+		{"too many coefficients", &models.PolynomialRootsRequest{Coefficients: make([]models.Coefficient, 102)}, true},
+		{"constant expression", &models.PolynomialRootsRequest{Expression: "2 = 3"}, true},
+		{"two variables", &models.PolynomialRootsRequest{Expression: "x + y"}, true},
+		{"not a polynomial", &models.PolynomialRootsRequest{Expression: "sin(x)"}, true},
+		{"largest coefficients", &models.PolynomialRootsRequest{Coefficients: []models.Coefficient{"1e300", "1e-300"}}, false},
+		{"coefficient too large", &models.PolynomialRootsRequest{Expression: "x + (10^100)^20"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidatePolynomialRootsRequest(tt.req)
+			if (err != nil) != tt.expectError {
+				t.Errorf("ValidatePolynomialRootsRequest() error = %v, expectError %v", err, tt.expectError)
+			}
+		})
+	}
+}
+
+func TestValidateEquationsRequest(t *testing.T) {
+	tests := []struct {
+		name        string
+		req         *models.EquationsRequest
+		expectError bool
+	}{
+		{"valid system", &models.EquationsRequest{Equations: []string{"2x + 3y = 5", "x - y = 1"}}, false},
+		{"inconsistent is valid", &models.EquationsRequest{Equations: []string{"x = 1", "x = 2"}}, false},
+		{"nil request", nil, true},
+		{"no equations", &models.EquationsRequest{}, true},
+		{"missing equals", &models.EquationsRequest{Equations: []string{"x + y"}}, true},
+		{"no variables", &models.EquationsRequest{Equations: []string{"1 = 1"}}, true},
+		{"nonlinear", &models.EquationsRequest{Equations: []string{"x*y = 1"}}, true},
+		{"syntax error", &models.EquationsRequest{Equations: []string{"x + = 1"}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateEquationsRequest(tt.req)
+			if (err != nil) != tt.expectError {
+				t.Errorf("ValidateEquationsRequest() error = %v, expectError %v", err, tt.expectError)
+			}
+		})
+	}
+}
+
+func TestValidateVectorRequest(t *testing.T) {
+	tests := []struct {
+		name        string
+		req         *models.VectorRequest
+		needsB      bool
+		expectError bool
+	}{
+		{"valid pair", &models.VectorRequest{A: []float64{1, 2, 3}, B: []float64{4, 5, 6}}, true, false},
+		{"norm without b", &models.VectorRequest{A: []float64{3, 4}}, false, false},
+		{"with units", &models.VectorRequest{A: []float64{3, 4}, LengthUnits: models.LengthUnits{Unit: "km", OutputUnit: "mi"}}, false, false},
+		{"nil request", nil, false, true},
+		{"missing b", &models.VectorRequest{A: []float64{1, 2}}, true, true},
+		{"one component", &models.VectorRequest{A: []float64{1}}, false, true},
+		{"four components", &models.VectorRequest{A: []float64{1, 2, 3, 4}}, false, true},
+		{"mismatched lengths", &models.VectorRequest{A: []float64{1, 2}, B: []float64{1, 2, 3}}, true, true},
+		{"NaN component", &models.VectorRequest{A: []float64{math.NaN(), 2}}, false, true},
+		{"invalid angle mode", &models.VectorRequest{A: []float64{1, 2}, AngleMode: "gradians"}, false, true},
+		{"invalid unit", &models.VectorRequest{A: []float64{1, 2}, LengthUnits: models.LengthUnits{Unit: "kg"}}, false, true},
+		{"output unit without unit", &models.VectorRequest{A: []float64{1, 2}, LengthUnits: models.LengthUnits{OutputUnit: "m"}}, false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateVectorRequest(tt.req, tt.needsB)
+			if (err != nil) != tt.expectError {
+				t.Errorf("ValidateVectorRequest() error = %v, expectError %v", err, tt.expectError)
+			}
+		})
+	}
+}
+
+func TestValidatePolygonRequest(t *testing.T) {
+	tests := []struct {
+		name        string
+		req         *models.PolygonRequest
+		expectError bool
+	}{
+		{"valid triangle", &models.PolygonRequest{Points: [][]float64{{0, 0}, {1, 0}, {0, 1}}}, false},
+		{"nil request", nil, true},
+		{"two points", &models.PolygonRequest{Points: [][]float64{{0, 0}, {1, 0}}}, true},
+		{"too many points", &models.PolygonRequest{Points: make([][]float64, 11)}, true},
+		{"3D point", &models.PolygonRequest{Points: [][]float64{{0, 0}, {1, 0}, {0, 1, 0}}}, true},
+		{"infinite coordinate", &models.PolygonRequest{Points: [][]float64{{0, 0}, {math.Inf(1), 0}, {0, 1}}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidatePolygonRequest(tt.req, 10)
+			if (err != nil) != tt.expectError {
+				t.Errorf("ValidatePolygonRequest() error = %v, expectError %v", err, tt.expectError)
+			}
+		})
+	}
+}
+
+func TestValidateCircleRequest(t *testing.T) {
+	tests := []struct {
+		name        string
+		req         *models.CircleRequest
+		expectError bool
+	}{
+		{"valid", &models.CircleRequest{Radius: floatPtr(2)}, false},
+		{"nil request", nil, true},
+		{"missing radius", &models.CircleRequest{}, true},
+		{"zero radius", &models.CircleRequest{Radius: floatPtr(0)}, true},
+		{"invalid output unit", &models.CircleRequest{Radius: floatPtr(1), LengthUnits: models.LengthUnits{Unit: "m", OutputUnit: "furlong"}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateCircleRequest(tt.req)
+			if (err != nil) != tt.expectError {
+				t.Errorf("ValidateCircleRequest() error = %v, expectError %v", err, tt.expectError)
+			}
+		})
+	}
+}
+
+func TestValidateTriangleRequest(t *testing.T) {
+	tests := []struct {
+		name        string
+		req         *models.TriangleRequest
+		expectError bool
+	}{
+		{"valid sss", &models.TriangleRequest{Method: "sss", A: floatPtr(3), B: floatPtr(4), C: floatPtr(5)}, false},
+		{"valid sas in degrees", &models.TriangleRequest{Method: "SAS", A: floatPtr(3), B: floatPtr(4), Gamma: floatPtr(90), AngleMode: "degrees"}, false},
+		{"valid asa", &models.TriangleRequest{Method: "asa", Alpha: floatPtr(0.5), C: floatPtr(2), Beta: floatPtr(1)}, false},
+		{"nil request", nil, true},
+		{"unknown method", &models.TriangleRequest{Method: "ssa"}, true},
+		{"missing side", &models.TriangleRequest{Method: "sss", A: floatPtr(3), B: floatPtr(4)}, true},
+		{"negative side", &models.TriangleRequest{Method: "sss", A: floatPtr(3), B: floatPtr(-4), C: floatPtr(5)}, true},
+		{"missing angle", &models.TriangleRequest{Method: "sas", A: floatPtr(3), B: floatPtr(4)}, true},
+		{"straight angle in degrees", &models.TriangleRequest{Method: "sas", A: floatPtr(3), B: floatPtr(4), Gamma: floatPtr(180), AngleMode: "degrees"}, true},
+		{"angle above π", &models.TriangleRequest{Method: "asa", Alpha: floatPtr(4), C: floatPtr(2), Beta: floatPtr(1)}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateTriangleRequest(tt.req)
+			if (err != nil) != tt.expectError {
+				t.Errorf("ValidateTriangleRequest() error = %v, expectError %v", err, tt.expectError)
+			}
+		})
+	}
+}
+
+func TestValidateSolidRequest(t *testing.T) {
+	tests := []struct {
+		name        string
+		req         *models.SolidRequest
+		expectError bool
+	}{
+		{"valid sphere", &models.SolidRequest{Solid: "sphere", Radius: floatPtr(1)}, false},
+		{"valid cuboid", &models.SolidRequest{Solid: "Cuboid", Length: floatPtr(1), Width: floatPtr(2), Height: floatPtr(3)}, false},
+		{"nil request", nil, true},
+		{"unknown solid", &models.SolidRequest{Solid: "prism"}, true},
+		{"missing height", &models.SolidRequest{Solid: "cone", Radius: floatPtr(1)}, true},
+		{"zero side", &models.SolidRequest{Solid: "cube", Side: floatPtr(0)}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSolidRequest(tt.req)
+			if (err != nil) != tt.expectError {
+				t.Errorf("ValidateSolidRequest() error = %v, expectError %v", err, tt.expectError)
+			}
+		})
+	}
+}
+
+func TestValidateGeoDistanceRequest(t *testing.T) {
+	london := &models.GeoPoint{Lat: 51.5074, Lon: -0.1278}
+	paris := &models.GeoPoint{Lat: 48.8566, Lon: 2.3522}
+
+	tests := []struct {
+		name        string
+		req         *models.GeoDistanceRequest
+		expectError bool
+	}{
+		{"valid distance", &models.GeoDistanceRequest{From: london, To: paris, Unit: "km"}, false},
+		{"valid destination", &models.GeoDistanceRequest{From: london, Bearing: floatPtr(-45), Distance: floatPtr(0)}, false},
+		{"valid pairs", &models.GeoDistanceRequest{Pairs: []models.GeoPair{{From: *london, To: *paris}}}, false},
+		{"nil request", nil, true},
+		{"missing from", &models.GeoDistanceRequest{To: paris}, true},
+		{"missing to and bearing", &models.GeoDistanceRequest{From: london}, true},
+		{"bearing without distance", &models.GeoDistanceRequest{From: london, Bearing: floatPtr(90)}, true},
+		{"to and bearing", &models.GeoDistanceRequest{From: london, To: paris, Bearing: floatPtr(90), Distance: floatPtr(1)}, true},
+		{"latitude out of range", &models.GeoDistanceRequest{From: &models.GeoPoint{Lat: 91}, To: paris}, true},
+		{"longitude out of range", &models.GeoDistanceRequest{From: london, To: &models.GeoPoint{Lon: 181}}, true},
+		{"negative distance", &models.GeoDistanceRequest{From: london, Bearing: floatPtr(90), Distance: floatPtr(-1)}, true},
+		{"infinite bearing", &models.GeoDistanceRequest{From: london, Bearing: floatPtr(math.Inf(1)), Distance: floatPtr(1)}, true},
+		{"invalid unit", &models.GeoDistanceRequest{From: london, To: paris, Unit: "kg"}, true},
+		{"pairs with from", &models.GeoDistanceRequest{From: london, Pairs: []models.GeoPair{{From: *london, To: *paris}}}, true},
+		{"too many pairs", &models.GeoDistanceRequest{Pairs: make([]models.GeoPair, 3)}, true},
+		{"invalid pair", &models.GeoDistanceRequest{Pairs: []models.GeoPair{{From: *london, To: models.GeoPoint{Lat: -100}}}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateGeoDistanceRequest(tt.req, 2)
+			if (err != nil) != tt.expectError {
+				t.Errorf("ValidateGeoDistanceRequest() error = %v, expectError %v", err, tt.expectError)
+			}
+		})
+	}
+}
+
+func TestValidateDistributionRequest(t *testing.T) {
+	n := int64(10)
+
+	tests := []struct {
+		name         string
+		distribution string
+		req          *models.DistributionRequest
+		expectError  bool
+	}{
+		{"normal with defaults", "normal", &models.DistributionRequest{X: floatPtr(1.96)}, false},
+		{"t quantile", "T", &models.DistributionRequest{Probability: floatPtr(0.975), DF: floatPtr(10)}, false},
+		{"binomial", "binomial", &models.DistributionRequest{X: floatPtr(3), N: &n, P: floatPtr(0.5)}, false},
+		{"nil request", "normal", nil, true},
+		{"unknown distribution", "gamma", &models.DistributionRequest{X: floatPtr(1)}, true},
+		{"neither x nor probability", "normal", &models.DistributionRequest{}, true},
+		{"infinite x", "normal", &models.DistributionRequest{X: floatPtr(math.Inf(-1))}, true},
+		{"probability of 1", "normal", &models.DistributionRequest{Probability: floatPtr(1)}, true},
+		{"missing df", "chi2", &models.DistributionRequest{X: floatPtr(1)}, true},
+		{"missing df2", "f", &models.DistributionRequest{X: floatPtr(1), DF1: floatPtr(2)}, true},
+		{"missing n", "binomial", &models.DistributionRequest{X: floatPtr(1), P: floatPtr(0.5)}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateDistributionRequest(tt.distribution, tt.req)
+			if (err != nil) != tt.expectError {
+				t.Errorf("ValidateDistributionRequest() error = %v, expectError %v", err, tt.expectError)
+			}
+		})
+	}
+}
+
+func TestValidateHypothesisTestRequest(t *testing.T) {
+	sample := []float64{1, 2, 3}
+
+	tests := []struct {
+		name        string
+		test        string
+		req         *models.HypothesisTestRequest
+		expectError bool
+	}{
+		{"one-sample", "one-sample-t", &models.HypothesisTestRequest{X: sample, Mu: 1}, false},
+		{"welch", "Welch-T", &models.HypothesisTestRequest{X: sample, Y: []float64{4, 5}, Alternative: "less"}, false},
+		{"gof with uniform expected", "chi-square-gof", &models.HypothesisTestRequest{Observed: []float64{10, 12}}, false},
+		{"independence", "chi-square-independence", &models.HypothesisTestRequest{Table: [][]float64{{1, 2}, {3, 4}}}, false},
+		{"two proportions", "two-proportion-z", &models.HypothesisTestRequest{Successes: []int64{4, 6}, Trials: []int64{10, 10}}, false},
+		{"nil request", "one-sample-t", nil, true},
+		{"unknown test", "anova", &models.HypothesisTestRequest{X: sample}, true},
+		{"invalid alternative", "one-sample-t", &models.HypothesisTestRequest{X: sample, Alternative: "bigger"}, true},
+		{"confidence of 1", "one-sample-t", &models.HypothesisTestRequest{X: sample, Confidence: floatPtr(1)}, true},
+		{"single value", "one-sample-t", &models.HypothesisTestRequest{X: []float64{1}}, true},
+		{"too many values", "one-sample-t", &models.HypothesisTestRequest{X: []float64{1, 2, 3, 4, 5, 6}}, true},
+		{"NaN in sample", "one-sample-t", &models.HypothesisTestRequest{X: []float64{1, math.NaN()}}, true},
+		{"missing y", "two-sample-t", &models.HypothesisTestRequest{X: sample}, true},
+		{"unequal paired lengths", "paired-t", &models.HypothesisTestRequest{X: sample, Y: []float64{1, 2}}, true},
+		{"expected length mismatch", "chi-square-gof", &models.HypothesisTestRequest{Observed: sample, Expected: []float64{1, 2}}, true},
+		{"ragged table", "chi-square-independence", &models.HypothesisTestRequest{Table: [][]float64{{1, 2}, {3}}}, true},
+		{"table too large", "chi-square-independence", &models.HypothesisTestRequest{Table: [][]float64{{1, 2, 3}, {4, 5, 6}}}, true},
+		{"one proportion", "two-proportion-z", &models.HypothesisTestRequest{Successes: []int64{4}, Trials: []int64{10}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateHypothesisTestRequest(tt.test, tt.req, 5)
+			if (err != nil) != tt.expectError {
+				t.Errorf("ValidateHypothesisTestRequest() error = %v, expectError %v", err, tt.expectError)
+			}
+		})
+	}
+}
+
+func TestValidateConfidenceIntervalRequest(t *testing.T) {
+	successes, trials := int64(3), int64(10)
+
+	tests := []struct {
+		name        string
+		parameter   string
+		req         *models.ConfidenceIntervalRequest
+		expectError bool
+	}{
+		{"mean", "mean", &models.ConfidenceIntervalRequest{X: []float64{1, 2, 3}, Confidence: floatPtr(0.99)}, false},
+		{"proportion", "proportion", &models.ConfidenceIntervalRequest{Successes: &successes, Trials: &trials, Method: "exact"}, false},
+		{"nil request", "mean", nil, true},
+		{"unknown parameter", "variance", &models.ConfidenceIntervalRequest{X: []float64{1, 2}}, true},
+		{"confidence of 0", "mean", &models.ConfidenceIntervalRequest{X: []float64{1, 2}, Confidence: floatPtr(0)}, true},
+		{"single value", "mean", &models.ConfidenceIntervalRequest{X: []float64{1}}, true},
+		{"missing trials", "proportion", &models.ConfidenceIntervalRequest{Successes: &successes}, true},
+		{"unknown method", "proportion", &models.ConfidenceIntervalRequest{Successes: &successes, Trials: &trials, Method: "agresti"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateConfidenceIntervalRequest(tt.parameter, tt.req, 5)
+			if (err != nil) != tt.expectError {
+				t.Errorf("ValidateConfidenceIntervalRequest() error = %v, expectError %v", err, tt.expectError)
+			}
+		})
+	}
+}
+
+func TestValidateRandomRequest(t *testing.T) {
+	items := []json.RawMessage{json.RawMessage(`"a"`), json.RawMessage(`"b"`)}
+
+	tests := []struct {
+		name        string
+		req         *models.RandomRequest
+		expectError bool
+	}{
+		{"uniform with defaults", &models.RandomRequest{Operation: "uniform"}, false},
+		{"integer", &models.RandomRequest{Operation: "Integer", Min: floatPtr(1), Max: floatPtr(6), Count: intPtr(5)}, false},
+		{"weighted choice", &models.RandomRequest{Operation: "choice", Items: items, Weights: []float64{1, 3}}, false},
+		{"nil request", nil, true},
+		{"unknown operation", &models.RandomRequest{Operation: "poisson"}, true},
+		{"zero count", &models.RandomRequest{Operation: "normal", Count: intPtr(0)}, true},
+		{"count above limit", &models.RandomRequest{Operation: "normal", Count: intPtr(11)}, true},
+		{"infinite sd", &models.RandomRequest{Operation: "normal", SD: floatPtr(math.Inf(1))}, true},
+		{"integer without max", &models.RandomRequest{Operation: "integer", Min: floatPtr(1)}, true},
+		{"fractional integer bound", &models.RandomRequest{Operation: "integer", Min: floatPtr(0.5), Max: floatPtr(2)}, true},
+		{"integer bound beyond 2^53", &models.RandomRequest{Operation: "integer", Min: floatPtr(0), Max: floatPtr(1e17)}, true},
+		{"shuffle without items", &models.RandomRequest{Operation: "shuffle"}, true},
+		{"weights length mismatch", &models.RandomRequest{Operation: "choice", Items: items, Weights: []float64{1}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateRandomRequest(tt.req, 10)
+			if (err != nil) != tt.expectError {
+				t.Errorf("ValidateRandomRequest() error = %v, expectError %v", err, tt.expectError)
+			}
+		})
+	}
+}
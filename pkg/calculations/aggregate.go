@@ -0,0 +1,99 @@
+package calculations
+
+import (
+	"fmt"
+	"math"
+)
+
+// Aggregation reduces a list of values to a single number.
+type Aggregation string
+
+const (
+	AggregateSum     Aggregation = "sum"
+	AggregateProduct Aggregation = "product"
+	AggregateMin     Aggregation = "min"
+	AggregateMax     Aggregation = "max"
+	AggregateMean    Aggregation = "mean"
+)
+
+// Aggregator reduces values one at a time, so an array can be processed while
+// it is being decoded without holding it in memory. Sums and means use
+// compensated summation, with a running mean to fall back on when the sum
+// overflows; products keep their binary exponent separately so intermediate
+// results neither overflow nor underflow.
+type Aggregator struct {
+	op       Aggregation
+	count    int
+	sum      SumAccumulator
+	mean     float64
+	mantissa float64
+	exponent int
+	min, max float64
+}
+
+// NewAggregator returns an empty aggregator for op.
+func NewAggregator(op Aggregation) *Aggregator {
+	return &Aggregator{op: op, mantissa: 1}
+}
+
+// Add feeds the next value, which must be finite.
+func (a *Aggregator) Add(x float64) error {
+	if math.IsNaN(x) || math.IsInf(x, 0) {
+		return fmt.Errorf("value at index %d must be a valid number", a.count)
+	}
+
+	if a.count == 0 || x < a.min {
+		a.min = x
+	}
+	if a.count == 0 || x > a.max {
+		a.max = x
+	}
+	a.sum.Add(x)
+	// Dividing before subtracting keeps the update finite for any finite x.
+	n := float64(a.count + 1)
+	a.mean += x/n - a.mean/n
+
+	frac, exp := math.Frexp(a.mantissa * x)
+	a.mantissa = frac
+	a.exponent += exp
+
+	a.count++
+	return nil
+}
+
+// Count returns the number of values added.
+func (a *Aggregator) Count() int {
+	return a.count
+}
+
+// Result returns the aggregate of the values added so far. A sum or product
+// too large for a float64 is a domain error; a mean never is.
+func (a *Aggregator) Result() (float64, error) {
+	if a.count == 0 {
+		return 0, fmt.Errorf("at least one value is required")
+	}
+
+	var result float64
+	switch a.op {
+	case AggregateSum:
+		result = a.sum.Sum()
+	case AggregateMean:
+		result = a.sum.Sum() / float64(a.count)
+		if math.IsInf(result, 0) || math.IsNaN(result) {
+			result = a.mean
+		}
+	case AggregateProduct:
+		result = math.Ldexp(a.mantissa, a.exponent)
+	case AggregateMin:
+		return a.min, nil
+	case AggregateMax:
+		return a.max, nil
+	default:
+		return 0, fmt.Errorf("unsupported aggregation: %s", a.op)
+	}
+
+	if math.IsInf(result, 0) || math.IsNaN(result) {
+		return 0, fmt.Errorf("%w: %s overflows float64", ErrDomain, a.op)
+	}
+	return result, nil
+}
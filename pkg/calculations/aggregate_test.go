@@ -0,0 +1,77 @@
+package calculations
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestAggregator(t *testing.T) {
+	tests := []struct {
+		name     string
+		op       Aggregation
+		values   []float64
+		expected float64
+		wantErr  error
+	}{
+		{"sum", AggregateSum, []float64{1, 2, 3.5}, 6.5, nil},
+		{"compensated sum", AggregateSum, []float64{1, 1e100, 1, -1e100}, 2, nil},
+		{"sum of tenths", AggregateSum, []float64{0.1, 0.1, 0.1, 0.1, 0.1, 0.1, 0.1, 0.1, 0.1, 0.1}, 1, nil},
+		{"sum overflow", AggregateSum, []float64{math.MaxFloat64, math.MaxFloat64}, 0, ErrDomain},
+		{"product", AggregateProduct, []float64{2, -3, 0.5}, -3, nil},
+		{"product with zero", AggregateProduct, []float64{1e300, 0, 1e300}, 0, nil},
+		{"product survives intermediate overflow", AggregateProduct, []float64{1e200, 1e200, 1e-300}, 1e100, nil},
+		{"product survives intermediate underflow", AggregateProduct, []float64{1e-200, 1e-200, 1e300}, 1e-100, nil},
+		{"product overflow", AggregateProduct, []float64{1e200, 1e200}, 0, ErrDomain},
+		{"min", AggregateMin, []float64{3, -1, 2}, -1, nil},
+		{"max", AggregateMax, []float64{3, -1, 2}, 3, nil},
+		{"mean", AggregateMean, []float64{1, 2, 3, 4}, 2.5, nil},
+		{"mean single", AggregateMean, []float64{-7}, -7, nil},
+		{"mean of values whose sum overflows", AggregateMean, []float64{1e308, 1e308}, 1e308, nil},
+		{"mean after the sum overflows", AggregateMean, []float64{math.MaxFloat64, math.MaxFloat64, -math.MaxFloat64, -math.MaxFloat64}, 0, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := NewAggregator(tt.op)
+			for _, v := range tt.values {
+				if err := a.Add(v); err != nil {
+					t.Fatalf("Add(%v) unexpected error: %v", v, err)
+				}
+			}
+			if a.Count() != len(tt.values) {
+				t.Errorf("Count() = %d, want %d", a.Count(), len(tt.values))
+			}
+
+			result, err := a.Result()
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("Result() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Result() unexpected error: %v", err)
+			}
+			if !almostEqual(result, tt.expected, math.Abs(tt.expected)*1e-15) {
+				t.Errorf("Result() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestAggregatorRejectsInvalidInput(t *testing.T) {
+	a := NewAggregator(AggregateSum)
+	if _, err := a.Result(); err == nil {
+		t.Error("Result() of no values expected error")
+	}
+	if err := a.Add(math.NaN()); err == nil {
+		t.Error("Add(NaN) expected error")
+	}
+	if err := a.Add(math.Inf(-1)); err == nil {
+		t.Error("Add(-Inf) expected error")
+	}
+	if a.Count() != 0 {
+		t.Errorf("Count() = %d after rejected values, want 0", a.Count())
+	}
+}
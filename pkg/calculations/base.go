@@ -0,0 +1,262 @@
+package calculations
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+const (
+	MinBase = 2
+	MaxBase = 36
+
+	// MaxBaseDigits bounds the length of a numeral accepted by ParseInBase.
+	MaxBaseDigits = 1024
+	// MaxFractionDigits bounds the fractional digits produced by FormatInBase.
+	MaxFractionDigits = 256
+	// MaxBitWidth bounds the width accepted by TwosComplement.
+	MaxBitWidth = 128
+
+	MaxRoman = 3999
+)
+
+// RomanBase is the base name for Roman numerals in ParseNumeralBase.
+const RomanBase = "roman"
+
+// ParseNumeralBase parses a base name: an integer radix between MinBase and
+// MaxBase, or "roman". An empty name defaults to base 10.
+func ParseNumeralBase(name string) (base int, roman bool, err error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" {
+		return 10, false, nil
+	}
+	if name == RomanBase {
+		return 0, true, nil
+	}
+	base, err = strconv.Atoi(name)
+	if err != nil || base < MinBase || base > MaxBase {
+		return 0, false, fmt.Errorf("invalid base %q (valid bases: %d-%d or %s)", name, MinBase, MaxBase, RomanBase)
+	}
+	return base, false, nil
+}
+
+// ParseInBase parses an exact value written in the given base, with an
+// optional sign and fractional part, e.g. "-ff.8" in base 16 is -255.5.
+// Digits beyond 9 are the letters a-z, case-insensitively.
+func ParseInBase(s string, base int) (*big.Rat, error) {
+	if base < MinBase || base > MaxBase {
+		return nil, fmt.Errorf("base must be between %d and %d, got %d", MinBase, MaxBase, base)
+	}
+	s = strings.TrimSpace(s)
+	if len(s) > MaxBaseDigits {
+		return nil, fmt.Errorf("value must be at most %d characters", MaxBaseDigits)
+	}
+
+	negative := false
+	if s != "" && (s[0] == '-' || s[0] == '+') {
+		negative = s[0] == '-'
+		s = s[1:]
+	}
+	intPart, fracPart, _ := strings.Cut(s, ".")
+	if intPart == "" && fracPart == "" {
+		return nil, fmt.Errorf("value must contain at least one digit")
+	}
+
+	b := big.NewInt(int64(base))
+	num := new(big.Int)
+	den := big.NewInt(1)
+	for i, part := range []string{intPart, fracPart} {
+		for _, c := range strings.ToLower(part) {
+			d := digitValue(c)
+			if d < 0 || d >= base {
+				return nil, fmt.Errorf("invalid digit %q for base %d", c, base)
+			}
+			num.Mul(num, b)
+			num.Add(num, big.NewInt(int64(d)))
+			if i == 1 {
+				den.Mul(den, b)
+			}
+		}
+	}
+	if negative {
+		num.Neg(num)
+	}
+	return new(big.Rat).SetFrac(num, den), nil
+}
+
+func digitValue(c rune) int {
+	switch {
+	case c >= '0' && c <= '9':
+		return int(c - '0')
+	case c >= 'a' && c <= 'z':
+		return int(c-'a') + 10
+	default:
+		return -1
+	}
+}
+
+// FormatInBase writes r in the given base with lowercase digits. Fractions are
+// expanded to at most precision digits; exact is false if the expansion was cut
+// short, as for 0.1 in base 2.
+func FormatInBase(r *big.Rat, base, precision int) (s string, exact bool) {
+	num := new(big.Int).Abs(r.Num())
+	intPart, rem := new(big.Int).QuoRem(num, r.Denom(), new(big.Int))
+
+	var sb strings.Builder
+	if r.Sign() < 0 {
+		sb.WriteByte('-')
+	}
+	sb.WriteString(intPart.Text(base))
+	if rem.Sign() == 0 {
+		return sb.String(), true
+	}
+
+	sb.WriteByte('.')
+	b := big.NewInt(int64(base))
+	digit := new(big.Int)
+	for i := 0; i < precision && rem.Sign() != 0; i++ {
+		rem.Mul(rem, b)
+		digit.QuoRem(rem, r.Denom(), rem)
+		sb.WriteString(digit.Text(base))
+	}
+	return sb.String(), rem.Sign() == 0
+}
+
+// TwosComplement returns the bit pattern of n in a two's complement integer of
+// the given width, as an unsigned value. n must lie in [-2^(bits-1), 2^(bits-1)).
+func TwosComplement(n *big.Int, bits int) (*big.Int, error) {
+	if bits < 2 || bits > MaxBitWidth {
+		return nil, fmt.Errorf("bit width must be between 2 and %d, got %d", MaxBitWidth, bits)
+	}
+	limit := new(big.Int).Lsh(big.NewInt(1), uint(bits-1))
+	if n.Cmp(new(big.Int).Neg(limit)) < 0 || n.Cmp(limit) >= 0 {
+		return nil, fmt.Errorf("%w: %s does not fit in a %d-bit two's complement integer [-%s, %s]",
+			ErrDomain, n, bits, limit, new(big.Int).Sub(limit, big.NewInt(1)))
+	}
+	if n.Sign() >= 0 {
+		return new(big.Int).Set(n), nil
+	}
+	return new(big.Int).Add(n, new(big.Int).Lsh(big.NewInt(1), uint(bits))), nil
+}
+
+var romanNumerals = []struct {
+	value  int
+	symbol string
+}{
+	{1000, "M"}, {900, "CM"}, {500, "D"}, {400, "CD"},
+	{100, "C"}, {90, "XC"}, {50, "L"}, {40, "XL"},
+	{10, "X"}, {9, "IX"}, {5, "V"}, {4, "IV"}, {1, "I"},
+}
+
+// ToRoman writes n (1 to 3999) as a standard Roman numeral.
+func ToRoman(n int) (string, error) {
+	if n < 1 || n > MaxRoman {
+		return "", fmt.Errorf("%w: Roman numerals represent integers from 1 to %d, got %d", ErrDomain, MaxRoman, n)
+	}
+	var sb strings.Builder
+	for _, r := range romanNumerals {
+		for n >= r.value {
+			sb.WriteString(r.symbol)
+			n -= r.value
+		}
+	}
+	return sb.String(), nil
+}
+
+// FromRoman parses a Roman numeral case-insensitively. Only the standard
+// subtractive form is accepted, so "IIII" and "IC" are rejected.
+func FromRoman(s string) (int, error) {
+	upper := strings.ToUpper(strings.TrimSpace(s))
+	if upper == "" {
+		return 0, fmt.Errorf("empty Roman numeral")
+	}
+	n, rest := 0, upper
+	for _, r := range romanNumerals {
+		for strings.HasPrefix(rest, r.symbol) {
+			n += r.value
+			rest = rest[len(r.symbol):]
+		}
+	}
+	if rest != "" || n > MaxRoman {
+		return 0, fmt.Errorf("invalid Roman numeral %q", s)
+	}
+	// The greedy parse accepts non-canonical forms such as "IIII"; round-trip to reject them.
+	if canonical, _ := ToRoman(n); canonical != upper {
+		return 0, fmt.Errorf("invalid Roman numeral %q (did you mean %s?)", s, canonical)
+	}
+	return n, nil
+}
+
+// FloatLayout is the IEEE-754 encoding of a binary floating-point value.
+type FloatLayout struct {
+	Bits             string // all bits, most significant first
+	Hex              string
+	Sign             int
+	ExponentBits     string
+	MantissaBits     string
+	BiasedExponent   int
+	UnbiasedExponent int    // exponent after removing the bias; -bias+1 for subnormals
+	Class            string // zero, subnormal, normal, infinity or nan
+	Stored           string // exact decimal value of the stored number
+}
+
+// Float32Layout returns the float32 encoding of the value nearest to r.
+func Float32Layout(r *big.Rat) FloatLayout {
+	f, _ := r.Float32()
+	return floatLayout(uint64(math.Float32bits(f)), 32, 8, float64(f))
+}
+
+// Float64Layout returns the float64 encoding of the value nearest to r.
+func Float64Layout(r *big.Rat) FloatLayout {
+	f, _ := r.Float64()
+	return floatLayout(math.Float64bits(f), 64, 11, f)
+}
+
+func floatLayout(bits uint64, width, exponentWidth int, value float64) FloatLayout {
+	mantissaWidth := width - 1 - exponentWidth
+	bias := 1<<(exponentWidth-1) - 1
+	biased := int(bits>>mantissaWidth) & (1<<exponentWidth - 1)
+	mantissa := bits & (1<<mantissaWidth - 1)
+	all := fmt.Sprintf("%0*b", width, bits)
+
+	layout := FloatLayout{
+		Bits:             all,
+		Hex:              fmt.Sprintf("%0*x", width/4, bits),
+		Sign:             int(bits >> (width - 1)),
+		ExponentBits:     all[1 : 1+exponentWidth],
+		MantissaBits:     all[1+exponentWidth:],
+		BiasedExponent:   biased,
+		UnbiasedExponent: biased - bias,
+	}
+
+	switch {
+	case biased == 1<<exponentWidth-1 && mantissa == 0:
+		layout.Class = "infinity"
+	case biased == 1<<exponentWidth-1:
+		layout.Class = "nan"
+	case biased == 0 && mantissa == 0:
+		layout.Class = "zero"
+	case biased == 0:
+		layout.Class = "subnormal"
+		layout.UnbiasedExponent = 1 - bias
+	default:
+		layout.Class = "normal"
+	}
+
+	switch layout.Class {
+	case "infinity":
+		layout.Stored = "-Infinity"
+		if layout.Sign == 0 {
+			layout.Stored = "Infinity"
+		}
+	case "nan":
+		layout.Stored = "NaN"
+	default:
+		exact := new(big.Rat).SetFloat64(value)
+		// A binary fraction p/2^k has exactly k decimal places.
+		layout.Stored = exact.FloatString(exact.Denom().BitLen() - 1)
+	}
+	return layout
+}
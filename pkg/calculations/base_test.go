@@ -0,0 +1,213 @@
+package calculations
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func TestParseNumeralBase(t *testing.T) {
+	tests := []struct {
+		name    string
+		base    int
+		roman   bool
+		wantErr bool
+	}{
+		{"", 10, false, false},
+		{"16", 16, false, false},
+		{" Roman ", 0, true, false},
+		{"1", 0, false, true},
+		{"37", 0, false, true},
+		{"hex", 0, false, true},
+	}
+
+	for _, tt := range tests {
+		base, roman, err := ParseNumeralBase(tt.name)
+		if (err != nil) != tt.wantErr || base != tt.base || roman != tt.roman {
+			t.Errorf("ParseNumeralBase(%q) = (%d, %v, %v), want (%d, %v, error %v)", tt.name, base, roman, err, tt.base, tt.roman, tt.wantErr)
+		}
+	}
+}
+
+func TestParseInBase(t *testing.T) {
+	tests := []struct {
+		input    string
+		base     int
+		expected string
+		wantErr  bool
+	}{
+		{"ff", 16, "255", false},
+		{"FF", 16, "255", false},
+		{"-101", 2, "-5", false},
+		{"0.1", 2, "1/2", false},
+		{"ff.8", 16, "511/2", false},
+		{".5", 10, "1/2", false},
+		{"z", 36, "35", false},
+		{"12", 2, "", true},
+		{"g", 16, "", true},
+		{"", 10, "", true},
+		{"-", 10, "", true},
+		{"1.2.3", 10, "", true},
+		{"10", 37, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			r, err := ParseInBase(tt.input, tt.base)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ParseInBase(%q, %d) = %v, want error", tt.input, tt.base, r)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseInBase(%q, %d) unexpected error: %v", tt.input, tt.base, err)
+			}
+			if r.RatString() != tt.expected {
+				t.Errorf("ParseInBase(%q, %d) = %s, want %s", tt.input, tt.base, r.RatString(), tt.expected)
+			}
+		})
+	}
+}
+
+func TestFormatInBase(t *testing.T) {
+	tests := []struct {
+		name      string
+		r         *big.Rat
+		base      int
+		precision int
+		expected  string
+		exact     bool
+	}{
+		{"integer to hex", big.NewRat(255, 1), 16, 20, "ff", true},
+		{"negative binary", big.NewRat(-5, 1), 2, 20, "-101", true},
+		{"terminating fraction", big.NewRat(511, 2), 16, 20, "ff.8", true},
+		{"one tenth in binary is inexact", big.NewRat(1, 10), 2, 8, "0.00011001", false},
+		{"one third in base 3", big.NewRat(1, 3), 3, 20, "0.1", true},
+		{"zero", new(big.Rat), 2, 20, "0", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, exact := FormatInBase(tt.r, tt.base, tt.precision)
+			if s != tt.expected || exact != tt.exact {
+				t.Errorf("FormatInBase() = (%q, %v), want (%q, %v)", s, exact, tt.expected, tt.exact)
+			}
+		})
+	}
+}
+
+func TestTwosComplement(t *testing.T) {
+	tests := []struct {
+		n        int64
+		bits     int
+		expected string
+		wantErr  bool
+	}{
+		{-1, 8, "ff", false},
+		{-128, 8, "80", false},
+		{127, 8, "7f", false},
+		{-2, 16, "fffe", false},
+		{128, 8, "", true},
+		{-129, 8, "", true},
+		{1, 1, "", true},
+	}
+
+	for _, tt := range tests {
+		result, err := TwosComplement(big.NewInt(tt.n), tt.bits)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("TwosComplement(%d, %d) = %v, want error", tt.n, tt.bits, result)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("TwosComplement(%d, %d) unexpected error: %v", tt.n, tt.bits, err)
+		}
+		if result.Text(16) != tt.expected {
+			t.Errorf("TwosComplement(%d, %d) = %s, want %s", tt.n, tt.bits, result.Text(16), tt.expected)
+		}
+	}
+}
+
+func TestRomanNumerals(t *testing.T) {
+	tests := []struct {
+		n     int
+		roman string
+	}{
+		{1, "I"},
+		{4, "IV"},
+		{9, "IX"},
+		{14, "XIV"},
+		{40, "XL"},
+		{90, "XC"},
+		{400, "CD"},
+		{1994, "MCMXCIV"},
+		{2024, "MMXXIV"},
+		{3999, "MMMCMXCIX"},
+	}
+
+	for _, tt := range tests {
+		if s, err := ToRoman(tt.n); err != nil || s != tt.roman {
+			t.Errorf("ToRoman(%d) = %q, %v, want %q", tt.n, s, err, tt.roman)
+		}
+		if n, err := FromRoman(tt.roman); err != nil || n != tt.n {
+			t.Errorf("FromRoman(%q) = %d, %v, want %d", tt.roman, n, err, tt.n)
+		}
+	}
+
+	if n, err := FromRoman("mcmxciv"); err != nil || n != 1994 {
+		t.Errorf("FromRoman should be case-insensitive, got %d, %v", n, err)
+	}
+
+	for _, invalid := range []string{"", "IIII", "IC", "VX", "MMMM", "ABC"} {
+		if _, err := FromRoman(invalid); err == nil {
+			t.Errorf("FromRoman(%q) expected error", invalid)
+		}
+	}
+
+	for _, n := range []int{0, -1, 4000} {
+		if _, err := ToRoman(n); !errors.Is(err, ErrDomain) {
+			t.Errorf("ToRoman(%d) error = %v, want ErrDomain", n, err)
+		}
+	}
+}
+
+func TestFloatLayout(t *testing.T) {
+	t.Run("float32 of 0.1", func(t *testing.T) {
+		layout := Float32Layout(big.NewRat(1, 10))
+		if layout.Hex != "3dcccccd" {
+			t.Errorf("Hex = %s, want 3dcccccd", layout.Hex)
+		}
+		if layout.Sign != 0 || layout.ExponentBits != "01111011" || layout.UnbiasedExponent != -4 {
+			t.Errorf("unexpected layout: %+v", layout)
+		}
+		if layout.Stored != "0.100000001490116119384765625" {
+			t.Errorf("Stored = %s", layout.Stored)
+		}
+		if layout.Class != "normal" || len(layout.MantissaBits) != 23 {
+			t.Errorf("unexpected layout: %+v", layout)
+		}
+	})
+
+	t.Run("float64 of -2", func(t *testing.T) {
+		layout := Float64Layout(big.NewRat(-2, 1))
+		if layout.Hex != "c000000000000000" || layout.Sign != 1 || layout.UnbiasedExponent != 1 || layout.Stored != "-2" {
+			t.Errorf("unexpected layout: %+v", layout)
+		}
+	})
+
+	t.Run("classes", func(t *testing.T) {
+		if c := Float64Layout(new(big.Rat)).Class; c != "zero" {
+			t.Errorf("class of 0 = %s, want zero", c)
+		}
+		tiny, _ := ParseFraction("1e-40")
+		if layout := Float32Layout(tiny); layout.Class != "subnormal" || layout.UnbiasedExponent != -126 {
+			t.Errorf("float32 of 1e-40 = %+v, want subnormal", layout)
+		}
+		huge, _ := ParseFraction("1e300")
+		if c := Float32Layout(huge).Class; c != "infinity" {
+			t.Errorf("float32 of 1e300 class = %s, want infinity", c)
+		}
+	})
+}
@@ -0,0 +1,354 @@
+package calculations
+
+import (
+	"fmt"
+	"math/big"
+	"math/bits"
+	"strconv"
+	"strings"
+)
+
+type BitwiseOperation string
+
+const (
+	BitwiseAnd      BitwiseOperation = "and"
+	BitwiseOr       BitwiseOperation = "or"
+	BitwiseXor      BitwiseOperation = "xor"
+	BitwiseNot      BitwiseOperation = "not"
+	BitwiseShl      BitwiseOperation = "shl"
+	BitwiseShr      BitwiseOperation = "shr"
+	BitwiseRotl     BitwiseOperation = "rotl"
+	BitwiseRotr     BitwiseOperation = "rotr"
+	BitwisePopcount BitwiseOperation = "popcount"
+	BitwiseClz      BitwiseOperation = "clz"
+	BitwiseCtz      BitwiseOperation = "ctz"
+	BitwiseMask     BitwiseOperation = "mask"
+	BitwiseAdd      BitwiseOperation = "add"
+	BitwiseSub      BitwiseOperation = "sub"
+	BitwiseMul      BitwiseOperation = "mul"
+)
+
+// MaxIntegerLiteralLength bounds the length of a literal accepted by
+// ParseIntegerLiteral; no fixed-width value needs more than a 64-digit binary
+// literal, but saturating and wrapping modes accept larger inputs.
+const MaxIntegerLiteralLength = 256
+
+func ValidBitwiseOperations() []BitwiseOperation {
+	return []BitwiseOperation{
+		BitwiseAnd, BitwiseOr, BitwiseXor, BitwiseNot,
+		BitwiseShl, BitwiseShr, BitwiseRotl, BitwiseRotr,
+		BitwisePopcount, BitwiseClz, BitwiseCtz, BitwiseMask,
+		BitwiseAdd, BitwiseSub, BitwiseMul,
+	}
+}
+
+func IsValidBitwiseOperation(name string) bool {
+	normalized := strings.ToLower(strings.TrimSpace(name))
+	for _, valid := range ValidBitwiseOperations() {
+		if string(valid) == normalized {
+			return true
+		}
+	}
+	return false
+}
+
+// RequiresSecondBitwiseOperand reports whether op combines two integers.
+func RequiresSecondBitwiseOperand(op BitwiseOperation) bool {
+	switch op {
+	case BitwiseAnd, BitwiseOr, BitwiseXor, BitwiseAdd, BitwiseSub, BitwiseMul:
+		return true
+	default:
+		return false
+	}
+}
+
+// RequiresBitCount reports whether op takes a bit count: the shift or rotate
+// amount, or the width of a mask.
+func RequiresBitCount(op BitwiseOperation) bool {
+	switch op {
+	case BitwiseShl, BitwiseShr, BitwiseRotl, BitwiseRotr, BitwiseMask:
+		return true
+	default:
+		return false
+	}
+}
+
+// IntType is a fixed-width integer type such as int8 or uint64.
+type IntType struct {
+	Bits   int
+	Signed bool
+}
+
+// DefaultIntType is used when a request does not name a type.
+var DefaultIntType = IntType{Bits: 64, Signed: true}
+
+// ParseIntType parses a type name: int8, int16, int32, int64 or their uint
+// counterparts. An empty name defaults to int64.
+func ParseIntType(name string) (IntType, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" {
+		return DefaultIntType, nil
+	}
+	signed := true
+	digits, ok := strings.CutPrefix(name, "uint")
+	if ok {
+		signed = false
+	} else if digits, ok = strings.CutPrefix(name, "int"); !ok {
+		return IntType{}, fmt.Errorf("invalid integer type %q (valid types: int8, int16, int32, int64, uint8, uint16, uint32, uint64)", name)
+	}
+	switch digits {
+	case "8", "16", "32", "64":
+		n, _ := strconv.Atoi(digits)
+		return IntType{Bits: n, Signed: signed}, nil
+	default:
+		return IntType{}, fmt.Errorf("invalid integer type %q (valid types: int8, int16, int32, int64, uint8, uint16, uint32, uint64)", name)
+	}
+}
+
+func (t IntType) String() string {
+	if t.Signed {
+		return fmt.Sprintf("int%d", t.Bits)
+	}
+	return fmt.Sprintf("uint%d", t.Bits)
+}
+
+// Min returns the smallest value representable by t.
+func (t IntType) Min() *big.Int {
+	if !t.Signed {
+		return new(big.Int)
+	}
+	return new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), uint(t.Bits-1)))
+}
+
+// Max returns the largest value representable by t.
+func (t IntType) Max() *big.Int {
+	width := t.Bits
+	if t.Signed {
+		width--
+	}
+	return new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), uint(width)), big.NewInt(1))
+}
+
+func (t IntType) mask() uint64 {
+	if t.Bits == 64 {
+		return ^uint64(0)
+	}
+	return 1<<uint(t.Bits) - 1
+}
+
+// Value interprets a bit pattern as an integer of type t, sign-extending
+// signed types.
+func (t IntType) Value(pattern uint64) *big.Int {
+	pattern &= t.mask()
+	if t.Signed {
+		shift := uint(64 - t.Bits)
+		return big.NewInt(int64(pattern<<shift) >> shift)
+	}
+	return new(big.Int).SetUint64(pattern)
+}
+
+type OverflowMode string
+
+const (
+	// OverflowWrap reduces results modulo 2^bits, as Go and C do.
+	OverflowWrap OverflowMode = "wrap"
+	// OverflowSaturate clamps results to the type's minimum or maximum.
+	OverflowSaturate OverflowMode = "saturate"
+	// OverflowError rejects results that do not fit the type.
+	OverflowError OverflowMode = "error"
+)
+
+// ParseOverflowMode parses an overflow mode name. An empty name defaults to wrap.
+func ParseOverflowMode(mode string) (OverflowMode, error) {
+	switch OverflowMode(strings.ToLower(strings.TrimSpace(mode))) {
+	case "", OverflowWrap:
+		return OverflowWrap, nil
+	case OverflowSaturate:
+		return OverflowSaturate, nil
+	case OverflowError:
+		return OverflowError, nil
+	default:
+		return "", fmt.Errorf("invalid overflow mode %q (valid modes: %s, %s, %s)", mode, OverflowWrap, OverflowSaturate, OverflowError)
+	}
+}
+
+// Fit converts v to a bit pattern of type t. Values outside the type's range
+// are wrapped, saturated or rejected with ErrDomain according to mode, and
+// reported as overflowed.
+func Fit(v *big.Int, t IntType, mode OverflowMode) (pattern uint64, overflowed bool, err error) {
+	lo, hi := t.Min(), t.Max()
+	if v.Cmp(lo) < 0 || v.Cmp(hi) > 0 {
+		overflowed = true
+		switch mode {
+		case OverflowSaturate:
+			if v.Sign() < 0 {
+				v = lo
+			} else {
+				v = hi
+			}
+		case OverflowError:
+			return 0, true, fmt.Errorf("%w: %s overflows %s (range %s to %s)", ErrDomain, v, t, lo, hi)
+		}
+	}
+	modulus := new(big.Int).Lsh(big.NewInt(1), uint(t.Bits))
+	return new(big.Int).Mod(v, modulus).Uint64(), overflowed, nil
+}
+
+// ParseIntegerLiteral parses a decimal, hexadecimal (0x), binary (0b) or
+// octal (0o) integer literal with an optional sign. Underscores may separate
+// digits, as in Go. The second result reports whether the literal was an
+// unsigned hex, binary or octal literal, which callers treat as a raw bit
+// pattern.
+func ParseIntegerLiteral(s string) (*big.Int, bool, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, false, fmt.Errorf("integer literal is required")
+	}
+	if len(s) > MaxIntegerLiteralLength {
+		return nil, false, fmt.Errorf("integer literal must be at most %d characters", MaxIntegerLiteralLength)
+	}
+
+	digits := s
+	signed := false
+	if digits[0] == '-' || digits[0] == '+' {
+		signed = true
+		digits = digits[1:]
+	}
+	base := 10
+	if len(digits) > 2 && digits[0] == '0' {
+		switch digits[1] {
+		case 'x', 'X':
+			base = 16
+		case 'b', 'B':
+			base = 2
+		case 'o', 'O':
+			base = 8
+		}
+		if base != 10 {
+			digits = digits[2:]
+		}
+	}
+	if digits == "" || digits[0] == '-' || digits[0] == '+' || strings.HasPrefix(digits, "_") || strings.HasSuffix(digits, "_") || strings.Contains(digits, "__") {
+		return nil, false, fmt.Errorf("invalid integer literal %q", s)
+	}
+
+	n, ok := new(big.Int).SetString(strings.ReplaceAll(digits, "_", ""), base)
+	if !ok {
+		return nil, false, fmt.Errorf("invalid integer literal %q", s)
+	}
+	if s[0] == '-' {
+		n.Neg(n)
+	}
+	return n, base != 10 && !signed, nil
+}
+
+// ParseFixedWidth parses a literal as a value of type t. Unsigned hex, binary
+// and octal literals that fit in t.Bits are taken as bit patterns, so 0xff is
+// -1 as an int8; every other literal is a mathematical value converted with Fit.
+func ParseFixedWidth(s string, t IntType, mode OverflowMode) (pattern uint64, overflowed bool, err error) {
+	n, raw, err := ParseIntegerLiteral(s)
+	if err != nil {
+		return 0, false, err
+	}
+	if raw && n.BitLen() <= t.Bits {
+		return n.Uint64(), false, nil
+	}
+	return Fit(n, t, mode)
+}
+
+// BitwiseBinary applies and, or, xor, add, sub or mul to two bit patterns of
+// type t. Only the arithmetic operations can overflow.
+func BitwiseBinary(op BitwiseOperation, t IntType, mode OverflowMode, a, b uint64) (uint64, bool, error) {
+	switch op {
+	case BitwiseAnd:
+		return a & b & t.mask(), false, nil
+	case BitwiseOr:
+		return (a | b) & t.mask(), false, nil
+	case BitwiseXor:
+		return (a ^ b) & t.mask(), false, nil
+	}
+
+	x, y := t.Value(a), t.Value(b)
+	switch op {
+	case BitwiseAdd:
+		return Fit(x.Add(x, y), t, mode)
+	case BitwiseSub:
+		return Fit(x.Sub(x, y), t, mode)
+	case BitwiseMul:
+		return Fit(x.Mul(x, y), t, mode)
+	default:
+		return 0, false, fmt.Errorf("unsupported binary operation: %s", op)
+	}
+}
+
+// Complement returns the complement of a within t.Bits.
+func Complement(t IntType, a uint64) uint64 {
+	return ^a & t.mask()
+}
+
+// Shift applies shl, shr, rotl or rotr by n bits, where n is between 0 and
+// t.Bits. A left shift multiplies by 2^n and is subject to mode; a right shift
+// is arithmetic for signed types and logical for unsigned ones.
+func Shift(op BitwiseOperation, t IntType, mode OverflowMode, a uint64, n int) (uint64, bool, error) {
+	if n < 0 || n > t.Bits {
+		return 0, false, fmt.Errorf("shift amount must be between 0 and %d, got %d", t.Bits, n)
+	}
+	a &= t.mask()
+	switch op {
+	case BitwiseShl:
+		v := t.Value(a)
+		return Fit(v.Lsh(v, uint(n)), t, mode)
+	case BitwiseShr:
+		v := t.Value(a)
+		p, _, err := Fit(v.Rsh(v, uint(n)), t, mode)
+		return p, false, err
+	case BitwiseRotl, BitwiseRotr:
+		k := n % t.Bits
+		if op == BitwiseRotr {
+			k = (t.Bits - k) % t.Bits
+		}
+		if k == 0 {
+			return a, false, nil
+		}
+		return (a<<uint(k) | a>>uint(t.Bits-k)) & t.mask(), false, nil
+	default:
+		return 0, false, fmt.Errorf("unsupported shift operation: %s", op)
+	}
+}
+
+// BitCount applies popcount, clz or ctz to a within t.Bits. The zero value
+// has t.Bits leading and trailing zeros.
+func BitCount(op BitwiseOperation, t IntType, a uint64) (int, error) {
+	a &= t.mask()
+	switch op {
+	case BitwisePopcount:
+		return bits.OnesCount64(a), nil
+	case BitwiseClz:
+		return bits.LeadingZeros64(a) - (64 - t.Bits), nil
+	case BitwiseCtz:
+		if a == 0 {
+			return t.Bits, nil
+		}
+		return bits.TrailingZeros64(a), nil
+	default:
+		return 0, fmt.Errorf("unsupported count operation: %s", op)
+	}
+}
+
+// BitMask returns a pattern of width set bits starting at bit offset, e.g.
+// width 4 and offset 8 gives 0x0f00.
+func BitMask(t IntType, offset, width int) (uint64, error) {
+	if offset < 0 || width < 0 || offset+width > t.Bits {
+		return 0, fmt.Errorf("mask of %d bits at offset %d does not fit in %d bits", width, offset, t.Bits)
+	}
+	if width == 64 {
+		return ^uint64(0), nil
+	}
+	return (1<<uint(width) - 1) << uint(offset), nil
+}
+
+// FormatPattern renders a bit pattern as zero-padded hex and binary literals.
+func FormatPattern(t IntType, pattern uint64) (hex, binary string) {
+	pattern &= t.mask()
+	return fmt.Sprintf("0x%0*x", t.Bits/4, pattern), fmt.Sprintf("0b%0*b", t.Bits, pattern)
+}
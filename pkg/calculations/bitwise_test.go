@@ -0,0 +1,262 @@
+package calculations
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+var (
+	int8Type   = IntType{Bits: 8, Signed: true}
+	uint8Type  = IntType{Bits: 8}
+	int64Type  = IntType{Bits: 64, Signed: true}
+	uint64Type = IntType{Bits: 64}
+)
+
+func TestParseIntType(t *testing.T) {
+	tests := []struct {
+		name      string
+		expected  IntType
+		expectErr bool
+	}{
+		{"", int64Type, false},
+		{"int8", int8Type, false},
+		{"UINT64", uint64Type, false},
+		{"uint16", IntType{Bits: 16}, false},
+		{"int128", IntType{}, true},
+		{"uint", IntType{}, true},
+		{"float32", IntType{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseIntType(tt.name)
+			if (err != nil) != tt.expectErr {
+				t.Fatalf("ParseIntType(%q) error = %v, expectErr %v", tt.name, err, tt.expectErr)
+			}
+			if got != tt.expected {
+				t.Errorf("ParseIntType(%q) = %v, want %v", tt.name, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseIntegerLiteral(t *testing.T) {
+	tests := []struct {
+		literal   string
+		expected  string
+		raw       bool
+		expectErr bool
+	}{
+		{"42", "42", false, false},
+		{"-42", "-42", false, false},
+		{"0xFF", "255", true, false},
+		{"0b1010_1010", "170", true, false},
+		{"0o17", "15", true, false},
+		{"-0x80", "-128", false, false},
+		{"1_000_000", "1000000", false, false},
+		{"", "", false, true},
+		{"0x", "", false, true},
+		{"0b102", "", false, true},
+		{"--5", "", false, true},
+		{"1__0", "", false, true},
+		{"_1", "", false, true},
+		{"12abc", "", false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.literal, func(t *testing.T) {
+			n, raw, err := ParseIntegerLiteral(tt.literal)
+			if (err != nil) != tt.expectErr {
+				t.Fatalf("ParseIntegerLiteral(%q) error = %v, expectErr %v", tt.literal, err, tt.expectErr)
+			}
+			if err != nil {
+				return
+			}
+			if n.String() != tt.expected || raw != tt.raw {
+				t.Errorf("ParseIntegerLiteral(%q) = %s, %v, want %s, %v", tt.literal, n, raw, tt.expected, tt.raw)
+			}
+		})
+	}
+}
+
+func TestFit(t *testing.T) {
+	tests := []struct {
+		name       string
+		value      int64
+		typ        IntType
+		mode       OverflowMode
+		expected   int64
+		overflowed bool
+	}{
+		{"in range", -5, int8Type, OverflowError, -5, false},
+		{"wrap high", 200, int8Type, OverflowWrap, -56, true},
+		{"wrap negative unsigned", -1, uint8Type, OverflowWrap, 255, true},
+		{"saturate high", 200, int8Type, OverflowSaturate, 127, true},
+		{"saturate low", -200, int8Type, OverflowSaturate, -128, true},
+		{"saturate unsigned low", -1, uint8Type, OverflowSaturate, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pattern, overflowed, err := Fit(big.NewInt(tt.value), tt.typ, tt.mode)
+			if err != nil {
+				t.Fatalf("Fit() unexpected error: %v", err)
+			}
+			if got := tt.typ.Value(pattern).Int64(); got != tt.expected || overflowed != tt.overflowed {
+				t.Errorf("Fit() = %d, %v, want %d, %v", got, overflowed, tt.expected, tt.overflowed)
+			}
+		})
+	}
+
+	if _, _, err := Fit(big.NewInt(128), int8Type, OverflowError); !errors.Is(err, ErrDomain) {
+		t.Errorf("Fit() error = %v, want ErrDomain", err)
+	}
+}
+
+func TestParseFixedWidth(t *testing.T) {
+	// Unsigned hex literals are bit patterns: 0xff is -1 as an int8
+	pattern, overflowed, err := ParseFixedWidth("0xff", int8Type, OverflowError)
+	if err != nil || overflowed || int8Type.Value(pattern).Int64() != -1 {
+		t.Errorf("ParseFixedWidth(0xff, int8) = %d, %v, %v, want -1", int8Type.Value(pattern), overflowed, err)
+	}
+
+	// Decimal literals are values and must fit the range
+	if _, _, err := ParseFixedWidth("255", int8Type, OverflowError); !errors.Is(err, ErrDomain) {
+		t.Errorf("ParseFixedWidth(255, int8) error = %v, want ErrDomain", err)
+	}
+
+	// Hex literals wider than the type overflow like any other value
+	pattern, overflowed, err = ParseFixedWidth("0x1ff", uint8Type, OverflowSaturate)
+	if err != nil || !overflowed || pattern != 0xff {
+		t.Errorf("ParseFixedWidth(0x1ff, uint8) = %#x, %v, %v, want 0xff", pattern, overflowed, err)
+	}
+}
+
+func TestBitwiseBinary(t *testing.T) {
+	tests := []struct {
+		op         BitwiseOperation
+		typ        IntType
+		mode       OverflowMode
+		a, b       uint64
+		expected   uint64
+		overflowed bool
+	}{
+		{BitwiseAnd, uint8Type, OverflowError, 0b1100, 0b1010, 0b1000, false},
+		{BitwiseOr, uint8Type, OverflowError, 0b1100, 0b1010, 0b1110, false},
+		{BitwiseXor, uint8Type, OverflowError, 0b1100, 0b1010, 0b0110, false},
+		{BitwiseAdd, uint8Type, OverflowWrap, 250, 10, 4, true},
+		{BitwiseAdd, uint8Type, OverflowSaturate, 250, 10, 255, true},
+		{BitwiseSub, uint8Type, OverflowSaturate, 5, 10, 0, true},
+		{BitwiseAdd, int8Type, OverflowWrap, 0x7f, 1, 0x80, true},
+		{BitwiseMul, int8Type, OverflowSaturate, 0xf0, 0x10, 0x80, true},
+		{BitwiseMul, uint64Type, OverflowError, 1 << 31, 1 << 31, 1 << 62, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.op)+"_"+tt.typ.String()+"_"+string(tt.mode), func(t *testing.T) {
+			got, overflowed, err := BitwiseBinary(tt.op, tt.typ, tt.mode, tt.a, tt.b)
+			if err != nil {
+				t.Fatalf("BitwiseBinary() unexpected error: %v", err)
+			}
+			if got != tt.expected || overflowed != tt.overflowed {
+				t.Errorf("BitwiseBinary() = %#x, %v, want %#x, %v", got, overflowed, tt.expected, tt.overflowed)
+			}
+		})
+	}
+
+	if _, _, err := BitwiseBinary(BitwiseAdd, uint64Type, OverflowError, ^uint64(0), 1); !errors.Is(err, ErrDomain) {
+		t.Errorf("BitwiseBinary() error = %v, want ErrDomain", err)
+	}
+}
+
+func TestShift(t *testing.T) {
+	tests := []struct {
+		name       string
+		op         BitwiseOperation
+		typ        IntType
+		mode       OverflowMode
+		a          uint64
+		n          int
+		expected   uint64
+		overflowed bool
+	}{
+		{"shl", BitwiseShl, uint8Type, OverflowError, 0b0011, 2, 0b1100, false},
+		{"shl wrap", BitwiseShl, uint8Type, OverflowWrap, 0x81, 1, 0x02, true},
+		{"shl saturate signed", BitwiseShl, int8Type, OverflowSaturate, 0x40, 1, 0x7f, true},
+		{"shl negative", BitwiseShl, int8Type, OverflowError, 0xff, 3, 0xf8, false},
+		{"shr arithmetic", BitwiseShr, int8Type, OverflowError, 0x80, 4, 0xf8, false},
+		{"shr logical", BitwiseShr, uint8Type, OverflowError, 0x80, 4, 0x08, false},
+		{"shr full width", BitwiseShr, int8Type, OverflowError, 0x80, 8, 0xff, false},
+		{"rotl", BitwiseRotl, uint8Type, OverflowError, 0x81, 1, 0x03, false},
+		{"rotr", BitwiseRotr, uint8Type, OverflowError, 0x81, 1, 0xc0, false},
+		{"rotr full width", BitwiseRotr, uint8Type, OverflowError, 0x81, 8, 0x81, false},
+		{"rotl uint64", BitwiseRotl, uint64Type, OverflowError, 1 << 63, 1, 1, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, overflowed, err := Shift(tt.op, tt.typ, tt.mode, tt.a, tt.n)
+			if err != nil {
+				t.Fatalf("Shift() unexpected error: %v", err)
+			}
+			if got != tt.expected || overflowed != tt.overflowed {
+				t.Errorf("Shift() = %#x, %v, want %#x, %v", got, overflowed, tt.expected, tt.overflowed)
+			}
+		})
+	}
+
+	if _, _, err := Shift(BitwiseShl, uint8Type, OverflowWrap, 1, 9); err == nil {
+		t.Error("Shift() expected error for amount beyond width")
+	}
+	if _, _, err := Shift(BitwiseShl, int8Type, OverflowError, 0x40, 1); !errors.Is(err, ErrDomain) {
+		t.Errorf("Shift() error = %v, want ErrDomain", err)
+	}
+}
+
+func TestBitCount(t *testing.T) {
+	tests := []struct {
+		op       BitwiseOperation
+		typ      IntType
+		a        uint64
+		expected int
+	}{
+		{BitwisePopcount, uint8Type, 0xff, 8},
+		{BitwisePopcount, int64Type, 0xf0f0, 8},
+		{BitwiseClz, uint8Type, 0x01, 7},
+		{BitwiseClz, IntType{Bits: 16}, 0, 16},
+		{BitwiseCtz, uint8Type, 0x80, 7},
+		{BitwiseCtz, IntType{Bits: 32}, 0, 32},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.op)+"_"+tt.typ.String(), func(t *testing.T) {
+			got, err := BitCount(tt.op, tt.typ, tt.a)
+			if err != nil {
+				t.Fatalf("BitCount() unexpected error: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("BitCount(%s, %#x) = %d, want %d", tt.op, tt.a, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestBitMask(t *testing.T) {
+	if got, _ := BitMask(IntType{Bits: 16}, 8, 4); got != 0x0f00 {
+		t.Errorf("BitMask(8, 4) = %#x, want 0x0f00", got)
+	}
+	if got, _ := BitMask(uint64Type, 0, 64); got != ^uint64(0) {
+		t.Errorf("BitMask(0, 64) = %#x, want all ones", got)
+	}
+	if _, err := BitMask(uint8Type, 6, 4); err == nil {
+		t.Error("BitMask() expected error for mask beyond width")
+	}
+}
+
+func TestFormatPattern(t *testing.T) {
+	hex, binary := FormatPattern(IntType{Bits: 16}, 0x0a5)
+	if hex != "0x00a5" || binary != "0b0000000010100101" {
+		t.Errorf("FormatPattern() = %s, %s", hex, binary)
+	}
+}
@@ -0,0 +1,58 @@
+// Package calculus implements numerical integration, differentiation and root
+// finding for real functions of one variable. Every routine checks its context
+// periodically so long runs stop when a request times out.
+package calculus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+)
+
+var (
+	ErrNotFinite     = errors.New("function value is not finite")
+	ErrNoBracket     = errors.New("root is not bracketed")
+	ErrNoConvergence = errors.New("did not converge")
+)
+
+const (
+	DefaultTolerance = 1e-10
+	// MaxIterations bounds the iteration limit a caller may request.
+	MaxIterations = 10000
+
+	// contextCheckInterval is the number of function evaluations between
+	// context checks.
+	contextCheckInterval = 256
+)
+
+// Result describes the outcome of a numerical method. When a method returns
+// ErrNoConvergence, Result holds the last estimate it reached.
+type Result struct {
+	Value         float64
+	ErrorEstimate float64
+	Iterations    int
+	Evaluations   int
+}
+
+// evaluator wraps a function, counting evaluations, rejecting non-finite
+// values and checking the context every contextCheckInterval calls.
+type evaluator struct {
+	ctx         context.Context
+	f           func(float64) float64
+	evaluations int
+}
+
+func (e *evaluator) eval(x float64) (float64, error) {
+	e.evaluations++
+	if e.evaluations%contextCheckInterval == 0 {
+		if err := e.ctx.Err(); err != nil {
+			return 0, err
+		}
+	}
+	y := e.f(x)
+	if math.IsNaN(y) || math.IsInf(y, 0) {
+		return 0, fmt.Errorf("%w: f(%g) = %g", ErrNotFinite, x, y)
+	}
+	return y, nil
+}
@@ -0,0 +1,218 @@
+package calculus
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestIntegrate(t *testing.T) {
+	tests := []struct {
+		name     string
+		f        func(float64) float64
+		a, b     float64
+		expected float64
+	}{
+		{"polynomial", func(x float64) float64 { return 3*x*x + 2*x }, 0, 2, 12},
+		{"sine", math.Sin, 0, math.Pi, 2},
+		{"exponential", math.Exp, 0, 1, math.E - 1},
+		{"reversed bounds", math.Exp, 1, 0, 1 - math.E},
+		{"gaussian", func(x float64) float64 { return math.Exp(-x * x) }, -5, 5, math.Sqrt(math.Pi) * math.Erf(5)},
+		{"oscillatory", func(x float64) float64 { return math.Cos(20 * x) }, 0, 1, math.Sin(20) / 20},
+		{"empty interval", math.Exp, 1, 1, 0},
+	}
+
+	for _, method := range []IntegrationMethod{Simpson, GaussKronrod} {
+		for _, tt := range tests {
+			t.Run(string(method)+"/"+tt.name, func(t *testing.T) {
+				result, err := Integrate(context.Background(), tt.f, tt.a, tt.b, method, 1e-10, DefaultIntegrationIterations)
+				if err != nil {
+					t.Fatalf("Integrate() unexpected error: %v", err)
+				}
+				if math.Abs(result.Value-tt.expected) > 1e-9 {
+					t.Errorf("Integrate() = %v, want %v", result.Value, tt.expected)
+				}
+				if result.ErrorEstimate > 1e-10 {
+					t.Errorf("error estimate = %v, want <= 1e-10", result.ErrorEstimate)
+				}
+				if tt.a != tt.b && result.Evaluations == 0 {
+					t.Errorf("expected evaluations to be counted")
+				}
+			})
+		}
+	}
+}
+
+func TestIntegrateEndpointSingularity(t *testing.T) {
+	f := func(x float64) float64 { return 1 / math.Sqrt(x) }
+
+	result, err := Integrate(context.Background(), f, 0, 1, GaussKronrod, 1e-8, DefaultIntegrationIterations)
+	if err != nil {
+		t.Fatalf("Integrate() unexpected error: %v", err)
+	}
+	if math.Abs(result.Value-2) > 1e-7 || result.Iterations == 0 {
+		t.Errorf("Integrate() = %v after %d iterations, want 2", result.Value, result.Iterations)
+	}
+
+	// Simpson evaluates the endpoints, where the integrand is infinite
+	if _, err := Integrate(context.Background(), f, 0, 1, Simpson, 1e-8, DefaultIntegrationIterations); !errors.Is(err, ErrNotFinite) {
+		t.Errorf("Integrate() error = %v, want ErrNotFinite", err)
+	}
+}
+
+func TestIntegrateNoConvergence(t *testing.T) {
+	f := func(x float64) float64 { return math.Sin(1 / x) }
+	for _, method := range []IntegrationMethod{Simpson, GaussKronrod} {
+		result, err := Integrate(context.Background(), f, 1e-6, 1, method, 1e-14, 5)
+		if !errors.Is(err, ErrNoConvergence) {
+			t.Errorf("%s: error = %v, want ErrNoConvergence", method, err)
+		}
+		if result.Iterations != 5 {
+			t.Errorf("%s: iterations = %d, want 5", method, result.Iterations)
+		}
+	}
+}
+
+func TestIntegrateContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	f := func(x float64) float64 { return math.Sin(1 / x) }
+	if _, err := Integrate(ctx, f, 1e-9, 1, GaussKronrod, 1e-15, MaxIterations); !errors.Is(err, context.Canceled) {
+		t.Errorf("Integrate() error = %v, want context.Canceled", err)
+	}
+}
+
+func TestDerivative(t *testing.T) {
+	tests := []struct {
+		name     string
+		f        func(float64) float64
+		x        float64
+		order    int
+		expected float64
+	}{
+		{"sin'", math.Sin, 1, 1, math.Cos(1)},
+		{"exp'", math.Exp, 2, 1, math.Exp(2)},
+		{"cubic'", func(x float64) float64 { return x * x * x }, -3, 1, 27},
+		{"sin''", math.Sin, 1, 2, -math.Sin(1)},
+		{"exp'' at large x", math.Exp, 10, 2, math.Exp(10)},
+		{"sqrt' near domain edge", math.Sqrt, 0.01, 1, 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Derivative(context.Background(), tt.f, tt.x, tt.order, DefaultTolerance, DefaultDerivativeIterations)
+			if err != nil {
+				t.Fatalf("Derivative() unexpected error: %v", err)
+			}
+			if relErr := math.Abs(result.Value-tt.expected) / math.Max(1, math.Abs(tt.expected)); relErr > 1e-7 {
+				t.Errorf("Derivative() = %v, want %v", result.Value, tt.expected)
+			}
+			if result.Iterations == 0 || result.Evaluations == 0 {
+				t.Errorf("expected iterations and evaluations to be counted, got %d and %d", result.Iterations, result.Evaluations)
+			}
+		})
+	}
+
+	if _, err := Derivative(context.Background(), math.Sin, 0, 3, DefaultTolerance, DefaultDerivativeIterations); err == nil {
+		t.Error("Derivative() expected error for unsupported order")
+	}
+	if _, err := Derivative(context.Background(), math.Log, -1, 1, DefaultTolerance, DefaultDerivativeIterations); !errors.Is(err, ErrNotFinite) {
+		t.Errorf("Derivative() error = %v, want ErrNotFinite", err)
+	}
+}
+
+func TestFindRoot(t *testing.T) {
+	cubic := func(x float64) float64 { return x*x*x - 2*x - 5 }
+	const cubicRoot = 2.0945514815423265
+
+	t.Run("bisection", func(t *testing.T) {
+		result, err := FindBisection(context.Background(), cubic, 2, 3, 1e-12, DefaultRootIterations)
+		if err != nil {
+			t.Fatalf("FindBisection() unexpected error: %v", err)
+		}
+		if math.Abs(result.Value-cubicRoot) > 1e-11 || result.ErrorEstimate > 1e-12 {
+			t.Errorf("FindBisection() = %v ± %v, want %v", result.Value, result.ErrorEstimate, cubicRoot)
+		}
+		if result.Iterations < 30 {
+			t.Errorf("iterations = %d, expected at least 30", result.Iterations)
+		}
+	})
+
+	t.Run("brent", func(t *testing.T) {
+		result, err := FindBrent(context.Background(), cubic, 3, 2, 1e-12, DefaultRootIterations)
+		if err != nil {
+			t.Fatalf("FindBrent() unexpected error: %v", err)
+		}
+		if math.Abs(result.Value-cubicRoot) > 1e-11 {
+			t.Errorf("FindBrent() = %v, want %v", result.Value, cubicRoot)
+		}
+		if result.Iterations >= 20 {
+			t.Errorf("iterations = %d, expected Brent to converge quickly", result.Iterations)
+		}
+		if math.Abs(result.Residual) > 1e-10 {
+			t.Errorf("residual = %v", result.Residual)
+		}
+	})
+
+	t.Run("newton", func(t *testing.T) {
+		result, err := FindNewton(context.Background(), cubic, 2, 1e-12, DefaultRootIterations)
+		if err != nil {
+			t.Fatalf("FindNewton() unexpected error: %v", err)
+		}
+		if math.Abs(result.Value-cubicRoot) > 1e-11 || result.Iterations > 10 {
+			t.Errorf("FindNewton() = %v after %d iterations, want %v", result.Value, result.Iterations, cubicRoot)
+		}
+	})
+
+	t.Run("endpoint is a root", func(t *testing.T) {
+		result, err := FindBrent(context.Background(), math.Sin, 0, 1, 1e-12, DefaultRootIterations)
+		if err != nil || result.Value != 0 || result.Iterations != 0 {
+			t.Errorf("FindBrent() = %v after %d iterations, %v", result.Value, result.Iterations, err)
+		}
+	})
+}
+
+func TestFindRootErrors(t *testing.T) {
+	square := func(x float64) float64 { return x*x + 1 }
+
+	if _, err := FindBisection(context.Background(), square, -1, 1, 1e-12, DefaultRootIterations); !errors.Is(err, ErrNoBracket) {
+		t.Errorf("FindBisection() error = %v, want ErrNoBracket", err)
+	}
+	if _, err := FindBrent(context.Background(), square, -1, 1, 1e-12, DefaultRootIterations); !errors.Is(err, ErrNoBracket) {
+		t.Errorf("FindBrent() error = %v, want ErrNoBracket", err)
+	}
+	if _, err := FindNewton(context.Background(), square, 0, 1e-12, DefaultRootIterations); !errors.Is(err, ErrNoConvergence) {
+		t.Errorf("FindNewton() error = %v, want ErrNoConvergence", err)
+	}
+
+	result, err := FindBisection(context.Background(), math.Sin, 3, 4, 1e-15, 5)
+	if !errors.Is(err, ErrNoConvergence) || result.Iterations != 5 {
+		t.Errorf("FindBisection() = %d iterations, %v, want 5 and ErrNoConvergence", result.Iterations, err)
+	}
+}
+
+func TestFindRootContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	// x^2 + 1 has no real root, so Newton's method wanders until stopped
+	f := func(x float64) float64 { return x*x + 1 }
+	if _, err := FindNewton(ctx, f, 0.5, 1e-15, MaxIterations); !errors.Is(err, context.Canceled) {
+		t.Errorf("FindNewton() error = %v, want context.Canceled", err)
+	}
+}
+
+func TestParseMethods(t *testing.T) {
+	if m, err := ParseIntegrationMethod(""); err != nil || m != GaussKronrod {
+		t.Errorf("ParseIntegrationMethod(\"\") = %v, %v", m, err)
+	}
+	if _, err := ParseIntegrationMethod("trapezoid"); err == nil {
+		t.Error("ParseIntegrationMethod() expected error")
+	}
+	if m, err := ParseRootMethod("Newton"); err != nil || m != Newton {
+		t.Errorf("ParseRootMethod(Newton) = %v, %v", m, err)
+	}
+	if _, err := ParseRootMethod("secant"); err == nil {
+		t.Error("ParseRootMethod() expected error")
+	}
+}
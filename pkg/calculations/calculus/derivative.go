@@ -0,0 +1,113 @@
+package calculus
+
+import (
+	"context"
+	"fmt"
+	"math"
+)
+
+const (
+	MaxDerivativeOrder = 2
+	// DefaultDerivativeIterations is the default size of the Ridders tableau.
+	DefaultDerivativeIterations = 10
+	// MaxDerivativeIterations bounds the tableau, whose memory grows
+	// quadratically; by then the step has shrunk by a factor of 1.4^30 ≈ 24000.
+	MaxDerivativeIterations = 30
+
+	riddersShrink = 1.4 // step reduction between tableau columns
+	riddersSafe   = 2.0 // stop once the error grows by this factor
+	// maxStepReductions bounds how often the initial step is shrunk when f is
+	// not finite at x ± h, e.g. near the edge of its domain.
+	maxStepReductions = 10
+)
+
+// Derivative approximates the first or second derivative of f at x by Ridders'
+// method: central differences at shrinking steps, extrapolated to zero step
+// size. It stops once the error estimate falls below tol, the estimate starts
+// to deteriorate, or maxIterations steps have been taken.
+func Derivative(ctx context.Context, f func(float64) float64, x float64, order int, tol float64, maxIterations int) (Result, error) {
+	if order < 1 || order > MaxDerivativeOrder {
+		return Result{}, fmt.Errorf("derivative order must be between 1 and %d, got %d", MaxDerivativeOrder, order)
+	}
+	if maxIterations < 1 || maxIterations > MaxDerivativeIterations {
+		return Result{}, fmt.Errorf("iteration limit must be between 1 and %d, got %d", MaxDerivativeIterations, maxIterations)
+	}
+
+	e := &evaluator{ctx: ctx, f: f}
+	difference := func(h float64) (float64, error) {
+		fp, err := e.eval(x + h)
+		if err != nil {
+			return 0, err
+		}
+		fm, err := e.eval(x - h)
+		if err != nil {
+			return 0, err
+		}
+		if order == 1 {
+			return (fp - fm) / (2 * h), nil
+		}
+		f0, err := e.eval(x)
+		if err != nil {
+			return 0, err
+		}
+		return (fp - 2*f0 + fm) / (h * h), nil
+	}
+
+	h := 0.1 * math.Max(1, math.Abs(x))
+	first, err := difference(h)
+	for reductions := 0; err != nil && reductions < maxStepReductions; reductions++ {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return Result{}, ctxErr
+		}
+		h /= 10
+		if first, err = difference(h); err == nil {
+			// Step back from the domain edge so the tableau starts on
+			// well-behaved differences.
+			h /= 10
+			first, err = difference(h)
+		}
+	}
+	if err != nil {
+		return Result{Evaluations: e.evaluations}, err
+	}
+
+	// tableau[j][i] is the estimate from step h/riddersShrink^i after j rounds
+	// of extrapolation.
+	tableau := make([][]float64, maxIterations)
+	for j := range tableau {
+		tableau[j] = make([]float64, maxIterations)
+	}
+	tableau[0][0] = first
+	result := Result{Value: first, ErrorEstimate: math.Inf(1)}
+
+	for i := 1; i < maxIterations; i++ {
+		h /= riddersShrink
+		d, err := difference(h)
+		if err != nil {
+			result.Evaluations = e.evaluations
+			return result, err
+		}
+		result.Iterations = i
+		tableau[0][i] = d
+
+		factor := riddersShrink * riddersShrink
+		for j := 1; j <= i; j++ {
+			tableau[j][i] = (tableau[j-1][i]*factor - tableau[j-1][i-1]) / (factor - 1)
+			factor *= riddersShrink * riddersShrink
+			errt := math.Max(math.Abs(tableau[j][i]-tableau[j-1][i]), math.Abs(tableau[j][i]-tableau[j-1][i-1]))
+			if errt <= result.ErrorEstimate {
+				result.ErrorEstimate = errt
+				result.Value = tableau[j][i]
+			}
+		}
+		if result.ErrorEstimate <= tol || math.Abs(tableau[i][i]-tableau[i-1][i-1]) >= riddersSafe*result.ErrorEstimate {
+			break
+		}
+	}
+
+	result.Evaluations = e.evaluations
+	if math.IsInf(result.ErrorEstimate, 1) {
+		result.ErrorEstimate = math.Abs(result.Value)
+	}
+	return result, nil
+}
@@ -0,0 +1,261 @@
+package calculus
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+)
+
+type RootMethod string
+
+const (
+	Bisection RootMethod = "bisection"
+	Brent     RootMethod = "brent"
+	Newton    RootMethod = "newton"
+)
+
+// DefaultRootIterations is the default iteration limit for root finding.
+const DefaultRootIterations = 100
+
+// ParseRootMethod parses a method name. An empty name defaults to Brent.
+func ParseRootMethod(name string) (RootMethod, error) {
+	switch RootMethod(strings.ToLower(strings.TrimSpace(name))) {
+	case "", Brent:
+		return Brent, nil
+	case Bisection:
+		return Bisection, nil
+	case Newton:
+		return Newton, nil
+	default:
+		return "", fmt.Errorf("invalid root method %q (valid methods: %s, %s, %s)", name, Bisection, Brent, Newton)
+	}
+}
+
+// RootResult is a Result whose Value is a root, with the function value there.
+type RootResult struct {
+	Result
+	Residual float64
+}
+
+// FindBisection finds a root of f in [a, b] by bisection. f(a) and f(b) must
+// differ in sign. ErrorEstimate is half the final bracket width.
+func FindBisection(ctx context.Context, f func(float64) float64, a, b, tol float64, maxIterations int) (RootResult, error) {
+	e := &evaluator{ctx: ctx, f: f}
+	a, b, fa, fb, err := bracket(e, a, b)
+	if err != nil || fa == 0 || fb == 0 {
+		return bracketEndpoint(e, a, b, fa, fb), err
+	}
+
+	var result RootResult
+	for {
+		m := a + (b-a)/2
+		result.Value, result.ErrorEstimate = m, (b-a)/2
+		if result.ErrorEstimate <= tol || m <= a || m >= b {
+			break
+		}
+		if result.Iterations >= maxIterations {
+			result.Residual, _ = e.eval(m)
+			result.Evaluations = e.evaluations
+			return result, fmt.Errorf("%w: bisection reached %d iterations with bracket half-width %g", ErrNoConvergence, maxIterations, result.ErrorEstimate)
+		}
+		result.Iterations++
+
+		fm, err := e.eval(m)
+		if err != nil {
+			result.Evaluations = e.evaluations
+			return result, err
+		}
+		if fm == 0 {
+			result.ErrorEstimate = 0
+			break
+		}
+		if math.Signbit(fm) == math.Signbit(fa) {
+			a, fa = m, fm
+		} else {
+			b = m
+		}
+	}
+
+	result.Residual, err = e.eval(result.Value)
+	result.Evaluations = e.evaluations
+	return result, err
+}
+
+// FindBrent finds a root of f in [a, b] with Brent's method, which combines
+// bisection with secant and inverse quadratic interpolation steps. f(a) and
+// f(b) must differ in sign. ErrorEstimate bounds the distance to the root.
+func FindBrent(ctx context.Context, f func(float64) float64, a, b, tol float64, maxIterations int) (RootResult, error) {
+	e := &evaluator{ctx: ctx, f: f}
+	a, b, fa, fb, err := bracket(e, a, b)
+	if err != nil || fa == 0 || fb == 0 {
+		return bracketEndpoint(e, a, b, fa, fb), err
+	}
+
+	c, fc := b, fb
+	var d, step float64
+	var result RootResult
+	for {
+		if math.Signbit(fb) == math.Signbit(fc) {
+			c, fc = a, fa
+			d = b - a
+			step = d
+		}
+		if math.Abs(fc) < math.Abs(fb) {
+			a, b, c = b, c, b
+			fa, fb, fc = fb, fc, fb
+		}
+
+		tol1 := 2*epsilon*math.Abs(b) + tol/2
+		xm := (c - b) / 2
+		result.Value, result.ErrorEstimate, result.Residual = b, math.Abs(xm), fb
+		if math.Abs(xm) <= tol1 || fb == 0 {
+			if fb == 0 {
+				result.ErrorEstimate = 0
+			}
+			result.Evaluations = e.evaluations
+			return result, nil
+		}
+		if result.Iterations >= maxIterations {
+			result.Evaluations = e.evaluations
+			return result, fmt.Errorf("%w: Brent's method reached %d iterations with bracket half-width %g", ErrNoConvergence, maxIterations, result.ErrorEstimate)
+		}
+		result.Iterations++
+
+		if math.Abs(step) >= tol1 && math.Abs(fa) > math.Abs(fb) {
+			// Attempt inverse quadratic interpolation, or a secant step when
+			// only two distinct points are available.
+			s := fb / fa
+			var p, q float64
+			if a == c {
+				p = 2 * xm * s
+				q = 1 - s
+			} else {
+				q = fa / fc
+				r := fb / fc
+				p = s * (2*xm*q*(q-r) - (b-a)*(r-1))
+				q = (q - 1) * (r - 1) * (s - 1)
+			}
+			if p > 0 {
+				q = -q
+			}
+			p = math.Abs(p)
+			if 2*p < math.Min(3*xm*q-math.Abs(tol1*q), math.Abs(step*q)) {
+				step = d
+				d = p / q
+			} else {
+				d = xm
+				step = d
+			}
+		} else {
+			d = xm
+			step = d
+		}
+
+		a, fa = b, fb
+		if math.Abs(d) > tol1 {
+			b += d
+		} else {
+			b += math.Copysign(tol1, xm)
+		}
+		fb, err = e.eval(b)
+		if err != nil {
+			result.Evaluations = e.evaluations
+			return result, err
+		}
+	}
+}
+
+// FindNewton finds a root of f by Newton's method from x0, estimating f' by
+// central differences. ErrorEstimate is the size of the last step.
+func FindNewton(ctx context.Context, f func(float64) float64, x0, tol float64, maxIterations int) (RootResult, error) {
+	e := &evaluator{ctx: ctx, f: f}
+	x := x0
+	fx, err := e.eval(x)
+	if err != nil {
+		return RootResult{Result: Result{Evaluations: e.evaluations}}, err
+	}
+
+	result := RootResult{Result: Result{Value: x, ErrorEstimate: math.Inf(1)}, Residual: fx}
+	for fx != 0 {
+		if result.Iterations >= maxIterations {
+			result.Evaluations = e.evaluations
+			return result, fmt.Errorf("%w: Newton's method reached %d iterations with last step %g", ErrNoConvergence, maxIterations, result.ErrorEstimate)
+		}
+		result.Iterations++
+
+		h := math.Cbrt(epsilon) * math.Max(1, math.Abs(x))
+		fp, err := e.eval(x + h)
+		if err != nil {
+			result.Evaluations = e.evaluations
+			return result, err
+		}
+		fm, err := e.eval(x - h)
+		if err != nil {
+			result.Evaluations = e.evaluations
+			return result, err
+		}
+		slope := (fp - fm) / (2 * h)
+		if slope == 0 {
+			result.Evaluations = e.evaluations
+			return result, fmt.Errorf("%w: derivative is zero at x = %g", ErrNoConvergence, x)
+		}
+
+		step := fx / slope
+		x -= step
+		if math.IsInf(x, 0) || math.IsNaN(x) {
+			result.Evaluations = e.evaluations
+			return result, fmt.Errorf("%w: Newton's method diverged from x = %g", ErrNoConvergence, result.Value)
+		}
+		fx, err = e.eval(x)
+		if err != nil {
+			result.Evaluations = e.evaluations
+			return result, err
+		}
+		result.Value, result.ErrorEstimate, result.Residual = x, math.Abs(step), fx
+		if math.Abs(step) <= tol {
+			break
+		}
+	}
+	if fx == 0 {
+		result.ErrorEstimate = 0
+	}
+	result.Evaluations = e.evaluations
+	return result, nil
+}
+
+// epsilon is the machine epsilon for float64.
+const epsilon = 2.220446049250313e-16
+
+// bracket evaluates f at both ends of [a, b], ordering them so a < b, and
+// checks that they differ in sign.
+func bracket(e *evaluator, a, b float64) (lo, hi, flo, fhi float64, err error) {
+	if a > b {
+		a, b = b, a
+	}
+	fa, err := e.eval(a)
+	if err != nil {
+		return a, b, 0, 0, err
+	}
+	fb, err := e.eval(b)
+	if err != nil {
+		return a, b, fa, 0, err
+	}
+	if fa != 0 && fb != 0 && math.Signbit(fa) == math.Signbit(fb) {
+		return a, b, fa, fb, fmt.Errorf("%w: f(%g) = %g and f(%g) = %g have the same sign", ErrNoBracket, a, fa, b, fb)
+	}
+	return a, b, fa, fb, nil
+}
+
+// bracketEndpoint builds the result when bracket failed or an endpoint is
+// already an exact root.
+func bracketEndpoint(e *evaluator, a, b, fa, fb float64) RootResult {
+	result := RootResult{Result: Result{Evaluations: e.evaluations}}
+	switch {
+	case fa == 0:
+		result.Value = a
+	case fb == 0:
+		result.Value = b
+	}
+	return result
+}
@@ -0,0 +1,174 @@
+package calculations
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/cmplx"
+	"strings"
+)
+
+type ComplexOperation string
+
+const (
+	ComplexAdd       ComplexOperation = "add"
+	ComplexSub       ComplexOperation = "sub"
+	ComplexMul       ComplexOperation = "mul"
+	ComplexDiv       ComplexOperation = "div"
+	ComplexModulus   ComplexOperation = "modulus"
+	ComplexArgument  ComplexOperation = "arg"
+	ComplexConjugate ComplexOperation = "conjugate"
+	ComplexPolar     ComplexOperation = "polar"
+	ComplexRect      ComplexOperation = "rect"
+	ComplexExp       ComplexOperation = "exp"
+	ComplexLog       ComplexOperation = "log"
+	ComplexPow       ComplexOperation = "pow"
+	ComplexRoots     ComplexOperation = "roots"
+)
+
+// MaxRootCount bounds n for NthRoots so a single request cannot allocate an
+// arbitrarily large result.
+const MaxRootCount = 1000
+
+func ValidComplexOperations() []ComplexOperation {
+	return []ComplexOperation{
+		ComplexAdd, ComplexSub, ComplexMul, ComplexDiv,
+		ComplexModulus, ComplexArgument, ComplexConjugate,
+		ComplexPolar, ComplexRect,
+		ComplexExp, ComplexLog, ComplexPow, ComplexRoots,
+	}
+}
+
+func IsValidComplexOperation(name string) bool {
+	normalized := strings.ToLower(strings.TrimSpace(name))
+	for _, valid := range ValidComplexOperations() {
+		if string(valid) == normalized {
+			return true
+		}
+	}
+	return false
+}
+
+// RequiresSecondComplexOperand reports whether op takes a second complex
+// operand: the right-hand side for add, sub, mul and div, and the exponent for pow.
+func RequiresSecondComplexOperand(op ComplexOperation) bool {
+	switch op {
+	case ComplexAdd, ComplexSub, ComplexMul, ComplexDiv, ComplexPow:
+		return true
+	default:
+		return false
+	}
+}
+
+// ComplexArithmetic applies add, sub, mul or div to a and b.
+func ComplexArithmetic(op ComplexOperation, a, b complex128) (complex128, error) {
+	switch op {
+	case ComplexAdd:
+		return finiteComplex(a+b, "add")
+	case ComplexSub:
+		return finiteComplex(a-b, "sub")
+	case ComplexMul:
+		return finiteComplex(a*b, "mul")
+	case ComplexDiv:
+		return ComplexDivide(a, b)
+	default:
+		return 0, fmt.Errorf("unsupported arithmetic operation: %s", op)
+	}
+}
+
+// ErrComplexDivisionByZero is returned by ComplexDivide for a zero divisor.
+var ErrComplexDivisionByZero = errors.New("division by zero")
+
+func ComplexDivide(a, b complex128) (complex128, error) {
+	if b == 0 {
+		return 0, ErrComplexDivisionByZero
+	}
+	return finiteComplex(a/b, "div")
+}
+
+// Argument returns the principal argument of z in (-π, π], or (-180, 180]
+// in degree mode.
+func Argument(z complex128, mode AngleMode) float64 {
+	return FromRadians(cmplx.Phase(z), mode)
+}
+
+// Modulus returns |z|, which can overflow for components near the float64 limit.
+func Modulus(z complex128) (float64, error) {
+	return finite(cmplx.Abs(z), "modulus")
+}
+
+// ToPolar returns the modulus and principal argument of z.
+func ToPolar(z complex128, mode AngleMode) (modulus, argument float64, err error) {
+	modulus, err = Modulus(z)
+	if err != nil {
+		return 0, 0, err
+	}
+	return modulus, Argument(z, mode), nil
+}
+
+// FromPolar returns the complex number with the given modulus and argument.
+// In degree mode multiples of 90° map exactly onto the axes.
+func FromPolar(modulus, argument float64, mode AngleMode) complex128 {
+	return complex(modulus*Cos(argument, mode), modulus*Sin(argument, mode))
+}
+
+func ComplexExponential(z complex128) (complex128, error) {
+	return finiteComplex(cmplx.Exp(z), "exp")
+}
+
+// ComplexLogarithm returns the principal natural logarithm of z.
+func ComplexLogarithm(z complex128) (complex128, error) {
+	if z == 0 {
+		return 0, fmt.Errorf("%w: logarithm of zero", ErrDomain)
+	}
+	return cmplx.Log(z), nil
+}
+
+// ComplexPower returns the principal value of z^w.
+func ComplexPower(z, w complex128) (complex128, error) {
+	if z == 0 && (real(w) < 0 || (real(w) == 0 && imag(w) != 0)) {
+		return 0, fmt.Errorf("%w: zero cannot be raised to a power with non-positive real part", ErrDomain)
+	}
+	return finiteComplex(cmplx.Pow(z, w), "pow")
+}
+
+// NthRoots returns all n distinct n-th roots of z, starting with the principal
+// root and proceeding counter-clockwise. Components smaller than the rounding
+// error of the root's modulus are reported as exactly zero, so the fourth
+// roots of 1 are 1, i, -1 and -i rather than values like 6e-17 + 1i.
+func NthRoots(z complex128, n int) ([]complex128, error) {
+	if n < 1 || n > MaxRootCount {
+		return nil, fmt.Errorf("%w: root degree must be an integer between 1 and %d", ErrDomain, MaxRootCount)
+	}
+
+	roots := make([]complex128, n)
+	if z == 0 {
+		return roots, nil
+	}
+
+	modulus := math.Pow(cmplx.Abs(z), 1/float64(n))
+	phase := cmplx.Phase(z)
+	tolerance := 4 * epsilon * modulus
+	for k := range roots {
+		theta := (phase + 2*math.Pi*float64(k)) / float64(n)
+		re, im := modulus*math.Cos(theta), modulus*math.Sin(theta)
+		if math.Abs(re) < tolerance {
+			re = 0
+		}
+		if math.Abs(im) < tolerance {
+			im = 0
+		}
+		roots[k] = complex(re, im)
+	}
+	return roots, nil
+}
+
+// epsilon is the float64 machine epsilon (2^-52).
+const epsilon = 2.220446049250313e-16
+
+func finiteComplex(result complex128, fn string) (complex128, error) {
+	if cmplx.IsInf(result) || cmplx.IsNaN(result) {
+		return 0, fmt.Errorf("%w: %s result is outside the representable range", ErrDomain, fn)
+	}
+	return result, nil
+}
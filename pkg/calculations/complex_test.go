@@ -0,0 +1,167 @@
+package calculations
+
+import (
+	"errors"
+	"math"
+	"math/cmplx"
+	"testing"
+)
+
+func complexAlmostEqual(a, b complex128, tolerance float64) bool {
+	return cmplx.Abs(a-b) <= tolerance
+}
+
+func TestComplexArithmetic(t *testing.T) {
+	tests := []struct {
+		op       ComplexOperation
+		expected complex128
+	}{
+		{ComplexAdd, 4 + 2i},
+		{ComplexSub, 2 + 6i},
+		{ComplexMul, 11 - 2i},
+		{ComplexDiv, -1 + 2i},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.op), func(t *testing.T) {
+			result, err := ComplexArithmetic(tt.op, 3+4i, 1-2i)
+			if err != nil {
+				t.Fatalf("ComplexArithmetic() unexpected error: %v", err)
+			}
+			if !complexAlmostEqual(result, tt.expected, 1e-12) {
+				t.Errorf("ComplexArithmetic(%s) = %v, want %v", tt.op, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestComplexDivide(t *testing.T) {
+	// Impedance divider: (3+4i) / (1-2i) = -1+2i
+	result, err := ComplexDivide(3+4i, 1-2i)
+	if err != nil {
+		t.Fatalf("ComplexDivide() unexpected error: %v", err)
+	}
+	if !complexAlmostEqual(result, -1+2i, 1e-12) {
+		t.Errorf("ComplexDivide() = %v, want (-1+2i)", result)
+	}
+
+	if _, err := ComplexDivide(1, 0); !errors.Is(err, ErrComplexDivisionByZero) {
+		t.Errorf("ComplexDivide() by zero error = %v, want ErrComplexDivisionByZero", err)
+	}
+}
+
+func TestPolarConversion(t *testing.T) {
+	modulus, argument, err := ToPolar(1+1i, AngleDegrees)
+	if err != nil {
+		t.Fatalf("ToPolar() unexpected error: %v", err)
+	}
+	if !almostEqual(modulus, math.Sqrt2, 1e-12) || !almostEqual(argument, 45, 1e-12) {
+		t.Errorf("ToPolar(1+i) = (%v, %v), want (√2, 45)", modulus, argument)
+	}
+
+	if argument := Argument(-1, AngleRadians); argument != math.Pi {
+		t.Errorf("Argument(-1) = %v, want π", argument)
+	}
+
+	if z := FromPolar(2, 90, AngleDegrees); z != 2i {
+		t.Errorf("FromPolar(2, 90°) = %v, want exactly 2i", z)
+	}
+
+	if z := FromPolar(math.Sqrt2, math.Pi/4, AngleRadians); !complexAlmostEqual(z, 1+1i, 1e-12) {
+		t.Errorf("FromPolar(√2, π/4) = %v, want (1+1i)", z)
+	}
+}
+
+func TestComplexExpLogPow(t *testing.T) {
+	// Euler's identity
+	if z, err := ComplexExponential(complex(0, math.Pi)); err != nil || !complexAlmostEqual(z, -1, 1e-12) {
+		t.Errorf("ComplexExponential(iπ) = %v, %v, want -1", z, err)
+	}
+
+	if z, err := ComplexLogarithm(-1); err != nil || !complexAlmostEqual(z, complex(0, math.Pi), 1e-12) {
+		t.Errorf("ComplexLogarithm(-1) = %v, %v, want iπ", z, err)
+	}
+
+	// i^i = e^(-π/2)
+	if z, err := ComplexPower(1i, 1i); err != nil || !complexAlmostEqual(z, complex(math.Exp(-math.Pi/2), 0), 1e-12) {
+		t.Errorf("ComplexPower(i, i) = %v, %v, want e^(-π/2)", z, err)
+	}
+
+	if z, err := ComplexPower(0, 0); err != nil || z != 1 {
+		t.Errorf("ComplexPower(0, 0) = %v, %v, want 1", z, err)
+	}
+}
+
+func TestComplexDomainErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   func() error
+	}{
+		{"log of zero", func() error { _, err := ComplexLogarithm(0); return err }},
+		{"zero to negative power", func() error { _, err := ComplexPower(0, -1); return err }},
+		{"zero to imaginary power", func() error { _, err := ComplexPower(0, 1i); return err }},
+		{"exp overflow", func() error { _, err := ComplexExponential(1000); return err }},
+		{"mul overflow", func() error { _, err := ComplexArithmetic(ComplexMul, 1e200, 1e200i); return err }},
+		{"modulus overflow", func() error { _, err := Modulus(complex(math.MaxFloat64, math.MaxFloat64)); return err }},
+		{"zero roots", func() error { _, err := NthRoots(1, 0); return err }},
+		{"too many roots", func() error { _, err := NthRoots(1, MaxRootCount+1); return err }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.fn(); !errors.Is(err, ErrDomain) {
+				t.Errorf("expected ErrDomain, got %v", err)
+			}
+		})
+	}
+}
+
+func TestNthRoots(t *testing.T) {
+	roots, err := NthRoots(1, 4)
+	if err != nil {
+		t.Fatalf("NthRoots() unexpected error: %v", err)
+	}
+	expected := []complex128{1, 1i, -1, -1i}
+	for i, want := range expected {
+		if roots[i] != want {
+			t.Errorf("root %d = %v, want exactly %v", i, roots[i], want)
+		}
+	}
+
+	roots, err = NthRoots(-8, 3)
+	if err != nil {
+		t.Fatalf("NthRoots() unexpected error: %v", err)
+	}
+	if len(roots) != 3 {
+		t.Fatalf("expected 3 roots, got %d", len(roots))
+	}
+	for i, root := range roots {
+		if cube := root * root * root; !complexAlmostEqual(cube, -8, 1e-12) {
+			t.Errorf("root %d = %v cubes to %v, want -8", i, root, cube)
+		}
+	}
+	if !complexAlmostEqual(roots[0], complex(1, math.Sqrt(3)), 1e-12) {
+		t.Errorf("principal cube root of -8 = %v, want 1+√3i", roots[0])
+	}
+
+	roots, _ = NthRoots(0, 3)
+	for i, root := range roots {
+		if root != 0 {
+			t.Errorf("root %d of zero = %v, want 0", i, root)
+		}
+	}
+}
+
+func TestIsValidComplexOperation(t *testing.T) {
+	for _, op := range ValidComplexOperations() {
+		if !IsValidComplexOperation(string(op)) {
+			t.Errorf("IsValidComplexOperation(%q) = false", op)
+		}
+	}
+	if !IsValidComplexOperation(" MUL ") {
+		t.Error("IsValidComplexOperation should be case-insensitive")
+	}
+	if IsValidComplexOperation("sqrt") {
+		t.Error("IsValidComplexOperation(\"sqrt\") = true")
+	}
+}
@@ -0,0 +1,189 @@
+package calculations
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// UnitDefinition describes a custom unit. Dimension is either a unit type,
+// whose base unit Factor and Offset are then in ("weight" is kilograms), or a
+// unit expression such as "g", "m^3" or an earlier custom unit. A value v in
+// the unit is Factor*v + Offset in that reference unit.
+type UnitDefinition struct {
+	Symbol    string       `json:"symbol" yaml:"symbol"`
+	Name      string       `json:"name" yaml:"name"`
+	Plural    string       `json:"plural" yaml:"plural"` // derived from Name when empty
+	Aliases   []string     `json:"aliases" yaml:"aliases"`
+	Dimension string       `json:"dimension" yaml:"dimension"`
+	Factor    float64      `json:"factor" yaml:"factor"`
+	Offset    float64      `json:"offset" yaml:"offset"`
+	Metric    bool         `json:"metric" yaml:"metric"` // accepts SI prefixes
+	Systems   []UnitSystem `json:"systems" yaml:"systems"`
+}
+
+// unitDefinitionFile is the layout of a custom units file:
+//
+//	units:
+//	  - symbol: ozt
+//	    name: troy ounce
+//	    dimension: g
+//	    factor: 31.1034768
+type unitDefinitionFile struct {
+	Units []UnitDefinition `json:"units" yaml:"units"`
+}
+
+// ReadUnitDefinitions reads custom unit definitions from a JSON file (.json)
+// or a YAML file (.yaml or .yml). Unknown fields are rejected so that typos
+// do not silently drop a setting.
+func ReadUnitDefinitions(path string) ([]UnitDefinition, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var file unitDefinitionFile
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".json":
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		err = decoder.Decode(&file)
+	case ".yaml", ".yml":
+		decoder := yaml.NewDecoder(bytes.NewReader(data))
+		decoder.KnownFields(true)
+		err = decoder.Decode(&file)
+	default:
+		return nil, fmt.Errorf("unsupported units file extension %q (use .json, .yaml or .yml)", ext)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+	return file.Units, nil
+}
+
+// NewUnitRegistryWith returns a registry of the built-in units and defs.
+// Every invalid definition is reported, not just the first.
+func NewUnitRegistryWith(defs []UnitDefinition) (*UnitRegistry, error) {
+	r := NewUnitRegistry()
+	var errs []error
+	for i, def := range defs {
+		if err := r.Define(def); err != nil {
+			errs = append(errs, fmt.Errorf("unit %d (%q): %w", i+1, def.Symbol, err))
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Define adds a custom unit. Its symbol, name and aliases must not clash with
+// a registered unit or with an SI-prefixed metric unit: "Mm" is taken.
+func (r *UnitRegistry) Define(def UnitDefinition) error {
+	def.Symbol = strings.TrimSpace(def.Symbol)
+	if def.Symbol == "" {
+		return fmt.Errorf("symbol is required")
+	}
+	if math.IsNaN(def.Factor) || math.IsInf(def.Factor, 0) || def.Factor <= 0 {
+		return fmt.Errorf("factor must be a positive finite number")
+	}
+	if math.IsNaN(def.Offset) || math.IsInf(def.Offset, 0) {
+		return fmt.Errorf("offset must be a finite number")
+	}
+	if def.Metric && def.Offset != 0 {
+		return fmt.Errorf("a unit with an offset cannot take SI prefixes")
+	}
+	for _, system := range def.Systems {
+		if !slices.Contains(ValidUnitSystems(), system) {
+			return fmt.Errorf("invalid system %q (valid systems: %v)", system, ValidUnitSystems())
+		}
+	}
+
+	reference, types, err := r.definitionReference(def.Dimension)
+	if err != nil {
+		return err
+	}
+
+	unit := Unit{
+		Symbol:    def.Symbol,
+		Name:      strings.TrimSpace(def.Name),
+		Plural:    strings.TrimSpace(def.Plural),
+		Dimension: reference.Dimension,
+		Factor:    def.Factor * reference.Factor,
+		Offset:    reference.ToSI(def.Offset),
+		Metric:    def.Metric,
+		Types:     types,
+		Systems:   def.Systems,
+	}
+	for _, alias := range def.Aliases {
+		if alias = strings.TrimSpace(alias); alias != "" {
+			unit.Aliases = append(unit.Aliases, alias)
+		}
+	}
+
+	for _, key := range unitKeys(unit) {
+		if strings.EqualFold(key, AutoUnit) {
+			return fmt.Errorf("%q is reserved", key)
+		}
+		if existing, ok := r.units[key]; ok {
+			return fmt.Errorf("%q is already defined as %s (%s)", key, existing.Symbol, existing.Name)
+		}
+		if existing, ok := r.lookupPrefixed(key); ok {
+			return fmt.Errorf("%q is already defined as %s (%s)", key, existing.Symbol, existing.Name)
+		}
+	}
+	return r.register(unit)
+}
+
+// definitionReference resolves the dimension of a definition to the unit its
+// factor is in, along with the unit types the new unit is listed under.
+func (r *UnitRegistry) definitionReference(dimension string) (*Unit, []UnitType, error) {
+	dimension = strings.TrimSpace(dimension)
+	if dimension == "" {
+		return nil, nil, fmt.Errorf("dimension is required (a unit type or a unit such as \"m^3\")")
+	}
+	if IsValidUnitType(dimension) {
+		unitType := UnitType(strings.ToLower(dimension))
+		base, _ := r.Lookup(unitTypeInfo[unitType].base)
+		return base, []UnitType{unitType}, nil
+	}
+
+	reference, err := r.Resolve(dimension)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid dimension: %w", err)
+	}
+	if reference.Inverse {
+		return nil, nil, fmt.Errorf("invalid dimension: %s is an inverse unit", reference.Symbol)
+	}
+	return reference, nil, nil
+}
+
+// LoadCustomUnits replaces the units behind the package-level conversion
+// functions with the built-in units plus those defined in path, returning how
+// many were added. An empty path restores the built-in units alone. On error
+// the current units stay in use, so a bad edit does not disrupt a running
+// server.
+func LoadCustomUnits(path string) (int, error) {
+	var defs []UnitDefinition
+	if path != "" {
+		var err error
+		if defs, err = ReadUnitDefinitions(path); err != nil {
+			return 0, err
+		}
+	}
+
+	r, err := NewUnitRegistryWith(defs)
+	if err != nil {
+		return 0, fmt.Errorf("invalid unit definitions in %s:\n%w", path, err)
+	}
+	globalRegistry.Store(r)
+	return len(defs), nil
+}
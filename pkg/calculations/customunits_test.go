@@ -0,0 +1,187 @@
+package calculations
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestReadUnitDefinitions(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"units.json": `{"units": [{"symbol": "ozt", "name": "troy ounce", "aliases": ["troy_oz"], "dimension": "g", "factor": 31.1034768}]}`,
+		"units.yaml": "units:\n  - symbol: ozt\n    name: troy ounce\n    aliases: [troy_oz]\n    dimension: g\n    factor: 31.1034768\n    systems: [imperial]\n",
+		"units.yml":  "units:\n  - {symbol: ozt, name: troy ounce, aliases: [troy_oz], dimension: g, factor: 31.1034768}\n",
+	}
+	want := UnitDefinition{Symbol: "ozt", Name: "troy ounce", Aliases: []string{"troy_oz"}, Dimension: "g", Factor: 31.1034768}
+
+	for name, content := range files {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(dir, name)
+			if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			defs, err := ReadUnitDefinitions(path)
+			if err != nil {
+				t.Fatalf("ReadUnitDefinitions() unexpected error: %v", err)
+			}
+			if len(defs) != 1 || defs[0].Symbol != want.Symbol || defs[0].Name != want.Name ||
+				strings.Join(defs[0].Aliases, ",") != "troy_oz" || defs[0].Dimension != want.Dimension || defs[0].Factor != want.Factor {
+				t.Errorf("ReadUnitDefinitions() = %+v, want [%+v]", defs, want)
+			}
+		})
+	}
+
+	errorFiles := map[string]string{
+		"typo.json":  `{"units": [{"symbol": "ozt", "factr": 31.1}]}`,
+		"typo.yaml":  "units:\n  - symbol: ozt\n    factr: 31.1\n",
+		"units.toml": `[[units]]`,
+		"bad.json":   `{"units": [`,
+	}
+	for name, content := range errorFiles {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(dir, name)
+			if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := ReadUnitDefinitions(path); err == nil {
+				t.Error("ReadUnitDefinitions() expected error")
+			}
+		})
+	}
+
+	if _, err := ReadUnitDefinitions(filepath.Join(dir, "missing.json")); err == nil {
+		t.Error("ReadUnitDefinitions() of a missing file expected error")
+	}
+}
+
+func TestNewUnitRegistryWith(t *testing.T) {
+	_, err := NewUnitRegistryWith([]UnitDefinition{
+		{Symbol: "ozt", Name: "troy ounce", Dimension: "g", Factor: 31.1034768},
+		{Symbol: "FBM", Name: "board foot", Aliases: []string{"board_feet"}, Dimension: "in^3", Factor: 144},
+		{Symbol: "crate", Name: "crate", Dimension: "bottle", Factor: 12},
+		{Symbol: "bottle", Name: "bottle", Dimension: "volume", Factor: 0.75},
+		{Symbol: "°Ré", Name: "degree Réaumur", Dimension: "K", Factor: 1.25, Offset: 273.15},
+		{Symbol: "furlong", Dimension: "distance", Factor: 201.168, Metric: true},
+	})
+	if err == nil {
+		t.Fatal("NewUnitRegistryWith() expected error for a unit defined before its reference")
+	}
+	if !strings.Contains(err.Error(), `unit 3 ("crate"): invalid dimension`) {
+		t.Errorf("NewUnitRegistryWith() error = %v", err)
+	}
+
+	r, err := NewUnitRegistryWith([]UnitDefinition{
+		{Symbol: "ozt", Name: "troy ounce", Dimension: "g", Factor: 31.1034768},
+		{Symbol: "FBM", Name: "board foot", Aliases: []string{"board_feet"}, Dimension: "in^3", Factor: 144},
+		{Symbol: "bottle", Name: "bottle", Dimension: "volume", Factor: 0.75},
+		{Symbol: "crate", Name: "crate", Dimension: "bottle", Factor: 12},
+		{Symbol: "°Ré", Name: "degree Réaumur", Dimension: "K", Factor: 1.25, Offset: 273.15},
+		{Symbol: "fur", Name: "furlong", Dimension: "distance", Factor: 201.168, Metric: true},
+	})
+	if err != nil {
+		t.Fatalf("NewUnitRegistryWith() unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		value    float64
+		from, to string
+		expected float64
+	}{
+		{1, "ozt", "g", 31.1034768},
+		{12, "board_feet", "ft³", 1},
+		{2, "crate", "L", 18},
+		{80, "°Ré", "C", 100},
+		{1, "kfur", "km", 201.168},
+	}
+	for _, tt := range tests {
+		from, err := r.Resolve(tt.from)
+		if err != nil {
+			t.Fatalf("Resolve(%q) unexpected error: %v", tt.from, err)
+		}
+		to, _ := r.Resolve(tt.to)
+		result, err := r.ConvertUnits(tt.value, from, to)
+		if err != nil || math.Abs(result-tt.expected) > 1e-9*tt.expected {
+			t.Errorf("ConvertUnits(%v %s to %s) = %v, %v, want %v", tt.value, tt.from, tt.to, result, err, tt.expected)
+		}
+	}
+
+	if units := strings.Join(r.GetValidUnits(UnitTypeVolume), ","); !strings.Contains(units, "bottle") || !strings.Contains(units, "crate") || !strings.Contains(units, "FBM") {
+		t.Errorf("GetValidUnits(volume) = %s, want the custom volumes", units)
+	}
+	if units := strings.Join(r.GetValidUnits(UnitTypeCooking), ","); strings.Contains(units, "bottle") {
+		t.Errorf("GetValidUnits(cooking) = %s, want bottle listed under volume only", units)
+	}
+}
+
+func TestUnitRegistryDefineErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		def     UnitDefinition
+		wantErr string
+	}{
+		{"missing symbol", UnitDefinition{Dimension: "g", Factor: 1}, "symbol is required"},
+		{"zero factor", UnitDefinition{Symbol: "x", Dimension: "g"}, "factor must be a positive finite number"},
+		{"infinite offset", UnitDefinition{Symbol: "x", Dimension: "K", Factor: 1, Offset: math.Inf(1)}, "offset must be a finite number"},
+		{"prefixed offset", UnitDefinition{Symbol: "x", Dimension: "K", Factor: 1, Offset: 1, Metric: true}, "cannot take SI prefixes"},
+		{"missing dimension", UnitDefinition{Symbol: "x", Factor: 1}, "dimension is required"},
+		{"unknown dimension", UnitDefinition{Symbol: "x", Dimension: "parsec", Factor: 1}, `invalid dimension: invalid unit "parsec"`},
+		{"inverse dimension", UnitDefinition{Symbol: "x", Dimension: "L/100km", Factor: 1}, "L/100km is an inverse unit"},
+		{"built-in symbol", UnitDefinition{Symbol: "kg", Dimension: "g", Factor: 1}, `"kg" is already defined as kg (kilogram)`},
+		{"built-in alias", UnitDefinition{Symbol: "x", Aliases: []string{"feet"}, Dimension: "m", Factor: 1}, `"feet" is already defined as ft (foot)`},
+		{"built-in name", UnitDefinition{Symbol: "x", Name: "mile", Dimension: "m", Factor: 1}, `"mile" is already defined as mi (mile)`},
+		{"prefixed built-in", UnitDefinition{Symbol: "Mm", Dimension: "m", Factor: 1}, `"Mm" is already defined as Mm (megameter)`},
+		{"reserved", UnitDefinition{Symbol: "Auto", Dimension: "m", Factor: 1}, `"Auto" is reserved`},
+		{"unknown system", UnitDefinition{Symbol: "x", Dimension: "m", Factor: 1, Systems: []UnitSystem{"nautical"}}, `invalid system "nautical"`},
+		{"built-in plural", UnitDefinition{Symbol: "x", Name: "fathom", Plural: "feet", Dimension: "m", Factor: 1}, `"feet" is already defined as ft (foot)`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewUnitRegistry()
+			err := r.Define(tt.def)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Define() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLoadCustomUnits(t *testing.T) {
+	t.Cleanup(func() { LoadCustomUnits("") })
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "units.yaml")
+	write := func(content string) {
+		t.Helper()
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	write("units:\n  - {symbol: ozt, name: troy ounce, dimension: weight, factor: 0.0311034768}\n")
+	if count, err := LoadCustomUnits(path); err != nil || count != 1 {
+		t.Fatalf("LoadCustomUnits() = %d, %v, want 1", count, err)
+	}
+	if result, err := ConvertUnit(10, "ozt", "g", "weight", ConversionRounding); err != nil || result != 311.034768 {
+		t.Errorf("ConvertUnit(10 ozt to g) = %v, %v", result, err)
+	}
+
+	// A broken edit is reported and the loaded units stay in use.
+	write("units:\n  - {symbol: lb, dimension: weight, factor: 1}\n")
+	if _, err := LoadCustomUnits(path); err == nil || !strings.Contains(err.Error(), `"lb" is already defined`) {
+		t.Errorf("LoadCustomUnits() error = %v, want a conflict with lb", err)
+	}
+	if _, err := ConvertUnit(1, "ozt", "g", "", ConversionRounding); err != nil {
+		t.Errorf("ConvertUnit() after a failed reload: %v", err)
+	}
+
+	if _, err := LoadCustomUnits(""); err != nil {
+		t.Fatalf("LoadCustomUnits(\"\") unexpected error: %v", err)
+	}
+	if _, err := ConvertUnit(1, "ozt", "g", "", ConversionRounding); err == nil {
+		t.Error("ConvertUnit() after unloading custom units expected error")
+	}
+}
@@ -0,0 +1,158 @@
+package calculations
+
+import (
+	"fmt"
+	"strings"
+)
+
+// BaseDimension indexes the SI base quantities, followed by angle and
+// information, which SI treats as dimensionless but which must not convert
+// to plain numbers or to each other.
+type BaseDimension int
+
+const (
+	DimLength BaseDimension = iota
+	DimMass
+	DimTime
+	DimCurrent
+	DimTemperature
+	DimAmount
+	DimLuminosity
+	DimAngle
+	DimInformation
+	numBaseDimensions
+)
+
+// dimensionSymbols are the conventional SI symbols of the base dimensions.
+var dimensionSymbols = [numBaseDimensions]string{"L", "M", "T", "I", "Θ", "N", "J", "rad", "bit"}
+
+// Dimension is a product of powers of the base dimensions, e.g. speed is
+// length¹·time⁻¹. The zero value is dimensionless.
+type Dimension [numBaseDimensions]int8
+
+// maxDimensionExponent bounds each exponent so unit expressions cannot
+// overflow int8 or produce meaningless powers.
+const maxDimensionExponent = 24
+
+// Mul returns the dimension of a product of quantities.
+func (d Dimension) Mul(other Dimension) (Dimension, error) {
+	var result Dimension
+	for i := range d {
+		e := int(d[i]) + int(other[i])
+		if e > maxDimensionExponent || e < -maxDimensionExponent {
+			return Dimension{}, fmt.Errorf("dimension exponent out of range (maximum %d)", maxDimensionExponent)
+		}
+		result[i] = int8(e)
+	}
+	return result, nil
+}
+
+// Pow returns the dimension of a quantity raised to n.
+func (d Dimension) Pow(n int) (Dimension, error) {
+	var result Dimension
+	for i := range d {
+		e := int(d[i]) * n
+		if e > maxDimensionExponent || e < -maxDimensionExponent {
+			return Dimension{}, fmt.Errorf("dimension exponent out of range (maximum %d)", maxDimensionExponent)
+		}
+		result[i] = int8(e)
+	}
+	return result, nil
+}
+
+// IsDimensionless reports whether every exponent is zero.
+func (d Dimension) IsDimensionless() bool {
+	return d == Dimension{}
+}
+
+// String formats d with the base dimension symbols, positive powers first and
+// otherwise in base dimension order, e.g. "L²·M·T⁻²" for energy. A
+// dimensionless quantity is "1".
+func (d Dimension) String() string {
+	var parts []string
+	for _, positive := range []bool{true, false} {
+		for i, e := range d {
+			if e == 0 || (e > 0) != positive {
+				continue
+			}
+			part := dimensionSymbols[i]
+			if e != 1 {
+				part += superscript(int(e))
+			}
+			parts = append(parts, part)
+		}
+	}
+	if len(parts) == 0 {
+		return "1"
+	}
+	return strings.Join(parts, "·")
+}
+
+// Name returns the common name of the quantity with dimension d, such as
+// "speed" or "energy", falling back to its formula.
+func (d Dimension) Name() string {
+	if name, ok := dimensionNames[d]; ok {
+		return name
+	}
+	return d.String()
+}
+
+// Describe names d together with its formula, e.g. "speed (L·T⁻¹)".
+func (d Dimension) Describe() string {
+	if name, ok := dimensionNames[d]; ok {
+		return fmt.Sprintf("%s (%s)", name, d)
+	}
+	return d.String()
+}
+
+// dim builds a dimension from exponents of length, mass, time, current,
+// temperature, amount, luminosity, angle and information, in that order;
+// missing ones are zero.
+func dim(exponents ...int8) Dimension {
+	var d Dimension
+	copy(d[:], exponents)
+	return d
+}
+
+var dimensionNames = map[Dimension]string{
+	{}:                              "dimensionless",
+	dim(1):                          "length",
+	dim(0, 1):                       "mass",
+	dim(0, 0, 1):                    "time",
+	dim(0, 0, 0, 1):                 "electric current",
+	dim(0, 0, 0, 0, 1):              "temperature",
+	dim(0, 0, 0, 0, 0, 1):           "amount of substance",
+	dim(0, 0, 0, 0, 0, 0, 1):        "luminous intensity",
+	dim(0, 0, 0, 0, 0, 0, 0, 1):     "angle",
+	dim(0, 0, 0, 0, 0, 0, 0, 0, 1):  "information",
+	dim(2):                          "area",
+	dim(3):                          "volume",
+	dim(1, 0, -1):                   "speed",
+	dim(1, 0, -2):                   "acceleration",
+	dim(0, 0, -1):                   "frequency",
+	dim(1, 1, -2):                   "force",
+	dim(2, 1, -2):                   "energy",
+	dim(2, 1, -3):                   "power",
+	dim(-1, 1, -2):                  "pressure",
+	dim(-3, 1):                      "density",
+	dim(3, 0, -1):                   "volumetric flow rate",
+	dim(0, 0, 1, 1):                 "electric charge",
+	dim(2, 1, -3, -1):               "voltage",
+	dim(-2):                         "fuel economy",
+	dim(0, 0, -1, 0, 0, 0, 0, 1):    "angular velocity",
+	dim(0, 0, -1, 0, 0, 0, 0, 0, 1): "data rate",
+}
+
+var superscriptDigits = []rune("⁰¹²³⁴⁵⁶⁷⁸⁹")
+
+func superscript(n int) string {
+	var b strings.Builder
+	if n < 0 {
+		b.WriteRune('⁻')
+		n = -n
+	}
+	for _, c := range fmt.Sprint(n) {
+		b.WriteRune(superscriptDigits[c-'0'])
+	}
+	return b.String()
+}
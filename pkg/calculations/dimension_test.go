@@ -0,0 +1,52 @@
+package calculations
+
+import "testing"
+
+func TestDimensionString(t *testing.T) {
+	tests := []struct {
+		dimension Dimension
+		formula   string
+		name      string
+	}{
+		{Dimension{}, "1", "dimensionless"},
+		{dim(1), "L", "length"},
+		{dim(1, 0, -1), "L·T⁻¹", "speed"},
+		{dim(2, 1, -2), "L²·M·T⁻²", "energy"},
+		{dim(0, 0, 0, 0, -1), "Θ⁻¹", "Θ⁻¹"},
+		{dim(12, 0, -10), "L¹²·T⁻¹⁰", "L¹²·T⁻¹⁰"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.formula, func(t *testing.T) {
+			if got := tt.dimension.String(); got != tt.formula {
+				t.Errorf("String() = %q, want %q", got, tt.formula)
+			}
+			if got := tt.dimension.Name(); got != tt.name {
+				t.Errorf("Name() = %q, want %q", got, tt.name)
+			}
+		})
+	}
+
+	if got := dim(1, 0, -1).Describe(); got != "speed (L·T⁻¹)" {
+		t.Errorf("Describe() = %q, want %q", got, "speed (L·T⁻¹)")
+	}
+}
+
+func TestDimensionArithmetic(t *testing.T) {
+	force, err := dim(0, 1).Mul(dim(1, 0, -2))
+	if err != nil || force != dim(1, 1, -2) {
+		t.Errorf("mass × acceleration = %v, %v, want force", force, err)
+	}
+	if inverse, err := dim(1, 0, -1).Pow(-1); err != nil || inverse != dim(-1, 0, 1) {
+		t.Errorf("speed^-1 = %v, %v", inverse, err)
+	}
+	if _, err := dim(20).Mul(dim(20)); err == nil {
+		t.Error("Mul() beyond the exponent limit expected error")
+	}
+	if _, err := dim(3).Pow(12); err == nil {
+		t.Error("Pow() beyond the exponent limit expected error")
+	}
+	if !(Dimension{}).IsDimensionless() || dim(1).IsDimensionless() {
+		t.Error("IsDimensionless() mismatch")
+	}
+}
@@ -0,0 +1,99 @@
+package distribution
+
+import "math"
+
+func isInteger(x float64) bool { return x == math.Trunc(x) }
+
+type binomial struct {
+	n int64
+	p float64
+}
+
+func (d binomial) PDF(x float64) float64 {
+	n := float64(d.n)
+	if x < 0 || x > n || !isInteger(x) {
+		return 0
+	}
+	lgn, _ := math.Lgamma(n + 1)
+	lgk, _ := math.Lgamma(x + 1)
+	lgnk, _ := math.Lgamma(n - x + 1)
+	return math.Exp(lgn - lgk - lgnk + xlogy(x, d.p) + xlog1py(n-x, -d.p))
+}
+
+// split returns P(X ≤ x) and P(X > x).
+func (d binomial) split(x float64) (lower, upper float64) {
+	n := float64(d.n)
+	k := math.Floor(x)
+	switch {
+	case k < 0:
+		return 0, 1
+	case k >= n:
+		return 1, 0
+	}
+	// P(X > k) = I_p(k+1, n-k).
+	upper, lower = betaInc(k+1, n-k, d.p, 1-d.p)
+	return lower, upper
+}
+
+func (d binomial) CDF(x float64) float64 {
+	lower, _ := d.split(x)
+	return lower
+}
+
+func (d binomial) SF(x float64) float64 {
+	_, upper := d.split(x)
+	return upper
+}
+
+func (d binomial) Quantile(p float64) (float64, error) {
+	if err := checkProbability(p); err != nil {
+		return 0, err
+	}
+	return invertDiscrete(d, p, 0, float64(d.n))
+}
+
+func (d binomial) Mean() float64     { return float64(d.n) * d.p }
+func (d binomial) Variance() float64 { return float64(d.n) * d.p * (1 - d.p) }
+func (d binomial) Discrete() bool    { return true }
+
+type poisson struct{ lambda float64 }
+
+func (d poisson) PDF(x float64) float64 {
+	if x < 0 || !isInteger(x) {
+		return 0
+	}
+	lg, _ := math.Lgamma(x + 1)
+	return math.Exp(x*math.Log(d.lambda) - d.lambda - lg)
+}
+
+// split returns P(X ≤ x) and P(X > x).
+func (d poisson) split(x float64) (lower, upper float64) {
+	k := math.Floor(x)
+	if k < 0 {
+		return 0, 1
+	}
+	// P(X ≤ k) = Q(k+1, λ).
+	upper, lower = gammaInc(k+1, d.lambda)
+	return lower, upper
+}
+
+func (d poisson) CDF(x float64) float64 {
+	lower, _ := d.split(x)
+	return lower
+}
+
+func (d poisson) SF(x float64) float64 {
+	_, upper := d.split(x)
+	return upper
+}
+
+func (d poisson) Quantile(p float64) (float64, error) {
+	if err := checkProbability(p); err != nil {
+		return 0, err
+	}
+	return invertDiscrete(d, p, 0, math.Inf(1))
+}
+
+func (d poisson) Mean() float64     { return d.lambda }
+func (d poisson) Variance() float64 { return d.lambda }
+func (d poisson) Discrete() bool    { return true }
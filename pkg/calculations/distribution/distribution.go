@@ -0,0 +1,311 @@
+// Package distribution implements the density, cumulative distribution and
+// quantile functions of common continuous and discrete probability
+// distributions. Upper tail probabilities are computed directly rather than
+// as 1 - CDF, so they stay accurate far into the tail where p-values live.
+package distribution
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/m-szczepanski/gocalc-api/pkg/calculations"
+)
+
+// ErrNoConvergence is returned when a quantile cannot be located, which only
+// happens for extreme parameters.
+var ErrNoConvergence = errors.New("quantile did not converge")
+
+type Name string
+
+const (
+	Normal      Name = "normal"
+	StudentT    Name = "t"
+	ChiSquare   Name = "chi2"
+	F           Name = "f"
+	Binomial    Name = "binomial"
+	Poisson     Name = "poisson"
+	Exponential Name = "exponential"
+	Uniform     Name = "uniform"
+	Beta        Name = "beta"
+)
+
+func ValidNames() []Name {
+	return []Name{Normal, StudentT, ChiSquare, F, Binomial, Poisson, Exponential, Uniform, Beta}
+}
+
+// ParseName normalizes a distribution name.
+func ParseName(name string) (Name, error) {
+	normalized := Name(strings.ToLower(strings.TrimSpace(name)))
+	for _, n := range ValidNames() {
+		if n == normalized {
+			return n, nil
+		}
+	}
+	return "", fmt.Errorf("invalid distribution %q (valid distributions: %v)", name, ValidNames())
+}
+
+// Params holds distribution parameters. Which fields are used depends on the
+// distribution; see RequiredParams.
+type Params struct {
+	Mean   float64 // normal
+	SD     float64 // normal standard deviation
+	DF     float64 // t and chi2 degrees of freedom
+	DF1    float64 // f numerator degrees of freedom
+	DF2    float64 // f denominator degrees of freedom
+	N      int64   // binomial number of trials
+	P      float64 // binomial probability of success
+	Lambda float64 // poisson mean
+	Rate   float64 // exponential rate
+	Min    float64 // uniform lower bound
+	Max    float64 // uniform upper bound
+	Alpha  float64 // beta shape
+	Beta   float64 // beta shape
+}
+
+// StandardNormal is the normal distribution with mean 0 and standard
+// deviation 1.
+var StandardNormal Distribution = normal{mean: 0, sd: 1}
+
+// RequiredParams returns the JSON names of the parameters a distribution
+// needs. The normal distribution defaults to mean 0 and sd 1.
+func RequiredParams(n Name) []string {
+	switch n {
+	case StudentT, ChiSquare:
+		return []string{"df"}
+	case F:
+		return []string{"df1", "df2"}
+	case Binomial:
+		return []string{"n", "p"}
+	case Poisson:
+		return []string{"lambda"}
+	case Exponential:
+		return []string{"rate"}
+	case Uniform:
+		return []string{"min", "max"}
+	case Beta:
+		return []string{"alpha", "beta"}
+	default:
+		return nil
+	}
+}
+
+// Distribution is a univariate probability distribution.
+type Distribution interface {
+	// PDF returns the probability density at x, or for a discrete
+	// distribution the probability mass.
+	PDF(x float64) float64
+	// CDF returns P(X ≤ x).
+	CDF(x float64) float64
+	// SF returns the survival function P(X > x).
+	SF(x float64) float64
+	// Quantile returns the smallest x with CDF(x) ≥ p for p in (0, 1).
+	Quantile(p float64) (float64, error)
+	// Mean and Variance are NaN where undefined and +Inf where infinite.
+	Mean() float64
+	Variance() float64
+	Discrete() bool
+}
+
+// New returns the named distribution, checking its parameters.
+func New(n Name, p Params) (Distribution, error) {
+	switch n {
+	case Normal:
+		if err := positive("sd", p.SD); err != nil {
+			return nil, err
+		}
+		if err := finite("mean", p.Mean); err != nil {
+			return nil, err
+		}
+		return normal{mean: p.Mean, sd: p.SD}, nil
+	case StudentT:
+		if err := positive("df", p.DF); err != nil {
+			return nil, err
+		}
+		return NewStudentT(p.DF), nil
+	case ChiSquare:
+		if err := positive("df", p.DF); err != nil {
+			return nil, err
+		}
+		return NewChiSquare(p.DF), nil
+	case F:
+		if err := positive("df1", p.DF1); err != nil {
+			return nil, err
+		}
+		if err := positive("df2", p.DF2); err != nil {
+			return nil, err
+		}
+		return fisher{d1: p.DF1, d2: p.DF2}, nil
+	case Binomial:
+		if p.N < 1 {
+			return nil, fmt.Errorf("n must be a positive integer, got %d", p.N)
+		}
+		if math.IsNaN(p.P) || p.P < 0 || p.P > 1 {
+			return nil, fmt.Errorf("p must be between 0 and 1, got %v", p.P)
+		}
+		return binomial{n: p.N, p: p.P}, nil
+	case Poisson:
+		if err := positive("lambda", p.Lambda); err != nil {
+			return nil, err
+		}
+		return poisson{lambda: p.Lambda}, nil
+	case Exponential:
+		if err := positive("rate", p.Rate); err != nil {
+			return nil, err
+		}
+		return exponential{rate: p.Rate}, nil
+	case Uniform:
+		if err := finite("min", p.Min); err != nil {
+			return nil, err
+		}
+		if err := finite("max", p.Max); err != nil {
+			return nil, err
+		}
+		if p.Min >= p.Max || math.IsInf(p.Max-p.Min, 0) {
+			return nil, fmt.Errorf("min must be less than max, got %v and %v", p.Min, p.Max)
+		}
+		return uniform{min: p.Min, max: p.Max}, nil
+	case Beta:
+		if err := positive("alpha", p.Alpha); err != nil {
+			return nil, err
+		}
+		if err := positive("beta", p.Beta); err != nil {
+			return nil, err
+		}
+		return beta{a: p.Alpha, b: p.Beta}, nil
+	default:
+		return nil, fmt.Errorf("invalid distribution %q (valid distributions: %v)", n, ValidNames())
+	}
+}
+
+// NewStudentT returns Student's t distribution with df > 0 degrees of freedom.
+func NewStudentT(df float64) Distribution { return studentT{df: df} }
+
+// NewChiSquare returns the chi-square distribution with df > 0 degrees of
+// freedom.
+func NewChiSquare(df float64) Distribution { return chiSquare{k: df} }
+
+func positive(name string, x float64) error {
+	if math.IsNaN(x) || math.IsInf(x, 0) || x <= 0 {
+		return fmt.Errorf("%s must be a positive number, got %v", name, x)
+	}
+	return nil
+}
+
+func finite(name string, x float64) error {
+	if math.IsNaN(x) || math.IsInf(x, 0) {
+		return fmt.Errorf("%s must be a valid number, got %v", name, x)
+	}
+	return nil
+}
+
+func checkProbability(p float64) error {
+	if math.IsNaN(p) || p <= 0 || p >= 1 {
+		return fmt.Errorf("%w: probability must be strictly between 0 and 1, got %v", calculations.ErrDomain, p)
+	}
+	return nil
+}
+
+// invert finds x in [lo, hi] with CDF(x) = p for a continuous distribution.
+// It solves SF(x) = 1 - p in the upper half so that quantiles near 1 keep
+// their precision. Newton steps are taken when they stay inside the bracket,
+// otherwise the bracket is bisected. lo and hi may be infinite, in which case
+// the bracket is first grown outwards from start.
+func invert(d Distribution, p, lo, hi, start float64) (float64, error) {
+	upper := p > 0.5
+	q := 1 - p
+	// g is increasing in x and has its root at the quantile.
+	g := func(x float64) float64 {
+		if upper {
+			return q - d.SF(x)
+		}
+		return d.CDF(x) - p
+	}
+
+	// Grow an infinite bracket geometrically away from start.
+	step := math.Max(1, math.Abs(start))
+	if math.IsInf(lo, -1) {
+		lo = start - step
+		for g(lo) > 0 {
+			step *= 2
+			lo = start - step
+			if math.IsInf(lo, 0) {
+				return math.NaN(), ErrNoConvergence
+			}
+		}
+	}
+	step = math.Max(1, math.Abs(start))
+	if math.IsInf(hi, 1) {
+		hi = start + step
+		for g(hi) < 0 {
+			step *= 2
+			hi = start + step
+			if math.IsInf(hi, 0) {
+				return math.NaN(), ErrNoConvergence
+			}
+		}
+	}
+
+	x := math.Max(lo, math.Min(hi, start))
+	for i := 0; i < maxIterations; i++ {
+		fx := g(x)
+		if math.IsNaN(fx) {
+			return math.NaN(), ErrNoConvergence
+		}
+		if fx == 0 {
+			return x, nil
+		}
+		if fx < 0 {
+			lo = x
+		} else {
+			hi = x
+		}
+		if hi-lo <= 4*epsilon*math.Max(math.Abs(lo), math.Abs(hi)) || hi-lo < tiny {
+			return (lo + hi) / 2, nil
+		}
+
+		next := x - fx/d.PDF(x)
+		if !(next > lo && next < hi) || math.Abs(next-x) > (hi-lo)/2 {
+			next = lo + (hi-lo)/2
+		}
+		if math.Abs(next-x) <= epsilon*math.Abs(x) {
+			return next, nil
+		}
+		x = next
+	}
+	return math.NaN(), ErrNoConvergence
+}
+
+// invertDiscrete returns the smallest integer k in [lo, hi] with CDF(k) ≥ p.
+// hi may be infinite, in which case it is found by doubling.
+func invertDiscrete(d Distribution, p, lo, hi float64) (float64, error) {
+	// Allow for rounding in the CDF so that p = CDF(k) maps back to k.
+	p *= 1 - 64*epsilon
+	if math.IsInf(hi, 1) {
+		hi = math.Max(1, lo)
+		for d.CDF(hi) < p {
+			hi *= 2
+			if hi > 1<<53 {
+				return math.NaN(), ErrNoConvergence
+			}
+		}
+	}
+	if d.CDF(lo) >= p {
+		return lo, nil
+	}
+	// Invariant: CDF(lo) < p ≤ CDF(hi).
+	for hi-lo > 1 {
+		mid := math.Floor(lo + (hi-lo)/2)
+		cdf := d.CDF(mid)
+		if math.IsNaN(cdf) {
+			return math.NaN(), ErrNoConvergence
+		}
+		if cdf >= p {
+			hi = mid
+		} else {
+			lo = mid
+		}
+	}
+	return hi, nil
+}
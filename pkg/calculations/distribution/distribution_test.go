@@ -0,0 +1,235 @@
+package distribution
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/m-szczepanski/gocalc-api/pkg/calculations"
+)
+
+func mustNew(t *testing.T, n Name, p Params) Distribution {
+	t.Helper()
+	d, err := New(n, p)
+	if err != nil {
+		t.Fatalf("New(%s) unexpected error: %v", n, err)
+	}
+	return d
+}
+
+func closeTo(got, want, tol float64) bool {
+	return math.Abs(got-want) <= tol*math.Max(1, math.Abs(want))
+}
+
+func TestCDFClosedForms(t *testing.T) {
+	tests := []struct {
+		name string
+		d    Distribution
+		cdf  func(x float64) float64
+		xs   []float64
+	}{
+		{"cauchy as t(1)", NewStudentT(1), func(x float64) float64 { return 0.5 + math.Atan(x)/math.Pi }, []float64{-50, -1, 0, 0.3, 1, 7}},
+		{"t(2)", NewStudentT(2), func(x float64) float64 { return 0.5 + x/(2*math.Sqrt(2+x*x)) }, []float64{-3, -0.5, 0, 0.5, 2, 40}},
+		{"chi2(1)", NewChiSquare(1), func(x float64) float64 { return math.Erf(math.Sqrt(x / 2)) }, []float64{0, 0.01, 1, 3.84, 20}},
+		{"chi2(2)", NewChiSquare(2), func(x float64) float64 { return -math.Expm1(-x / 2) }, []float64{0.1, 1, 5, 30}},
+		{"f(2, 2)", mustNew(t, F, Params{DF1: 2, DF2: 2}), func(x float64) float64 { return x / (1 + x) }, []float64{0, 0.2, 1, 9}},
+		{"beta(2, 3)", mustNew(t, Beta, Params{Alpha: 2, Beta: 3}), func(x float64) float64 {
+			return x * x * (6 - 8*x + 3*x*x)
+		}, []float64{0, 0.1, 0.5, 0.9, 1}},
+		{"arcsine as beta(1/2, 1/2)", mustNew(t, Beta, Params{Alpha: 0.5, Beta: 0.5}), func(x float64) float64 {
+			return 2 / math.Pi * math.Asin(math.Sqrt(x))
+		}, []float64{0.001, 0.25, 0.5, 0.999}},
+		{"exponential", mustNew(t, Exponential, Params{Rate: 2}), func(x float64) float64 { return 1 - math.Exp(-2*math.Max(0, x)) }, []float64{-1, 0, 0.5, 3}},
+		{"uniform", mustNew(t, Uniform, Params{Min: -1, Max: 3}), func(x float64) float64 {
+			return math.Max(0, math.Min(1, (x+1)/4))
+		}, []float64{-2, -1, 0, 3, 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, x := range tt.xs {
+				want := tt.cdf(x)
+				if got := tt.d.CDF(x); !closeTo(got, want, 1e-13) {
+					t.Errorf("CDF(%v) = %v, want %v", x, got, want)
+				}
+				if got := tt.d.SF(x); !closeTo(got, 1-want, 1e-13) {
+					t.Errorf("SF(%v) = %v, want %v", x, got, 1-want)
+				}
+			}
+		})
+	}
+}
+
+func TestPDF(t *testing.T) {
+	tests := []struct {
+		name string
+		d    Distribution
+		x    float64
+		want float64
+	}{
+		{"standard normal at 0", StandardNormal, 0, 1 / math.Sqrt(2*math.Pi)},
+		{"normal", mustNew(t, Normal, Params{Mean: 10, SD: 2}), 12, math.Exp(-0.5) / (2 * math.Sqrt(2*math.Pi))},
+		{"cauchy", NewStudentT(1), 1, 1 / (2 * math.Pi)},
+		{"chi2(2) at 0", NewChiSquare(2), 0, 0.5},
+		{"chi2(4)", NewChiSquare(4), 2, math.Exp(-1) / 2},
+		{"f(2, 2)", mustNew(t, F, Params{DF1: 2, DF2: 2}), 1, 0.25},
+		{"beta(2, 3)", mustNew(t, Beta, Params{Alpha: 2, Beta: 3}), 0.5, 1.5},
+		{"beta(1, 3) at 0", mustNew(t, Beta, Params{Alpha: 1, Beta: 3}), 0, 3},
+		{"binomial", mustNew(t, Binomial, Params{N: 10, P: 0.5}), 5, 252.0 / 1024},
+		{"binomial with p = 0", mustNew(t, Binomial, Params{N: 4, P: 0}), 0, 1},
+		{"binomial off the integers", mustNew(t, Binomial, Params{N: 4, P: 0.5}), 1.5, 0},
+		{"poisson", mustNew(t, Poisson, Params{Lambda: 3}), 2, 4.5 * math.Exp(-3)},
+		{"exponential", mustNew(t, Exponential, Params{Rate: 2}), 1, 2 * math.Exp(-2)},
+		{"uniform outside", mustNew(t, Uniform, Params{Min: 0, Max: 2}), 3, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.d.PDF(tt.x); !closeTo(got, tt.want, 1e-13) {
+				t.Errorf("PDF(%v) = %v, want %v", tt.x, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiscreteCDF(t *testing.T) {
+	b := mustNew(t, Binomial, Params{N: 10, P: 0.5})
+	if got := b.CDF(5); !closeTo(got, 638.0/1024, 1e-14) {
+		t.Errorf("binomial CDF(5) = %v, want %v", got, 638.0/1024)
+	}
+	if got := b.CDF(5.7); !closeTo(got, 638.0/1024, 1e-14) {
+		t.Errorf("binomial CDF(5.7) = %v, want %v", got, 638.0/1024)
+	}
+	if b.CDF(-1) != 0 || b.CDF(10) != 1 || b.SF(10) != 0 {
+		t.Errorf("binomial CDF outside the support is wrong")
+	}
+
+	p := mustNew(t, Poisson, Params{Lambda: 3})
+	if got, want := p.CDF(2), 8.5*math.Exp(-3); !closeTo(got, want, 1e-14) {
+		t.Errorf("poisson CDF(2) = %v, want %v", got, want)
+	}
+	if got, want := p.SF(2), 1-8.5*math.Exp(-3); !closeTo(got, want, 1e-14) {
+		t.Errorf("poisson SF(2) = %v, want %v", got, want)
+	}
+}
+
+func TestTails(t *testing.T) {
+	if got := StandardNormal.SF(10); !closeTo(got/7.619853024160527e-24, 1, 1e-12) {
+		t.Errorf("normal SF(10) = %v", got)
+	}
+	// P(T > 1e4) for t(1) is atan(1e-4)/π; 1 - CDF would lose most digits.
+	if got, want := NewStudentT(1).SF(1e4), math.Atan(1e-4)/math.Pi; !closeTo(got/want, 1, 1e-12) {
+		t.Errorf("t(1) SF(1e4) = %v, want %v", got, want)
+	}
+	if got, want := NewChiSquare(2).SF(200), math.Exp(-100); !closeTo(got/want, 1, 1e-12) {
+		t.Errorf("chi2(2) SF(200) = %v, want %v", got, want)
+	}
+}
+
+func TestQuantile(t *testing.T) {
+	tests := []struct {
+		name string
+		d    Distribution
+		p    float64
+		want float64
+		tol  float64
+	}{
+		{"normal 97.5%", StandardNormal, 0.975, 1.959963984540054, 1e-14},
+		{"normal 1e-20", StandardNormal, 1e-20, -9.262340089798405, 1e-14},
+		{"normal far tail", StandardNormal, 1e-300, -37.0470962993612, 1e-13},
+		{"cauchy", NewStudentT(1), 0.75, 1, 1e-13},
+		{"cauchy upper tail", NewStudentT(1), 1 - 1e-10, 1 / math.Tan(math.Pi*1e-10), 1e-5},
+		{"t(2)", NewStudentT(2), 0.1, -0.8 / math.Sqrt(2*0.1*0.9), 1e-12},
+		{"t(10) 97.5%", NewStudentT(10), 0.975, 2.228138851986274, 1e-9},
+		{"chi2(1) 95%", NewChiSquare(1), 0.95, 3.841458820694124, 1e-9},
+		{"chi2(2) median", NewChiSquare(2), 0.5, 2 * math.Ln2, 1e-13},
+		{"f(5, 10) 95%", mustNew(t, F, Params{DF1: 5, DF2: 10}), 0.95, 3.325834530413011, 1e-9},
+		{"beta(2, 3) median", mustNew(t, Beta, Params{Alpha: 2, Beta: 3}), 0.6875, 0.5, 1e-12},
+		{"exponential", mustNew(t, Exponential, Params{Rate: 2}), 0.5, math.Ln2 / 2, 1e-15},
+		{"uniform", mustNew(t, Uniform, Params{Min: -1, Max: 3}), 0.25, 0, 1e-15},
+		{"binomial median", mustNew(t, Binomial, Params{N: 10, P: 0.5}), 0.5, 5, 0},
+		{"binomial boundary", mustNew(t, Binomial, Params{N: 10, P: 0.5}), 638.0 / 1024, 5, 0},
+		{"binomial top", mustNew(t, Binomial, Params{N: 10, P: 0.5}), 0.9999, 10, 0},
+		{"binomial large n median", mustNew(t, Binomial, Params{N: 1e12, P: 0.5}), 0.5, 5e11, 0},
+		{"binomial large n tail", mustNew(t, Binomial, Params{N: 1e12, P: 0.5}), 0.975, 5e11 + 979982, 0},
+		{"poisson", mustNew(t, Poisson, Params{Lambda: 3}), 0.5, 3, 0},
+		{"poisson large mean", mustNew(t, Poisson, Params{Lambda: 1e6}), 0.5, 1e6, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.d.Quantile(tt.p)
+			if err != nil {
+				t.Fatalf("Quantile(%v) unexpected error: %v", tt.p, err)
+			}
+			if !closeTo(got, tt.want, tt.tol) {
+				t.Errorf("Quantile(%v) = %v, want %v", tt.p, got, tt.want)
+			}
+		})
+	}
+
+	for _, p := range []float64{0, 1, -0.5, math.NaN()} {
+		if _, err := StandardNormal.Quantile(p); !errors.Is(err, calculations.ErrDomain) {
+			t.Errorf("Quantile(%v) error = %v, want ErrDomain", p, err)
+		}
+	}
+}
+
+func TestQuantileRoundTrip(t *testing.T) {
+	dists := []Distribution{
+		NewStudentT(0.5), NewStudentT(30), NewChiSquare(0.3), NewChiSquare(500),
+		mustNew(t, F, Params{DF1: 0.7, DF2: 3}), mustNew(t, Beta, Params{Alpha: 0.2, Beta: 40}),
+	}
+	for _, d := range dists {
+		for _, p := range []float64{1e-12, 0.01, 0.3, 0.5, 0.8, 0.999} {
+			x, err := d.Quantile(p)
+			if err != nil {
+				t.Fatalf("%T Quantile(%v) unexpected error: %v", d, p, err)
+			}
+			if got := d.CDF(x); !closeTo(got/p, 1, 1e-9) {
+				t.Errorf("%+v: CDF(Quantile(%v)) = %v", d, p, got)
+			}
+		}
+	}
+}
+
+func TestMoments(t *testing.T) {
+	if v := NewStudentT(1).Mean(); !math.IsNaN(v) {
+		t.Errorf("t(1) mean = %v, want NaN", v)
+	}
+	if v := NewStudentT(2).Variance(); !math.IsInf(v, 1) {
+		t.Errorf("t(2) variance = %v, want +Inf", v)
+	}
+	if v := mustNew(t, Binomial, Params{N: 10, P: 0.3}).Variance(); !closeTo(v, 2.1, 1e-15) {
+		t.Errorf("binomial variance = %v, want 2.1", v)
+	}
+	if v := mustNew(t, F, Params{DF1: 5, DF2: 10}).Variance(); !closeTo(v, 200.0*13/(5*64*6), 1e-15) {
+		t.Errorf("f variance = %v", v)
+	}
+}
+
+func TestNewValidation(t *testing.T) {
+	invalid := []struct {
+		name Name
+		p    Params
+	}{
+		{Normal, Params{SD: 0}},
+		{StudentT, Params{DF: -1}},
+		{F, Params{DF1: 1}},
+		{Binomial, Params{N: 0, P: 0.5}},
+		{Binomial, Params{N: 5, P: 1.5}},
+		{Poisson, Params{Lambda: math.Inf(1)}},
+		{Uniform, Params{Min: 2, Max: 1}},
+		{Beta, Params{Alpha: 1}},
+		{"gamma", Params{}},
+	}
+	for _, tt := range invalid {
+		if _, err := New(tt.name, tt.p); err == nil {
+			t.Errorf("New(%s, %+v) expected error", tt.name, tt.p)
+		}
+	}
+
+	if n, err := ParseName(" Chi2 "); err != nil || n != ChiSquare {
+		t.Errorf("ParseName() = %v, %v", n, err)
+	}
+}
@@ -0,0 +1,508 @@
+// Package expr parses and evaluates arithmetic expressions over named
+// variables, such as "x^2 + 3sin(x)" or "2x + 3y".
+package expr
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// MaxLength bounds the length of an expression accepted by Parse.
+	MaxLength = 1000
+	// MaxDepth bounds the nesting of parentheses, function calls and unary
+	// operators so deeply nested input cannot exhaust the stack.
+	MaxDepth = 100
+)
+
+// SyntaxError reports a malformed expression.
+type SyntaxError struct {
+	Position int // 1-based byte offset into the expression
+	Message  string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("syntax error at position %d: %s", e.Position, e.Message)
+}
+
+// Expr is a parsed expression. It is safe for concurrent use.
+type Expr struct {
+	source    string
+	variables []string
+	root      node
+}
+
+// Parse parses s as an expression over the given variable names. It supports
+// + - * / and ^ (or **), unary signs, parentheses, implicit multiplication
+// ("2x", "3(x+1)", "2sin(x)"), the constants pi, tau and e, and the functions
+// listed by Functions. Names are case-sensitive.
+func Parse(s string, variables ...string) (*Expr, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, &SyntaxError{Position: 1, Message: "expression is empty"}
+	}
+	if len(s) > MaxLength {
+		return nil, &SyntaxError{Position: MaxLength + 1, Message: fmt.Sprintf("expression must be at most %d characters", MaxLength)}
+	}
+
+	tokens, err := tokenize(s)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens, variables: variables}
+	root, err := p.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != tokenEOF {
+		return nil, &SyntaxError{Position: tok.pos, Message: fmt.Sprintf("unexpected %s", tok)}
+	}
+	return &Expr{source: s, variables: variables, root: root}, nil
+}
+
+// Eval evaluates the expression with values bound to its variables in the
+// order they were passed to Parse. Results outside a function's domain are
+// NaN or ±Inf, following package math.
+func (e *Expr) Eval(values ...float64) float64 {
+	return e.root.eval(values)
+}
+
+// Func returns the expression as a function of its first variable.
+func (e *Expr) Func() func(float64) float64 {
+	return func(x float64) float64 {
+		return e.root.eval([]float64{x})
+	}
+}
+
+// Uses reports whether the expression refers to the named variable.
+func (e *Expr) Uses(variable string) bool {
+	for i, name := range e.variables {
+		if name == variable {
+			return uses(e.root, i)
+		}
+	}
+	return false
+}
+
+func (e *Expr) String() string {
+	return e.source
+}
+
+type function struct {
+	arity int
+	call  func(args []float64) float64
+}
+
+func unary(f func(float64) float64) function {
+	return function{arity: 1, call: func(args []float64) float64 { return f(args[0]) }}
+}
+
+func binary(f func(float64, float64) float64) function {
+	return function{arity: 2, call: func(args []float64) float64 { return f(args[0], args[1]) }}
+}
+
+var functions = map[string]function{
+	"sin":   unary(math.Sin),
+	"cos":   unary(math.Cos),
+	"tan":   unary(math.Tan),
+	"asin":  unary(math.Asin),
+	"acos":  unary(math.Acos),
+	"atan":  unary(math.Atan),
+	"sinh":  unary(math.Sinh),
+	"cosh":  unary(math.Cosh),
+	"tanh":  unary(math.Tanh),
+	"asinh": unary(math.Asinh),
+	"acosh": unary(math.Acosh),
+	"atanh": unary(math.Atanh),
+	"exp":   unary(math.Exp),
+	"ln":    unary(math.Log),
+	"log":   unary(math.Log10),
+	"log2":  unary(math.Log2),
+	"log10": unary(math.Log10),
+	"sqrt":  unary(math.Sqrt),
+	"cbrt":  unary(math.Cbrt),
+	"abs":   unary(math.Abs),
+	"floor": unary(math.Floor),
+	"ceil":  unary(math.Ceil),
+	"round": unary(math.Round),
+	"sign": unary(func(x float64) float64 {
+		switch {
+		case x > 0:
+			return 1
+		case x < 0:
+			return -1
+		default:
+			return x
+		}
+	}),
+	"pow":   binary(math.Pow),
+	"atan2": binary(math.Atan2),
+	"hypot": binary(math.Hypot),
+	"min":   binary(math.Min),
+	"max":   binary(math.Max),
+}
+
+var constants = map[string]float64{
+	"pi":  math.Pi,
+	"tau": 2 * math.Pi,
+	"e":   math.E,
+}
+
+// Functions returns the names of the supported functions.
+func Functions() []string {
+	names := make([]string, 0, len(functions))
+	for name := range functions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+type node interface {
+	eval(vars []float64) float64
+}
+
+type (
+	numberNode struct {
+		value   float64
+		literal string // source text, or the name of an irrational constant
+		exact   bool   // literal is a decimal number
+	}
+	variableNode int
+	negateNode   struct{ operand node }
+	binaryNode   struct {
+		op          byte
+		left, right node
+	}
+	callNode struct {
+		name string
+		fn   function
+		args []node
+	}
+)
+
+func (n numberNode) eval([]float64) float64        { return n.value }
+func (n variableNode) eval(vars []float64) float64 { return vars[n] }
+func (n negateNode) eval(vars []float64) float64   { return -n.operand.eval(vars) }
+
+func (n binaryNode) eval(vars []float64) float64 {
+	l, r := n.left.eval(vars), n.right.eval(vars)
+	switch n.op {
+	case '+':
+		return l + r
+	case '-':
+		return l - r
+	case '*':
+		return l * r
+	case '/':
+		return l / r
+	default:
+		return math.Pow(l, r)
+	}
+}
+
+func (n callNode) eval(vars []float64) float64 {
+	args := make([]float64, len(n.args))
+	for i, arg := range n.args {
+		args[i] = arg.eval(vars)
+	}
+	return n.fn.call(args)
+}
+
+func uses(n node, index int) bool {
+	switch n := n.(type) {
+	case variableNode:
+		return int(n) == index
+	case negateNode:
+		return uses(n.operand, index)
+	case binaryNode:
+		return uses(n.left, index) || uses(n.right, index)
+	case callNode:
+		for _, arg := range n.args {
+			if uses(arg, index) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenNumber
+	tokenIdent
+	tokenOperator
+	tokenLParen
+	tokenRParen
+	tokenComma
+)
+
+type token struct {
+	kind  tokenKind
+	text  string
+	value float64
+	pos   int
+}
+
+func (t token) String() string {
+	if t.kind == tokenEOF {
+		return "end of expression"
+	}
+	return fmt.Sprintf("%q", t.text)
+}
+
+func tokenize(s string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case isDigit(c) || c == '.':
+			start := i
+			for i < len(s) && (isDigit(s[i]) || s[i] == '.') {
+				i++
+			}
+			// An exponent must be followed by a digit, so "2e" is 2*e.
+			if i < len(s) && (s[i] == 'e' || s[i] == 'E') {
+				j := i + 1
+				if j < len(s) && (s[j] == '+' || s[j] == '-') {
+					j++
+				}
+				if j < len(s) && isDigit(s[j]) {
+					for i = j; i < len(s) && isDigit(s[i]); i++ {
+					}
+				}
+			}
+			value, err := strconv.ParseFloat(s[start:i], 64)
+			if err != nil || math.IsInf(value, 0) {
+				return nil, &SyntaxError{Position: start + 1, Message: fmt.Sprintf("invalid number %q", s[start:i])}
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: s[start:i], value: value, pos: start + 1})
+		case isLetter(c):
+			start := i
+			for i < len(s) && (isLetter(s[i]) || isDigit(s[i])) {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenIdent, text: s[start:i], pos: start + 1})
+		case c == '*' && i+1 < len(s) && s[i+1] == '*':
+			tokens = append(tokens, token{kind: tokenOperator, text: "^", pos: i + 1})
+			i += 2
+		case strings.IndexByte("+-*/^", c) >= 0:
+			tokens = append(tokens, token{kind: tokenOperator, text: string(c), pos: i + 1})
+			i++
+		case c == '(':
+			tokens = append(tokens, token{kind: tokenLParen, text: "(", pos: i + 1})
+			i++
+		case c == ')':
+			tokens = append(tokens, token{kind: tokenRParen, text: ")", pos: i + 1})
+			i++
+		case c == ',':
+			tokens = append(tokens, token{kind: tokenComma, text: ",", pos: i + 1})
+			i++
+		default:
+			r := []rune(s[i:])[0]
+			return nil, &SyntaxError{Position: i + 1, Message: fmt.Sprintf("unexpected character %q", r)}
+		}
+	}
+	return append(tokens, token{kind: tokenEOF, pos: len(s) + 1}), nil
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isLetter(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+type parser struct {
+	tokens    []token
+	pos       int
+	depth     int
+	variables []string
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	tok := p.tokens[p.pos]
+	if tok.kind != tokenEOF {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *parser) enter(tok token) error {
+	p.depth++
+	if p.depth > MaxDepth {
+		return &SyntaxError{Position: tok.pos, Message: fmt.Sprintf("expression is nested more than %d levels deep", MaxDepth)}
+	}
+	return nil
+}
+
+// expression := term (("+" | "-") term)*
+func (p *parser) parseExpression() (node, error) {
+	left, err := p.parseTerm()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		tok := p.peek()
+		if tok.kind != tokenOperator || (tok.text != "+" && tok.text != "-") {
+			return left, nil
+		}
+		p.next()
+		right, err := p.parseTerm()
+		if err != nil {
+			return nil, err
+		}
+		left = binaryNode{op: tok.text[0], left: left, right: right}
+	}
+}
+
+// term := unary (("*" | "/") unary | power)*
+//
+// A name or parenthesis directly after a factor multiplies it, so "2x^2" is
+// 2*(x^2) and "x(x+1)" is x*(x+1), while "2 -x" remains a subtraction.
+func (p *parser) parseTerm() (node, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		tok := p.peek()
+		var right node
+		switch {
+		case tok.kind == tokenOperator && (tok.text == "*" || tok.text == "/"):
+			p.next()
+			right, err = p.parseUnary()
+		case tok.kind == tokenIdent || tok.kind == tokenLParen:
+			tok.text = "*"
+			right, err = p.parsePower()
+		default:
+			return left, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		left = binaryNode{op: tok.text[0], left: left, right: right}
+	}
+}
+
+// unary := ("+" | "-") unary | power
+func (p *parser) parseUnary() (node, error) {
+	tok := p.peek()
+	if tok.kind == tokenOperator && (tok.text == "-" || tok.text == "+") {
+		p.next()
+		if err := p.enter(tok); err != nil {
+			return nil, err
+		}
+		operand, err := p.parseUnary()
+		p.depth--
+		if err != nil {
+			return nil, err
+		}
+		if tok.text == "+" {
+			return operand, nil
+		}
+		return negateNode{operand: operand}, nil
+	}
+	return p.parsePower()
+}
+
+// power := primary ("^" unary)?, so "2^3^2" is 2^9 and "-x^2" is -(x^2).
+func (p *parser) parsePower() (node, error) {
+	base, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	tok := p.peek()
+	if tok.kind != tokenOperator || tok.text != "^" {
+		return base, nil
+	}
+	p.next()
+	if err := p.enter(tok); err != nil {
+		return nil, err
+	}
+	exponent, err := p.parseUnary()
+	p.depth--
+	if err != nil {
+		return nil, err
+	}
+	return binaryNode{op: '^', left: base, right: exponent}, nil
+}
+
+// primary := number | name | function "(" arguments ")" | "(" expression ")"
+func (p *parser) parsePrimary() (node, error) {
+	tok := p.next()
+	switch tok.kind {
+	case tokenNumber:
+		return numberNode{value: tok.value, literal: tok.text, exact: true}, nil
+	case tokenLParen:
+		if err := p.enter(tok); err != nil {
+			return nil, err
+		}
+		inner, err := p.parseExpression()
+		p.depth--
+		if err != nil {
+			return nil, err
+		}
+		if closing := p.next(); closing.kind != tokenRParen {
+			return nil, &SyntaxError{Position: closing.pos, Message: fmt.Sprintf("expected \")\", got %s", closing)}
+		}
+		return inner, nil
+	case tokenIdent:
+		for i, name := range p.variables {
+			if name == tok.text {
+				return variableNode(i), nil
+			}
+		}
+		if value, ok := constants[tok.text]; ok {
+			return numberNode{value: value, literal: tok.text}, nil
+		}
+		if fn, ok := functions[tok.text]; ok {
+			return p.parseCall(tok, fn)
+		}
+		return nil, &SyntaxError{Position: tok.pos, Message: fmt.Sprintf("unknown name %q (variables: %s)", tok.text, strings.Join(p.variables, ", "))}
+	default:
+		return nil, &SyntaxError{Position: tok.pos, Message: fmt.Sprintf("unexpected %s", tok)}
+	}
+}
+
+func (p *parser) parseCall(name token, fn function) (node, error) {
+	open := p.next()
+	if open.kind != tokenLParen {
+		return nil, &SyntaxError{Position: open.pos, Message: fmt.Sprintf("function %s must be followed by \"(\"", name.text)}
+	}
+	if err := p.enter(open); err != nil {
+		return nil, err
+	}
+	defer func() { p.depth-- }()
+
+	var args []node
+	for {
+		arg, err := p.parseExpression()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+		tok := p.next()
+		if tok.kind == tokenRParen {
+			break
+		}
+		if tok.kind != tokenComma {
+			return nil, &SyntaxError{Position: tok.pos, Message: fmt.Sprintf("expected \",\" or \")\", got %s", tok)}
+		}
+	}
+	if len(args) != fn.arity {
+		return nil, &SyntaxError{Position: name.pos, Message: fmt.Sprintf("%s takes %d argument(s), got %d", name.text, fn.arity, len(args))}
+	}
+	return callNode{name: name.text, fn: fn, args: args}, nil
+}
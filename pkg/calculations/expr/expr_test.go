@@ -0,0 +1,126 @@
+package expr
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestEval(t *testing.T) {
+	tests := []struct {
+		expression string
+		x          float64
+		expected   float64
+	}{
+		{"1 + 2 * 3", 0, 7},
+		{"(1 + 2) * 3", 0, 9},
+		{"2^3^2", 0, 512},
+		{"2**3", 0, 8},
+		{"-x^2", 3, -9},
+		{"2^-1", 0, 0.5},
+		{"10 / 4 / 5", 0, 0.5},
+		{"2x^2 + 3x - 1", 2, 13},
+		{"x(x+1)", 3, 12},
+		{"3(x+1)(x-1)", 2, 9},
+		{"2sin(x)", math.Pi / 2, 2},
+		{"2 -x", 5, -3},
+		{"2e", 0, 2 * math.E},
+		{"1.5e3 + 2E-1", 0, 1500.2},
+		{"pi * tau / e", 0, 2 * math.Pi * math.Pi / math.E},
+		{"ln(e^2) + log(1000) + log2(8)", 0, 8},
+		{"sqrt(16) + cbrt(-27) + abs(-2)", 0, 3},
+		{"pow(2, 10) + max(1, min(x, 5)) + hypot(3, 4)", 9, 1034},
+		{"atan2(1, 1)", 0, math.Pi / 4},
+		{"sign(-x) + floor(2.7) + ceil(2.1) + round(2.5)", 4, 7},
+		{"+x", 4, 4},
+		{"--x", 4, 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			e, err := Parse(tt.expression, "x")
+			if err != nil {
+				t.Fatalf("Parse(%q) unexpected error: %v", tt.expression, err)
+			}
+			if got := e.Eval(tt.x); math.Abs(got-tt.expected) > 1e-9 {
+				t.Errorf("Eval(%v) = %v, want %v", tt.x, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestEvalMultipleVariables(t *testing.T) {
+	e, err := Parse("2x + 3y - e", "x", "y", "e")
+	if err != nil {
+		t.Fatalf("Parse() unexpected error: %v", err)
+	}
+	// Variables shadow constants
+	if got := e.Eval(1, 2, 10); got != -2 {
+		t.Errorf("Eval() = %v, want -2", got)
+	}
+	if !e.Uses("y") || e.Uses("z") {
+		t.Errorf("Uses() reported wrong variables")
+	}
+}
+
+func TestEvalDomain(t *testing.T) {
+	e, _ := Parse("sqrt(x) + 1/x", "x")
+	if !math.IsNaN(e.Eval(-1)) {
+		t.Errorf("sqrt(-1) should be NaN")
+	}
+	if !math.IsInf(e.Eval(0), 1) {
+		t.Errorf("1/0 should be +Inf")
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		expression string
+		position   int
+	}{
+		{"", 1},
+		{"1 +", 4},
+		{"(x + 1", 7},
+		{"x + 1)", 6},
+		{"y + 1", 1},
+		{"sin x", 5},
+		{"sin(x, 1)", 1},
+		{"pow(2)", 1},
+		{"foo(x)", 1},
+		{"2 3", 3},
+		{"x $ 2", 3},
+		{"1.2.3", 1},
+		{"1e999", 1},
+		{"x * * 2", 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			_, err := Parse(tt.expression, "x")
+			var syntaxErr *SyntaxError
+			if !errors.As(err, &syntaxErr) {
+				t.Fatalf("Parse(%q) error = %v, want *SyntaxError", tt.expression, err)
+			}
+			if syntaxErr.Position != tt.position {
+				t.Errorf("Parse(%q) position = %d, want %d (%v)", tt.expression, syntaxErr.Position, tt.position, err)
+			}
+		})
+	}
+}
+
+func TestParseLimits(t *testing.T) {
+	if _, err := Parse(strings.Repeat("x+", MaxLength/2)+"x", "x"); err == nil {
+		t.Error("Parse() expected error for overlong expression")
+	}
+	deep := strings.Repeat("(", MaxDepth+1) + "x" + strings.Repeat(")", MaxDepth+1)
+	if _, err := Parse(deep, "x"); err == nil {
+		t.Error("Parse() expected error for deeply nested expression")
+	}
+	if _, err := Parse(strings.Repeat("-", MaxDepth+1)+"x", "x"); err == nil {
+		t.Error("Parse() expected error for deeply nested unary operators")
+	}
+	if _, err := Parse(strings.Repeat("(", 50)+"x"+strings.Repeat(")", 50), "x"); err != nil {
+		t.Errorf("Parse() unexpected error for moderately nested expression: %v", err)
+	}
+}
@@ -0,0 +1,329 @@
+package expr
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// ErrNotPolynomial is returned (wrapped) when an expression cannot be expanded
+// into a polynomial with rational coefficients.
+var ErrNotPolynomial = errors.New("expression is not a polynomial")
+
+const (
+	// MaxPolynomialDegree bounds the total degree of an expanded polynomial.
+	MaxPolynomialDegree = 100
+	// maxPolynomialTerms bounds the number of monomials, which grows quickly
+	// when multivariate expressions are raised to powers.
+	maxPolynomialTerms = 10000
+	// maxCoefficientBits bounds the size of a constant raised to a power, so
+	// nested powers such as 10^100^100 cannot exhaust memory.
+	maxCoefficientBits = 1 << 16
+)
+
+// Polynomial is a polynomial in an expression's variables with exact rational
+// coefficients.
+type Polynomial struct {
+	variables int
+	terms     map[string]*monomial
+}
+
+type monomial struct {
+	exponents   []int
+	coefficient *big.Rat
+}
+
+func (m *monomial) degree() int {
+	total := 0
+	for _, e := range m.exponents {
+		total += e
+	}
+	return total
+}
+
+func monomialKey(exponents []int) string {
+	parts := make([]string, len(exponents))
+	for i, e := range exponents {
+		parts[i] = strconv.Itoa(e)
+	}
+	return strings.Join(parts, ",")
+}
+
+func newPolynomial(variables int) *Polynomial {
+	return &Polynomial{variables: variables, terms: make(map[string]*monomial)}
+}
+
+func constantPolynomial(variables int, c *big.Rat) *Polynomial {
+	p := newPolynomial(variables)
+	p.addTerm(make([]int, variables), c)
+	return p
+}
+
+func (p *Polynomial) addTerm(exponents []int, c *big.Rat) {
+	if c.Sign() == 0 {
+		return
+	}
+	key := monomialKey(exponents)
+	if term, ok := p.terms[key]; ok {
+		term.coefficient.Add(term.coefficient, c)
+		if term.coefficient.Sign() == 0 {
+			delete(p.terms, key)
+		}
+		return
+	}
+	p.terms[key] = &monomial{exponents: exponents, coefficient: new(big.Rat).Set(c)}
+}
+
+// Degree returns the total degree of p; the zero polynomial has degree -1.
+func (p *Polynomial) Degree() int {
+	degree := -1
+	for _, term := range p.terms {
+		degree = max(degree, term.degree())
+	}
+	return degree
+}
+
+// IsZero reports whether every coefficient of p is zero.
+func (p *Polynomial) IsZero() bool {
+	return len(p.terms) == 0
+}
+
+// Constant returns the constant term of p.
+func (p *Polynomial) Constant() *big.Rat {
+	if term, ok := p.terms[monomialKey(make([]int, p.variables))]; ok {
+		return new(big.Rat).Set(term.coefficient)
+	}
+	return new(big.Rat)
+}
+
+// Add returns p + q.
+func (p *Polynomial) Add(q *Polynomial) *Polynomial {
+	sum := newPolynomial(p.variables)
+	for _, term := range p.terms {
+		sum.addTerm(term.exponents, term.coefficient)
+	}
+	for _, term := range q.terms {
+		sum.addTerm(term.exponents, term.coefficient)
+	}
+	return sum
+}
+
+// Sub returns p - q.
+func (p *Polynomial) Sub(q *Polynomial) *Polynomial {
+	return p.Add(q.scale(big.NewRat(-1, 1)))
+}
+
+func (p *Polynomial) scale(c *big.Rat) *Polynomial {
+	scaled := newPolynomial(p.variables)
+	for _, term := range p.terms {
+		scaled.addTerm(term.exponents, new(big.Rat).Mul(term.coefficient, c))
+	}
+	return scaled
+}
+
+func (p *Polynomial) mul(q *Polynomial) (*Polynomial, error) {
+	if p.Degree()+q.Degree() > MaxPolynomialDegree {
+		return nil, fmt.Errorf("%w: degree exceeds %d", ErrNotPolynomial, MaxPolynomialDegree)
+	}
+	product := newPolynomial(p.variables)
+	for _, a := range p.terms {
+		for _, b := range q.terms {
+			exponents := make([]int, p.variables)
+			for i := range exponents {
+				exponents[i] = a.exponents[i] + b.exponents[i]
+			}
+			product.addTerm(exponents, new(big.Rat).Mul(a.coefficient, b.coefficient))
+		}
+		if len(product.terms) > maxPolynomialTerms {
+			return nil, fmt.Errorf("%w: expansion exceeds %d terms", ErrNotPolynomial, maxPolynomialTerms)
+		}
+	}
+	return product, nil
+}
+
+// Univariate returns the coefficients of p in its only variable, highest
+// degree first. It fails if p depends on more than one variable.
+func (p *Polynomial) Univariate() ([]*big.Rat, error) {
+	if p.variables > 1 {
+		for _, term := range p.terms {
+			for i := 1; i < p.variables; i++ {
+				if term.exponents[i] != 0 {
+					return nil, fmt.Errorf("%w: expected a single variable", ErrNotPolynomial)
+				}
+			}
+		}
+	}
+
+	degree := max(p.Degree(), 0)
+	coefficients := make([]*big.Rat, degree+1)
+	for i := range coefficients {
+		coefficients[i] = new(big.Rat)
+	}
+	for _, term := range p.terms {
+		e := 0
+		if p.variables > 0 {
+			e = term.exponents[0]
+		}
+		coefficients[degree-e].Set(term.coefficient)
+	}
+	return coefficients, nil
+}
+
+// Linear returns the coefficient of each variable and the constant term of a
+// polynomial of degree at most one.
+func (p *Polynomial) Linear() (coefficients []*big.Rat, constant *big.Rat, err error) {
+	if p.Degree() > 1 {
+		return nil, nil, fmt.Errorf("%w of degree at most 1: found degree %d", ErrNotPolynomial, p.Degree())
+	}
+	coefficients = make([]*big.Rat, p.variables)
+	for i := range coefficients {
+		coefficients[i] = new(big.Rat)
+	}
+	for _, term := range p.terms {
+		for i, e := range term.exponents {
+			if e == 1 {
+				coefficients[i].Set(term.coefficient)
+			}
+		}
+	}
+	return coefficients, p.Constant(), nil
+}
+
+// Polynomial expands the expression into a polynomial with rational
+// coefficients. Decimal literals are taken exactly, so "0.1x" has coefficient
+// 1/10. Functions, irrational constants, division by a non-constant and
+// non-integer or negative powers of a variable are rejected with
+// ErrNotPolynomial.
+func (e *Expr) Polynomial() (*Polynomial, error) {
+	return expand(e.root, len(e.variables))
+}
+
+func expand(n node, variables int) (*Polynomial, error) {
+	switch n := n.(type) {
+	case numberNode:
+		if !n.exact {
+			return nil, fmt.Errorf("%w: constant %s is not rational", ErrNotPolynomial, n.literal)
+		}
+		c, ok := new(big.Rat).SetString(n.literal)
+		if !ok {
+			return nil, fmt.Errorf("%w: invalid number %q", ErrNotPolynomial, n.literal)
+		}
+		return constantPolynomial(variables, c), nil
+	case variableNode:
+		exponents := make([]int, variables)
+		exponents[n] = 1
+		p := newPolynomial(variables)
+		p.addTerm(exponents, big.NewRat(1, 1))
+		return p, nil
+	case negateNode:
+		operand, err := expand(n.operand, variables)
+		if err != nil {
+			return nil, err
+		}
+		return operand.scale(big.NewRat(-1, 1)), nil
+	case callNode:
+		return nil, fmt.Errorf("%w: contains function %s", ErrNotPolynomial, n.name)
+	case binaryNode:
+		left, err := expand(n.left, variables)
+		if err != nil {
+			return nil, err
+		}
+		right, err := expand(n.right, variables)
+		if err != nil {
+			return nil, err
+		}
+		switch n.op {
+		case '+':
+			return left.Add(right), nil
+		case '-':
+			return left.Sub(right), nil
+		case '*':
+			return left.mul(right)
+		case '/':
+			if right.Degree() > 0 {
+				return nil, fmt.Errorf("%w: division by an expression containing a variable", ErrNotPolynomial)
+			}
+			if right.IsZero() {
+				return nil, fmt.Errorf("%w: division by zero", ErrNotPolynomial)
+			}
+			return left.scale(new(big.Rat).Inv(right.Constant())), nil
+		default:
+			return power(left, right)
+		}
+	default:
+		return nil, fmt.Errorf("%w: unsupported expression", ErrNotPolynomial)
+	}
+}
+
+func power(base, exponent *Polynomial) (*Polynomial, error) {
+	if exponent.Degree() > 0 {
+		return nil, fmt.Errorf("%w: exponent contains a variable", ErrNotPolynomial)
+	}
+	k := exponent.Constant()
+	if !k.IsInt() || k.Num().CmpAbs(big.NewInt(MaxPolynomialDegree)) > 0 {
+		return nil, fmt.Errorf("%w: exponent must be an integer between -%d and %d, got %s", ErrNotPolynomial, MaxPolynomialDegree, MaxPolynomialDegree, k.RatString())
+	}
+	n := int(k.Num().Int64())
+
+	if base.Degree() <= 0 {
+		c := base.Constant()
+		if n < 0 {
+			if c.Sign() == 0 {
+				return nil, fmt.Errorf("%w: division by zero", ErrNotPolynomial)
+			}
+			c.Inv(c)
+			n = -n
+		}
+		if bits := max(c.Num().BitLen(), c.Denom().BitLen()); bits*n > maxCoefficientBits {
+			return nil, fmt.Errorf("%w: constant power is too large", ErrNotPolynomial)
+		}
+		result := big.NewRat(1, 1)
+		for i := 0; i < n; i++ {
+			result.Mul(result, c)
+		}
+		return constantPolynomial(base.variables, result), nil
+	}
+
+	if n < 0 {
+		return nil, fmt.Errorf("%w: negative power of a variable", ErrNotPolynomial)
+	}
+	result := constantPolynomial(base.variables, big.NewRat(1, 1))
+	for i := 0; i < n; i++ {
+		var err error
+		if result, err = result.mul(base); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// Names returns the variable names used in s, in order of first appearance:
+// every name that is not a function or one of the constants pi, tau and e.
+func Names(s string) ([]string, error) {
+	if len(s) > MaxLength {
+		return nil, &SyntaxError{Position: MaxLength + 1, Message: fmt.Sprintf("expression must be at most %d characters", MaxLength)}
+	}
+	tokens, err := tokenize(s)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	seen := make(map[string]bool)
+	for _, tok := range tokens {
+		if tok.kind != tokenIdent || seen[tok.text] {
+			continue
+		}
+		if _, ok := functions[tok.text]; ok {
+			continue
+		}
+		if _, ok := constants[tok.text]; ok {
+			continue
+		}
+		seen[tok.text] = true
+		names = append(names, tok.text)
+	}
+	return names, nil
+}
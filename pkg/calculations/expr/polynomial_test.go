@@ -0,0 +1,118 @@
+package expr
+
+import (
+	"errors"
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+func ratStrings(values []*big.Rat) []string {
+	s := make([]string, len(values))
+	for i, v := range values {
+		s[i] = v.RatString()
+	}
+	return s
+}
+
+func TestPolynomialUnivariate(t *testing.T) {
+	tests := []struct {
+		expression string
+		expected   []string
+	}{
+		{"x^2 - 5x + 6", []string{"1", "-5", "6"}},
+		{"(x - 1)(x + 1)", []string{"1", "0", "-1"}},
+		{"(x + 1)^3", []string{"1", "3", "3", "1"}},
+		{"0.1x + 1/3", []string{"1/10", "1/3"}},
+		{"x^2 / 4 - 2^-1", []string{"1/4", "0", "-1/2"}},
+		{"1.5e1", []string{"15"}},
+		{"x - x", []string{"0"}},
+		{"-(2x)^2", []string{"-4", "0", "0"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			e, err := Parse(tt.expression, "x")
+			if err != nil {
+				t.Fatalf("Parse() unexpected error: %v", err)
+			}
+			p, err := e.Polynomial()
+			if err != nil {
+				t.Fatalf("Polynomial() unexpected error: %v", err)
+			}
+			coefficients, err := p.Univariate()
+			if err != nil {
+				t.Fatalf("Univariate() unexpected error: %v", err)
+			}
+			if got := ratStrings(coefficients); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("Univariate() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestPolynomialErrors(t *testing.T) {
+	tests := []string{
+		"sin(x)",
+		"pi * x",
+		"1 / x",
+		"x^0.5",
+		"x^-1",
+		"2^x",
+		"x / (1 - 1)",
+		"x^60 * x^60",
+		"10^100^100",
+	}
+
+	for _, expression := range tests {
+		t.Run(expression, func(t *testing.T) {
+			e, err := Parse(expression, "x")
+			if err != nil {
+				t.Fatalf("Parse() unexpected error: %v", err)
+			}
+			if _, err := e.Polynomial(); !errors.Is(err, ErrNotPolynomial) {
+				t.Errorf("Polynomial() error = %v, want ErrNotPolynomial", err)
+			}
+		})
+	}
+}
+
+func TestPolynomialLinear(t *testing.T) {
+	e, err := Parse("2x + 3y - 5 - (x - y)", "x", "y")
+	if err != nil {
+		t.Fatalf("Parse() unexpected error: %v", err)
+	}
+	p, err := e.Polynomial()
+	if err != nil {
+		t.Fatalf("Polynomial() unexpected error: %v", err)
+	}
+	coefficients, constant, err := p.Linear()
+	if err != nil {
+		t.Fatalf("Linear() unexpected error: %v", err)
+	}
+	if got := ratStrings(coefficients); !reflect.DeepEqual(got, []string{"1", "4"}) || constant.RatString() != "-5" {
+		t.Errorf("Linear() = %v, %s", got, constant.RatString())
+	}
+	if _, err := p.Univariate(); !errors.Is(err, ErrNotPolynomial) {
+		t.Errorf("Univariate() error = %v, want ErrNotPolynomial", err)
+	}
+
+	e, _ = Parse("x*y + 1", "x", "y")
+	p, _ = e.Polynomial()
+	if _, _, err := p.Linear(); !errors.Is(err, ErrNotPolynomial) {
+		t.Errorf("Linear() error = %v, want ErrNotPolynomial", err)
+	}
+}
+
+func TestNames(t *testing.T) {
+	names, err := Names("2y + sin(x) - pi*y + e^z")
+	if err != nil {
+		t.Fatalf("Names() unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(names, []string{"y", "x", "z"}) {
+		t.Errorf("Names() = %v", names)
+	}
+	if _, err := Names("2 $ x"); err == nil {
+		t.Error("Names() expected error for invalid character")
+	}
+}
@@ -5,6 +5,9 @@ import (
 	"math"
 )
 
+// CurrencyRounding is the default rounding of monetary results: cents, half-up.
+var CurrencyRounding = DecimalPlaces(2)
+
 // CalculateVAT calculates VAT tax for a given amount and rate.
 // If inclusive is true, it extracts VAT from the amount (amount already includes VAT).
 // If inclusive is false, it adds VAT to the amount (amount is net).
@@ -64,11 +67,11 @@ func CalculateVAT(amount, rate float64, inclusive bool) (float64, float64, float
 // - Rate remains constant throughout the investment period
 //
 // Precision: Uses float64 arithmetic. For very long time periods or high compound
-// frequencies, floating-point errors may accumulate. Results are rounded to 2 decimal
-// places for practical financial use.
+// frequencies, floating-point errors may accumulate. Results are rounded with
+// rounding, normally CurrencyRounding for practical financial use.
 //
 // Returns: (finalAmount, interestEarned, error)
-func CalculateCompoundInterest(principal, rate, time float64, compoundFrequency int) (float64, float64, error) {
+func CalculateCompoundInterest(principal, rate, time float64, compoundFrequency int, rounding Rounding) (float64, float64, error) {
 	if principal < 0 {
 		return 0, 0, fmt.Errorf("principal cannot be negative")
 	}
@@ -91,8 +94,8 @@ func CalculateCompoundInterest(principal, rate, time float64, compoundFrequency
 	finalAmount := principal * math.Pow(base, exponent)
 	interestEarned := finalAmount - principal
 
-	finalAmount = math.Round(finalAmount*100) / 100
-	interestEarned = math.Round(interestEarned*100) / 100
+	finalAmount = rounding.Round(finalAmount)
+	interestEarned = rounding.Round(interestEarned)
 
 	return finalAmount, interestEarned, nil
 }
@@ -118,10 +121,11 @@ func CalculateCompoundInterest(principal, rate, time float64, compoundFrequency
 //
 // Precision: Uses float64 arithmetic. Monthly payment calculations should be
 // accurate for typical loan amounts and terms. For exact amortization schedules,
-// consider using a decimal library.
+// consider using a decimal library. The payment is rounded with rounding,
+// normally CurrencyRounding, before the totals are derived from it.
 //
 // Returns: (paymentAmount, totalPayment, totalInterest, error)
-func CalculateLoanPayment(principal, annualRate, years float64, paymentsPerYear int) (float64, float64, float64, error) {
+func CalculateLoanPayment(principal, annualRate, years float64, paymentsPerYear int, rounding Rounding) (float64, float64, float64, error) {
 	if principal < 0 {
 		return 0, 0, 0, fmt.Errorf("principal cannot be negative")
 	}
@@ -140,10 +144,10 @@ func CalculateLoanPayment(principal, annualRate, years float64, paymentsPerYear
 	// Special case: zero interest rate
 	if annualRate == 0 {
 		paymentAmount := principal / totalPayments
-		paymentAmount = math.Round(paymentAmount*100) / 100
+		paymentAmount = rounding.Round(paymentAmount)
 		// For a 0% loan, totalPayment should exactly match the principal (to cents),
 		// and totalInterest must be 0, regardless of per-period rounding.
-		totalPayment := math.Round(principal*100) / 100
+		totalPayment := rounding.Round(principal)
 		totalInterest := 0.0
 		return paymentAmount, totalPayment, totalInterest, nil
 	}
@@ -155,13 +159,13 @@ func CalculateLoanPayment(principal, annualRate, years float64, paymentsPerYear
 	powerN := math.Pow(onePlusR, totalPayments)
 	paymentAmount := principal * (ratePerPeriod * powerN) / (powerN - 1)
 
-	paymentAmount = math.Round(paymentAmount*100) / 100
+	paymentAmount = rounding.Round(paymentAmount)
 
 	totalPayment := paymentAmount * totalPayments
 	totalInterest := totalPayment - principal
 
-	totalPayment = math.Round(totalPayment*100) / 100
-	totalInterest = math.Round(totalInterest*100) / 100
+	totalPayment = rounding.Round(totalPayment)
+	totalInterest = rounding.Round(totalInterest)
 
 	return paymentAmount, totalPayment, totalInterest, nil
 }
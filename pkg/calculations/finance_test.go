@@ -298,6 +298,7 @@ func TestCalculateCompoundInterest(t *testing.T) {
 				tt.rate,
 				tt.time,
 				tt.compoundFrequency,
+				CurrencyRounding,
 			)
 
 			if (err != nil) != tt.expectError {
@@ -476,6 +477,7 @@ func TestCalculateLoanPayment(t *testing.T) {
 				tt.annualRate,
 				tt.years,
 				tt.paymentsPerYear,
+				CurrencyRounding,
 			)
 
 			if (err != nil) != tt.expectError {
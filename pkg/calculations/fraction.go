@@ -0,0 +1,211 @@
+package calculations
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// NumberMode selects how the basic math operations represent numbers.
+type NumberMode string
+
+const (
+	ModeDecimal  NumberMode = "decimal"
+	ModeFraction NumberMode = "fraction"
+	// ModeUncertainty propagates standard uncertainties to first order.
+	ModeUncertainty NumberMode = "uncertainty"
+	// ModeInterval bounds results with interval arithmetic.
+	ModeInterval NumberMode = "interval"
+)
+
+// ParseNumberMode normalizes a mode string. An empty string defaults to decimal.
+func ParseNumberMode(mode string) (NumberMode, error) {
+	switch strings.ToLower(strings.TrimSpace(mode)) {
+	case "", string(ModeDecimal):
+		return ModeDecimal, nil
+	case string(ModeFraction):
+		return ModeFraction, nil
+	case string(ModeUncertainty):
+		return ModeUncertainty, nil
+	case string(ModeInterval):
+		return ModeInterval, nil
+	default:
+		return "", fmt.Errorf("invalid mode: %s (valid modes: decimal, fraction, uncertainty, interval)", mode)
+	}
+}
+
+const (
+	// maxFractionLength bounds the text accepted by ParseFraction.
+	maxFractionLength = 256
+	// maxFractionExponent bounds scientific notation exponents, since big.Rat
+	// would otherwise expand "1e1000000000" into a billion-digit integer.
+	maxFractionExponent = 300
+)
+
+// ParseFraction parses an exact rational number. Accepted forms are integers
+// ("5"), fractions ("-3/4"), mixed numbers ("2 1/4", "-2 1/4" = -9/4) and
+// decimals ("0.75", "1.5e-3"), which are converted exactly.
+func ParseFraction(s string) (*big.Rat, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, fmt.Errorf("empty fraction")
+	}
+	if len(s) > maxFractionLength {
+		return nil, fmt.Errorf("fraction must be at most %d characters", maxFractionLength)
+	}
+
+	if parts := strings.Fields(s); len(parts) == 2 {
+		return parseMixedNumber(parts[0], parts[1], s)
+	} else if len(parts) > 2 {
+		return nil, fmt.Errorf("invalid fraction %q", s)
+	}
+
+	if num, den, ok := strings.Cut(s, "/"); ok {
+		return parseSimpleFraction(num, den, s)
+	}
+
+	// big.Rat also accepts binary exponents ("1p4") and base prefixes
+	// ("0x10"), which would bypass the exponent bound below, so only plain
+	// decimals reach it.
+	mantissa, exponent, hasExponent := strings.Cut(s, "e")
+	if !hasExponent {
+		mantissa, exponent, hasExponent = strings.Cut(s, "E")
+	}
+	if !isDecimalDigits(strings.TrimPrefix(strings.TrimPrefix(mantissa, "-"), "+")) {
+		return nil, fmt.Errorf("invalid fraction %q (expected forms like 3, 3/4, 2 1/4 or 0.75)", s)
+	}
+	if hasExponent {
+		e, err := strconv.Atoi(exponent)
+		if err != nil || e < -maxFractionExponent || e > maxFractionExponent {
+			return nil, fmt.Errorf("invalid fraction %q: exponent must be an integer between -%d and %d", s, maxFractionExponent, maxFractionExponent)
+		}
+	}
+
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return nil, fmt.Errorf("invalid fraction %q (expected forms like 3, 3/4, 2 1/4 or 0.75)", s)
+	}
+	return r, nil
+}
+
+// parseSimpleFraction parses "n/d" in base 10; big.Rat would read a leading
+// zero in the numerator as octal.
+func parseSimpleFraction(num, den, s string) (*big.Rat, error) {
+	negative := strings.HasPrefix(num, "-")
+	digits := strings.TrimPrefix(strings.TrimPrefix(num, "-"), "+")
+	if len(num)-len(digits) > 1 || !isUnsignedDigits(digits) || !isUnsignedDigits(den) {
+		return nil, fmt.Errorf("invalid fraction %q (expected forms like 3, 3/4, 2 1/4 or 0.75)", s)
+	}
+
+	n, _ := new(big.Int).SetString(digits, 10)
+	d, _ := new(big.Int).SetString(den, 10)
+	if d.Sign() == 0 {
+		return nil, fmt.Errorf("invalid fraction %q: denominator is zero", s)
+	}
+	if negative {
+		n.Neg(n)
+	}
+	return new(big.Rat).SetFrac(n, d), nil
+}
+
+// parseMixedNumber parses "w n/d", where the sign of w applies to the whole value.
+func parseMixedNumber(whole, frac, s string) (*big.Rat, error) {
+	negative := strings.HasPrefix(whole, "-")
+	digits := strings.TrimLeft(whole, "+-")
+	num, den, ok := strings.Cut(frac, "/")
+	if !ok || len(whole)-len(digits) > 1 || !isUnsignedDigits(digits) || !isUnsignedDigits(num) || !isUnsignedDigits(den) {
+		return nil, fmt.Errorf("invalid mixed number %q (expected a form like 2 1/4)", s)
+	}
+
+	w, _ := new(big.Int).SetString(digits, 10)
+	n, _ := new(big.Int).SetString(num, 10)
+	d, _ := new(big.Int).SetString(den, 10)
+	if d.Sign() == 0 {
+		return nil, fmt.Errorf("invalid mixed number %q: denominator is zero", s)
+	}
+	if n.Cmp(d) >= 0 {
+		return nil, fmt.Errorf("invalid mixed number %q: fractional part must be less than 1", s)
+	}
+
+	r := new(big.Rat).SetFrac(n, d)
+	r.Add(r, new(big.Rat).SetInt(w))
+	if negative {
+		r.Neg(r)
+	}
+	return r, nil
+}
+
+// isDecimalDigits reports whether s is an unsigned decimal such as "12",
+// "1.5", "5." or ".5".
+func isDecimalDigits(s string) bool {
+	whole, frac, _ := strings.Cut(s, ".")
+	if whole == "" && frac == "" {
+		return false
+	}
+	return (whole == "" || isUnsignedDigits(whole)) && (frac == "" || isUnsignedDigits(frac))
+}
+
+func isUnsignedDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func AddFractions(a, b *big.Rat) *big.Rat {
+	return new(big.Rat).Add(a, b)
+}
+
+func SubtractFractions(a, b *big.Rat) *big.Rat {
+	return new(big.Rat).Sub(a, b)
+}
+
+func MultiplyFractions(a, b *big.Rat) *big.Rat {
+	return new(big.Rat).Mul(a, b)
+}
+
+func DivideFractions(a, b *big.Rat) (*big.Rat, error) {
+	if b.Sign() == 0 {
+		return nil, fmt.Errorf("division by zero")
+	}
+	return new(big.Rat).Quo(a, b), nil
+}
+
+// FormatFraction returns r in lowest terms, e.g. "9/4", "-1/3" or "5".
+func FormatFraction(r *big.Rat) string {
+	return r.RatString()
+}
+
+// FormatMixedNumber returns r as a mixed number, e.g. "2 1/4" or "-2 1/4".
+// Proper fractions and integers are returned as "3/4" and "5".
+func FormatMixedNumber(r *big.Rat) string {
+	if r.IsInt() {
+		return r.Num().String()
+	}
+	num := new(big.Int).Abs(r.Num())
+	whole, rem := new(big.Int).QuoRem(num, r.Denom(), new(big.Int))
+	sign := ""
+	if r.Sign() < 0 {
+		sign = "-"
+	}
+	if whole.Sign() == 0 {
+		return fmt.Sprintf("%s%s/%s", sign, rem, r.Denom())
+	}
+	return fmt.Sprintf("%s%s %s/%s", sign, whole, rem, r.Denom())
+}
+
+// FractionToFloat returns the float64 nearest to r.
+func FractionToFloat(r *big.Rat) (float64, error) {
+	f, _ := r.Float64()
+	if math.IsInf(f, 0) {
+		return 0, fmt.Errorf("%w: fraction is outside the float64 range", ErrDomain)
+	}
+	return f, nil
+}
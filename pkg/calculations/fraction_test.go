@@ -0,0 +1,136 @@
+package calculations
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func TestParseFraction(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+		wantErr  bool
+	}{
+		{"5", "5", false},
+		{"-3/4", "-3/4", false},
+		{"6/8", "3/4", false},
+		{"2 1/4", "9/4", false},
+		{"-2 1/4", "-9/4", false},
+		{"  1   1/2 ", "3/2", false},
+		{"0.75", "3/4", false},
+		{"1.5e-3", "3/2000", false},
+		{"", "", true},
+		{"1/0", "", true},
+		{"2 0/0", "", true},
+		{"2 5/4", "", true},
+		{"2 -1/4", "", true},
+		{"1/3/4", "", true},
+		{"1 2 3/4", "", true},
+		{"one third", "", true},
+		{"1e1000000000", "", true},
+		{"010/3", "10/3", false},
+		{"+3/4", "3/4", false},
+		{".5", "1/2", false},
+		{"1p10000000", "", true},
+		{"1P4", "", true},
+		{"0x10", "", true},
+		{"0b11/3", "", true},
+		{"0o17", "", true},
+		{"1_000", "", true},
+		{"1/-2", "", true},
+		{"--1/2", "", true},
+		{"1.2.3", "", true},
+		{".", "", true},
+		{"1e", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			r, err := ParseFraction(tt.input)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ParseFraction(%q) = %v, want error", tt.input, r)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseFraction(%q) unexpected error: %v", tt.input, err)
+			}
+			if r.RatString() != tt.expected {
+				t.Errorf("ParseFraction(%q) = %s, want %s", tt.input, r.RatString(), tt.expected)
+			}
+		})
+	}
+}
+
+func TestFractionArithmetic(t *testing.T) {
+	third := big.NewRat(1, 3)
+	sixth := big.NewRat(1, 6)
+
+	if r := AddFractions(third, sixth); r.RatString() != "1/2" {
+		t.Errorf("1/3 + 1/6 = %s, want 1/2", r.RatString())
+	}
+	if r := SubtractFractions(sixth, third); r.RatString() != "-1/6" {
+		t.Errorf("1/6 - 1/3 = %s, want -1/6", r.RatString())
+	}
+	if r := MultiplyFractions(third, big.NewRat(3, 1)); r.RatString() != "1" {
+		t.Errorf("1/3 * 3 = %s, want 1", r.RatString())
+	}
+	if r, err := DivideFractions(big.NewRat(1, 1), big.NewRat(3, 1)); err != nil || r.RatString() != "1/3" {
+		t.Errorf("1 / 3 = %v, %v, want 1/3", r, err)
+	}
+	if _, err := DivideFractions(third, new(big.Rat)); err == nil {
+		t.Error("DivideFractions() by zero expected error")
+	}
+}
+
+func TestFormatMixedNumber(t *testing.T) {
+	tests := []struct {
+		r        *big.Rat
+		expected string
+	}{
+		{big.NewRat(9, 4), "2 1/4"},
+		{big.NewRat(-9, 4), "-2 1/4"},
+		{big.NewRat(3, 4), "3/4"},
+		{big.NewRat(-1, 3), "-1/3"},
+		{big.NewRat(10, 2), "5"},
+		{new(big.Rat), "0"},
+	}
+
+	for _, tt := range tests {
+		if got := FormatMixedNumber(tt.r); got != tt.expected {
+			t.Errorf("FormatMixedNumber(%s) = %q, want %q", tt.r.RatString(), got, tt.expected)
+		}
+	}
+}
+
+func TestFractionToFloat(t *testing.T) {
+	if f, err := FractionToFloat(big.NewRat(1, 3)); err != nil || !almostEqual(f, 1.0/3, 1e-15) {
+		t.Errorf("FractionToFloat(1/3) = %v, %v", f, err)
+	}
+
+	huge, _ := ParseFraction("1e300")
+	huge.Mul(huge, huge)
+	if _, err := FractionToFloat(huge); !errors.Is(err, ErrDomain) {
+		t.Errorf("FractionToFloat(1e600) error = %v, want ErrDomain", err)
+	}
+}
+
+func TestParseNumberMode(t *testing.T) {
+	if mode, err := ParseNumberMode(""); err != nil || mode != ModeDecimal {
+		t.Errorf("ParseNumberMode(\"\") = %v, %v, want decimal", mode, err)
+	}
+	if mode, err := ParseNumberMode(" Fraction "); err != nil || mode != ModeFraction {
+		t.Errorf("ParseNumberMode(\"Fraction\") = %v, %v, want fraction", mode, err)
+	}
+	if mode, err := ParseNumberMode("uncertainty"); err != nil || mode != ModeUncertainty {
+		t.Errorf("ParseNumberMode(\"uncertainty\") = %v, %v, want uncertainty", mode, err)
+	}
+	if mode, err := ParseNumberMode("INTERVAL"); err != nil || mode != ModeInterval {
+		t.Errorf("ParseNumberMode(\"INTERVAL\") = %v, %v, want interval", mode, err)
+	}
+	if _, err := ParseNumberMode("exact"); err == nil {
+		t.Error("ParseNumberMode(\"exact\") expected error")
+	}
+}
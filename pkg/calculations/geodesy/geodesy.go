@@ -0,0 +1,241 @@
+// Package geodesy computes distances, bearings and positions between points
+// given by latitude and longitude. Great-circle results use a spherical Earth
+// of radius MeanRadius; Vincenty's formulae use the WGS-84 ellipsoid and are
+// accurate to within a millimetre. Coordinates and bearings are in degrees and
+// distances in metres.
+package geodesy
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// MeanRadius is the IUGG mean radius of the Earth in metres.
+const MeanRadius = 6371008.8
+
+// WGS-84 ellipsoid.
+const (
+	semiMajorAxis = 6378137.0
+	flattening    = 1 / 298.257223563
+	semiMinorAxis = semiMajorAxis * (1 - flattening)
+)
+
+const (
+	vincentyTolerance     = 1e-12
+	vincentyMaxIterations = 200
+)
+
+// ErrNoConvergence is returned by Vincenty's inverse formula for nearly
+// antipodal points, where the iteration does not converge.
+var ErrNoConvergence = errors.New("vincenty formula did not converge for nearly antipodal points")
+
+// Point is a position in degrees of latitude and longitude.
+type Point struct {
+	Lat float64
+	Lon float64
+}
+
+// Validate checks that the latitude is within [-90, 90] and the longitude
+// within [-180, 180].
+func (p Point) Validate() error {
+	if math.IsNaN(p.Lat) || p.Lat < -90 || p.Lat > 90 {
+		return fmt.Errorf("latitude must be between -90 and 90, got %v", p.Lat)
+	}
+	if math.IsNaN(p.Lon) || p.Lon < -180 || p.Lon > 180 {
+		return fmt.Errorf("longitude must be between -180 and 180, got %v", p.Lon)
+	}
+	return nil
+}
+
+// Path describes the shortest route between two points. Bearings are measured
+// clockwise from true north in [0, 360); the final bearing is the direction of
+// travel on arrival. Both bearings are 0 for coincident points.
+type Path struct {
+	Distance       float64
+	InitialBearing float64
+	FinalBearing   float64
+}
+
+func radians(deg float64) float64 { return deg * math.Pi / 180 }
+
+func degrees(rad float64) float64 { return rad * 180 / math.Pi }
+
+// bearing converts an angle in radians to degrees in [0, 360).
+func bearing(rad float64) float64 {
+	deg := math.Mod(degrees(rad)+360, 360)
+	if deg >= 360 {
+		return 0
+	}
+	return deg
+}
+
+// longitude wraps an angle in radians to degrees in [-180, 180].
+func longitude(rad float64) float64 {
+	return math.Remainder(degrees(rad), 360)
+}
+
+// Haversine returns the great-circle path between p and q.
+func Haversine(p, q Point) Path {
+	phi1, phi2 := radians(p.Lat), radians(q.Lat)
+	dPhi, dLambda := phi2-phi1, radians(q.Lon-p.Lon)
+
+	sinPhi, sinLambda := math.Sin(dPhi/2), math.Sin(dLambda/2)
+	a := sinPhi*sinPhi + math.Cos(phi1)*math.Cos(phi2)*sinLambda*sinLambda
+	distance := 2 * MeanRadius * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+	if distance == 0 {
+		return Path{}
+	}
+
+	return Path{
+		Distance:       distance,
+		InitialBearing: bearing(initialBearing(phi1, phi2, dLambda)),
+		FinalBearing:   bearing(initialBearing(phi2, phi1, -dLambda) + math.Pi),
+	}
+}
+
+func initialBearing(phi1, phi2, dLambda float64) float64 {
+	y := math.Sin(dLambda) * math.Cos(phi2)
+	x := math.Cos(phi1)*math.Sin(phi2) - math.Sin(phi1)*math.Cos(phi2)*math.Cos(dLambda)
+	return math.Atan2(y, x)
+}
+
+// Midpoint returns the point halfway along the great circle from p to q. The
+// midpoint of antipodal points is not unique.
+func Midpoint(p, q Point) Point {
+	phi1, phi2 := radians(p.Lat), radians(q.Lat)
+	lambda1, dLambda := radians(p.Lon), radians(q.Lon-p.Lon)
+
+	bx := math.Cos(phi2) * math.Cos(dLambda)
+	by := math.Cos(phi2) * math.Sin(dLambda)
+	phi := math.Atan2(math.Sin(phi1)+math.Sin(phi2), math.Hypot(math.Cos(phi1)+bx, by))
+	lambda := lambda1 + math.Atan2(by, math.Cos(phi1)+bx)
+	return Point{Lat: degrees(phi), Lon: longitude(lambda)}
+}
+
+// Destination returns the point reached by travelling distance metres along a
+// great circle from p with the given initial bearing, and the final bearing on
+// arrival.
+func Destination(p Point, initial, distance float64) (Point, float64) {
+	phi1, lambda1, theta := radians(p.Lat), radians(p.Lon), radians(initial)
+	delta := distance / MeanRadius
+
+	sinPhi2 := math.Sin(phi1)*math.Cos(delta) + math.Cos(phi1)*math.Sin(delta)*math.Cos(theta)
+	phi2 := math.Asin(math.Max(-1, math.Min(1, sinPhi2)))
+	y := math.Sin(theta) * math.Sin(delta) * math.Cos(phi1)
+	x := math.Cos(delta) - math.Sin(phi1)*sinPhi2
+	lambda2 := lambda1 + math.Atan2(y, x)
+
+	dest := Point{Lat: degrees(phi2), Lon: longitude(lambda2)}
+	final := bearing(initialBearing(phi2, phi1, lambda1-lambda2) + math.Pi)
+	return dest, final
+}
+
+// reducedLatitude returns the sine and cosine of the reduced latitude of phi.
+func reducedLatitude(phi float64) (sinU, cosU float64) {
+	tanU := (1 - flattening) * math.Tan(phi)
+	cosU = 1 / math.Sqrt(1+tanU*tanU)
+	return tanU * cosU, cosU
+}
+
+// series returns Vincenty's coefficients A and B for cos²α.
+func series(cosSqAlpha float64) (a, b float64) {
+	uSq := cosSqAlpha * (semiMajorAxis*semiMajorAxis - semiMinorAxis*semiMinorAxis) / (semiMinorAxis * semiMinorAxis)
+	a = 1 + uSq/16384*(4096+uSq*(-768+uSq*(320-175*uSq)))
+	b = uSq / 1024 * (256 + uSq*(-128+uSq*(74-47*uSq)))
+	return a, b
+}
+
+func deltaSigma(b, sinSigma, cosSigma, cos2SigmaM float64) float64 {
+	c2 := cos2SigmaM * cos2SigmaM
+	return b * sinSigma * (cos2SigmaM + b/4*(cosSigma*(-1+2*c2)-b/6*cos2SigmaM*(-3+4*sinSigma*sinSigma)*(-3+4*c2)))
+}
+
+// Vincenty returns the geodesic between p and q on the WGS-84 ellipsoid using
+// Vincenty's inverse formula.
+func Vincenty(p, q Point) (Path, error) {
+	sinU1, cosU1 := reducedLatitude(radians(p.Lat))
+	sinU2, cosU2 := reducedLatitude(radians(q.Lat))
+	l := radians(q.Lon - p.Lon)
+
+	lambda := l
+	var sinLambda, cosLambda, sinSigma, cosSigma, sigma, sinAlpha, cosSqAlpha, cos2SigmaM float64
+	converged := false
+	for i := 0; i < vincentyMaxIterations; i++ {
+		sinLambda, cosLambda = math.Sin(lambda), math.Cos(lambda)
+		sinSigma = math.Hypot(cosU2*sinLambda, cosU1*sinU2-sinU1*cosU2*cosLambda)
+		if sinSigma == 0 {
+			return Path{}, nil
+		}
+		cosSigma = sinU1*sinU2 + cosU1*cosU2*cosLambda
+		sigma = math.Atan2(sinSigma, cosSigma)
+		sinAlpha = cosU1 * cosU2 * sinLambda / sinSigma
+		cosSqAlpha = 1 - sinAlpha*sinAlpha
+		cos2SigmaM = 0 // both points on the equator
+		if cosSqAlpha != 0 {
+			cos2SigmaM = cosSigma - 2*sinU1*sinU2/cosSqAlpha
+		}
+		c := flattening / 16 * cosSqAlpha * (4 + flattening*(4-3*cosSqAlpha))
+		previous := lambda
+		lambda = l + (1-c)*flattening*sinAlpha*(sigma+c*sinSigma*(cos2SigmaM+c*cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)))
+		if math.Abs(lambda) > math.Pi+vincentyTolerance {
+			break
+		}
+		if math.Abs(lambda-previous) < vincentyTolerance {
+			converged = true
+			break
+		}
+	}
+	if !converged {
+		return Path{}, ErrNoConvergence
+	}
+
+	a, b := series(cosSqAlpha)
+	distance := semiMinorAxis * a * (sigma - deltaSigma(b, sinSigma, cosSigma, cos2SigmaM))
+
+	alpha1 := math.Atan2(cosU2*sinLambda, cosU1*sinU2-sinU1*cosU2*cosLambda)
+	alpha2 := math.Atan2(cosU1*sinLambda, -sinU1*cosU2+cosU1*sinU2*cosLambda)
+	return Path{Distance: distance, InitialBearing: bearing(alpha1), FinalBearing: bearing(alpha2)}, nil
+}
+
+// VincentyDestination returns the point reached by travelling distance metres
+// along a geodesic of the WGS-84 ellipsoid from p with the given initial
+// bearing, and the final bearing on arrival.
+func VincentyDestination(p Point, initial, distance float64) (Point, float64, error) {
+	alpha1 := radians(initial)
+	sinAlpha1, cosAlpha1 := math.Sin(alpha1), math.Cos(alpha1)
+	sinU1, cosU1 := reducedLatitude(radians(p.Lat))
+
+	sigma1 := math.Atan2(sinU1/cosU1, cosAlpha1)
+	sinAlpha := cosU1 * sinAlpha1
+	cosSqAlpha := 1 - sinAlpha*sinAlpha
+	a, b := series(cosSqAlpha)
+
+	sigma := distance / (semiMinorAxis * a)
+	var sinSigma, cosSigma, cos2SigmaM float64
+	converged := false
+	for i := 0; i < vincentyMaxIterations; i++ {
+		cos2SigmaM = math.Cos(2*sigma1 + sigma)
+		sinSigma, cosSigma = math.Sin(sigma), math.Cos(sigma)
+		previous := sigma
+		sigma = distance/(semiMinorAxis*a) + deltaSigma(b, sinSigma, cosSigma, cos2SigmaM)
+		if math.Abs(sigma-previous) < vincentyTolerance {
+			converged = true
+			break
+		}
+	}
+	if !converged {
+		return Point{}, 0, ErrNoConvergence
+	}
+	cos2SigmaM = math.Cos(2*sigma1 + sigma)
+	sinSigma, cosSigma = math.Sin(sigma), math.Cos(sigma)
+
+	x := sinU1*sinSigma - cosU1*cosSigma*cosAlpha1
+	phi2 := math.Atan2(sinU1*cosSigma+cosU1*sinSigma*cosAlpha1, (1-flattening)*math.Hypot(sinAlpha, x))
+	lambda := math.Atan2(sinSigma*sinAlpha1, cosU1*cosSigma-sinU1*sinSigma*cosAlpha1)
+	c := flattening / 16 * cosSqAlpha * (4 + flattening*(4-3*cosSqAlpha))
+	l := lambda - (1-c)*flattening*sinAlpha*(sigma+c*sinSigma*(cos2SigmaM+c*cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)))
+
+	dest := Point{Lat: degrees(phi2), Lon: longitude(radians(p.Lon) + l)}
+	return dest, bearing(math.Atan2(sinAlpha, -x)), nil
+}
@@ -0,0 +1,136 @@
+package geodesy
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+// dms converts degrees, minutes and seconds to decimal degrees.
+func dms(d, m, s float64) float64 {
+	if d < 0 {
+		return d - m/60 - s/3600
+	}
+	return d + m/60 + s/3600
+}
+
+// Vincenty's published example: Flinders Peak to Buninyong.
+var (
+	flindersPeak = Point{Lat: dms(-37, 57, 3.72030), Lon: dms(144, 25, 29.52440)}
+	buninyong    = Point{Lat: dms(-37, 39, 10.15610), Lon: dms(143, 55, 35.38390)}
+)
+
+func TestPointValidate(t *testing.T) {
+	valid := []Point{{0, 0}, {90, 180}, {-90, -180}}
+	for _, p := range valid {
+		if err := p.Validate(); err != nil {
+			t.Errorf("Validate(%v) unexpected error: %v", p, err)
+		}
+	}
+	invalid := []Point{{91, 0}, {0, -180.5}, {math.NaN(), 0}}
+	for _, p := range invalid {
+		if err := p.Validate(); err == nil {
+			t.Errorf("Validate(%v) expected error", p)
+		}
+	}
+}
+
+func TestHaversine(t *testing.T) {
+	tests := []struct {
+		name    string
+		p, q    Point
+		want    Path
+		epsilon float64
+	}{
+		{"quarter of the equator", Point{0, 0}, Point{0, 90}, Path{MeanRadius * math.Pi / 2, 90, 90}, 1e-6},
+		{"due north to the pole", Point{0, 10}, Point{90, 10}, Path{MeanRadius * math.Pi / 2, 0, 0}, 1e-6},
+		{"across the antimeridian", Point{0, 179.5}, Point{0, -179.5}, Path{MeanRadius * math.Pi / 180, 90, 90}, 1e-6},
+		{"coincident", Point{51.5, -0.1}, Point{51.5, -0.1}, Path{}, 0},
+		{"westbound", Point{10, 10}, Point{10, 0}, Path{1095015.7371, 270.8704, 269.1296}, 1e-3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Haversine(tt.p, tt.q)
+			if math.Abs(got.Distance-tt.want.Distance) > tt.epsilon*math.Max(1, tt.want.Distance) ||
+				math.Abs(got.InitialBearing-tt.want.InitialBearing) > 1e-4 ||
+				math.Abs(got.FinalBearing-tt.want.FinalBearing) > 1e-4 {
+				t.Errorf("Haversine() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMidpoint(t *testing.T) {
+	tests := []struct {
+		p, q, want Point
+	}{
+		{Point{0, 0}, Point{0, 90}, Point{0, 45}},
+		{Point{0, 170}, Point{0, -170}, Point{0, 180}},
+		{Point{-30, 20}, Point{30, 20}, Point{0, 20}},
+	}
+	for _, tt := range tests {
+		got := Midpoint(tt.p, tt.q)
+		if math.Abs(got.Lat-tt.want.Lat) > 1e-9 || math.Abs(math.Remainder(got.Lon-tt.want.Lon, 360)) > 1e-9 {
+			t.Errorf("Midpoint(%v, %v) = %v, want %v", tt.p, tt.q, got, tt.want)
+		}
+	}
+}
+
+func TestDestination(t *testing.T) {
+	dest, final := Destination(Point{0, 0}, 90, MeanRadius*math.Pi/2)
+	if math.Abs(dest.Lat) > 1e-9 || math.Abs(dest.Lon-90) > 1e-9 || math.Abs(final-90) > 1e-9 {
+		t.Errorf("Destination() = %v, %v, want (0, 90), 90", dest, final)
+	}
+
+	// Travelling along the path returned by Haversine arrives at its end.
+	p, q := Point{40.7128, -74.006}, Point{51.5074, -0.1278}
+	path := Haversine(p, q)
+	dest, final = Destination(p, path.InitialBearing, path.Distance)
+	if math.Abs(dest.Lat-q.Lat) > 1e-9 || math.Abs(dest.Lon-q.Lon) > 1e-9 || math.Abs(final-path.FinalBearing) > 1e-9 {
+		t.Errorf("Destination() = %v, %v, want %v, %v", dest, final, q, path.FinalBearing)
+	}
+}
+
+func TestVincenty(t *testing.T) {
+	path, err := Vincenty(flindersPeak, buninyong)
+	if err != nil {
+		t.Fatalf("Vincenty() unexpected error: %v", err)
+	}
+	if math.Abs(path.Distance-54972.271) > 1e-3 {
+		t.Errorf("distance = %v, want 54972.271", path.Distance)
+	}
+	if want := dms(306, 52, 5.37); math.Abs(path.InitialBearing-want) > 1e-5 {
+		t.Errorf("initial bearing = %v, want %v", path.InitialBearing, want)
+	}
+	if want := dms(307, 10, 25.07); math.Abs(path.FinalBearing-want) > 1e-5 {
+		t.Errorf("final bearing = %v, want %v", path.FinalBearing, want)
+	}
+
+	// One degree of longitude along the equator is an arc of the semi-major axis.
+	path, err = Vincenty(Point{0, 0}, Point{0, 1})
+	if err != nil || math.Abs(path.Distance-semiMajorAxis*math.Pi/180) > 1e-6 || path.InitialBearing != 90 {
+		t.Errorf("Vincenty() along the equator = %+v, %v", path, err)
+	}
+
+	if path, err := Vincenty(buninyong, buninyong); err != nil || path != (Path{}) {
+		t.Errorf("Vincenty() of coincident points = %+v, %v", path, err)
+	}
+
+	if _, err := Vincenty(Point{0, 0}, Point{0.5, 179.7}); !errors.Is(err, ErrNoConvergence) {
+		t.Errorf("Vincenty() of nearly antipodal points error = %v, want ErrNoConvergence", err)
+	}
+}
+
+func TestVincentyDestination(t *testing.T) {
+	dest, final, err := VincentyDestination(flindersPeak, dms(306, 52, 5.37), 54972.271)
+	if err != nil {
+		t.Fatalf("VincentyDestination() unexpected error: %v", err)
+	}
+	if math.Abs(dest.Lat-buninyong.Lat) > 1e-7 || math.Abs(dest.Lon-buninyong.Lon) > 1e-7 {
+		t.Errorf("destination = %v, want %v", dest, buninyong)
+	}
+	if want := dms(307, 10, 25.07); math.Abs(final-want) > 1e-5 {
+		t.Errorf("final bearing = %v, want %v", final, want)
+	}
+}
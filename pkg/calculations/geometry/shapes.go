@@ -0,0 +1,203 @@
+package geometry
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/m-szczepanski/gocalc-api/pkg/calculations"
+)
+
+var ErrTooFewVertices = errors.New("a polygon needs at least 3 vertices")
+
+type Orientation string
+
+const (
+	CounterClockwise Orientation = "counterclockwise"
+	Clockwise        Orientation = "clockwise"
+	Degenerate       Orientation = "degenerate" // all vertices are collinear
+)
+
+// PolygonMeasures describes a simple polygon.
+type PolygonMeasures struct {
+	Area        float64
+	Perimeter   float64
+	Orientation Orientation
+}
+
+// Polygon measures the polygon with the given 2D vertices in order. The area
+// comes from the shoelace formula, taken relative to the first vertex to limit
+// cancellation far from the origin; for a self-intersecting polygon it is the
+// net signed area.
+func Polygon(vertices []Vector) (PolygonMeasures, error) {
+	if len(vertices) < 3 {
+		return PolygonMeasures{}, fmt.Errorf("%w, got %d", ErrTooFewVertices, len(vertices))
+	}
+	for i, v := range vertices {
+		if err := Validate(v); err != nil {
+			return PolygonMeasures{}, fmt.Errorf("vertex %d: %w", i, err)
+		}
+		if len(v) != 2 {
+			return PolygonMeasures{}, fmt.Errorf("vertex %d: polygon vertices must be 2D, got %d components", i, len(v))
+		}
+	}
+
+	var twiceArea, perimeter float64
+	origin := vertices[0]
+	for i, v := range vertices {
+		next := vertices[(i+1)%len(vertices)]
+		x1, y1 := v[0]-origin[0], v[1]-origin[1]
+		x2, y2 := next[0]-origin[0], next[1]-origin[1]
+		twiceArea += x1*y2 - x2*y1
+		perimeter += math.Hypot(next[0]-v[0], next[1]-v[1])
+	}
+
+	measures := PolygonMeasures{Area: math.Abs(twiceArea) / 2, Perimeter: perimeter, Orientation: Degenerate}
+	switch {
+	case twiceArea > 0:
+		measures.Orientation = CounterClockwise
+	case twiceArea < 0:
+		measures.Orientation = Clockwise
+	}
+	return measures, nil
+}
+
+// CircleMeasures describes a circle of a given radius.
+type CircleMeasures struct {
+	Diameter      float64
+	Circumference float64
+	Area          float64
+}
+
+func Circle(radius float64) (CircleMeasures, error) {
+	if err := positive("radius", radius); err != nil {
+		return CircleMeasures{}, err
+	}
+	return CircleMeasures{
+		Diameter:      2 * radius,
+		Circumference: 2 * math.Pi * radius,
+		Area:          math.Pi * radius * radius,
+	}, nil
+}
+
+// Triangle is a solved triangle. Sides A, B and C are opposite the angles
+// Alpha, Beta and Gamma, which are in radians.
+type Triangle struct {
+	A, B, C            float64
+	Alpha, Beta, Gamma float64
+	Area               float64
+	Perimeter          float64
+}
+
+// SolveSSS solves a triangle from its three sides, which must satisfy the
+// strict triangle inequality.
+func SolveSSS(a, b, c float64) (Triangle, error) {
+	for _, side := range []struct {
+		name  string
+		value float64
+	}{{"a", a}, {"b", b}, {"c", c}} {
+		if err := positive("side "+side.name, side.value); err != nil {
+			return Triangle{}, err
+		}
+	}
+	if a >= b+c || b >= a+c || c >= a+b {
+		return Triangle{}, fmt.Errorf("%w: sides %g, %g and %g violate the triangle inequality", calculations.ErrDomain, a, b, c)
+	}
+
+	t := Triangle{A: a, B: b, C: c, Perimeter: a + b + c}
+	t.Alpha = angleFromSides(a, b, c)
+	t.Beta = angleFromSides(b, c, a)
+	t.Gamma = math.Pi - t.Alpha - t.Beta
+	t.Area = heron(a, b, c)
+	return t, nil
+}
+
+// SolveSAS solves a triangle from sides a and b and the angle gamma between
+// them.
+func SolveSAS(a, b, gamma float64) (Triangle, error) {
+	if err := positive("side a", a); err != nil {
+		return Triangle{}, err
+	}
+	if err := positive("side b", b); err != nil {
+		return Triangle{}, err
+	}
+	if err := interiorAngle("gamma", gamma); err != nil {
+		return Triangle{}, err
+	}
+
+	// c² = a² + b² - 2ab·cos γ, written as (a - b)² + 4ab·sin²(γ/2) so that
+	// small angles do not cancel.
+	s := math.Sin(gamma / 2)
+	c := math.Sqrt((a-b)*(a-b) + 4*a*b*s*s)
+	alpha := math.Atan2(a*math.Sin(gamma), b-a*math.Cos(gamma))
+	return Triangle{
+		A: a, B: b, C: c,
+		Alpha: alpha, Beta: math.Pi - alpha - gamma, Gamma: gamma,
+		Area:      a * b * math.Sin(gamma) / 2,
+		Perimeter: a + b + c,
+	}, nil
+}
+
+// SolveASA solves a triangle from angles alpha and beta and the side c
+// between them.
+func SolveASA(alpha, c, beta float64) (Triangle, error) {
+	if err := interiorAngle("alpha", alpha); err != nil {
+		return Triangle{}, err
+	}
+	if err := interiorAngle("beta", beta); err != nil {
+		return Triangle{}, err
+	}
+	if err := positive("side c", c); err != nil {
+		return Triangle{}, err
+	}
+	gamma := math.Pi - alpha - beta
+	if gamma <= 0 {
+		return Triangle{}, fmt.Errorf("%w: alpha and beta must add up to less than π", calculations.ErrDomain)
+	}
+
+	ratio := c / math.Sin(gamma)
+	a, b := ratio*math.Sin(alpha), ratio*math.Sin(beta)
+	return Triangle{
+		A: a, B: b, C: c,
+		Alpha: alpha, Beta: beta, Gamma: gamma,
+		Area:      a * b * math.Sin(gamma) / 2,
+		Perimeter: a + b + c,
+	}, nil
+}
+
+// angleFromSides returns the angle opposite side a by the law of cosines.
+func angleFromSides(a, b, c float64) float64 {
+	cos := (b*b + c*c - a*a) / (2 * b * c)
+	return math.Acos(math.Max(-1, math.Min(1, cos)))
+}
+
+// heron returns the area of a triangle from its sides with Kahan's
+// rearrangement of Heron's formula, which stays accurate for needle-like
+// triangles.
+func heron(a, b, c float64) float64 {
+	// Sort so that a >= b >= c.
+	if a < b {
+		a, b = b, a
+	}
+	if b < c {
+		b, c = c, b
+	}
+	if a < b {
+		a, b = b, a
+	}
+	return math.Sqrt((a+(b+c))*(c-(a-b))*(c+(a-b))*(a+(b-c))) / 4
+}
+
+func positive(name string, x float64) error {
+	if math.IsNaN(x) || math.IsInf(x, 0) || x <= 0 {
+		return fmt.Errorf("%s must be a positive number, got %v", name, x)
+	}
+	return nil
+}
+
+func interiorAngle(name string, x float64) error {
+	if math.IsNaN(x) || x <= 0 || x >= math.Pi {
+		return fmt.Errorf("%s must be strictly between 0 and π radians, got %v", name, x)
+	}
+	return nil
+}
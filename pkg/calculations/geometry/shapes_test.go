@@ -0,0 +1,121 @@
+package geometry
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/m-szczepanski/gocalc-api/pkg/calculations"
+)
+
+func TestPolygon(t *testing.T) {
+	tests := []struct {
+		name        string
+		vertices    []Vector
+		area        float64
+		perimeter   float64
+		orientation Orientation
+	}{
+		{"unit square", []Vector{{0, 0}, {1, 0}, {1, 1}, {0, 1}}, 1, 4, CounterClockwise},
+		{"clockwise triangle", []Vector{{0, 0}, {0, 3}, {4, 0}}, 6, 12, Clockwise},
+		{"concave L shape", []Vector{{0, 0}, {2, 0}, {2, 1}, {1, 1}, {1, 2}, {0, 2}}, 3, 8, CounterClockwise},
+		{"far from origin", []Vector{{1e8, 1e8}, {1e8 + 1, 1e8}, {1e8 + 1, 1e8 + 1}, {1e8, 1e8 + 1}}, 1, 4, CounterClockwise},
+		{"collinear", []Vector{{0, 0}, {1, 1}, {2, 2}}, 0, 4 * math.Sqrt2, Degenerate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Polygon(tt.vertices)
+			if err != nil {
+				t.Fatalf("Polygon() unexpected error: %v", err)
+			}
+			if math.Abs(m.Area-tt.area) > 1e-12 || math.Abs(m.Perimeter-tt.perimeter) > 1e-12 || m.Orientation != tt.orientation {
+				t.Errorf("Polygon() = %+v, want area %v, perimeter %v, %s", m, tt.area, tt.perimeter, tt.orientation)
+			}
+		})
+	}
+
+	if _, err := Polygon([]Vector{{0, 0}, {1, 1}}); !errors.Is(err, ErrTooFewVertices) {
+		t.Errorf("Polygon() error = %v, want ErrTooFewVertices", err)
+	}
+	if _, err := Polygon([]Vector{{0, 0, 0}, {1, 0, 0}, {0, 1, 0}}); err == nil {
+		t.Error("Polygon() expected error for 3D vertices")
+	}
+}
+
+func TestCircle(t *testing.T) {
+	m, err := Circle(2)
+	if err != nil || m.Diameter != 4 || m.Circumference != 4*math.Pi || m.Area != 4*math.Pi {
+		t.Errorf("Circle(2) = %+v, %v", m, err)
+	}
+	if _, err := Circle(0); err == nil {
+		t.Error("Circle(0) expected error")
+	}
+}
+
+func TestSolveTriangle(t *testing.T) {
+	check := func(t *testing.T, got Triangle, want Triangle) {
+		t.Helper()
+		values := [][2]float64{
+			{got.A, want.A}, {got.B, want.B}, {got.C, want.C},
+			{got.Alpha, want.Alpha}, {got.Beta, want.Beta}, {got.Gamma, want.Gamma},
+			{got.Area, want.Area}, {got.Perimeter, want.Perimeter},
+		}
+		for _, v := range values {
+			if math.Abs(v[0]-v[1]) > 1e-12*math.Max(1, math.Abs(v[1])) {
+				t.Errorf("got %+v, want %+v", got, want)
+				return
+			}
+		}
+	}
+
+	right := Triangle{
+		A: 3, B: 4, C: 5,
+		Alpha: math.Atan2(3, 4), Beta: math.Atan2(4, 3), Gamma: math.Pi / 2,
+		Area: 6, Perimeter: 12,
+	}
+
+	t.Run("SSS", func(t *testing.T) {
+		got, err := SolveSSS(3, 4, 5)
+		if err != nil {
+			t.Fatalf("SolveSSS() unexpected error: %v", err)
+		}
+		check(t, got, right)
+	})
+	t.Run("SAS", func(t *testing.T) {
+		got, err := SolveSAS(3, 4, math.Pi/2)
+		if err != nil {
+			t.Fatalf("SolveSAS() unexpected error: %v", err)
+		}
+		check(t, got, right)
+	})
+	t.Run("ASA", func(t *testing.T) {
+		got, err := SolveASA(math.Atan2(3, 4), 5, math.Atan2(4, 3))
+		if err != nil {
+			t.Fatalf("SolveASA() unexpected error: %v", err)
+		}
+		check(t, got, right)
+	})
+	t.Run("needle", func(t *testing.T) {
+		got, err := SolveSSS(1e8, 1e8, 1)
+		if err != nil {
+			t.Fatalf("SolveSSS() unexpected error: %v", err)
+		}
+		if want := 0.5 * math.Sqrt(1e16-0.25); math.Abs(got.Area-want)/want > 1e-12 {
+			t.Errorf("area = %v, want %v", got.Area, want)
+		}
+	})
+
+	if _, err := SolveSSS(1, 2, 3); !errors.Is(err, calculations.ErrDomain) {
+		t.Errorf("SolveSSS() error = %v, want ErrDomain", err)
+	}
+	if _, err := SolveSSS(-1, 2, 2); err == nil {
+		t.Error("SolveSSS() expected error for a negative side")
+	}
+	if _, err := SolveSAS(1, 1, math.Pi); err == nil {
+		t.Error("SolveSAS() expected error for a straight angle")
+	}
+	if _, err := SolveASA(2, 1, 2); !errors.Is(err, calculations.ErrDomain) {
+		t.Errorf("SolveASA() error = %v, want ErrDomain", err)
+	}
+}
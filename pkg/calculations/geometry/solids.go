@@ -0,0 +1,109 @@
+package geometry
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/m-szczepanski/gocalc-api/pkg/calculations"
+)
+
+type Solid string
+
+const (
+	Sphere   Solid = "sphere"
+	Cube     Solid = "cube"
+	Cuboid   Solid = "cuboid"
+	Cylinder Solid = "cylinder"
+	Cone     Solid = "cone"
+	Pyramid  Solid = "pyramid" // right pyramid with a rectangular base
+	Torus    Solid = "torus"
+)
+
+func ValidSolids() []Solid {
+	return []Solid{Sphere, Cube, Cuboid, Cylinder, Cone, Pyramid, Torus}
+}
+
+// ParseSolid normalizes a solid name.
+func ParseSolid(name string) (Solid, error) {
+	normalized := Solid(strings.ToLower(strings.TrimSpace(name)))
+	for _, s := range ValidSolids() {
+		if s == normalized {
+			return s, nil
+		}
+	}
+	return "", fmt.Errorf("invalid solid %q (valid solids: %v)", name, ValidSolids())
+}
+
+// Dimensions holds the measurements of a solid. Which fields are used
+// depends on the solid; see RequiredDimensions.
+type Dimensions struct {
+	Radius      float64 // sphere, cylinder and cone; the major radius of a torus
+	MinorRadius float64 // torus tube radius
+	Side        float64 // cube
+	Length      float64 // cuboid and pyramid base
+	Width       float64 // cuboid and pyramid base
+	Height      float64 // cuboid, cylinder, cone and pyramid
+}
+
+// RequiredDimensions returns the JSON names of the dimensions a solid needs.
+func RequiredDimensions(s Solid) []string {
+	switch s {
+	case Sphere:
+		return []string{"radius"}
+	case Cube:
+		return []string{"side"}
+	case Cuboid, Pyramid:
+		return []string{"length", "width", "height"}
+	case Cylinder, Cone:
+		return []string{"radius", "height"}
+	case Torus:
+		return []string{"radius", "minor_radius"}
+	default:
+		return nil
+	}
+}
+
+// SolidMeasures returns the volume and total surface area of a solid.
+func SolidMeasures(s Solid, d Dimensions) (volume, surfaceArea float64, err error) {
+	values := map[string]float64{
+		"radius": d.Radius, "minor_radius": d.MinorRadius, "side": d.Side,
+		"length": d.Length, "width": d.Width, "height": d.Height,
+	}
+	required := RequiredDimensions(s)
+	if required == nil {
+		return 0, 0, fmt.Errorf("invalid solid %q", s)
+	}
+	for _, name := range required {
+		if err := positive(name, values[name]); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	r, h := d.Radius, d.Height
+	switch s {
+	case Sphere:
+		return 4 * math.Pi * r * r * r / 3, 4 * math.Pi * r * r, nil
+	case Cube:
+		a := d.Side
+		return a * a * a, 6 * a * a, nil
+	case Cuboid:
+		l, w := d.Length, d.Width
+		return l * w * h, 2 * (l*w + l*h + w*h), nil
+	case Cylinder:
+		return math.Pi * r * r * h, 2 * math.Pi * r * (r + h), nil
+	case Cone:
+		return math.Pi * r * r * h / 3, math.Pi * r * (r + math.Hypot(r, h)), nil
+	case Pyramid:
+		l, w := d.Length, d.Width
+		// Each pair of opposite faces is a triangle whose height is the slant
+		// height to the middle of its base edge.
+		lateral := l*math.Hypot(w/2, h) + w*math.Hypot(l/2, h)
+		return l * w * h / 3, l*w + lateral, nil
+	default:
+		if d.MinorRadius > r {
+			return 0, 0, fmt.Errorf("%w: a torus with minor radius %g larger than its radius %g intersects itself", calculations.ErrDomain, d.MinorRadius, r)
+		}
+		return 2 * math.Pi * math.Pi * r * d.MinorRadius * d.MinorRadius, 4 * math.Pi * math.Pi * r * d.MinorRadius, nil
+	}
+}
@@ -0,0 +1,54 @@
+package geometry
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/m-szczepanski/gocalc-api/pkg/calculations"
+)
+
+func TestSolidMeasures(t *testing.T) {
+	tests := []struct {
+		solid   Solid
+		d       Dimensions
+		volume  float64
+		surface float64
+	}{
+		{Sphere, Dimensions{Radius: 3}, 36 * math.Pi, 36 * math.Pi},
+		{Cube, Dimensions{Side: 2}, 8, 24},
+		{Cuboid, Dimensions{Length: 2, Width: 3, Height: 4}, 24, 52},
+		{Cylinder, Dimensions{Radius: 1, Height: 2}, 2 * math.Pi, 6 * math.Pi},
+		{Cone, Dimensions{Radius: 3, Height: 4}, 12 * math.Pi, 24 * math.Pi},
+		{Pyramid, Dimensions{Length: 6, Width: 6, Height: 4}, 48, 96},
+		{Torus, Dimensions{Radius: 2, MinorRadius: 1}, 4 * math.Pi * math.Pi, 8 * math.Pi * math.Pi},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.solid), func(t *testing.T) {
+			volume, surface, err := SolidMeasures(tt.solid, tt.d)
+			if err != nil {
+				t.Fatalf("SolidMeasures() unexpected error: %v", err)
+			}
+			if math.Abs(volume-tt.volume) > 1e-12*tt.volume || math.Abs(surface-tt.surface) > 1e-12*tt.surface {
+				t.Errorf("SolidMeasures() = %v, %v, want %v, %v", volume, surface, tt.volume, tt.surface)
+			}
+		})
+	}
+
+	if _, _, err := SolidMeasures(Cylinder, Dimensions{Radius: 1}); err == nil {
+		t.Error("SolidMeasures() expected error for a missing height")
+	}
+	if _, _, err := SolidMeasures(Torus, Dimensions{Radius: 1, MinorRadius: 2}); !errors.Is(err, calculations.ErrDomain) {
+		t.Errorf("SolidMeasures() error = %v, want ErrDomain", err)
+	}
+}
+
+func TestParseSolid(t *testing.T) {
+	if s, err := ParseSolid(" Cone "); err != nil || s != Cone {
+		t.Errorf("ParseSolid() = %v, %v", s, err)
+	}
+	if _, err := ParseSolid("dodecahedron"); err == nil {
+		t.Error("ParseSolid() expected error")
+	}
+}
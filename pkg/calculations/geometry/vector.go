@@ -0,0 +1,137 @@
+// Package geometry implements 2D and 3D vector operations and measurements of
+// plane figures and solids: polygons by the shoelace formula, circles,
+// triangles solved from three known parts, and the volumes and surface areas
+// of common solids. Lengths are unitless and angles are in radians; callers
+// convert units.
+package geometry
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/m-szczepanski/gocalc-api/pkg/calculations"
+)
+
+// Vector is a 2D or 3D vector, or a point given by its position vector.
+type Vector []float64
+
+var (
+	ErrDimension = errors.New("vectors must have 2 or 3 components")
+	ErrMismatch  = errors.New("vectors must have the same number of components")
+	ErrNotFinite = errors.New("coordinates must be finite numbers")
+)
+
+// Validate checks that v has 2 or 3 finite components.
+func Validate(v Vector) error {
+	if len(v) != 2 && len(v) != 3 {
+		return fmt.Errorf("%w, got %d", ErrDimension, len(v))
+	}
+	for _, x := range v {
+		if math.IsNaN(x) || math.IsInf(x, 0) {
+			return ErrNotFinite
+		}
+	}
+	return nil
+}
+
+func validatePair(a, b Vector) error {
+	if err := Validate(a); err != nil {
+		return err
+	}
+	if err := Validate(b); err != nil {
+		return err
+	}
+	if len(a) != len(b) {
+		return fmt.Errorf("%w, got %d and %d", ErrMismatch, len(a), len(b))
+	}
+	return nil
+}
+
+// Dot returns the dot product a · b.
+func Dot(a, b Vector) (float64, error) {
+	if err := validatePair(a, b); err != nil {
+		return 0, err
+	}
+	var sum float64
+	for i := range a {
+		sum += a[i] * b[i]
+	}
+	return sum, nil
+}
+
+// Cross returns the cross product a × b. 2D vectors are treated as lying in
+// the xy-plane, so their cross product is (0, 0, a₁b₂ - a₂b₁).
+func Cross(a, b Vector) (Vector, error) {
+	if err := validatePair(a, b); err != nil {
+		return nil, err
+	}
+	a3, b3 := extend(a), extend(b)
+	return Vector{
+		a3[1]*b3[2] - a3[2]*b3[1],
+		a3[2]*b3[0] - a3[0]*b3[2],
+		a3[0]*b3[1] - a3[1]*b3[0],
+	}, nil
+}
+
+func extend(v Vector) Vector {
+	if len(v) == 3 {
+		return v
+	}
+	return Vector{v[0], v[1], 0}
+}
+
+// Norm returns the Euclidean length of v without intermediate overflow.
+func Norm(v Vector) float64 {
+	var norm float64
+	for _, x := range v {
+		norm = math.Hypot(norm, x)
+	}
+	return norm
+}
+
+// Angle returns the angle between a and b in [0, π]. It uses atan2 of the
+// cross and dot products, which stays accurate for nearly parallel vectors
+// where the arccosine of the normalized dot product does not.
+func Angle(a, b Vector) (float64, error) {
+	if err := validatePair(a, b); err != nil {
+		return 0, err
+	}
+	if Norm(a) == 0 || Norm(b) == 0 {
+		return 0, fmt.Errorf("%w: the angle with a zero vector is undefined", calculations.ErrDomain)
+	}
+	cross, _ := Cross(a, b)
+	dot, _ := Dot(a, b)
+	return math.Atan2(Norm(cross), dot), nil
+}
+
+// Project returns the vector projection of a onto b and the scalar projection
+// a·b/|b|, the signed length of that vector.
+func Project(a, b Vector) (Vector, float64, error) {
+	if err := validatePair(a, b); err != nil {
+		return nil, 0, err
+	}
+	norm := Norm(b)
+	if norm == 0 {
+		return nil, 0, fmt.Errorf("%w: cannot project onto a zero vector", calculations.ErrDomain)
+	}
+	dot, _ := Dot(a, b)
+	scalar := dot / norm
+	projection := make(Vector, len(b))
+	for i, x := range b {
+		projection[i] = scalar * (x / norm)
+	}
+	return projection, scalar, nil
+}
+
+// Distance returns the Euclidean distance between points p and q.
+func Distance(p, q Vector) (float64, error) {
+	if err := validatePair(p, q); err != nil {
+		return 0, err
+	}
+	d := make(Vector, len(p))
+	for i := range p {
+		d[i] = q[i] - p[i]
+	}
+	return Norm(d), nil
+}
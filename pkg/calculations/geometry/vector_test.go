@@ -0,0 +1,90 @@
+package geometry
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/m-szczepanski/gocalc-api/pkg/calculations"
+)
+
+func vectorsEqual(a, b Vector, tol float64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if math.Abs(a[i]-b[i]) > tol {
+			return false
+		}
+	}
+	return true
+}
+
+func TestDotAndCross(t *testing.T) {
+	if dot, err := Dot(Vector{1, 2, 3}, Vector{4, -5, 6}); err != nil || dot != 12 {
+		t.Errorf("Dot() = %v, %v, want 12", dot, err)
+	}
+	if cross, err := Cross(Vector{1, 0, 0}, Vector{0, 1, 0}); err != nil || !vectorsEqual(cross, Vector{0, 0, 1}, 0) {
+		t.Errorf("Cross() = %v, %v, want (0, 0, 1)", cross, err)
+	}
+	if cross, err := Cross(Vector{2, 1}, Vector{1, 3}); err != nil || !vectorsEqual(cross, Vector{0, 0, 5}, 0) {
+		t.Errorf("Cross() of 2D vectors = %v, %v, want (0, 0, 5)", cross, err)
+	}
+
+	if _, err := Dot(Vector{1, 2}, Vector{1, 2, 3}); !errors.Is(err, ErrMismatch) {
+		t.Errorf("Dot() error = %v, want ErrMismatch", err)
+	}
+	if _, err := Cross(Vector{1}, Vector{1}); !errors.Is(err, ErrDimension) {
+		t.Errorf("Cross() error = %v, want ErrDimension", err)
+	}
+	if _, err := Dot(Vector{math.NaN(), 0}, Vector{1, 1}); !errors.Is(err, ErrNotFinite) {
+		t.Errorf("Dot() error = %v, want ErrNotFinite", err)
+	}
+}
+
+func TestNormAndDistance(t *testing.T) {
+	if n := Norm(Vector{3, 4}); n != 5 {
+		t.Errorf("Norm() = %v, want 5", n)
+	}
+	if n := Norm(Vector{1e200, 1e200, 0}); math.IsInf(n, 0) || math.Abs(n/(math.Sqrt2*1e200)-1) > 1e-15 {
+		t.Errorf("Norm() overflowed: %v", n)
+	}
+	if d, err := Distance(Vector{1, 2, 3}, Vector{3, 4, 4}); err != nil || d != 3 {
+		t.Errorf("Distance() = %v, %v, want 3", d, err)
+	}
+}
+
+func TestAngle(t *testing.T) {
+	tests := []struct {
+		a, b     Vector
+		expected float64
+	}{
+		{Vector{1, 0}, Vector{0, 1}, math.Pi / 2},
+		{Vector{1, 0}, Vector{-1, 0}, math.Pi},
+		{Vector{1, 1, 0}, Vector{1, 0, 0}, math.Pi / 4},
+		{Vector{1, 0}, Vector{1, 1e-10}, 1e-10},
+	}
+	for _, tt := range tests {
+		angle, err := Angle(tt.a, tt.b)
+		if err != nil || math.Abs(angle-tt.expected) > 1e-15*math.Max(1, tt.expected) {
+			t.Errorf("Angle(%v, %v) = %v, %v, want %v", tt.a, tt.b, angle, err, tt.expected)
+		}
+	}
+	if _, err := Angle(Vector{0, 0}, Vector{1, 0}); !errors.Is(err, calculations.ErrDomain) {
+		t.Errorf("Angle() error = %v, want ErrDomain", err)
+	}
+}
+
+func TestProject(t *testing.T) {
+	projection, scalar, err := Project(Vector{3, 4}, Vector{2, 0})
+	if err != nil || !vectorsEqual(projection, Vector{3, 0}, 1e-15) || scalar != 3 {
+		t.Errorf("Project() = %v, %v, %v", projection, scalar, err)
+	}
+	projection, scalar, _ = Project(Vector{-1, 1, 0}, Vector{1, 1, 1})
+	if !vectorsEqual(projection, Vector{0, 0, 0}, 1e-15) || scalar != 0 {
+		t.Errorf("Project() of orthogonal vectors = %v, %v", projection, scalar)
+	}
+	if _, _, err := Project(Vector{1, 1}, Vector{0, 0}); !errors.Is(err, calculations.ErrDomain) {
+		t.Errorf("Project() error = %v, want ErrDomain", err)
+	}
+}
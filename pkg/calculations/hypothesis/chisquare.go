@@ -0,0 +1,155 @@
+package hypothesis
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/m-szczepanski/gocalc-api/pkg/calculations"
+	"github.com/m-szczepanski/gocalc-api/pkg/calculations/distribution"
+)
+
+// minExpected is the smallest expected count for which the chi-square
+// approximation is conventionally considered reliable.
+const minExpected = 5
+
+func checkCount(name string, v float64) error {
+	if math.IsNaN(v) || math.IsInf(v, 0) || v < 0 {
+		return fmt.Errorf("%s must be a count >= 0, got %v", name, v)
+	}
+	return nil
+}
+
+func smallExpectedWarning(cells, total int) []string {
+	if cells == 0 {
+		return nil
+	}
+	return []string{fmt.Sprintf(
+		"%d of %d expected counts are below %d; the chi-square approximation may be inaccurate",
+		cells, total, minExpected,
+	)}
+}
+
+// ChiSquareGOF tests whether observed counts follow the expected
+// distribution. expected may hold counts or proportions; it is rescaled to the
+// observed total, and nil means every category is equally likely. The effect
+// size is Cohen's w, sqrt(χ²/N).
+func ChiSquareGOF(observed, expected []float64) (*Result, error) {
+	if len(observed) < 2 {
+		return nil, fmt.Errorf("%w: observed must contain at least 2 categories, got %d", calculations.ErrDomain, len(observed))
+	}
+	if expected != nil && len(expected) != len(observed) {
+		return nil, fmt.Errorf("expected must have %d entries to match observed, got %d", len(observed), len(expected))
+	}
+
+	for i, o := range observed {
+		if err := checkCount(fmt.Sprintf("observed[%d]", i), o); err != nil {
+			return nil, err
+		}
+	}
+	total := calculations.CompensatedSum(observed)
+	if total == 0 {
+		return nil, fmt.Errorf("%w: observed counts sum to 0", calculations.ErrDomain)
+	}
+
+	weights := expected
+	if weights == nil {
+		weights = make([]float64, len(observed))
+		for i := range weights {
+			weights[i] = 1
+		}
+	}
+	for i, e := range weights {
+		if math.IsNaN(e) || math.IsInf(e, 0) || e <= 0 {
+			return nil, fmt.Errorf("expected[%d] must be > 0, got %v", i, e)
+		}
+	}
+	weightTotal := calculations.CompensatedSum(weights)
+
+	terms := make([]float64, len(observed))
+	small := 0
+	for i, o := range observed {
+		e := weights[i] / weightTotal * total
+		if e < minExpected {
+			small++
+		}
+		terms[i] = (o - e) * (o - e) / e
+	}
+	stat := calculations.CompensatedSum(terms)
+	df := float64(len(observed) - 1)
+
+	return &Result{
+		Statistic: stat,
+		DF:        df,
+		PValue:    distribution.NewChiSquare(df).SF(stat),
+		Effect:    EffectSize{CohensW, math.Sqrt(stat / total)},
+		Estimate:  math.NaN(),
+		Warnings:  smallExpectedWarning(small, len(observed)),
+	}, nil
+}
+
+// ChiSquareIndependence tests whether the row and column variables of a
+// contingency table of counts are independent, without continuity
+// correction. The effect size is Cramér's V.
+func ChiSquareIndependence(table [][]float64) (*Result, error) {
+	if len(table) < 2 || len(table[0]) < 2 {
+		return nil, fmt.Errorf("%w: table must have at least 2 rows and 2 columns", calculations.ErrDomain)
+	}
+
+	rows, cols := len(table), len(table[0])
+	rowSums := make([]float64, rows)
+	colSums := make([]float64, cols)
+	column := make([]float64, rows)
+	for i, row := range table {
+		if len(row) != cols {
+			return nil, fmt.Errorf("table row %d must have %d columns, got %d", i, cols, len(row))
+		}
+		for j, v := range row {
+			if err := checkCount(fmt.Sprintf("table[%d][%d]", i, j), v); err != nil {
+				return nil, err
+			}
+		}
+		rowSums[i] = calculations.CompensatedSum(row)
+	}
+	for j := range colSums {
+		for i := range table {
+			column[i] = table[i][j]
+		}
+		colSums[j] = calculations.CompensatedSum(column)
+	}
+	total := calculations.CompensatedSum(rowSums)
+
+	for i, s := range rowSums {
+		if s == 0 {
+			return nil, fmt.Errorf("%w: row %d of the table sums to 0", calculations.ErrDomain, i)
+		}
+	}
+	for j, s := range colSums {
+		if s == 0 {
+			return nil, fmt.Errorf("%w: column %d of the table sums to 0", calculations.ErrDomain, j)
+		}
+	}
+
+	terms := make([]float64, 0, rows*cols)
+	small := 0
+	for i, row := range table {
+		for j, o := range row {
+			e := rowSums[i] * colSums[j] / total
+			if e < minExpected {
+				small++
+			}
+			terms = append(terms, (o-e)*(o-e)/e)
+		}
+	}
+	stat := calculations.CompensatedSum(terms)
+	df := float64((rows - 1) * (cols - 1))
+	k := math.Min(float64(rows), float64(cols)) - 1
+
+	return &Result{
+		Statistic: stat,
+		DF:        df,
+		PValue:    distribution.NewChiSquare(df).SF(stat),
+		Effect:    EffectSize{CramersV, math.Sqrt(stat / (total * k))},
+		Estimate:  math.NaN(),
+		Warnings:  smallExpectedWarning(small, rows*cols),
+	}, nil
+}
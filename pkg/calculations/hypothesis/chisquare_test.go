@@ -0,0 +1,65 @@
+package hypothesis
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/m-szczepanski/gocalc-api/pkg/calculations"
+)
+
+func TestChiSquareGOF(t *testing.T) {
+	got, err := ChiSquareGOF([]float64{10, 20, 30}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// For 2 degrees of freedom the upper tail is exp(-χ²/2).
+	if got.Statistic != 10 || got.DF != 2 || !closeTo(got.PValue, math.Exp(-5), 1e-12) {
+		t.Errorf("got %+v", got)
+	}
+	if got.Effect.Measure != CohensW || !closeTo(got.Effect.Value, math.Sqrt(10.0/60), 1e-15) || got.Warnings != nil {
+		t.Errorf("effect = %+v, warnings = %v", got.Effect, got.Warnings)
+	}
+
+	// Proportions and counts give the same expected distribution.
+	byCounts, _ := ChiSquareGOF([]float64{30, 50, 20}, []float64{250, 500, 250})
+	byProportions, _ := ChiSquareGOF([]float64{30, 50, 20}, []float64{0.25, 0.5, 0.25})
+	if !closeTo(byCounts.Statistic, 2, 1e-12) || !closeTo(byProportions.Statistic, 2, 1e-12) {
+		t.Errorf("statistics = %v and %v, want 2", byCounts.Statistic, byProportions.Statistic)
+	}
+
+	small, _ := ChiSquareGOF([]float64{1, 2, 3}, nil)
+	if len(small.Warnings) != 1 {
+		t.Errorf("warnings = %v, want one for small expected counts", small.Warnings)
+	}
+
+	if _, err := ChiSquareGOF([]float64{5}, nil); !errors.Is(err, calculations.ErrDomain) {
+		t.Errorf("single category error = %v, want ErrDomain", err)
+	}
+	if _, err := ChiSquareGOF([]float64{5, 5}, []float64{1, 0}); err == nil {
+		t.Error("expected error for a zero expected proportion")
+	}
+	if _, err := ChiSquareGOF([]float64{5, -1}, nil); err == nil {
+		t.Error("expected error for a negative count")
+	}
+}
+
+func TestChiSquareIndependence(t *testing.T) {
+	got, err := ChiSquareIndependence([][]float64{{10, 20}, {30, 40}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !closeTo(got.Statistic, 0.7936507936507936, 1e-12) || got.DF != 1 || !closeTo(got.PValue, 0.37299848361348714, 1e-12) {
+		t.Errorf("got %+v", got)
+	}
+	if got.Effect.Measure != CramersV || !closeTo(got.Effect.Value, 0.0890870806374748, 1e-12) {
+		t.Errorf("effect = %+v", got.Effect)
+	}
+
+	if _, err := ChiSquareIndependence([][]float64{{1, 2}, {0, 0}}); !errors.Is(err, calculations.ErrDomain) {
+		t.Errorf("empty row error = %v, want ErrDomain", err)
+	}
+	if _, err := ChiSquareIndependence([][]float64{{1, 2}, {3}}); err == nil {
+		t.Error("expected error for a ragged table")
+	}
+}
@@ -0,0 +1,289 @@
+// Package hypothesis implements t-tests, chi-square tests, the two-proportion
+// z-test and confidence intervals for means and proportions. Every test
+// reports its statistic, degrees of freedom, p-value and an effect size.
+package hypothesis
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/m-szczepanski/gocalc-api/pkg/calculations"
+	"github.com/m-szczepanski/gocalc-api/pkg/calculations/distribution"
+)
+
+// Alternative is the alternative hypothesis of a test: that the true value is
+// different from, less than or greater than the hypothesized value.
+type Alternative string
+
+const (
+	TwoSided Alternative = "two-sided"
+	Less     Alternative = "less"
+	Greater  Alternative = "greater"
+)
+
+// ParseAlternative normalizes an alternative hypothesis; the empty string
+// means two-sided.
+func ParseAlternative(s string) (Alternative, error) {
+	switch a := Alternative(strings.ToLower(strings.TrimSpace(s))); a {
+	case "":
+		return TwoSided, nil
+	case TwoSided, Less, Greater:
+		return a, nil
+	default:
+		return "", fmt.Errorf("invalid alternative %q (valid alternatives: two-sided, less, greater)", s)
+	}
+}
+
+// Test names a hypothesis test.
+type Test string
+
+const (
+	OneSample              Test = "one-sample-t"
+	TwoSample              Test = "two-sample-t"
+	Welch                  Test = "welch-t"
+	Paired                 Test = "paired-t"
+	ChiSquareGoodnessOfFit Test = "chi-square-gof"
+	ChiSquareIndependent   Test = "chi-square-independence"
+	TwoProportion          Test = "two-proportion-z"
+)
+
+// ValidTests returns every supported test.
+func ValidTests() []Test {
+	return []Test{OneSample, TwoSample, Welch, Paired, ChiSquareGoodnessOfFit, ChiSquareIndependent, TwoProportion}
+}
+
+// ParseTest normalizes a test name.
+func ParseTest(name string) (Test, error) {
+	normalized := Test(strings.ToLower(strings.TrimSpace(name)))
+	for _, t := range ValidTests() {
+		if t == normalized {
+			return t, nil
+		}
+	}
+	return "", fmt.Errorf("invalid test %q (valid tests: %v)", name, ValidTests())
+}
+
+// Effect size measures.
+const (
+	CohensD  = "cohens_d"
+	CohensDz = "cohens_dz"
+	CohensH  = "cohens_h"
+	CohensW  = "cohens_w"
+	CramersV = "cramers_v"
+)
+
+// DefaultConfidence is the confidence level used when none is given.
+const DefaultConfidence = 0.95
+
+type EffectSize struct {
+	Measure string
+	Value   float64
+}
+
+// Interval is a confidence interval. A one-sided interval has an infinite
+// bound.
+type Interval struct {
+	Lower      float64
+	Upper      float64
+	Confidence float64
+}
+
+// Result is the outcome of a hypothesis test. DF is NaN for z-tests, and
+// Estimate and Interval are unset for chi-square tests.
+type Result struct {
+	Statistic float64
+	DF        float64
+	PValue    float64
+	Effect    EffectSize
+	Estimate  float64
+	Interval  *Interval
+	// Warnings flag conditions under which the test's approximation may be
+	// poor, such as small expected counts.
+	Warnings []string
+}
+
+// meanVariance returns the mean and sample variance of x, which must hold at
+// least two values.
+func meanVariance(x []float64) (mean, variance float64) {
+	n := float64(len(x))
+	mean = calculations.CompensatedSum(x) / n
+	squares := make([]float64, len(x))
+	for i, v := range x {
+		squares[i] = (v - mean) * (v - mean)
+	}
+	return mean, calculations.CompensatedSum(squares) / (n - 1)
+}
+
+func checkSample(name string, x []float64) error {
+	if len(x) < 2 {
+		return fmt.Errorf("%w: %s must contain at least 2 values, got %d", calculations.ErrDomain, name, len(x))
+	}
+	for i, v := range x {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return fmt.Errorf("%s[%d] must be a valid number", name, i)
+		}
+	}
+	return nil
+}
+
+func checkConfidence(confidence float64) error {
+	if !(confidence > 0 && confidence < 1) {
+		return fmt.Errorf("confidence must be strictly between 0 and 1, got %v", confidence)
+	}
+	return nil
+}
+
+// pValue returns the p-value of stat under a distribution symmetric about 0.
+func pValue(d distribution.Distribution, stat float64, alt Alternative) float64 {
+	switch alt {
+	case Less:
+		return d.CDF(stat)
+	case Greater:
+		return d.SF(stat)
+	default:
+		return math.Min(1, 2*d.SF(math.Abs(stat)))
+	}
+}
+
+// interval returns the confidence interval for estimate with standard error
+// se whose pivot follows d, matching the alternative.
+func interval(d distribution.Distribution, estimate, se, confidence float64, alt Alternative) *Interval {
+	ci := &Interval{Lower: math.Inf(-1), Upper: math.Inf(1), Confidence: confidence}
+	switch alt {
+	case Less:
+		q, _ := d.Quantile(confidence)
+		ci.Upper = estimate + q*se
+	case Greater:
+		q, _ := d.Quantile(confidence)
+		ci.Lower = estimate - q*se
+	default:
+		q, _ := d.Quantile((1 + confidence) / 2)
+		ci.Lower, ci.Upper = estimate-q*se, estimate+q*se
+	}
+	return ci
+}
+
+func tTest(estimate, se, df, mu, confidence float64, alt Alternative, effect EffectSize) (*Result, error) {
+	if se == 0 {
+		return nil, fmt.Errorf("%w: the data have zero variance", calculations.ErrDomain)
+	}
+	t := distribution.NewStudentT(df)
+	stat := (estimate - mu) / se
+	return &Result{
+		Statistic: stat,
+		DF:        df,
+		PValue:    pValue(t, stat, alt),
+		Effect:    effect,
+		Estimate:  estimate,
+		Interval:  interval(t, estimate, se, confidence, alt),
+	}, nil
+}
+
+// OneSampleT tests whether the mean of x equals mu. The effect size is
+// Cohen's d, (mean - mu) / sd.
+func OneSampleT(x []float64, mu float64, alt Alternative, confidence float64) (*Result, error) {
+	if err := checkSample("x", x); err != nil {
+		return nil, err
+	}
+	if err := checkConfidence(confidence); err != nil {
+		return nil, err
+	}
+
+	n := float64(len(x))
+	mean, variance := meanVariance(x)
+	sd := math.Sqrt(variance)
+	return tTest(mean, sd/math.Sqrt(n), n-1, mu, confidence, alt, EffectSize{CohensD, (mean - mu) / sd})
+}
+
+// TwoSampleT tests whether mean(x) - mean(y) equals mu. With welch set it
+// uses Welch's unequal-variance test and Welch–Satterthwaite degrees of
+// freedom; otherwise it pools the variances. The effect size is Cohen's d:
+// the difference over the pooled standard deviation, or for Welch's test over
+// the root mean of the two variances.
+func TwoSampleT(x, y []float64, mu float64, welch bool, alt Alternative, confidence float64) (*Result, error) {
+	if err := checkSample("x", x); err != nil {
+		return nil, err
+	}
+	if err := checkSample("y", y); err != nil {
+		return nil, err
+	}
+	if err := checkConfidence(confidence); err != nil {
+		return nil, err
+	}
+
+	nx, ny := float64(len(x)), float64(len(y))
+	meanX, varX := meanVariance(x)
+	meanY, varY := meanVariance(y)
+	diff := meanX - meanY
+
+	if welch {
+		vx, vy := varX/nx, varY/ny
+		se := math.Sqrt(vx + vy)
+		df := (vx + vy) * (vx + vy) / (vx*vx/(nx-1) + vy*vy/(ny-1))
+		d := (diff - mu) / math.Sqrt((varX+varY)/2)
+		return tTest(diff, se, df, mu, confidence, alt, EffectSize{CohensD, d})
+	}
+
+	pooled := ((nx-1)*varX + (ny-1)*varY) / (nx + ny - 2)
+	se := math.Sqrt(pooled * (1/nx + 1/ny))
+	d := (diff - mu) / math.Sqrt(pooled)
+	return tTest(diff, se, nx+ny-2, mu, confidence, alt, EffectSize{CohensD, d})
+}
+
+// PairedT tests whether the mean of the differences x[i] - y[i] equals mu.
+// The effect size is Cohen's d_z, the mean difference over the standard
+// deviation of the differences.
+func PairedT(x, y []float64, mu float64, alt Alternative, confidence float64) (*Result, error) {
+	if len(x) != len(y) {
+		return nil, fmt.Errorf("x and y must have the same length, got %d and %d", len(x), len(y))
+	}
+	diffs := make([]float64, len(x))
+	for i := range x {
+		diffs[i] = x[i] - y[i]
+	}
+	result, err := OneSampleT(diffs, mu, alt, confidence)
+	if err != nil {
+		return nil, err
+	}
+	result.Effect.Measure = CohensDz
+	return result, nil
+}
+
+// TwoProportionZ tests whether the success rates x1/n1 and x2/n2 are equal,
+// using the pooled proportion for the standard error of the statistic. The
+// interval for the difference uses the unpooled standard error. The effect
+// size is Cohen's h.
+func TwoProportionZ(x1, n1, x2, n2 int64, alt Alternative, confidence float64) (*Result, error) {
+	for _, c := range []struct {
+		name string
+		x, n int64
+	}{{"1", x1, n1}, {"2", x2, n2}} {
+		if c.n < 1 || c.x < 0 || c.x > c.n {
+			return nil, fmt.Errorf("sample %s must have 0 <= successes <= trials and trials >= 1, got %d of %d", c.name, c.x, c.n)
+		}
+	}
+	if err := checkConfidence(confidence); err != nil {
+		return nil, err
+	}
+
+	fn1, fn2 := float64(n1), float64(n2)
+	p1, p2 := float64(x1)/fn1, float64(x2)/fn2
+	pooled := float64(x1+x2) / (fn1 + fn2)
+	se := math.Sqrt(pooled * (1 - pooled) * (1/fn1 + 1/fn2))
+	if se == 0 {
+		return nil, fmt.Errorf("%w: the pooled proportion is %v, so the test is undefined", calculations.ErrDomain, pooled)
+	}
+
+	diff := p1 - p2
+	stat := diff / se
+	unpooled := math.Sqrt(p1*(1-p1)/fn1 + p2*(1-p2)/fn2)
+	return &Result{
+		Statistic: stat,
+		DF:        math.NaN(),
+		PValue:    pValue(distribution.StandardNormal, stat, alt),
+		Effect:    EffectSize{CohensH, 2*math.Asin(math.Sqrt(p1)) - 2*math.Asin(math.Sqrt(p2))},
+		Estimate:  diff,
+		Interval:  interval(distribution.StandardNormal, diff, unpooled, confidence, alt),
+	}, nil
+}
@@ -0,0 +1,124 @@
+package hypothesis
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/m-szczepanski/gocalc-api/pkg/calculations"
+)
+
+// Student's sleep data, as used in the R documentation for t.test.
+var (
+	sleep1 = []float64{0.7, -1.6, -0.2, -1.2, -0.1, 3.4, 3.7, 0.8, 0.0, 2.0}
+	sleep2 = []float64{1.9, 0.8, 1.1, 0.1, -0.1, 4.4, 5.5, 1.6, 4.6, 3.4}
+)
+
+func closeTo(got, want, tol float64) bool {
+	return math.Abs(got-want) <= tol*math.Max(1, math.Abs(want))
+}
+
+func checkResult(t *testing.T, got *Result, stat, df, p, lower, upper float64) {
+	t.Helper()
+	if !closeTo(got.Statistic, stat, 1e-4) || !closeTo(got.DF, df, 1e-3) || !closeTo(got.PValue, p, 1e-5) {
+		t.Errorf("statistic = %v, df = %v, p = %v; want %v, %v, %v", got.Statistic, got.DF, got.PValue, stat, df, p)
+	}
+	if got.Interval == nil || !closeTo(got.Interval.Lower, lower, 1e-6) || !closeTo(got.Interval.Upper, upper, 1e-6) {
+		t.Errorf("interval = %+v, want [%v, %v]", got.Interval, lower, upper)
+	}
+}
+
+func TestTTests(t *testing.T) {
+	t.Run("welch", func(t *testing.T) {
+		got, err := TwoSampleT(sleep1, sleep2, 0, true, TwoSided, 0.95)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		checkResult(t, got, -1.860813, 17.77647, 0.07939414, -3.3654832, 0.2054832)
+		if got.Effect.Measure != CohensD || !closeTo(got.Estimate, -1.58, 1e-12) {
+			t.Errorf("effect = %+v, estimate = %v", got.Effect, got.Estimate)
+		}
+	})
+	t.Run("pooled", func(t *testing.T) {
+		got, err := TwoSampleT(sleep1, sleep2, 0, false, TwoSided, 0.95)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		checkResult(t, got, -1.860813, 18, 0.07918671, -3.363874, 0.203874)
+		// Equal group sizes make both denominators of d coincide.
+		if !closeTo(got.Effect.Value, -0.8321811, 1e-6) {
+			t.Errorf("cohen's d = %v", got.Effect.Value)
+		}
+	})
+	t.Run("paired", func(t *testing.T) {
+		got, err := PairedT(sleep1, sleep2, 0, TwoSided, 0.95)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		checkResult(t, got, -4.062128, 9, 0.002832890, -2.4598858, -0.7001142)
+		if got.Effect.Measure != CohensDz || !closeTo(got.Effect.Value, -1.284558, 1e-6) {
+			t.Errorf("effect = %+v", got.Effect)
+		}
+	})
+	t.Run("one-sided", func(t *testing.T) {
+		got, err := OneSampleT(sleep2, 1, Greater, 0.9)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		less, _ := OneSampleT(sleep2, 1, Less, 0.9)
+		if !closeTo(got.PValue+less.PValue, 1, 1e-12) {
+			t.Errorf("one-sided p-values %v and %v do not sum to 1", got.PValue, less.PValue)
+		}
+		if !math.IsInf(got.Interval.Upper, 1) || math.IsInf(got.Interval.Lower, 0) {
+			t.Errorf("interval = %+v, want a finite lower bound only", got.Interval)
+		}
+	})
+
+	if _, err := OneSampleT([]float64{1}, 0, TwoSided, 0.95); !errors.Is(err, calculations.ErrDomain) {
+		t.Errorf("single value error = %v, want ErrDomain", err)
+	}
+	if _, err := OneSampleT([]float64{2, 2, 2}, 0, TwoSided, 0.95); !errors.Is(err, calculations.ErrDomain) {
+		t.Errorf("constant sample error = %v, want ErrDomain", err)
+	}
+	if _, err := PairedT([]float64{1, 2}, []float64{1, 2, 3}, 0, TwoSided, 0.95); err == nil {
+		t.Error("expected error for unequal lengths")
+	}
+	if _, err := OneSampleT(sleep1, 0, TwoSided, 1); err == nil {
+		t.Error("expected error for confidence 1")
+	}
+}
+
+func TestTwoProportionZ(t *testing.T) {
+	got, err := TwoProportionZ(45, 100, 30, 100, TwoSided, 0.95)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !closeTo(got.Statistic, 2.1908902300206647, 1e-12) || !closeTo(got.PValue, 0.02845973691631057, 1e-10) || !math.IsNaN(got.DF) {
+		t.Errorf("z = %v, p = %v, df = %v", got.Statistic, got.PValue, got.DF)
+	}
+	if got.Effect.Measure != CohensH || !closeTo(got.Effect.Value, 0.3113494249059283, 1e-12) {
+		t.Errorf("effect = %+v", got.Effect)
+	}
+	if !closeTo(got.Interval.Lower, 0.017430493307564826, 1e-12) || !closeTo(got.Interval.Upper, 0.2825695066924352, 1e-12) {
+		t.Errorf("interval = %+v", got.Interval)
+	}
+
+	if _, err := TwoProportionZ(0, 10, 0, 20, TwoSided, 0.95); !errors.Is(err, calculations.ErrDomain) {
+		t.Errorf("no successes error = %v, want ErrDomain", err)
+	}
+	if _, err := TwoProportionZ(11, 10, 0, 20, TwoSided, 0.95); err == nil {
+		t.Error("expected error for more successes than trials")
+	}
+}
+
+func TestParseAlternative(t *testing.T) {
+	if a, err := ParseAlternative(""); err != nil || a != TwoSided {
+		t.Errorf("ParseAlternative(\"\") = %v, %v", a, err)
+	}
+	if a, err := ParseAlternative(" Greater"); err != nil || a != Greater {
+		t.Errorf("ParseAlternative(Greater) = %v, %v", a, err)
+	}
+	if _, err := ParseAlternative("not-equal"); err == nil {
+		t.Error("expected error")
+	}
+}
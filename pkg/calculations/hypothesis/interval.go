@@ -0,0 +1,109 @@
+package hypothesis
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/m-szczepanski/gocalc-api/pkg/calculations/distribution"
+)
+
+// Estimate is a point estimate with its confidence interval. DF is NaN for
+// intervals not based on the t distribution.
+type Estimate struct {
+	Value         float64
+	StandardError float64
+	DF            float64
+	Interval      Interval
+}
+
+// MeanCI returns the t-based two-sided confidence interval for the mean of x.
+func MeanCI(x []float64, confidence float64) (*Estimate, error) {
+	if err := checkSample("x", x); err != nil {
+		return nil, err
+	}
+	if err := checkConfidence(confidence); err != nil {
+		return nil, err
+	}
+
+	n := float64(len(x))
+	mean, variance := meanVariance(x)
+	se := math.Sqrt(variance / n)
+	return &Estimate{
+		Value:         mean,
+		StandardError: se,
+		DF:            n - 1,
+		Interval:      *interval(distribution.NewStudentT(n-1), mean, se, confidence, TwoSided),
+	}, nil
+}
+
+// ProportionMethod selects how a confidence interval for a proportion is
+// computed.
+type ProportionMethod string
+
+const (
+	Wilson ProportionMethod = "wilson"
+	Wald   ProportionMethod = "wald"
+	// Exact is the Clopper–Pearson interval from beta quantiles, which never
+	// undercovers but is conservative.
+	Exact ProportionMethod = "exact"
+)
+
+// ParseProportionMethod normalizes a method name; the empty string means
+// Wilson.
+func ParseProportionMethod(s string) (ProportionMethod, error) {
+	switch m := ProportionMethod(strings.ToLower(strings.TrimSpace(s))); m {
+	case "":
+		return Wilson, nil
+	case Wilson, Wald, Exact:
+		return m, nil
+	default:
+		return "", fmt.Errorf("invalid method %q (valid methods: wilson, wald, exact)", s)
+	}
+}
+
+// ProportionCI returns a two-sided confidence interval for the proportion of
+// successes in trials.
+func ProportionCI(successes, trials int64, confidence float64, method ProportionMethod) (*Estimate, error) {
+	if trials < 1 || successes < 0 || successes > trials {
+		return nil, fmt.Errorf("need 0 <= successes <= trials and trials >= 1, got %d of %d", successes, trials)
+	}
+	if err := checkConfidence(confidence); err != nil {
+		return nil, err
+	}
+
+	n, x := float64(trials), float64(successes)
+	p := x / n
+	estimate := &Estimate{
+		Value:         p,
+		StandardError: math.Sqrt(p * (1 - p) / n),
+		DF:            math.NaN(),
+		Interval:      Interval{Confidence: confidence},
+	}
+	alpha := 1 - confidence
+	z, _ := distribution.StandardNormal.Quantile(1 - alpha/2)
+
+	switch method {
+	case Wald:
+		margin := z * estimate.StandardError
+		estimate.Interval.Lower = math.Max(0, p-margin)
+		estimate.Interval.Upper = math.Min(1, p+margin)
+	case Exact:
+		estimate.Interval.Lower, estimate.Interval.Upper = 0, 1
+		if successes > 0 {
+			d, _ := distribution.New(distribution.Beta, distribution.Params{Alpha: x, Beta: n - x + 1})
+			estimate.Interval.Lower, _ = d.Quantile(alpha / 2)
+		}
+		if successes < trials {
+			d, _ := distribution.New(distribution.Beta, distribution.Params{Alpha: x + 1, Beta: n - x})
+			estimate.Interval.Upper, _ = d.Quantile(1 - alpha/2)
+		}
+	default:
+		z2 := z * z
+		center := (x + z2/2) / (n + z2)
+		half := z / (n + z2) * math.Sqrt(x*(n-x)/n+z2/4)
+		estimate.Interval.Lower = math.Max(0, center-half)
+		estimate.Interval.Upper = math.Min(1, center+half)
+	}
+	return estimate, nil
+}
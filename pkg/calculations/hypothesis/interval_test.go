@@ -0,0 +1,59 @@
+package hypothesis
+
+import (
+	"math"
+	"testing"
+)
+
+func TestMeanCI(t *testing.T) {
+	got, err := MeanCI(sleep1, 0.95)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !closeTo(got.Value, 0.75, 1e-15) || got.DF != 9 {
+		t.Errorf("got %+v", got)
+	}
+	if !closeTo(got.Interval.Lower, -0.5297804, 1e-6) || !closeTo(got.Interval.Upper, 2.0297804, 1e-6) {
+		t.Errorf("interval = %+v", got.Interval)
+	}
+}
+
+func TestProportionCI(t *testing.T) {
+	tests := []struct {
+		name         string
+		x, n         int64
+		method       ProportionMethod
+		lower, upper float64
+	}{
+		{"wilson", 7, 20, Wilson, 0.1811918241010821, 0.5671457233147637},
+		{"wald clamps at 0", 1, 20, Wald, 0, 0.05 + 1.959963984540054*math.Sqrt(0.05*0.95/20)},
+		{"exact with no successes", 0, 20, Exact, 0, 1 - math.Pow(0.025, 1.0/20)},
+		{"exact with all successes", 20, 20, Exact, math.Pow(0.025, 1.0/20), 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ProportionCI(tt.x, tt.n, 0.95, tt.method)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !closeTo(got.Interval.Lower, tt.lower, 1e-10) || !closeTo(got.Interval.Upper, tt.upper, 1e-10) {
+				t.Errorf("interval = %+v, want [%v, %v]", got.Interval, tt.lower, tt.upper)
+			}
+		})
+	}
+
+	// The exact interval is wider than Wilson's.
+	exact, _ := ProportionCI(7, 20, 0.95, Exact)
+	wilson, _ := ProportionCI(7, 20, 0.95, Wilson)
+	if exact.Interval.Lower >= wilson.Interval.Lower || exact.Interval.Upper <= wilson.Interval.Upper {
+		t.Errorf("exact %+v is not wider than wilson %+v", exact.Interval, wilson.Interval)
+	}
+
+	if _, err := ProportionCI(3, 2, 0.95, Wilson); err == nil {
+		t.Error("expected error for more successes than trials")
+	}
+	if _, err := ParseProportionMethod("agresti"); err == nil {
+		t.Error("expected error for an unknown method")
+	}
+}
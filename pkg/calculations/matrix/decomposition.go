@@ -0,0 +1,310 @@
+package matrix
+
+import (
+	"fmt"
+	"math"
+)
+
+// LUDecomposition holds the factorization PA = LU, where P is a permutation
+// matrix, L is unit lower triangular and U is upper triangular.
+type LUDecomposition struct {
+	L     Matrix
+	U     Matrix
+	P     Matrix
+	pivot []int
+	sign  float64
+}
+
+// LU factors a square matrix using Gaussian elimination with partial pivoting.
+// Singular matrices still factor; their U has a zero on the diagonal.
+func LU(a Matrix) (*LUDecomposition, error) {
+	n, cols := a.Dims()
+	if n != cols {
+		return nil, fmt.Errorf("%w: LU decomposition requires a square matrix, got %dx%d", ErrNotSquare, n, cols)
+	}
+
+	u := a.Clone()
+	l := Identity(n)
+	pivot := make([]int, n)
+	for i := range pivot {
+		pivot[i] = i
+	}
+	sign := 1.0
+
+	for k := 0; k < n; k++ {
+		p := k
+		for i := k + 1; i < n; i++ {
+			if math.Abs(u[i][k]) > math.Abs(u[p][k]) {
+				p = i
+			}
+		}
+		if p != k {
+			u[k], u[p] = u[p], u[k]
+			pivot[k], pivot[p] = pivot[p], pivot[k]
+			for j := 0; j < k; j++ {
+				l[k][j], l[p][j] = l[p][j], l[k][j]
+			}
+			sign = -sign
+		}
+		if u[k][k] == 0 {
+			continue
+		}
+		for i := k + 1; i < n; i++ {
+			factor := u[i][k] / u[k][k]
+			l[i][k] = factor
+			u[i][k] = 0
+			for j := k + 1; j < n; j++ {
+				u[i][j] -= factor * u[k][j]
+			}
+		}
+	}
+
+	p := Zeros(n, n)
+	for i, j := range pivot {
+		p[i][j] = 1
+	}
+
+	return &LUDecomposition{L: l, U: u, P: p, pivot: pivot, sign: sign}, nil
+}
+
+// Determinant returns det(A) from the factorization.
+func (lu *LUDecomposition) Determinant() float64 {
+	det := lu.sign
+	for i := range lu.U {
+		det *= lu.U[i][i]
+	}
+	return det
+}
+
+// singular reports whether U has an exactly zero pivot.
+func (lu *LUDecomposition) singular() bool {
+	for i := range lu.U {
+		if lu.U[i][i] == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// solve solves Ax = b by forward and back substitution. It assumes A is nonsingular.
+func (lu *LUDecomposition) solve(b []float64) []float64 {
+	n := len(lu.U)
+	x := make([]float64, n)
+	for i := 0; i < n; i++ {
+		sum := b[lu.pivot[i]]
+		for j := 0; j < i; j++ {
+			sum -= lu.L[i][j] * x[j]
+		}
+		x[i] = sum
+	}
+	for i := n - 1; i >= 0; i-- {
+		sum := x[i]
+		for j := i + 1; j < n; j++ {
+			sum -= lu.U[i][j] * x[j]
+		}
+		x[i] = sum / lu.U[i][i]
+	}
+	return x
+}
+
+// inverse returns A⁻¹ and the 1-norm condition number ||A||₁·||A⁻¹||₁.
+// For an exactly singular matrix it returns a nil inverse and +Inf.
+func (lu *LUDecomposition) inverse(a Matrix) (Matrix, float64) {
+	if lu.singular() {
+		return nil, math.Inf(1)
+	}
+	n := len(a)
+	inv := Zeros(n, n)
+	e := make([]float64, n)
+	for j := 0; j < n; j++ {
+		e[j] = 1
+		column := lu.solve(e)
+		e[j] = 0
+		for i := 0; i < n; i++ {
+			inv[i][j] = column[i]
+		}
+	}
+	cond := Norm1(a) * Norm1(inv)
+	if math.IsNaN(cond) {
+		cond = math.Inf(1)
+	}
+	return inv, cond
+}
+
+// Determinant returns det(A) for a square matrix.
+func Determinant(a Matrix) (float64, error) {
+	lu, err := LU(a)
+	if err != nil {
+		return 0, err
+	}
+	return lu.Determinant(), nil
+}
+
+// ConditionNumber returns the 1-norm condition number of a square matrix,
+// or +Inf if it is singular.
+func ConditionNumber(a Matrix) (float64, error) {
+	lu, err := LU(a)
+	if err != nil {
+		return 0, err
+	}
+	_, cond := lu.inverse(a)
+	return cond, nil
+}
+
+// Inverse returns A⁻¹ together with its 1-norm condition number. Singular and
+// ill-conditioned matrices yield a *ConditionError.
+func Inverse(a Matrix) (Matrix, float64, error) {
+	lu, err := LU(a)
+	if err != nil {
+		return nil, 0, err
+	}
+	inv, cond := lu.inverse(a)
+	if !(cond <= IllConditionedThreshold) {
+		return nil, cond, &ConditionError{ConditionNumber: cond}
+	}
+	return inv, cond, nil
+}
+
+// Solve solves the square linear system Ax = b and returns x with the 1-norm
+// condition number of A. Singular and ill-conditioned systems yield a *ConditionError.
+func Solve(a Matrix, b []float64) ([]float64, float64, error) {
+	rows, _ := a.Dims()
+	if len(b) != rows {
+		return nil, 0, fmt.Errorf("%w: right-hand side has %d entries, matrix has %d rows", ErrDimensionMismatch, len(b), rows)
+	}
+	lu, err := LU(a)
+	if err != nil {
+		return nil, 0, err
+	}
+	_, cond := lu.inverse(a)
+	if !(cond <= IllConditionedThreshold) {
+		return nil, cond, &ConditionError{ConditionNumber: cond}
+	}
+	return lu.solve(b), cond, nil
+}
+
+// QRDecomposition holds the factorization A = QR, where Q is an m x m
+// orthogonal matrix and R is an m x n upper triangular matrix.
+type QRDecomposition struct {
+	Q Matrix
+	R Matrix
+}
+
+// QR factors a using Householder reflections.
+func QR(a Matrix) (*QRDecomposition, error) {
+	rows, cols := a.Dims()
+	if rows == 0 || cols == 0 {
+		return nil, ErrEmpty
+	}
+
+	r := a.Clone()
+	q := Identity(rows)
+	v := make([]float64, rows)
+
+	for k := 0; k < min(rows-1, cols); k++ {
+		norm := 0.0
+		for i := k; i < rows; i++ {
+			norm = math.Hypot(norm, r[i][k])
+		}
+		if norm == 0 {
+			continue
+		}
+		alpha := -norm
+		if r[k][k] < 0 {
+			alpha = norm
+		}
+
+		vNorm2 := 0.0
+		for i := k; i < rows; i++ {
+			v[i] = r[i][k]
+			if i == k {
+				v[i] -= alpha
+			}
+			vNorm2 += v[i] * v[i]
+		}
+		if vNorm2 == 0 {
+			continue
+		}
+
+		// R = H R
+		for j := k; j < cols; j++ {
+			dot := 0.0
+			for i := k; i < rows; i++ {
+				dot += v[i] * r[i][j]
+			}
+			scale := 2 * dot / vNorm2
+			for i := k; i < rows; i++ {
+				r[i][j] -= scale * v[i]
+			}
+		}
+		// Q = Q H
+		for i := 0; i < rows; i++ {
+			dot := 0.0
+			for j := k; j < rows; j++ {
+				dot += q[i][j] * v[j]
+			}
+			scale := 2 * dot / vNorm2
+			for j := k; j < rows; j++ {
+				q[i][j] -= scale * v[j]
+			}
+		}
+
+		r[k][k] = alpha
+		for i := k + 1; i < rows; i++ {
+			r[i][k] = 0
+		}
+	}
+
+	return &QRDecomposition{Q: q, R: r}, nil
+}
+
+// LeastSquares returns x minimizing ||Ax - b||₂ for a matrix with at least as
+// many rows as columns, using the QR factorization. A rank-deficient A yields a
+// *ConditionError.
+func LeastSquares(a Matrix, b []float64) ([]float64, error) {
+	rows, cols := a.Dims()
+	if rows < cols {
+		return nil, fmt.Errorf("%w: least squares requires at least as many rows as columns, got %dx%d", ErrDimensionMismatch, rows, cols)
+	}
+	if len(b) != rows {
+		return nil, fmt.Errorf("%w: right-hand side has %d entries, matrix has %d rows", ErrDimensionMismatch, len(b), rows)
+	}
+
+	qr, err := QR(a)
+	if err != nil {
+		return nil, err
+	}
+
+	// Qᵀb
+	qtb := make([]float64, cols)
+	for j := 0; j < cols; j++ {
+		for i := 0; i < rows; i++ {
+			qtb[j] += qr.Q[i][j] * b[i]
+		}
+	}
+
+	maxDiag, minDiag := 0.0, math.Inf(1)
+	for k := 0; k < cols; k++ {
+		d := math.Abs(qr.R[k][k])
+		maxDiag = math.Max(maxDiag, d)
+		minDiag = math.Min(minDiag, d)
+	}
+	// The ratio of extreme diagonal entries of R is a cheap lower bound on the
+	// 2-norm condition number of A.
+	if minDiag == 0 {
+		return nil, &ConditionError{ConditionNumber: math.Inf(1)}
+	}
+	if cond := maxDiag / minDiag; !(cond <= IllConditionedThreshold) {
+		return nil, &ConditionError{ConditionNumber: cond}
+	}
+
+	x := make([]float64, cols)
+	for k := cols - 1; k >= 0; k-- {
+		sum := qtb[k]
+		for j := k + 1; j < cols; j++ {
+			sum -= qr.R[k][j] * x[j]
+		}
+		x[k] = sum / qr.R[k][k]
+	}
+	return x, nil
+}
@@ -0,0 +1,178 @@
+package matrix
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestLU(t *testing.T) {
+	a := Matrix{{2, 1, 1}, {4, -6, 0}, {-2, 7, 2}}
+
+	lu, err := LU(a)
+	if err != nil {
+		t.Fatalf("LU() unexpected error: %v", err)
+	}
+
+	pa, _ := Multiply(lu.P, a)
+	product, _ := Multiply(lu.L, lu.U)
+	if !matricesEqual(pa, product, 1e-12) {
+		t.Errorf("PA != LU: PA = %v, LU = %v", pa, product)
+	}
+
+	for i := range lu.L {
+		if lu.L[i][i] != 1 {
+			t.Errorf("L[%d][%d] = %v, want 1", i, i, lu.L[i][i])
+		}
+		for j := i + 1; j < len(lu.L); j++ {
+			if lu.L[i][j] != 0 || lu.U[j][i] != 0 {
+				t.Errorf("L or U not triangular at [%d][%d]", i, j)
+			}
+		}
+	}
+
+	if _, err := LU(Matrix{{1, 2, 3}}); !errors.Is(err, ErrNotSquare) {
+		t.Errorf("LU() error = %v, want ErrNotSquare", err)
+	}
+}
+
+func TestDeterminant(t *testing.T) {
+	tests := []struct {
+		name     string
+		m        Matrix
+		expected float64
+	}{
+		{"1x1", Matrix{{5}}, 5},
+		{"2x2", Matrix{{1, 2}, {3, 4}}, -2},
+		{"3x3", Matrix{{2, 1, 1}, {4, -6, 0}, {-2, 7, 2}}, -16},
+		{"singular", Matrix{{1, 2}, {2, 4}}, 0},
+		{"requires pivoting", Matrix{{0, 1}, {1, 0}}, -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			det, err := Determinant(tt.m)
+			if err != nil {
+				t.Fatalf("Determinant() unexpected error: %v", err)
+			}
+			if !almostEqual(det, tt.expected, 1e-9) {
+				t.Errorf("Determinant() = %v, want %v", det, tt.expected)
+			}
+		})
+	}
+}
+
+func TestInverse(t *testing.T) {
+	inv, cond, err := Inverse(Matrix{{4, 7}, {2, 6}})
+	if err != nil {
+		t.Fatalf("Inverse() unexpected error: %v", err)
+	}
+	if !matricesEqual(inv, Matrix{{0.6, -0.7}, {-0.2, 0.4}}, 1e-12) {
+		t.Errorf("Inverse() = %v", inv)
+	}
+	// ||A||₁ = 13, ||A⁻¹||₁ = 1.1
+	if !almostEqual(cond, 14.3, 1e-9) {
+		t.Errorf("condition number = %v, want 14.3", cond)
+	}
+}
+
+func TestInverseConditionErrors(t *testing.T) {
+	t.Run("singular", func(t *testing.T) {
+		_, cond, err := Inverse(Matrix{{1, 2}, {2, 4}})
+		var condErr *ConditionError
+		if !errors.As(err, &condErr) {
+			t.Fatalf("Inverse() error = %v, want *ConditionError", err)
+		}
+		if !condErr.Singular() || !math.IsInf(cond, 1) {
+			t.Errorf("expected singular matrix with infinite condition number, got %v", condErr.ConditionNumber)
+		}
+	})
+
+	t.Run("ill-conditioned", func(t *testing.T) {
+		_, _, err := Inverse(Matrix{{1, 1}, {1, 1 + 1e-14}})
+		var condErr *ConditionError
+		if !errors.As(err, &condErr) {
+			t.Fatalf("Inverse() error = %v, want *ConditionError", err)
+		}
+		if condErr.Singular() || condErr.ConditionNumber <= IllConditionedThreshold {
+			t.Errorf("expected finite condition number above threshold, got %v", condErr.ConditionNumber)
+		}
+	})
+}
+
+func TestConditionErrorNaN(t *testing.T) {
+	err := &ConditionError{ConditionNumber: math.NaN()}
+	if err.Singular() || err.Error() != "matrix is ill-conditioned (condition number could not be computed)" {
+		t.Errorf("ConditionError{NaN} = %q, singular %v", err.Error(), err.Singular())
+	}
+}
+
+func TestSolve(t *testing.T) {
+	x, _, err := Solve(Matrix{{2, 1, -1}, {-3, -1, 2}, {-2, 1, 2}}, []float64{8, -11, -3})
+	if err != nil {
+		t.Fatalf("Solve() unexpected error: %v", err)
+	}
+	expected := []float64{2, 3, -1}
+	for i := range expected {
+		if !almostEqual(x[i], expected[i], 1e-12) {
+			t.Errorf("x[%d] = %v, want %v", i, x[i], expected[i])
+		}
+	}
+
+	if _, _, err := Solve(Matrix{{1, 2}, {3, 4}}, []float64{1}); !errors.Is(err, ErrDimensionMismatch) {
+		t.Errorf("Solve() error = %v, want ErrDimensionMismatch", err)
+	}
+
+	var condErr *ConditionError
+	if _, _, err := Solve(Matrix{{1, 2}, {2, 4}}, []float64{1, 2}); !errors.As(err, &condErr) {
+		t.Errorf("Solve() error = %v, want *ConditionError", err)
+	}
+}
+
+func TestQR(t *testing.T) {
+	a := Matrix{{12, -51, 4}, {6, 167, -68}, {-4, 24, -41}, {1, 2, 3}}
+
+	qr, err := QR(a)
+	if err != nil {
+		t.Fatalf("QR() unexpected error: %v", err)
+	}
+
+	product, _ := Multiply(qr.Q, qr.R)
+	if !matricesEqual(product, a, 1e-10) {
+		t.Errorf("QR != A: %v", product)
+	}
+
+	qtq, _ := Multiply(Transpose(qr.Q), qr.Q)
+	if !matricesEqual(qtq, Identity(4), 1e-12) {
+		t.Errorf("Q is not orthogonal: QᵀQ = %v", qtq)
+	}
+
+	for i := range qr.R {
+		for j := 0; j < i && j < len(qr.R[i]); j++ {
+			if qr.R[i][j] != 0 {
+				t.Errorf("R[%d][%d] = %v, want 0", i, j, qr.R[i][j])
+			}
+		}
+	}
+}
+
+func TestLeastSquares(t *testing.T) {
+	// Fit y = c0 + c1*x to (0,1), (1,3), (2,5), (3,7)
+	a := Matrix{{1, 0}, {1, 1}, {1, 2}, {1, 3}}
+	x, err := LeastSquares(a, []float64{1, 3, 5, 7})
+	if err != nil {
+		t.Fatalf("LeastSquares() unexpected error: %v", err)
+	}
+	if !almostEqual(x[0], 1, 1e-12) || !almostEqual(x[1], 2, 1e-12) {
+		t.Errorf("LeastSquares() = %v, want [1 2]", x)
+	}
+
+	var condErr *ConditionError
+	if _, err := LeastSquares(Matrix{{1, 1}, {1, 1}, {1, 1}}, []float64{1, 2, 3}); !errors.As(err, &condErr) {
+		t.Errorf("LeastSquares() error = %v, want *ConditionError", err)
+	}
+
+	if _, err := LeastSquares(Matrix{{1, 2, 3}}, []float64{1}); !errors.Is(err, ErrDimensionMismatch) {
+		t.Errorf("LeastSquares() error = %v, want ErrDimensionMismatch", err)
+	}
+}
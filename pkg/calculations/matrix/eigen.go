@@ -0,0 +1,293 @@
+package matrix
+
+import (
+	"errors"
+	"math"
+)
+
+// ErrEigenNoConvergence is returned when the QR algorithm fails to isolate an
+// eigenvalue within maxEigenIterations shifts.
+var ErrEigenNoConvergence = errors.New("eigenvalue iteration did not converge")
+
+const maxEigenIterations = 30
+
+// Eigenvalues returns the eigenvalues of a square matrix, real or complex, in
+// no particular order. The matrix is balanced, reduced to upper Hessenberg form
+// by stabilized elimination and then deflated by the Francis double-shift QR
+// algorithm.
+func Eigenvalues(a Matrix) ([]complex128, error) {
+	if err := Validate(a); err != nil {
+		return nil, err
+	}
+	n, cols := a.Dims()
+	if n != cols {
+		return nil, ErrNotSquare
+	}
+
+	// The routines below index from 1, as in the EISPACK formulation they follow.
+	h := make([][]float64, n+1)
+	for i := range h {
+		h[i] = make([]float64, n+1)
+	}
+	for i := 0; i < n; i++ {
+		copy(h[i+1][1:], a[i])
+	}
+
+	balance(h, n)
+	reduceToHessenberg(h, n)
+	return hessenbergEigenvalues(h, n)
+}
+
+// balance rescales rows and columns by powers of two so their norms are
+// comparable, which improves the accuracy of the eigenvalues without changing them.
+func balance(a [][]float64, n int) {
+	const radix = 2.0
+	done := false
+	for !done {
+		done = true
+		for i := 1; i <= n; i++ {
+			var c, r float64
+			for j := 1; j <= n; j++ {
+				if j != i {
+					c += math.Abs(a[j][i])
+					r += math.Abs(a[i][j])
+				}
+			}
+			if c == 0 || r == 0 {
+				continue
+			}
+			g := r / radix
+			f := 1.0
+			s := c + r
+			for c < g {
+				f *= radix
+				c *= radix * radix
+			}
+			g = r * radix
+			for c > g {
+				f /= radix
+				c /= radix * radix
+			}
+			if (c+r)/f < 0.95*s {
+				done = false
+				for j := 1; j <= n; j++ {
+					a[i][j] /= f
+				}
+				for j := 1; j <= n; j++ {
+					a[j][i] *= f
+				}
+			}
+		}
+	}
+}
+
+// reduceToHessenberg applies Gaussian elimination with pivoting as similarity
+// transformations, zeroing everything below the first subdiagonal.
+func reduceToHessenberg(a [][]float64, n int) {
+	for m := 2; m < n; m++ {
+		x := 0.0
+		pivot := m
+		for j := m; j <= n; j++ {
+			if math.Abs(a[j][m-1]) > math.Abs(x) {
+				x = a[j][m-1]
+				pivot = j
+			}
+		}
+		if pivot != m {
+			for j := m - 1; j <= n; j++ {
+				a[pivot][j], a[m][j] = a[m][j], a[pivot][j]
+			}
+			for j := 1; j <= n; j++ {
+				a[j][pivot], a[j][m] = a[j][m], a[j][pivot]
+			}
+		}
+		if x == 0 {
+			continue
+		}
+		for i := m + 1; i <= n; i++ {
+			y := a[i][m-1]
+			if y == 0 {
+				continue
+			}
+			y /= x
+			a[i][m-1] = 0
+			for j := m; j <= n; j++ {
+				a[i][j] -= y * a[m][j]
+			}
+			for j := 1; j <= n; j++ {
+				a[j][m] += y * a[j][i]
+			}
+		}
+	}
+}
+
+// hessenbergEigenvalues finds all eigenvalues of an upper Hessenberg matrix
+// with the Francis double-shift QR algorithm, destroying a.
+func hessenbergEigenvalues(a [][]float64, n int) ([]complex128, error) {
+	wr := make([]float64, n+1)
+	wi := make([]float64, n+1)
+
+	var anorm float64
+	for i := 1; i <= n; i++ {
+		for j := max(i-1, 1); j <= n; j++ {
+			anorm += math.Abs(a[i][j])
+		}
+	}
+
+	nn := n
+	t := 0.0
+	for nn >= 1 {
+		its := 0
+		var l int
+		for {
+			// Look for a single small subdiagonal element to split the matrix.
+			for l = nn; l >= 2; l-- {
+				s := math.Abs(a[l-1][l-1]) + math.Abs(a[l][l])
+				if s == 0 {
+					s = anorm
+				}
+				if math.Abs(a[l][l-1])+s == s {
+					a[l][l-1] = 0
+					break
+				}
+			}
+
+			x := a[nn][nn]
+			if l == nn {
+				// One root found.
+				wr[nn] = x + t
+				wi[nn] = 0
+				nn--
+				break
+			}
+
+			y := a[nn-1][nn-1]
+			w := a[nn][nn-1] * a[nn-1][nn]
+			if l == nn-1 {
+				// Two roots found.
+				p := 0.5 * (y - x)
+				q := p*p + w
+				z := math.Sqrt(math.Abs(q))
+				x += t
+				if q >= 0 {
+					z = p + math.Copysign(z, p)
+					wr[nn-1], wr[nn] = x+z, x+z
+					if z != 0 {
+						wr[nn] = x - w/z
+					}
+					wi[nn-1], wi[nn] = 0, 0
+				} else {
+					wr[nn-1], wr[nn] = x+p, x+p
+					wi[nn-1], wi[nn] = -z, z
+				}
+				nn -= 2
+				break
+			}
+
+			if its == maxEigenIterations {
+				return nil, ErrEigenNoConvergence
+			}
+			if its == 10 || its == 20 {
+				// Exceptional shift.
+				t += x
+				for i := 1; i <= nn; i++ {
+					a[i][i] -= x
+				}
+				s := math.Abs(a[nn][nn-1]) + math.Abs(a[nn-1][nn-2])
+				x = 0.75 * s
+				y = x
+				w = -0.4375 * s * s
+			}
+			its++
+
+			// Form the shift and look for two consecutive small subdiagonal elements.
+			var m int
+			var p, q, r, z float64
+			for m = nn - 2; m >= l; m-- {
+				z = a[m][m]
+				r = x - z
+				s := y - z
+				p = (r*s-w)/a[m+1][m] + a[m][m+1]
+				q = a[m+1][m+1] - z - r - s
+				r = a[m+2][m+1]
+				s = math.Abs(p) + math.Abs(q) + math.Abs(r)
+				p /= s
+				q /= s
+				r /= s
+				if m == l {
+					break
+				}
+				u := math.Abs(a[m][m-1]) * (math.Abs(q) + math.Abs(r))
+				v := math.Abs(p) * (math.Abs(a[m-1][m-1]) + math.Abs(z) + math.Abs(a[m+1][m+1]))
+				if u+v == v {
+					break
+				}
+			}
+			for i := m + 2; i <= nn; i++ {
+				a[i][i-2] = 0
+				if i != m+2 {
+					a[i][i-3] = 0
+				}
+			}
+
+			// Double QR step on rows l..nn and columns m..nn.
+			for k := m; k <= nn-1; k++ {
+				if k != m {
+					p = a[k][k-1]
+					q = a[k+1][k-1]
+					r = 0
+					if k != nn-1 {
+						r = a[k+2][k-1]
+					}
+					x = math.Abs(p) + math.Abs(q) + math.Abs(r)
+					if x != 0 {
+						p /= x
+						q /= x
+						r /= x
+					}
+				}
+				s := math.Copysign(math.Sqrt(p*p+q*q+r*r), p)
+				if s == 0 {
+					continue
+				}
+				if k == m {
+					if l != m {
+						a[k][k-1] = -a[k][k-1]
+					}
+				} else {
+					a[k][k-1] = -s * x
+				}
+				p += s
+				x = p / s
+				y = q / s
+				z = r / s
+				q /= p
+				r /= p
+				for j := k; j <= nn; j++ {
+					p = a[k][j] + q*a[k+1][j]
+					if k != nn-1 {
+						p += r * a[k+2][j]
+						a[k+2][j] -= p * z
+					}
+					a[k+1][j] -= p * y
+					a[k][j] -= p * x
+				}
+				for i := l; i <= min(nn, k+3); i++ {
+					p = x*a[i][k] + y*a[i][k+1]
+					if k != nn-1 {
+						p += z * a[i][k+2]
+						a[i][k+2] -= p * r
+					}
+					a[i][k+1] -= p * q
+					a[i][k] -= p
+				}
+			}
+		}
+	}
+
+	values := make([]complex128, n)
+	for i := 1; i <= n; i++ {
+		values[i-1] = complex(wr[i], wi[i])
+	}
+	return values, nil
+}
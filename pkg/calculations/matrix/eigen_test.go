@@ -0,0 +1,90 @@
+package matrix
+
+import (
+	"errors"
+	"math"
+	"math/cmplx"
+	"sort"
+	"testing"
+)
+
+func sortComplex(values []complex128) {
+	sort.Slice(values, func(i, j int) bool {
+		if real(values[i]) != real(values[j]) {
+			return real(values[i]) < real(values[j])
+		}
+		return imag(values[i]) < imag(values[j])
+	})
+}
+
+func TestEigenvalues(t *testing.T) {
+	tests := []struct {
+		name     string
+		m        Matrix
+		expected []complex128
+	}{
+		{"1x1", Matrix{{5}}, []complex128{5}},
+		{"diagonal", Matrix{{3, 0, 0}, {0, -1, 0}, {0, 0, 2}}, []complex128{-1, 2, 3}},
+		{"symmetric", Matrix{{2, 1}, {1, 2}}, []complex128{1, 3}},
+		{"rotation", Matrix{{0, -1}, {1, 0}}, []complex128{-1i, 1i}},
+		{"upper triangular", Matrix{{1, 2, 3}, {0, 4, 5}, {0, 0, 6}}, []complex128{1, 4, 6}},
+		// Companion matrix of (x-1)(x-2)(x-3)(x-4) = x^4 - 10x^3 + 35x^2 - 50x + 24
+		{"companion", Matrix{{10, -35, 50, -24}, {1, 0, 0, 0}, {0, 1, 0, 0}, {0, 0, 1, 0}}, []complex128{1, 2, 3, 4}},
+		// Companion matrix of x^4 + 1, whose roots are the primitive 8th roots of unity
+		{"complex pairs", Matrix{{0, 0, 0, -1}, {1, 0, 0, 0}, {0, 1, 0, 0}, {0, 0, 1, 0}}, []complex128{
+			complex(-math.Sqrt2/2, -math.Sqrt2/2), complex(-math.Sqrt2/2, math.Sqrt2/2),
+			complex(math.Sqrt2/2, -math.Sqrt2/2), complex(math.Sqrt2/2, math.Sqrt2/2),
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values, err := Eigenvalues(tt.m)
+			if err != nil {
+				t.Fatalf("Eigenvalues() unexpected error: %v", err)
+			}
+			sortComplex(values)
+			if len(values) != len(tt.expected) {
+				t.Fatalf("Eigenvalues() = %v, want %v", values, tt.expected)
+			}
+			for i := range values {
+				if cmplx.Abs(values[i]-tt.expected[i]) > 1e-9 {
+					t.Errorf("Eigenvalues() = %v, want %v", values, tt.expected)
+					break
+				}
+			}
+		})
+	}
+
+	if _, err := Eigenvalues(Matrix{{1, 2, 3}}); !errors.Is(err, ErrNotSquare) {
+		t.Errorf("Eigenvalues() error = %v, want ErrNotSquare", err)
+	}
+}
+
+func TestEigenvaluesTraceAndDeterminant(t *testing.T) {
+	m := Matrix{
+		{4, -2, 1, 3, 0},
+		{1, 5, -1, 0, 2},
+		{0, 3, -2, 4, 1},
+		{2, 0, 1, 1, -3},
+		{-1, 2, 0, 2, 6},
+	}
+	values, err := Eigenvalues(m)
+	if err != nil {
+		t.Fatalf("Eigenvalues() unexpected error: %v", err)
+	}
+
+	var sum complex128
+	product := complex(1, 0)
+	for _, v := range values {
+		sum += v
+		product *= v
+	}
+	det, _ := Determinant(m)
+	if cmplx.Abs(sum-14) > 1e-9 {
+		t.Errorf("sum of eigenvalues = %v, want trace 14", sum)
+	}
+	if cmplx.Abs(product-complex(det, 0)) > 1e-8*math.Abs(det) {
+		t.Errorf("product of eigenvalues = %v, want determinant %v", product, det)
+	}
+}
@@ -0,0 +1,199 @@
+// Package matrix implements dense real matrix operations: arithmetic,
+// determinants, inverses, rank, LU and QR decompositions, linear solves and
+// eigenvalues.
+package matrix
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Matrix is a dense matrix stored as a slice of rows.
+type Matrix [][]float64
+
+var (
+	ErrEmpty             = errors.New("matrix must have at least one row and one column")
+	ErrNotRectangular    = errors.New("matrix rows must all have the same length")
+	ErrNotFinite         = errors.New("matrix entries must be finite numbers")
+	ErrNotSquare         = errors.New("matrix must be square")
+	ErrDimensionMismatch = errors.New("matrix dimensions do not match")
+)
+
+// IllConditionedThreshold is the 1-norm condition number above which a matrix
+// is treated as numerically singular: solutions would lose about 12 of the
+// roughly 16 significant digits float64 carries.
+const IllConditionedThreshold = 1e12
+
+// ConditionError reports a singular or ill-conditioned matrix.
+// ConditionNumber is +Inf for an exactly singular matrix and NaN when it
+// could not be computed because the entries overflow.
+type ConditionError struct {
+	ConditionNumber float64
+}
+
+func (e *ConditionError) Error() string {
+	if e.Singular() {
+		return "matrix is singular"
+	}
+	if math.IsNaN(e.ConditionNumber) {
+		return "matrix is ill-conditioned (condition number could not be computed)"
+	}
+	return fmt.Sprintf("matrix is ill-conditioned (condition number %.3g exceeds %.0e)", e.ConditionNumber, IllConditionedThreshold)
+}
+
+// Singular reports whether the matrix is exactly singular.
+func (e *ConditionError) Singular() bool {
+	return math.IsInf(e.ConditionNumber, 1)
+}
+
+// Validate checks that m is non-empty, rectangular and contains only finite values.
+func Validate(m Matrix) error {
+	if len(m) == 0 || len(m[0]) == 0 {
+		return ErrEmpty
+	}
+	for i, row := range m {
+		if len(row) != len(m[0]) {
+			return fmt.Errorf("%w: row %d has %d columns, expected %d", ErrNotRectangular, i, len(row), len(m[0]))
+		}
+		for j, v := range row {
+			if math.IsNaN(v) || math.IsInf(v, 0) {
+				return fmt.Errorf("%w: entry [%d][%d] is %v", ErrNotFinite, i, j, v)
+			}
+		}
+	}
+	return nil
+}
+
+// Dims returns the number of rows and columns of m.
+func (m Matrix) Dims() (rows, cols int) {
+	if len(m) == 0 {
+		return 0, 0
+	}
+	return len(m), len(m[0])
+}
+
+// Zeros returns a rows x cols matrix of zeros.
+func Zeros(rows, cols int) Matrix {
+	m := make(Matrix, rows)
+	for i := range m {
+		m[i] = make([]float64, cols)
+	}
+	return m
+}
+
+// Identity returns the n x n identity matrix.
+func Identity(n int) Matrix {
+	m := Zeros(n, n)
+	for i := range m {
+		m[i][i] = 1
+	}
+	return m
+}
+
+// Clone returns a deep copy of m.
+func (m Matrix) Clone() Matrix {
+	c := make(Matrix, len(m))
+	for i, row := range m {
+		c[i] = append([]float64(nil), row...)
+	}
+	return c
+}
+
+func Add(a, b Matrix) (Matrix, error) {
+	ar, ac := a.Dims()
+	br, bc := b.Dims()
+	if ar != br || ac != bc {
+		return nil, fmt.Errorf("%w: cannot add %dx%d and %dx%d", ErrDimensionMismatch, ar, ac, br, bc)
+	}
+	result := Zeros(ar, ac)
+	for i := range a {
+		for j := range a[i] {
+			result[i][j] = a[i][j] + b[i][j]
+		}
+	}
+	return result, nil
+}
+
+func Multiply(a, b Matrix) (Matrix, error) {
+	ar, ac := a.Dims()
+	br, bc := b.Dims()
+	if ac != br {
+		return nil, fmt.Errorf("%w: cannot multiply %dx%d by %dx%d", ErrDimensionMismatch, ar, ac, br, bc)
+	}
+	result := Zeros(ar, bc)
+	for i := 0; i < ar; i++ {
+		for k := 0; k < ac; k++ {
+			aik := a[i][k]
+			for j := 0; j < bc; j++ {
+				result[i][j] += aik * b[k][j]
+			}
+		}
+	}
+	return result, nil
+}
+
+func Transpose(a Matrix) Matrix {
+	rows, cols := a.Dims()
+	result := Zeros(cols, rows)
+	for i := 0; i < rows; i++ {
+		for j := 0; j < cols; j++ {
+			result[j][i] = a[i][j]
+		}
+	}
+	return result
+}
+
+// Norm1 returns the maximum absolute column sum of m.
+func Norm1(m Matrix) float64 {
+	rows, cols := m.Dims()
+	norm := 0.0
+	for j := 0; j < cols; j++ {
+		sum := 0.0
+		for i := 0; i < rows; i++ {
+			sum += math.Abs(m[i][j])
+		}
+		norm = math.Max(norm, sum)
+	}
+	return norm
+}
+
+// Rank returns the numerical rank of m, counting pivots of Gaussian elimination
+// with partial pivoting that exceed max(rows, cols) * eps * max|m[i][j]|.
+func Rank(m Matrix) int {
+	a := m.Clone()
+	rows, cols := a.Dims()
+
+	maxAbs := 0.0
+	for _, row := range a {
+		for _, v := range row {
+			maxAbs = math.Max(maxAbs, math.Abs(v))
+		}
+	}
+	tolerance := float64(max(rows, cols)) * epsilon * maxAbs
+
+	rank := 0
+	for col := 0; col < cols && rank < rows; col++ {
+		pivot := rank
+		for i := rank + 1; i < rows; i++ {
+			if math.Abs(a[i][col]) > math.Abs(a[pivot][col]) {
+				pivot = i
+			}
+		}
+		if math.Abs(a[pivot][col]) <= tolerance {
+			continue
+		}
+		a[rank], a[pivot] = a[pivot], a[rank]
+		for i := rank + 1; i < rows; i++ {
+			factor := a[i][col] / a[rank][col]
+			for j := col; j < cols; j++ {
+				a[i][j] -= factor * a[rank][j]
+			}
+		}
+		rank++
+	}
+	return rank
+}
+
+// epsilon is the float64 machine epsilon (2^-52).
+const epsilon = 2.220446049250313e-16
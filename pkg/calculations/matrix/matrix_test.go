@@ -0,0 +1,107 @@
+package matrix
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func almostEqual(a, b, tolerance float64) bool {
+	return math.Abs(a-b) <= tolerance
+}
+
+func matricesEqual(a, b Matrix, tolerance float64) bool {
+	ar, ac := a.Dims()
+	br, bc := b.Dims()
+	if ar != br || ac != bc {
+		return false
+	}
+	for i := range a {
+		for j := range a[i] {
+			if !almostEqual(a[i][j], b[i][j], tolerance) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		m       Matrix
+		wantErr error
+	}{
+		{"valid", Matrix{{1, 2}, {3, 4}}, nil},
+		{"empty", Matrix{}, ErrEmpty},
+		{"empty row", Matrix{{}}, ErrEmpty},
+		{"ragged", Matrix{{1, 2}, {3}}, ErrNotRectangular},
+		{"NaN", Matrix{{1, math.NaN()}}, ErrNotFinite},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.m)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAdd(t *testing.T) {
+	result, err := Add(Matrix{{1, 2}, {3, 4}}, Matrix{{5, 6}, {7, 8}})
+	if err != nil {
+		t.Fatalf("Add() unexpected error: %v", err)
+	}
+	if !matricesEqual(result, Matrix{{6, 8}, {10, 12}}, 0) {
+		t.Errorf("Add() = %v", result)
+	}
+
+	if _, err := Add(Matrix{{1, 2}}, Matrix{{1}, {2}}); !errors.Is(err, ErrDimensionMismatch) {
+		t.Errorf("Add() error = %v, want ErrDimensionMismatch", err)
+	}
+}
+
+func TestMultiply(t *testing.T) {
+	result, err := Multiply(Matrix{{1, 2, 3}, {4, 5, 6}}, Matrix{{7, 8}, {9, 10}, {11, 12}})
+	if err != nil {
+		t.Fatalf("Multiply() unexpected error: %v", err)
+	}
+	if !matricesEqual(result, Matrix{{58, 64}, {139, 154}}, 0) {
+		t.Errorf("Multiply() = %v", result)
+	}
+
+	if _, err := Multiply(Matrix{{1, 2}}, Matrix{{1, 2}}); !errors.Is(err, ErrDimensionMismatch) {
+		t.Errorf("Multiply() error = %v, want ErrDimensionMismatch", err)
+	}
+}
+
+func TestTranspose(t *testing.T) {
+	result := Transpose(Matrix{{1, 2, 3}, {4, 5, 6}})
+	if !matricesEqual(result, Matrix{{1, 4}, {2, 5}, {3, 6}}, 0) {
+		t.Errorf("Transpose() = %v", result)
+	}
+}
+
+func TestRank(t *testing.T) {
+	tests := []struct {
+		name     string
+		m        Matrix
+		expected int
+	}{
+		{"full rank square", Matrix{{1, 2}, {3, 4}}, 2},
+		{"rank deficient", Matrix{{1, 2, 3}, {2, 4, 6}, {1, 1, 1}}, 2},
+		{"zero matrix", Matrix{{0, 0}, {0, 0}}, 0},
+		{"wide", Matrix{{1, 0, 2}, {0, 1, 3}}, 2},
+		{"tall", Matrix{{1}, {2}, {3}}, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if rank := Rank(tt.m); rank != tt.expected {
+				t.Errorf("Rank() = %d, want %d", rank, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+// Package numtheory implements integer number theory on arbitrary-size
+// integers: GCD and LCM, primality testing, factorization, prime sieving,
+// modular exponentiation and inverses, and Euler's totient.
+package numtheory
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+var (
+	ErrInvalidInteger = errors.New("invalid integer")
+	ErrOutOfRange     = errors.New("integer out of range")
+	ErrNoInverse      = errors.New("no modular inverse")
+)
+
+// MaxIntegerBits bounds the size of parsed integers so that modular
+// exponentiation stays fast (4096 bits is roughly 1233 decimal digits).
+const MaxIntegerBits = 4096
+
+// MaxLCMBits bounds the size of an LCM result; the LCM of many large coprime
+// values grows with their product.
+const MaxLCMBits = 16 * MaxIntegerBits
+
+// maxIntegerDigits rejects oversized strings before they are parsed.
+const maxIntegerDigits = 1234
+
+// ParseInteger parses a base-10 integer with an optional leading sign.
+// Integers are exchanged as strings so clients can send values beyond the
+// 2^53 limit of JSON numbers.
+func ParseInteger(s string) (*big.Int, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, fmt.Errorf("%w: empty string", ErrInvalidInteger)
+	}
+	if len(strings.TrimLeft(s, "+-")) > maxIntegerDigits {
+		return nil, fmt.Errorf("%w: integers are limited to %d bits", ErrOutOfRange, MaxIntegerBits)
+	}
+	n, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return nil, fmt.Errorf("%w: %q is not a base-10 integer", ErrInvalidInteger, s)
+	}
+	if n.BitLen() > MaxIntegerBits {
+		return nil, fmt.Errorf("%w: integers are limited to %d bits", ErrOutOfRange, MaxIntegerBits)
+	}
+	return n, nil
+}
+
+// GCD returns the greatest common divisor of the absolute values of values.
+// The GCD of an all-zero list is 0.
+func GCD(values []*big.Int) *big.Int {
+	result := new(big.Int)
+	for _, v := range values {
+		result.GCD(nil, nil, result, v)
+	}
+	return result
+}
+
+// LCM returns the least common multiple of the absolute values of values.
+// The LCM of a list containing 0 is 0.
+func LCM(values []*big.Int) (*big.Int, error) {
+	for _, v := range values {
+		if v.Sign() == 0 {
+			return new(big.Int), nil
+		}
+	}
+	result := big.NewInt(1)
+	gcd := new(big.Int)
+	for _, v := range values {
+		gcd.GCD(nil, nil, result, v)
+		result.Div(result, gcd)
+		result.Mul(result, new(big.Int).Abs(v))
+		if result.BitLen() > MaxLCMBits {
+			return nil, fmt.Errorf("%w: least common multiple exceeds %d bits", ErrOutOfRange, MaxLCMBits)
+		}
+	}
+	return result, nil
+}
+
+// ModPow returns base^exponent mod modulus in [0, modulus). A negative
+// exponent raises the modular inverse of base, which must exist.
+func ModPow(base, exponent, modulus *big.Int) (*big.Int, error) {
+	if modulus.Sign() <= 0 {
+		return nil, fmt.Errorf("%w: modulus must be positive", ErrOutOfRange)
+	}
+	b := new(big.Int).Mod(base, modulus)
+	e := new(big.Int).Set(exponent)
+	if e.Sign() < 0 {
+		inverse, err := ModInverse(b, modulus)
+		if err != nil {
+			return nil, err
+		}
+		b, e = inverse, e.Neg(e)
+	}
+	return new(big.Int).Exp(b, e, modulus), nil
+}
+
+// ModInverse returns x in [0, modulus) with a*x ≡ 1 (mod modulus).
+func ModInverse(a, modulus *big.Int) (*big.Int, error) {
+	if modulus.Sign() <= 0 {
+		return nil, fmt.Errorf("%w: modulus must be positive", ErrOutOfRange)
+	}
+	if modulus.Cmp(big.NewInt(1)) == 0 {
+		return new(big.Int), nil
+	}
+	r := new(big.Int).Mod(a, modulus)
+	if r.ModInverse(r, modulus) == nil {
+		return nil, fmt.Errorf("%w: %s and %s are not coprime", ErrNoInverse, a, modulus)
+	}
+	return r, nil
+}
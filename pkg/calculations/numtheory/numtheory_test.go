@@ -0,0 +1,155 @@
+package numtheory
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+func bigInts(values ...string) []*big.Int {
+	result := make([]*big.Int, len(values))
+	for i, v := range values {
+		result[i], _ = new(big.Int).SetString(v, 10)
+	}
+	return result
+}
+
+func TestParseInteger(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+		wantErr  error
+	}{
+		{"small", "42", "42", nil},
+		{"negative", "-17", "-17", nil},
+		{"explicit plus with whitespace", " +5 ", "5", nil},
+		{"beyond int64", "123456789012345678901234567890", "123456789012345678901234567890", nil},
+		{"empty", "", "", ErrInvalidInteger},
+		{"decimal point", "1.5", "", ErrInvalidInteger},
+		{"hex", "0x1F", "", ErrInvalidInteger},
+		{"too many digits", strings.Repeat("9", 1300), "", ErrOutOfRange},
+		{"too many bits", "2" + strings.Repeat("0", 1233), "", ErrOutOfRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n, err := ParseInteger(tt.input)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("ParseInteger(%q) error = %v, want %v", tt.input, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseInteger(%q) unexpected error: %v", tt.input, err)
+			}
+			if n.String() != tt.expected {
+				t.Errorf("ParseInteger(%q) = %s, want %s", tt.input, n, tt.expected)
+			}
+		})
+	}
+}
+
+func TestGCDAndLCM(t *testing.T) {
+	tests := []struct {
+		name        string
+		values      []*big.Int
+		expectedGCD string
+		expectedLCM string
+	}{
+		{"pair", bigInts("12", "18"), "6", "36"},
+		{"list", bigInts("24", "36", "60"), "12", "360"},
+		{"negative values", bigInts("-4", "6"), "2", "12"},
+		{"coprime", bigInts("7", "13"), "1", "91"},
+		{"with zero", bigInts("0", "5"), "5", "0"},
+		{"all zero", bigInts("0", "0"), "0", "0"},
+		{"big", bigInts("123456789012345678901234567890", "987654321098765432109876543210"), "9000000000900000000090", "13548070124980948012498094801236261410"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if gcd := GCD(tt.values); gcd.String() != tt.expectedGCD {
+				t.Errorf("GCD() = %s, want %s", gcd, tt.expectedGCD)
+			}
+			lcm, err := LCM(tt.values)
+			if err != nil {
+				t.Fatalf("LCM() unexpected error: %v", err)
+			}
+			if lcm.String() != tt.expectedLCM {
+				t.Errorf("LCM() = %s, want %s", lcm, tt.expectedLCM)
+			}
+		})
+	}
+}
+
+func TestLCMTooLarge(t *testing.T) {
+	// Any common factor of 2^4000+i and 2^4000+j divides i-j, so the LCM of
+	// these 20 values is close to their 80000-bit product.
+	values := make([]*big.Int, 20)
+	for i := range values {
+		values[i] = new(big.Int).Lsh(big.NewInt(1), 4000)
+		values[i].Add(values[i], big.NewInt(int64(i)))
+	}
+	if _, err := LCM(values); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("LCM() error = %v, want ErrOutOfRange", err)
+	}
+}
+
+func TestModPow(t *testing.T) {
+	tests := []struct {
+		name                    string
+		base, exponent, modulus string
+		expected                string
+		wantErr                 error
+	}{
+		{"basic", "4", "13", "497", "445", nil},
+		{"negative base", "-2", "3", "5", "2", nil},
+		{"negative exponent", "3", "-1", "11", "4", nil},
+		{"modulus one", "5", "3", "1", "0", nil},
+		{"zero exponent", "7", "0", "13", "1", nil},
+		{"no inverse", "2", "-1", "4", "", ErrNoInverse},
+		{"zero modulus", "2", "3", "0", "", ErrOutOfRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args := bigInts(tt.base, tt.exponent, tt.modulus)
+			result, err := ModPow(args[0], args[1], args[2])
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("ModPow() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ModPow() unexpected error: %v", err)
+			}
+			if result.String() != tt.expected {
+				t.Errorf("ModPow() = %s, want %s", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestModInverse(t *testing.T) {
+	args := bigInts("17", "3120")
+	inverse, err := ModInverse(args[0], args[1])
+	if err != nil {
+		t.Fatalf("ModInverse() unexpected error: %v", err)
+	}
+	if inverse.String() != "2753" {
+		t.Errorf("ModInverse(17, 3120) = %s, want 2753", inverse)
+	}
+
+	args = bigInts("-3", "11")
+	if inverse, _ := ModInverse(args[0], args[1]); inverse.String() != "7" {
+		t.Errorf("ModInverse(-3, 11) = %s, want 7", inverse)
+	}
+
+	args = bigInts("6", "9")
+	if _, err := ModInverse(args[0], args[1]); !errors.Is(err, ErrNoInverse) {
+		t.Errorf("ModInverse(6, 9) error = %v, want ErrNoInverse", err)
+	}
+}
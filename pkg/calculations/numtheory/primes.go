@@ -0,0 +1,238 @@
+package numtheory
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+)
+
+// MillerRabinRounds is the number of random-base Miller-Rabin rounds used by
+// IsPrime, on top of the Baillie-PSW test big.Int.ProbablyPrime always runs.
+const MillerRabinRounds = 20
+
+const (
+	// MaxSieveLimit is the largest upper bound accepted by PrimesInRange.
+	MaxSieveLimit = 1_000_000_000_000
+	// MaxSieveSpan is the widest range PrimesInRange will sieve in one call.
+	MaxSieveSpan = 1_000_000
+)
+
+// IsPrime tests n for primality. Certain is true when the answer is proven:
+// composites are always proven, and ProbablyPrime is exact below 2^64.
+// Larger probable primes have a false-positive chance below 4^-MillerRabinRounds.
+func IsPrime(n *big.Int) (prime, certain bool) {
+	if n.Sign() <= 0 || n.Cmp(big.NewInt(1)) == 0 {
+		return false, true
+	}
+	prime = n.ProbablyPrime(MillerRabinRounds)
+	return prime, !prime || n.BitLen() <= 64
+}
+
+// Factor is a prime factor and its multiplicity.
+type Factor struct {
+	Prime    *big.Int
+	Exponent int
+}
+
+// Factorize returns the prime factorization of n >= 1 in ascending order of
+// primes; 1 has no factors. Small factors are removed by trial division and
+// the rest are split with Brent's variant of Pollard's rho. Factoring large
+// semiprimes can take arbitrarily long, so the context is checked
+// periodically and its error returned when it is done.
+func Factorize(ctx context.Context, n *big.Int) ([]Factor, error) {
+	if n.Sign() <= 0 {
+		return nil, fmt.Errorf("%w: only positive integers can be factorized", ErrOutOfRange)
+	}
+
+	counts := make(map[string]*Factor)
+	add := func(p *big.Int) {
+		key := p.String()
+		if f, ok := counts[key]; ok {
+			f.Exponent++
+			return
+		}
+		counts[key] = &Factor{Prime: new(big.Int).Set(p), Exponent: 1}
+	}
+
+	remaining := new(big.Int).Set(n)
+	q, r := new(big.Int), new(big.Int)
+	for _, p := range smallPrimes {
+		bp := big.NewInt(int64(p))
+		for {
+			q.QuoRem(remaining, bp, r)
+			if r.Sign() != 0 {
+				break
+			}
+			add(bp)
+			remaining.Set(q)
+		}
+	}
+
+	stack := []*big.Int{remaining}
+	for len(stack) > 0 {
+		m := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if m.Cmp(big.NewInt(1)) == 0 {
+			continue
+		}
+		if m.ProbablyPrime(MillerRabinRounds) {
+			add(m)
+			continue
+		}
+		d, err := pollardBrent(ctx, m)
+		if err != nil {
+			return nil, err
+		}
+		stack = append(stack, d, new(big.Int).Quo(m, d))
+	}
+
+	factors := make([]Factor, 0, len(counts))
+	for _, f := range counts {
+		factors = append(factors, *f)
+	}
+	sort.Slice(factors, func(i, j int) bool {
+		return factors[i].Prime.Cmp(factors[j].Prime) < 0
+	})
+	return factors, nil
+}
+
+// Totient returns Euler's φ(n) for n >= 1, computed from the factorization
+// as the product of p^(k-1) * (p-1) over prime powers p^k dividing n.
+func Totient(ctx context.Context, n *big.Int) (*big.Int, error) {
+	factors, err := Factorize(ctx, n)
+	if err != nil {
+		return nil, err
+	}
+	result := big.NewInt(1)
+	for _, f := range factors {
+		pk := new(big.Int).Exp(f.Prime, big.NewInt(int64(f.Exponent-1)), nil)
+		result.Mul(result, pk)
+		result.Mul(result, new(big.Int).Sub(f.Prime, big.NewInt(1)))
+	}
+	return result, nil
+}
+
+// pollardBrent returns a non-trivial factor of the odd composite n, which must
+// have no prime factors below the trial division bound.
+func pollardBrent(ctx context.Context, n *big.Int) (*big.Int, error) {
+	const batch = 128
+
+	one := big.NewInt(1)
+	x, y, ys, q := new(big.Int), new(big.Int), new(big.Int), new(big.Int)
+	diff, d := new(big.Int), new(big.Int)
+	f := func(v, c *big.Int) {
+		v.Mul(v, v)
+		v.Add(v, c)
+		v.Mod(v, n)
+	}
+
+	for c := int64(1); ; c++ {
+		cc := big.NewInt(c)
+		y.SetInt64(2)
+		q.SetInt64(1)
+		d.SetInt64(1)
+
+		for r := 1; d.Cmp(one) == 0; r *= 2 {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			x.Set(y)
+			for i := 0; i < r; i++ {
+				f(y, cc)
+			}
+			for k := 0; k < r && d.Cmp(one) == 0; k += batch {
+				if err := ctx.Err(); err != nil {
+					return nil, err
+				}
+				ys.Set(y)
+				for i := 0; i < min(batch, r-k); i++ {
+					f(y, cc)
+					diff.Sub(x, y)
+					q.Mul(q, diff.Abs(diff))
+					q.Mod(q, n)
+				}
+				d.GCD(nil, nil, q, n)
+			}
+		}
+
+		if d.Cmp(n) == 0 {
+			// The batched product overshot; step back one iteration at a time.
+			for {
+				f(ys, cc)
+				diff.Sub(x, ys)
+				d.GCD(nil, nil, diff.Abs(diff), n)
+				if d.Cmp(one) != 0 {
+					break
+				}
+			}
+		}
+		if d.Cmp(n) != 0 {
+			return new(big.Int).Set(d), nil
+		}
+		// Both factors were found at once; retry with a different polynomial.
+	}
+}
+
+// PrimesInRange returns the primes p with from <= p <= to using a segmented
+// sieve of Eratosthenes.
+func PrimesInRange(from, to uint64) ([]uint64, error) {
+	if from > to {
+		return nil, fmt.Errorf("%w: from (%d) must not exceed to (%d)", ErrOutOfRange, from, to)
+	}
+	if to > MaxSieveLimit {
+		return nil, fmt.Errorf("%w: to must not exceed %d", ErrOutOfRange, uint64(MaxSieveLimit))
+	}
+	if to-from >= MaxSieveSpan {
+		return nil, fmt.Errorf("%w: range must span fewer than %d numbers", ErrOutOfRange, MaxSieveSpan)
+	}
+	if from < 2 {
+		from = 2
+	}
+	if from > to {
+		return []uint64{}, nil
+	}
+
+	composite := make([]bool, to-from+1)
+	for _, p := range sieve(isqrt(to)) {
+		start := max(p*p, (from+p-1)/p*p)
+		for m := start; m <= to; m += p {
+			composite[m-from] = true
+		}
+	}
+
+	primes := []uint64{}
+	for i, c := range composite {
+		if !c {
+			primes = append(primes, from+uint64(i))
+		}
+	}
+	return primes, nil
+}
+
+// sieve returns all primes <= limit.
+func sieve(limit uint64) []uint64 {
+	if limit < 2 {
+		return nil
+	}
+	composite := make([]bool, limit+1)
+	var primes []uint64
+	for i := uint64(2); i <= limit; i++ {
+		if composite[i] {
+			continue
+		}
+		primes = append(primes, i)
+		for j := i * i; j <= limit; j += i {
+			composite[j] = true
+		}
+	}
+	return primes
+}
+
+func isqrt(n uint64) uint64 {
+	return new(big.Int).Sqrt(new(big.Int).SetUint64(n)).Uint64()
+}
+
+// smallPrimes are removed by trial division before Pollard's rho, which is
+// slow to split out small factors and cannot handle even numbers.
+var smallPrimes = sieve(1000)
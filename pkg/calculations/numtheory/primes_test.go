@@ -0,0 +1,151 @@
+package numtheory
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestIsPrime(t *testing.T) {
+	tests := []struct {
+		n       string
+		prime   bool
+		certain bool
+	}{
+		{"-7", false, true},
+		{"0", false, true},
+		{"1", false, true},
+		{"2", true, true},
+		{"561", false, true}, // Carmichael number
+		{"18446744073709551557", true, true},
+		{"170141183460469231731687303715884105727", true, false}, // 2^127 - 1
+		{"170141183460469231731687303715884105729", false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.n, func(t *testing.T) {
+			prime, certain := IsPrime(bigInts(tt.n)[0])
+			if prime != tt.prime || certain != tt.certain {
+				t.Errorf("IsPrime(%s) = (%v, %v), want (%v, %v)", tt.n, prime, certain, tt.prime, tt.certain)
+			}
+		})
+	}
+}
+
+func TestFactorize(t *testing.T) {
+	tests := []struct {
+		n        string
+		expected map[string]int
+	}{
+		{"1", map[string]int{}},
+		{"360", map[string]int{"2": 3, "3": 2, "5": 1}},
+		{"1018081", map[string]int{"1009": 2}},
+		{"600851475143", map[string]int{"71": 1, "839": 1, "1471": 1, "6857": 1}},
+		{"10403", map[string]int{"101": 1, "103": 1}},
+		{"999999000001", map[string]int{"999999000001": 1}},
+		// Two 31-bit primes, out of reach of the trial division bound.
+		{"2305843014582403069", map[string]int{"2147483647": 1, "1073741827": 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.n, func(t *testing.T) {
+			factors, err := Factorize(context.Background(), bigInts(tt.n)[0])
+			if err != nil {
+				t.Fatalf("Factorize(%s) unexpected error: %v", tt.n, err)
+			}
+			if len(factors) != len(tt.expected) {
+				t.Fatalf("Factorize(%s) = %v, want %v", tt.n, factors, tt.expected)
+			}
+			product := big.NewInt(1)
+			for i, f := range factors {
+				if tt.expected[f.Prime.String()] != f.Exponent {
+					t.Errorf("factor %s has exponent %d, want %d", f.Prime, f.Exponent, tt.expected[f.Prime.String()])
+				}
+				if i > 0 && factors[i-1].Prime.Cmp(f.Prime) >= 0 {
+					t.Errorf("factors not in ascending order: %v", factors)
+				}
+				product.Mul(product, new(big.Int).Exp(f.Prime, big.NewInt(int64(f.Exponent)), nil))
+			}
+			if product.String() != tt.n {
+				t.Errorf("product of factors = %s, want %s", product, tt.n)
+			}
+		})
+	}
+
+	if _, err := Factorize(context.Background(), big.NewInt(0)); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("Factorize(0) error = %v, want ErrOutOfRange", err)
+	}
+}
+
+func TestFactorizeCancelled(t *testing.T) {
+	// Product of two 64-bit primes: far too slow for Pollard's rho within the deadline.
+	n := bigInts("340282366920938460843936948965011886881")[0]
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	if _, err := Factorize(ctx, n); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Factorize() error = %v, want context.DeadlineExceeded", err)
+	}
+}
+
+func TestTotient(t *testing.T) {
+	tests := []struct {
+		n        int64
+		expected int64
+	}{
+		{1, 1},
+		{9, 6},
+		{10, 4},
+		{36, 12},
+		{97, 96},
+	}
+
+	for _, tt := range tests {
+		phi, err := Totient(context.Background(), big.NewInt(tt.n))
+		if err != nil {
+			t.Fatalf("Totient(%d) unexpected error: %v", tt.n, err)
+		}
+		if phi.Int64() != tt.expected {
+			t.Errorf("Totient(%d) = %s, want %d", tt.n, phi, tt.expected)
+		}
+	}
+}
+
+func TestPrimesInRange(t *testing.T) {
+	primes, err := PrimesInRange(0, 30)
+	if err != nil {
+		t.Fatalf("PrimesInRange() unexpected error: %v", err)
+	}
+	expected := []uint64{2, 3, 5, 7, 11, 13, 17, 19, 23, 29}
+	if len(primes) != len(expected) {
+		t.Fatalf("PrimesInRange(0, 30) = %v, want %v", primes, expected)
+	}
+	for i := range expected {
+		if primes[i] != expected[i] {
+			t.Errorf("PrimesInRange(0, 30)[%d] = %d, want %d", i, primes[i], expected[i])
+		}
+	}
+
+	primes, _ = PrimesInRange(999_999_999_900, 1_000_000_000_000)
+	for _, p := range primes {
+		if prime, _ := IsPrime(new(big.Int).SetUint64(p)); !prime {
+			t.Errorf("PrimesInRange returned composite %d", p)
+		}
+	}
+	if len(primes) != 4 {
+		t.Errorf("expected 4 primes just below 10^12, got %v", primes)
+	}
+
+	if primes, _ := PrimesInRange(24, 28); len(primes) != 0 {
+		t.Errorf("PrimesInRange(24, 28) = %v, want none", primes)
+	}
+
+	if _, err := PrimesInRange(10, 5); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("PrimesInRange(10, 5) error = %v, want ErrOutOfRange", err)
+	}
+	if _, err := PrimesInRange(0, MaxSieveSpan); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("oversized span error = %v, want ErrOutOfRange", err)
+	}
+}
@@ -0,0 +1,116 @@
+package polynomial
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+// closedFormRoots returns the roots of a polynomial of degree 1 to 4 with
+// float coefficients, lowest degree first, from the classical formulas.
+func closedFormRoots(coefficients []float64) []complex128 {
+	n := len(coefficients) - 1
+	monic := make([]float64, n)
+	for i := range monic {
+		monic[i] = coefficients[i] / coefficients[n]
+	}
+
+	switch n {
+	case 1:
+		return []complex128{complex(-monic[0], 0)}
+	case 2:
+		r1, r2 := solveQuadratic(complex(monic[1], 0), complex(monic[0], 0))
+		return []complex128{r1, r2}
+	case 3:
+		return solveCubic(monic[2], monic[1], monic[0])
+	default:
+		return solveQuartic(monic[3], monic[2], monic[1], monic[0])
+	}
+}
+
+// solveQuadratic solves x² + bx + c = 0, avoiding cancellation between -b and
+// the square root of the discriminant.
+func solveQuadratic(b, c complex128) (complex128, complex128) {
+	root := cmplx.Sqrt(b*b - 4*c)
+	if real(cmplx.Conj(b)*root) < 0 {
+		root = -root
+	}
+	q := -(b + root) / 2
+	if q == 0 {
+		return 0, 0
+	}
+	r1, r2 := q, c/q
+	if imag(b) == 0 && imag(c) == 0 && imag(root) == 0 {
+		r1, r2 = complex(real(r1), 0), complex(real(r2), 0)
+	}
+	return r1, r2
+}
+
+// solveCubic solves x³ + ax² + bx + c = 0. With the substitution x = t - a/3
+// the cubic becomes t³ + pt + q = 0, solved by the trigonometric method when
+// it has three real roots and by Cardano's formula otherwise.
+func solveCubic(a, b, c float64) []complex128 {
+	shift := a / 3
+	p := b - a*a/3
+	q := 2*a*a*a/27 - a*b/3 + c
+
+	discriminant := q*q/4 + p*p*p/27
+	if discriminant <= 0 && p < 0 {
+		m := 2 * math.Sqrt(-p/3)
+		theta := math.Acos(math.Max(-1, math.Min(1, 3*q/(p*m)))) / 3
+		roots := make([]complex128, 3)
+		for k := range roots {
+			roots[k] = complex(m*math.Cos(theta-2*math.Pi*float64(k)/3)-shift, 0)
+		}
+		return roots
+	}
+
+	sqrtD := math.Sqrt(math.Max(discriminant, 0))
+	u := math.Cbrt(-q/2 + sqrtD)
+	v := math.Cbrt(-q/2 - sqrtD)
+	t := u + v
+	im := math.Sqrt(3) / 2 * (u - v)
+	return []complex128{
+		complex(t-shift, 0),
+		complex(-t/2-shift, im),
+		complex(-t/2-shift, -im),
+	}
+}
+
+// solveQuartic solves x⁴ + ax³ + bx² + cx + d = 0 by Ferrari's method. With
+// x = y - a/4 the quartic becomes y⁴ + py² + qy + r = 0, which factors into two
+// quadratics once a positive root m of the resolvent cubic is known.
+func solveQuartic(a, b, c, d float64) []complex128 {
+	shift := a / 4
+	p := b - 3*a*a/8
+	q := c - a*b/2 + a*a*a/8
+	r := d - a*c/4 + a*a*b/16 - 3*a*a*a*a/256
+
+	var roots []complex128
+	if math.Abs(q) <= 1e-14*(1+math.Abs(p)+math.Abs(r)) {
+		// Biquadratic: solve for y² and take both square roots.
+		z1, z2 := solveQuadratic(complex(p, 0), complex(r, 0))
+		for _, z := range []complex128{z1, z2} {
+			y := cmplx.Sqrt(z)
+			roots = append(roots, y, -y)
+		}
+	} else {
+		// m³ + pm² + (p²/4 - r)m - q²/8 = 0 has a positive root, since the
+		// left side is negative at 0 and grows without bound.
+		m := 0.0
+		for _, z := range solveCubic(p, p*p/4-r, -q*q/8) {
+			if imag(z) == 0 && real(z) > m {
+				m = real(z)
+			}
+		}
+		s := math.Sqrt(2 * m)
+		for _, sign := range []float64{1, -1} {
+			y1, y2 := solveQuadratic(complex(-sign*s, 0), complex(p/2+m+sign*q/(2*s), 0))
+			roots = append(roots, y1, y2)
+		}
+	}
+
+	for i := range roots {
+		roots[i] -= complex(shift, 0)
+	}
+	return roots
+}
@@ -0,0 +1,196 @@
+package polynomial
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/m-szczepanski/gocalc-api/pkg/calculations/expr"
+)
+
+var (
+	ErrInvalidEquation = errors.New("invalid equation")
+	ErrNotPolynomial   = expr.ErrNotPolynomial
+	ErrNotLinear       = errors.New("equation is not linear")
+)
+
+const (
+	// MaxEquations bounds the number of equations in a linear system.
+	MaxEquations = 50
+	// MaxVariables bounds the number of distinct variables in a linear system.
+	MaxVariables = 50
+)
+
+// SystemStatus classifies the solution set of a linear system.
+type SystemStatus string
+
+const (
+	Unique       SystemStatus = "unique"
+	Infinite     SystemStatus = "infinite"
+	Inconsistent SystemStatus = "inconsistent"
+)
+
+// System is the solution of a linear system. Solution is empty when the system
+// is inconsistent; when there are infinitely many solutions it holds the one
+// with every free variable set to zero.
+type System struct {
+	Variables     []string
+	Status        SystemStatus
+	Rank          int
+	Solution      []*big.Rat
+	FreeVariables []string
+}
+
+// ParseEquation parses an equation such as "2x + 3y = 5" into the coefficient
+// of each variable and the constant on the right-hand side, moving every term
+// with a variable to the left. Both sides may be any polynomial expression
+// that expands to degree at most one.
+func ParseEquation(equation string, variables []string) (coefficients []*big.Rat, constant *big.Rat, err error) {
+	if strings.Count(equation, "=") != 1 {
+		return nil, nil, fmt.Errorf("%w %q: expected exactly one \"=\"", ErrInvalidEquation, equation)
+	}
+	p, err := expand(equation, variables)
+	if errors.Is(err, ErrNotPolynomial) {
+		return nil, nil, fmt.Errorf("%w: %v", ErrNotLinear, err)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	coefficients, constant, err = p.Linear()
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w %q: %v", ErrNotLinear, equation, err)
+	}
+	return coefficients, constant.Neg(constant), nil
+}
+
+// expand parses an expression, or an equation lhs = rhs as lhs - rhs, into a
+// polynomial in the given variables.
+func expand(equation string, variables []string) (*expr.Polynomial, error) {
+	var sides []*expr.Polynomial
+	for _, side := range strings.Split(equation, "=") {
+		if strings.TrimSpace(side) == "" {
+			return nil, fmt.Errorf("%w %q: both sides of \"=\" must be non-empty", ErrInvalidEquation, equation)
+		}
+		e, err := expr.Parse(side, variables...)
+		if err != nil {
+			return nil, fmt.Errorf("%w %q: %v", ErrInvalidEquation, equation, err)
+		}
+		p, err := e.Polynomial()
+		if err != nil {
+			return nil, fmt.Errorf("%q: %w", equation, err)
+		}
+		sides = append(sides, p)
+	}
+	if len(sides) == 1 {
+		return sides[0], nil
+	}
+	return sides[0].Sub(sides[1]), nil
+}
+
+// Variables returns the variables used across the equations in order of
+// first appearance.
+func Variables(equations []string) ([]string, error) {
+	var variables []string
+	seen := make(map[string]bool)
+	for _, equation := range equations {
+		names, err := expr.Names(strings.ReplaceAll(equation, "=", " "))
+		if err != nil {
+			return nil, fmt.Errorf("%w %q: %v", ErrInvalidEquation, equation, err)
+		}
+		for _, name := range names {
+			if !seen[name] {
+				seen[name] = true
+				variables = append(variables, name)
+			}
+		}
+	}
+	if len(variables) > MaxVariables {
+		return nil, fmt.Errorf("%w: at most %d variables are supported, got %d", ErrInvalidEquation, MaxVariables, len(variables))
+	}
+	return variables, nil
+}
+
+// SolveLinear solves a system of linear equations exactly by Gauss-Jordan
+// elimination over the rationals.
+func SolveLinear(equations []string) (System, error) {
+	if len(equations) == 0 || len(equations) > MaxEquations {
+		return System{}, fmt.Errorf("%w: between 1 and %d equations are required, got %d", ErrInvalidEquation, MaxEquations, len(equations))
+	}
+	variables, err := Variables(equations)
+	if err != nil {
+		return System{}, err
+	}
+	if len(variables) == 0 {
+		return System{}, fmt.Errorf("%w: the equations contain no variables", ErrInvalidEquation)
+	}
+
+	// rows holds the augmented matrix [A | b].
+	n := len(variables)
+	rows := make([][]*big.Rat, len(equations))
+	for i, equation := range equations {
+		coefficients, constant, err := ParseEquation(equation, variables)
+		if err != nil {
+			return System{}, err
+		}
+		rows[i] = append(coefficients, constant)
+	}
+
+	pivots := make([]int, 0, n)
+	for col, row := 0, 0; col < n && row < len(rows); col++ {
+		pivot := -1
+		for i := row; i < len(rows); i++ {
+			if rows[i][col].Sign() != 0 {
+				pivot = i
+				break
+			}
+		}
+		if pivot < 0 {
+			continue
+		}
+		rows[row], rows[pivot] = rows[pivot], rows[row]
+
+		inverse := new(big.Rat).Inv(rows[row][col])
+		for j := col; j <= n; j++ {
+			rows[row][j].Mul(rows[row][j], inverse)
+		}
+		for i := range rows {
+			if i == row || rows[i][col].Sign() == 0 {
+				continue
+			}
+			factor := new(big.Rat).Set(rows[i][col])
+			for j := col; j <= n; j++ {
+				rows[i][j].Sub(rows[i][j], new(big.Rat).Mul(factor, rows[row][j]))
+			}
+		}
+		pivots = append(pivots, col)
+		row++
+	}
+
+	system := System{Variables: variables, Rank: len(pivots)}
+	for i := len(pivots); i < len(rows); i++ {
+		if rows[i][n].Sign() != 0 {
+			system.Status = Inconsistent
+			return system, nil
+		}
+	}
+
+	system.Solution = make([]*big.Rat, n)
+	for i := range system.Solution {
+		system.Solution[i] = new(big.Rat)
+	}
+	isPivot := make([]bool, n)
+	for i, col := range pivots {
+		system.Solution[col].Set(rows[i][n])
+		isPivot[col] = true
+	}
+
+	system.Status = Unique
+	for col, pivot := range isPivot {
+		if !pivot {
+			system.Status = Infinite
+			system.FreeVariables = append(system.FreeVariables, variables[col])
+		}
+	}
+	return system, nil
+}
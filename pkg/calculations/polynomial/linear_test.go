@@ -0,0 +1,134 @@
+package polynomial
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSolveLinear(t *testing.T) {
+	tests := []struct {
+		name      string
+		equations []string
+		variables []string
+		status    SystemStatus
+		rank      int
+		solution  []string
+		free      []string
+	}{
+		{
+			name:      "two by two",
+			equations: []string{"2x + 3y = 5", "x - y = 1"},
+			variables: []string{"x", "y"},
+			status:    Unique,
+			rank:      2,
+			solution:  []string{"8/5", "3/5"},
+		},
+		{
+			name:      "terms on both sides",
+			equations: []string{"3a = 2b + 1", "a + b = 2(a - 1)"},
+			variables: []string{"a", "b"},
+			status:    Unique,
+			rank:      2,
+			solution:  []string{"-3", "-5"},
+		},
+		{
+			name:      "three variables with decimals",
+			equations: []string{"x + y + z = 6", "0.5x - y = -1.5", "z = 3"},
+			variables: []string{"x", "y", "z"},
+			status:    Unique,
+			rank:      3,
+			solution:  []string{"1", "2", "3"},
+		},
+		{
+			name:      "single equation",
+			equations: []string{"4x = 2"},
+			variables: []string{"x"},
+			status:    Unique,
+			rank:      1,
+			solution:  []string{"1/2"},
+		},
+		{
+			name:      "dependent equations",
+			equations: []string{"x + y = 2", "2x + 2y = 4"},
+			variables: []string{"x", "y"},
+			status:    Infinite,
+			rank:      1,
+			solution:  []string{"2", "0"},
+			free:      []string{"y"},
+		},
+		{
+			name:      "inconsistent",
+			equations: []string{"x + y = 2", "x + y = 3"},
+			variables: []string{"x", "y"},
+			status:    Inconsistent,
+			rank:      1,
+		},
+		{
+			name:      "overdetermined but consistent",
+			equations: []string{"x = 1", "y = 2", "x + y = 3"},
+			variables: []string{"x", "y"},
+			status:    Unique,
+			rank:      2,
+			solution:  []string{"1", "2"},
+		},
+		{
+			name:      "quadratic terms cancel",
+			equations: []string{"x^2 + y = x^2 + 1", "x = y"},
+			variables: []string{"x", "y"},
+			status:    Unique,
+			rank:      2,
+			solution:  []string{"1", "1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			system, err := SolveLinear(tt.equations)
+			if err != nil {
+				t.Fatalf("SolveLinear() unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(system.Variables, tt.variables) || system.Status != tt.status || system.Rank != tt.rank {
+				t.Errorf("SolveLinear() = %v, %s, rank %d", system.Variables, system.Status, system.Rank)
+			}
+			var solution []string
+			for _, v := range system.Solution {
+				solution = append(solution, v.RatString())
+			}
+			if !reflect.DeepEqual(solution, tt.solution) {
+				t.Errorf("solution = %v, want %v", solution, tt.solution)
+			}
+			if !reflect.DeepEqual(system.FreeVariables, tt.free) {
+				t.Errorf("free variables = %v, want %v", system.FreeVariables, tt.free)
+			}
+		})
+	}
+}
+
+func TestSolveLinearErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		equations []string
+		wantErr   error
+	}{
+		{"no equations", nil, ErrInvalidEquation},
+		{"missing equals", []string{"2x + 3y"}, ErrInvalidEquation},
+		{"two equals", []string{"x = y = 1"}, ErrInvalidEquation},
+		{"empty side", []string{"x = "}, ErrInvalidEquation},
+		{"no variables", []string{"1 = 1"}, ErrInvalidEquation},
+		{"syntax error", []string{"2x + = 1"}, ErrInvalidEquation},
+		{"product of variables", []string{"x*y = 1"}, ErrNotLinear},
+		{"squared variable", []string{"x^2 = 4"}, ErrNotLinear},
+		{"function", []string{"sin(x) = 0"}, ErrNotLinear},
+		{"too many equations", strings.Split(strings.Repeat("x = 1,", MaxEquations+1), ",")[:MaxEquations+1], ErrInvalidEquation},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := SolveLinear(tt.equations); !errors.Is(err, tt.wantErr) {
+				t.Errorf("SolveLinear() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
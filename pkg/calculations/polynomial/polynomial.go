@@ -0,0 +1,450 @@
+// Package polynomial finds the real and complex roots of polynomials with
+// rational coefficients and solves systems of linear equations given as text.
+// Roots that can be written with rationals and square roots are returned in
+// exact form alongside their numeric values.
+package polynomial
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"math/cmplx"
+	"sort"
+	"strings"
+
+	"github.com/m-szczepanski/gocalc-api/pkg/calculations/expr"
+	"github.com/m-szczepanski/gocalc-api/pkg/calculations/matrix"
+)
+
+var (
+	ErrConstant   = errors.New("polynomial has no variable term")
+	ErrOutOfRange = errors.New("coefficient out of range")
+)
+
+const (
+	// MaxDegree bounds the degree of a polynomial passed to Roots.
+	MaxDegree = 100
+	// MaxCoefficientBits bounds the numerator and denominator of coefficients
+	// taken from requests. Larger values cannot be represented as float64, so
+	// the numeric root finders would only produce Inf and NaN from them.
+	MaxCoefficientBits = 1024
+)
+
+// Method names how the numeric values of the roots were obtained.
+type Method string
+
+const (
+	// ClosedForm uses the quadratic, cubic (Cardano or trigonometric) and
+	// quartic (Ferrari) formulas.
+	ClosedForm Method = "closed-form"
+	// CompanionMatrix takes the eigenvalues of the companion matrix.
+	CompanionMatrix Method = "companion-matrix"
+)
+
+// Root is a root of a polynomial with its multiplicity. Exact holds a closed
+// form such as "3/2", "1 - √2" or "-1/2 + i√3/2" when the root is rational or
+// a quadratic surd, and is empty otherwise.
+type Root struct {
+	Value        complex128
+	Exact        string
+	Multiplicity int
+}
+
+// Result holds all roots of a polynomial, real roots first.
+type Result struct {
+	Degree int
+	Method Method
+	Roots  []Root
+}
+
+// ParsePolynomial parses a polynomial in a single variable, such as
+// "x^2 - 3x + 2", or an equation such as "x^3 = 8", returning its coefficients
+// highest degree first and the name of the variable. A constant expression
+// such as "5" has no variable and yields an empty name.
+func ParsePolynomial(s string) (coefficients []*big.Rat, variable string, err error) {
+	if strings.Count(s, "=") > 1 {
+		return nil, "", fmt.Errorf("%w %q: expected at most one \"=\"", ErrInvalidEquation, s)
+	}
+	names, err := expr.Names(strings.ReplaceAll(s, "=", " "))
+	if err != nil {
+		return nil, "", fmt.Errorf("%w %q: %v", ErrInvalidEquation, s, err)
+	}
+	if len(names) > 1 {
+		return nil, "", fmt.Errorf("%w %q: expected a single variable, found %s", ErrNotPolynomial, s, strings.Join(names, ", "))
+	}
+
+	p, err := expand(s, names)
+	if err != nil {
+		return nil, "", err
+	}
+	coefficients, _ = p.Univariate()
+	if len(names) == 1 {
+		variable = names[0]
+	}
+	return coefficients, variable, nil
+}
+
+// Roots returns every root of the polynomial with the given coefficients,
+// highest degree first. The polynomial is first split exactly into square-free
+// factors, so repeated roots are reported once with their multiplicity, and
+// rational roots and rational quadratic factors are then split off so their
+// roots can be written exactly. The remaining roots are found numerically and
+// polished with Newton's method.
+func Roots(ctx context.Context, coefficients []*big.Rat) (Result, error) {
+	p := make(poly, len(coefficients))
+	for i, c := range coefficients {
+		p[len(coefficients)-1-i] = new(big.Rat).Set(c)
+	}
+	p = p.trim()
+	degree := p.degree()
+	if degree < 1 {
+		return Result{}, fmt.Errorf("%w: degree must be at least 1", ErrConstant)
+	}
+	if degree > MaxDegree {
+		return Result{}, fmt.Errorf("%w: degree must be at most %d, got %d", ErrOutOfRange, MaxDegree, degree)
+	}
+	if _, err := p.floats(); err != nil {
+		return Result{}, err
+	}
+
+	result := Result{Degree: degree, Method: ClosedForm}
+	if degree > 4 {
+		result.Method = CompanionMatrix
+	}
+
+	factors, err := squareFree(ctx, p)
+	if err != nil {
+		return Result{}, err
+	}
+	for i, factor := range factors {
+		roots, err := factorRoots(ctx, factor)
+		if err != nil {
+			return Result{}, err
+		}
+		for _, root := range roots {
+			root.Multiplicity = i + 1
+			result.Roots = append(result.Roots, root)
+		}
+	}
+
+	sort.SliceStable(result.Roots, func(i, j int) bool {
+		a, b := result.Roots[i].Value, result.Roots[j].Value
+		if realA, realB := imag(a) == 0, imag(b) == 0; realA != realB {
+			return realA
+		}
+		if real(a) != real(b) {
+			return real(a) < real(b)
+		}
+		return imag(a) < imag(b)
+	})
+	return result, nil
+}
+
+// factorRoots finds the roots of a square-free polynomial, splitting off linear
+// and quadratic factors with rational coefficients while they can be found.
+func factorRoots(ctx context.Context, p poly) ([]Root, error) {
+	var roots []Root
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		switch p.degree() {
+		case 0:
+			return roots, nil
+		case 1:
+			r := new(big.Rat).Quo(p[0], p[1])
+			r.Neg(r)
+			return append(roots, rationalRoot(r)), nil
+		case 2:
+			return append(roots, quadraticRoots(p)...), nil
+		}
+
+		coefficients, err := p.floats()
+		if err != nil {
+			return nil, err
+		}
+		approx, err := numericRoots(coefficients)
+		if err != nil {
+			return nil, err
+		}
+
+		if r, ok := findRationalRoot(p, approx); ok {
+			roots = append(roots, rationalRoot(r))
+			p, _ = p.divmod(poly{new(big.Rat).Neg(r), big.NewRat(1, 1)})
+			continue
+		}
+		if q, ok := findQuadraticFactor(p, approx); ok {
+			roots = append(roots, quadraticRoots(q)...)
+			p, _ = p.divmod(q)
+			continue
+		}
+
+		for _, z := range approx {
+			roots = append(roots, Root{Value: z})
+		}
+		return roots, nil
+	}
+}
+
+// numericRoots approximates the roots of a polynomial with float coefficients,
+// lowest degree first, and refines each with Newton's method.
+func numericRoots(coefficients []float64) ([]complex128, error) {
+	var approx []complex128
+	if len(coefficients) <= 5 {
+		approx = closedFormRoots(coefficients)
+	} else {
+		var err error
+		if approx, err = matrix.Eigenvalues(companion(coefficients)); err != nil {
+			return nil, err
+		}
+	}
+	for i, z := range approx {
+		approx[i] = polish(coefficients, z)
+	}
+	return approx, nil
+}
+
+// companion returns the companion matrix of a polynomial, whose eigenvalues
+// are its roots.
+func companion(coefficients []float64) matrix.Matrix {
+	n := len(coefficients) - 1
+	lead := coefficients[n]
+	m := make(matrix.Matrix, n)
+	for i := range m {
+		m[i] = make([]float64, n)
+		if i > 0 {
+			m[i][i-1] = 1
+		}
+	}
+	for i := 0; i < n; i++ {
+		m[i][n-1] = -coefficients[i] / lead
+	}
+	return m
+}
+
+// polish refines a simple root by Newton's method, keeping each step only
+// while it reduces the residual. Real roots stay on the real axis.
+func polish(coefficients []float64, z complex128) complex128 {
+	if math.Abs(imag(z)) <= 1e-12*math.Max(1, cmplx.Abs(z)) {
+		z = complex(real(z), 0)
+	}
+	value, slope := evaluate(coefficients, z)
+	for i := 0; i < 20 && value != 0 && slope != 0; i++ {
+		next := z - value/slope
+		nextValue, nextSlope := evaluate(coefficients, next)
+		if cmplx.Abs(nextValue) >= cmplx.Abs(value) {
+			break
+		}
+		z, value, slope = next, nextValue, nextSlope
+	}
+	return z
+}
+
+// evaluate returns p(z) and p'(z) by Horner's method.
+func evaluate(coefficients []float64, z complex128) (value, slope complex128) {
+	for i := len(coefficients) - 1; i >= 0; i-- {
+		slope = slope*z + value
+		value = value*z + complex(coefficients[i], 0)
+	}
+	return value, slope
+}
+
+// findRationalRoot looks for a rational number close to a real approximate
+// root that is an exact root of p. By the rational root theorem the
+// denominator of such a root divides the leading coefficient of p scaled to
+// integers.
+func findRationalRoot(p poly, approx []complex128) (*big.Rat, bool) {
+	lead := p.integerLead()
+	for _, z := range approx {
+		if math.Abs(imag(z)) > 1e-6*math.Max(1, cmplx.Abs(z)) {
+			continue
+		}
+		if r, ok := approximate(real(z), lead); ok && p.eval(r).Sign() == 0 {
+			return r, true
+		}
+	}
+	return nil, false
+}
+
+// findQuadraticFactor looks for a pair of approximate roots whose sum and
+// product are rational, giving a factor x² - sx + t of p with rational
+// coefficients.
+func findQuadraticFactor(p poly, approx []complex128) (poly, bool) {
+	lead := p.integerLead()
+	for i := range approx {
+		for j := i + 1; j < len(approx); j++ {
+			sum, product := approx[i]+approx[j], approx[i]*approx[j]
+			if math.Abs(imag(sum)) > 1e-6*math.Max(1, cmplx.Abs(sum)) || math.Abs(imag(product)) > 1e-6*math.Max(1, cmplx.Abs(product)) {
+				continue
+			}
+			s, ok := approximate(real(sum), lead)
+			if !ok {
+				continue
+			}
+			t, ok := approximate(real(product), lead)
+			if !ok {
+				continue
+			}
+			q := poly{t, new(big.Rat).Neg(s), big.NewRat(1, 1)}
+			if _, remainder := p.divmod(q); remainder.degree() < 0 {
+				return q, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// approximate returns the continued fraction convergent of x within a small
+// relative tolerance whose denominator divides bound, if there is one.
+func approximate(x float64, bound *big.Int) (*big.Rat, bool) {
+	const maxDenominator = 1 << 40
+	tol := 1e-8 * math.Max(1, math.Abs(x))
+	if math.Abs(x) > 1<<53 {
+		return nil, false
+	}
+
+	// h and k are the numerators and denominators of successive convergents.
+	h0, h1 := big.NewInt(0), big.NewInt(1)
+	k0, k1 := big.NewInt(1), big.NewInt(0)
+	remainder := x
+	for i := 0; i < 64; i++ {
+		a := math.Floor(remainder)
+		ai := big.NewInt(int64(a))
+		h0, h1 = h1, new(big.Int).Add(new(big.Int).Mul(ai, h1), h0)
+		k0, k1 = k1, new(big.Int).Add(new(big.Int).Mul(ai, k1), k0)
+		if k1.Cmp(big.NewInt(maxDenominator)) > 0 || k1.CmpAbs(bound) > 0 {
+			return nil, false
+		}
+
+		r := new(big.Rat).SetFrac(h1, k1)
+		f, _ := r.Float64()
+		if math.Abs(f-x) <= tol {
+			if new(big.Int).Rem(bound, k1).Sign() != 0 {
+				return nil, false
+			}
+			return r, true
+		}
+		if remainder == a {
+			return nil, false
+		}
+		remainder = 1 / (remainder - a)
+	}
+	return nil, false
+}
+
+func rationalRoot(r *big.Rat) Root {
+	f, _ := r.Float64()
+	return Root{Value: complex(f, 0), Exact: r.RatString()}
+}
+
+// quadraticRoots solves ax² + bx + c = 0 exactly, writing the roots as
+// -b/2a ± k√r/m with r square-free.
+func quadraticRoots(p poly) []Root {
+	a, b, c := p[2], p[1], p[0]
+	twoA := new(big.Rat).Mul(a, big.NewRat(2, 1))
+	center := new(big.Rat).Quo(new(big.Rat).Neg(b), twoA)
+
+	// discriminant = b² - 4ac = n/d, and √(n/d) = √(nd)/d.
+	discriminant := new(big.Rat).Mul(b, b)
+	discriminant.Sub(discriminant, new(big.Rat).Mul(big.NewRat(4, 1), new(big.Rat).Mul(a, c)))
+	if discriminant.Sign() == 0 {
+		root := rationalRoot(center)
+		return []Root{root, root}
+	}
+	radicand := new(big.Int).Mul(discriminant.Num(), discriminant.Denom())
+	radicand.Abs(radicand)
+	k, r := extractSquare(radicand)
+
+	// Each root is center ± coefficient·√r, with √r imaginary when the
+	// discriminant is negative.
+	coefficient := new(big.Rat).SetFrac(k, discriminant.Denom())
+	coefficient.Quo(coefficient, twoA)
+	coefficient.Abs(coefficient)
+
+	centerFloat, _ := center.Float64()
+	offset, _ := coefficient.Float64()
+	offset *= math.Sqrt(float64FromInt(r))
+	imaginary := discriminant.Sign() < 0
+
+	roots := make([]Root, 2)
+	for i, sign := range []int{-1, 1} {
+		var value complex128
+		if imaginary {
+			value = complex(centerFloat, float64(sign)*offset)
+		} else {
+			value = complex(centerFloat+float64(sign)*offset, 0)
+		}
+		if r.Cmp(big.NewInt(1)) == 0 && !imaginary {
+			exact := new(big.Rat).Add(center, new(big.Rat).Mul(coefficient, big.NewRat(int64(sign), 1)))
+			roots[i] = Root{Value: value, Exact: exact.RatString()}
+			continue
+		}
+		roots[i] = Root{Value: value, Exact: formatSurd(center, coefficient, r, sign, imaginary)}
+	}
+	return roots
+}
+
+// formatSurd writes center ± coefficient·√r, e.g. "3/2 + √5/2", "-2√3" or
+// "1 - i".
+func formatSurd(center, coefficient *big.Rat, r *big.Int, sign int, imaginary bool) string {
+	var surd strings.Builder
+	if coefficient.Num().Cmp(big.NewInt(1)) != 0 {
+		surd.WriteString(coefficient.Num().String())
+	}
+	if imaginary {
+		surd.WriteString("i")
+	}
+	if r.Cmp(big.NewInt(1)) != 0 {
+		surd.WriteString("√")
+		surd.WriteString(r.String())
+	}
+	if !coefficient.IsInt() {
+		surd.WriteString("/")
+		surd.WriteString(coefficient.Denom().String())
+	}
+
+	if center.Sign() == 0 {
+		if sign < 0 {
+			return "-" + surd.String()
+		}
+		return surd.String()
+	}
+	operator := " + "
+	if sign < 0 {
+		operator = " - "
+	}
+	return center.RatString() + operator + surd.String()
+}
+
+// extractSquare writes n = k²·r, removing square factors found by trial
+// division up to 10⁵ and a square cofactor.
+func extractSquare(n *big.Int) (k, r *big.Int) {
+	k, r = big.NewInt(1), new(big.Int).Set(n)
+	for p := int64(2); p <= 100000; p++ {
+		pp := big.NewInt(p * p)
+		if pp.Cmp(r) > 0 {
+			break
+		}
+		mod := new(big.Int)
+		for {
+			quotient, m := new(big.Int).QuoRem(r, pp, mod)
+			if m.Sign() != 0 {
+				break
+			}
+			r = quotient
+			k.Mul(k, big.NewInt(p))
+		}
+	}
+	if root := new(big.Int).Sqrt(r); new(big.Int).Mul(root, root).Cmp(r) == 0 {
+		k.Mul(k, root)
+		r = big.NewInt(1)
+	}
+	return k, r
+}
+
+func float64FromInt(n *big.Int) float64 {
+	f, _ := new(big.Float).SetInt(n).Float64()
+	return f
+}
@@ -0,0 +1,298 @@
+package polynomial
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/big"
+	"math/cmplx"
+	"reflect"
+	"testing"
+)
+
+func rats(values ...int64) []*big.Rat {
+	result := make([]*big.Rat, len(values))
+	for i, v := range values {
+		result[i] = big.NewRat(v, 1)
+	}
+	return result
+}
+
+type expectedRoot struct {
+	value        complex128
+	exact        string
+	multiplicity int
+}
+
+func TestRoots(t *testing.T) {
+	tests := []struct {
+		name         string
+		coefficients []*big.Rat
+		method       Method
+		expected     []expectedRoot
+	}{
+		{
+			name:         "linear",
+			coefficients: rats(2, -3),
+			method:       ClosedForm,
+			expected:     []expectedRoot{{1.5, "3/2", 1}},
+		},
+		{
+			name:         "quadratic with rational roots",
+			coefficients: rats(1, -5, 6),
+			method:       ClosedForm,
+			expected:     []expectedRoot{{2, "2", 1}, {3, "3", 1}},
+		},
+		{
+			name:         "quadratic surds",
+			coefficients: rats(1, -3, 1),
+			method:       ClosedForm,
+			expected: []expectedRoot{
+				{complex((3-math.Sqrt(5))/2, 0), "3/2 - √5/2", 1},
+				{complex((3+math.Sqrt(5))/2, 0), "3/2 + √5/2", 1},
+			},
+		},
+		{
+			name:         "surd with extracted square",
+			coefficients: rats(1, 0, -12),
+			method:       ClosedForm,
+			expected: []expectedRoot{
+				{complex(-2*math.Sqrt(3), 0), "-2√3", 1},
+				{complex(2*math.Sqrt(3), 0), "2√3", 1},
+			},
+		},
+		{
+			name:         "complex quadratic",
+			coefficients: rats(1, 1, 1),
+			method:       ClosedForm,
+			expected: []expectedRoot{
+				{complex(-0.5, -math.Sqrt(3)/2), "-1/2 - i√3/2", 1},
+				{complex(-0.5, math.Sqrt(3)/2), "-1/2 + i√3/2", 1},
+			},
+		},
+		{
+			name:         "pure imaginary",
+			coefficients: rats(1, 0, 1),
+			method:       ClosedForm,
+			expected:     []expectedRoot{{complex(0, -1), "-i", 1}, {complex(0, 1), "i", 1}},
+		},
+		{
+			name:         "repeated root",
+			coefficients: rats(1, -2, 1),
+			method:       ClosedForm,
+			expected:     []expectedRoot{{1, "1", 2}},
+		},
+		{
+			name:         "cubic with rational roots",
+			coefficients: rats(1, -3, -1, 3),
+			method:       ClosedForm,
+			expected: []expectedRoot{
+				{-1, "-1", 1},
+				{1, "1", 1},
+				{3, "3", 1},
+			},
+		},
+		{
+			name:         "cubic with irrational real root",
+			coefficients: rats(1, 0, -2, -5),
+			method:       ClosedForm,
+			expected: []expectedRoot{
+				{2.0945514815423265, "", 1},
+				{complex(-1.0472757407711633, -1.1359398890889283), "", 1},
+				{complex(-1.0472757407711633, 1.1359398890889283), "", 1},
+			},
+		},
+		{
+			name:         "biquadratic",
+			coefficients: rats(1, 0, -5, 0, 6),
+			method:       ClosedForm,
+			expected: []expectedRoot{
+				{complex(-math.Sqrt(3), 0), "-√3", 1},
+				{complex(-math.Sqrt(2), 0), "-√2", 1},
+				{complex(math.Sqrt(2), 0), "√2", 1},
+				{complex(math.Sqrt(3), 0), "√3", 1},
+			},
+		},
+		{
+			name:         "quartic with a triple root",
+			coefficients: rats(1, -2, 0, 2, -1), // (x - 1)³(x + 1)
+			method:       ClosedForm,
+			expected:     []expectedRoot{{-1, "-1", 1}, {1, "1", 3}},
+		},
+		{
+			name:         "quintic",
+			coefficients: rats(2, -3, -2, 3, -4, 6), // (2x - 3)(x² - 2)(x² + 1)
+			method:       CompanionMatrix,
+			expected: []expectedRoot{
+				{complex(-math.Sqrt(2), 0), "-√2", 1},
+				{complex(math.Sqrt(2), 0), "√2", 1},
+				{1.5, "3/2", 1},
+				{complex(0, -1), "-i", 1},
+				{complex(0, 1), "i", 1},
+			},
+		},
+		{
+			name:         "zero roots",
+			coefficients: rats(1, 0, -1, 0, 0, 0),
+			method:       CompanionMatrix,
+			expected:     []expectedRoot{{-1, "-1", 1}, {0, "0", 3}, {1, "1", 1}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Roots(context.Background(), tt.coefficients)
+			if err != nil {
+				t.Fatalf("Roots() unexpected error: %v", err)
+			}
+			if result.Method != tt.method || result.Degree != len(tt.coefficients)-1 {
+				t.Errorf("Roots() method = %s, degree = %d", result.Method, result.Degree)
+			}
+
+			// Every root must satisfy the polynomial and multiplicities must
+			// add up to the degree.
+			total := 0
+			for _, root := range result.Roots {
+				total += root.Multiplicity
+				var value complex128
+				for _, c := range tt.coefficients {
+					f, _ := c.Float64()
+					value = value*root.Value + complex(f, 0)
+				}
+				if cmplx.Abs(value) > 1e-9 {
+					t.Errorf("p(%v) = %v", root.Value, value)
+				}
+			}
+			if total != result.Degree {
+				t.Errorf("multiplicities add up to %d, want %d", total, result.Degree)
+			}
+
+			if tt.expected == nil {
+				return
+			}
+			if len(result.Roots) != len(tt.expected) {
+				t.Fatalf("Roots() = %+v, want %d roots", result.Roots, len(tt.expected))
+			}
+			for i, want := range tt.expected {
+				got := result.Roots[i]
+				if cmplx.Abs(got.Value-want.value) > 1e-12 || got.Exact != want.exact || got.Multiplicity != want.multiplicity {
+					t.Errorf("root %d = %+v, want %+v", i, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestRootsHighDegree(t *testing.T) {
+	// x^20 - 1 has the 20th roots of unity, of which ±1 and ±i are exact.
+	coefficients := make([]*big.Rat, 21)
+	for i := range coefficients {
+		coefficients[i] = new(big.Rat)
+	}
+	coefficients[0].SetInt64(1)
+	coefficients[20].SetInt64(-1)
+
+	result, err := Roots(context.Background(), coefficients)
+	if err != nil {
+		t.Fatalf("Roots() unexpected error: %v", err)
+	}
+	if len(result.Roots) != 20 {
+		t.Fatalf("Roots() returned %d roots, want 20", len(result.Roots))
+	}
+	exact := 0
+	for _, root := range result.Roots {
+		if math.Abs(cmplx.Abs(root.Value)-1) > 1e-12 {
+			t.Errorf("|%v| != 1", root.Value)
+		}
+		if root.Exact != "" {
+			exact++
+		}
+	}
+	if exact < 4 {
+		t.Errorf("expected at least 4 exact roots, got %d", exact)
+	}
+}
+
+func TestRootsErrors(t *testing.T) {
+	if _, err := Roots(context.Background(), rats(0, 0, 5)); !errors.Is(err, ErrConstant) {
+		t.Errorf("Roots() error = %v, want ErrConstant", err)
+	}
+	if _, err := Roots(context.Background(), rats(0, 0, 0)); !errors.Is(err, ErrConstant) {
+		t.Errorf("Roots() error = %v, want ErrConstant for the zero polynomial", err)
+	}
+
+	tooLarge := make([]*big.Rat, MaxDegree+2)
+	for i := range tooLarge {
+		tooLarge[i] = big.NewRat(1, 1)
+	}
+	if _, err := Roots(context.Background(), tooLarge); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("Roots() error = %v, want ErrOutOfRange", err)
+	}
+
+	huge := new(big.Rat).SetFrac(new(big.Int).Lsh(big.NewInt(1), 2000), big.NewInt(1))
+	if _, err := Roots(context.Background(), []*big.Rat{huge, big.NewRat(1, 1), big.NewRat(1, 1)}); err != nil {
+		t.Errorf("Roots() unexpected error for a large leading coefficient: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := Roots(ctx, rats(1, 0, -2, -5)); !errors.Is(err, context.Canceled) {
+		t.Errorf("Roots() error = %v, want context.Canceled", err)
+	}
+}
+
+func TestExtractSquare(t *testing.T) {
+	tests := []struct{ n, k, r int64 }{
+		{12, 2, 3},
+		{72, 6, 2},
+		{49, 7, 1},
+		{30, 1, 30},
+		{1, 1, 1},
+	}
+	for _, tt := range tests {
+		k, r := extractSquare(big.NewInt(tt.n))
+		if k.Int64() != tt.k || r.Int64() != tt.r {
+			t.Errorf("extractSquare(%d) = %v, %v, want %d, %d", tt.n, k, r, tt.k, tt.r)
+		}
+	}
+}
+
+func TestParsePolynomial(t *testing.T) {
+	tests := []struct {
+		input    string
+		variable string
+		expected []string
+		wantErr  error
+	}{
+		{"x^2 - 3x + 2", "x", []string{"1", "-3", "2"}, nil},
+		{"t^3 = 8", "t", []string{"1", "0", "0", "-8"}, nil},
+		{"(y - 1/2)(2y + 1) = y", "y", []string{"2", "-1", "-1/2"}, nil},
+		{"5", "", []string{"5"}, nil},
+		{"x^2 + y", "", nil, ErrNotPolynomial},
+		{"sqrt(x) = 2", "", nil, ErrNotPolynomial},
+		{"x = 1 = 2", "", nil, ErrInvalidEquation},
+		{"x^2 +", "", nil, ErrInvalidEquation},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			coefficients, variable, err := ParsePolynomial(tt.input)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("ParsePolynomial() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParsePolynomial() unexpected error: %v", err)
+			}
+			var got []string
+			for _, c := range coefficients {
+				got = append(got, c.RatString())
+			}
+			if variable != tt.variable || !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("ParsePolynomial() = %v in %q, want %v in %q", got, variable, tt.expected, tt.variable)
+			}
+		})
+	}
+}
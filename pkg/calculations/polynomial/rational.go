@@ -0,0 +1,176 @@
+package polynomial
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+)
+
+// poly is a polynomial with rational coefficients, lowest degree first.
+type poly []*big.Rat
+
+func (p poly) trim() poly {
+	n := len(p)
+	for n > 0 && p[n-1].Sign() == 0 {
+		n--
+	}
+	return p[:n]
+}
+
+// degree returns the degree of p, which must be trimmed; the zero polynomial
+// has degree -1.
+func (p poly) degree() int {
+	return len(p) - 1
+}
+
+func (p poly) eval(x *big.Rat) *big.Rat {
+	value := new(big.Rat)
+	for i := len(p) - 1; i >= 0; i-- {
+		value.Mul(value, x)
+		value.Add(value, p[i])
+	}
+	return value
+}
+
+func (p poly) derivative() poly {
+	if len(p) <= 1 {
+		return nil
+	}
+	d := make(poly, len(p)-1)
+	for i := range d {
+		d[i] = new(big.Rat).Mul(p[i+1], big.NewRat(int64(i+1), 1))
+	}
+	return d.trim()
+}
+
+func (p poly) sub(q poly) poly {
+	difference := make(poly, max(len(p), len(q)))
+	for i := range difference {
+		difference[i] = new(big.Rat)
+		if i < len(p) {
+			difference[i].Add(difference[i], p[i])
+		}
+		if i < len(q) {
+			difference[i].Sub(difference[i], q[i])
+		}
+	}
+	return difference.trim()
+}
+
+// monic scales p so its leading coefficient is 1.
+func (p poly) monic() poly {
+	if len(p) == 0 {
+		return p
+	}
+	lead := p[len(p)-1]
+	scaled := make(poly, len(p))
+	for i, c := range p {
+		scaled[i] = new(big.Rat).Quo(c, lead)
+	}
+	return scaled
+}
+
+// divmod divides p by a nonzero polynomial d.
+func (p poly) divmod(d poly) (quotient, remainder poly) {
+	remainder = make(poly, len(p))
+	for i, c := range p {
+		remainder[i] = new(big.Rat).Set(c)
+	}
+	if len(p) < len(d) {
+		return nil, remainder
+	}
+	quotient = make(poly, len(p)-len(d)+1)
+	lead := d[len(d)-1]
+	for i := len(quotient) - 1; i >= 0; i-- {
+		c := new(big.Rat).Quo(remainder[i+len(d)-1], lead)
+		quotient[i] = c
+		for j, dc := range d {
+			remainder[i+j].Sub(remainder[i+j], new(big.Rat).Mul(c, dc))
+		}
+	}
+	return quotient.trim(), remainder.trim()
+}
+
+// gcd returns the monic greatest common divisor of p and q.
+func gcd(ctx context.Context, p, q poly) (poly, error) {
+	for q.degree() >= 0 {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		_, remainder := p.divmod(q)
+		p, q = q, remainder.monic()
+	}
+	return p.monic(), nil
+}
+
+// squareFree splits p into factors a₁, a₂, … with p = c·a₁·a₂²·a₃³⋯, where each
+// factor is square-free and the factors are coprime (Yun's algorithm). A
+// factor with no roots is the constant 1.
+func squareFree(ctx context.Context, p poly) ([]poly, error) {
+	p = p.monic()
+	d := p.derivative()
+	a, err := gcd(ctx, p, d)
+	if err != nil {
+		return nil, err
+	}
+	b, _ := p.divmod(a)
+	c, _ := d.divmod(a)
+	d = c.sub(b.derivative())
+
+	var factors []poly
+	for b.degree() > 0 {
+		a, err = gcd(ctx, b, d)
+		if err != nil {
+			return nil, err
+		}
+		factors = append(factors, a)
+		b, _ = b.divmod(a)
+		c, _ = d.divmod(a)
+		d = c.sub(b.derivative())
+	}
+	return factors, nil
+}
+
+// floats converts p to float64 coefficients scaled so the largest has
+// magnitude near 1, which keeps the numeric root finders well away from
+// overflow.
+func (p poly) floats() ([]float64, error) {
+	var largest *big.Rat
+	for _, c := range p {
+		if largest == nil || new(big.Rat).Abs(c).Cmp(new(big.Rat).Abs(largest)) > 0 {
+			largest = c
+		}
+	}
+	scale := new(big.Rat).Abs(largest)
+
+	coefficients := make([]float64, len(p))
+	for i, c := range p {
+		f, _ := new(big.Rat).Quo(c, scale).Float64()
+		coefficients[i] = f
+	}
+	if coefficients[len(p)-1] == 0 || math.IsInf(coefficients[len(p)-1], 0) {
+		return nil, fmt.Errorf("%w: coefficients span too many orders of magnitude", ErrOutOfRange)
+	}
+	return coefficients, nil
+}
+
+// integerLead returns the leading coefficient of p scaled to coprime integer
+// coefficients. The denominator of every rational root of p divides it.
+func (p poly) integerLead() *big.Int {
+	lcm := big.NewInt(1)
+	for _, c := range p {
+		g := new(big.Int).GCD(nil, nil, lcm, c.Denom())
+		lcm.Mul(lcm, new(big.Int).Quo(c.Denom(), g))
+	}
+	content := new(big.Int)
+	for _, c := range p {
+		n := new(big.Int).Mul(c.Num(), new(big.Int).Quo(lcm, c.Denom()))
+		content.GCD(nil, nil, content, n.Abs(n))
+	}
+
+	lead := p[len(p)-1]
+	n := new(big.Int).Mul(lead.Num(), new(big.Int).Quo(lcm, lead.Denom()))
+	n.Quo(n, content)
+	return n.Abs(n)
+}
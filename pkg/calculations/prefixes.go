@@ -0,0 +1,175 @@
+package calculations
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// AutoUnit is the target unit that ConvertUnitAuto resolves to the most
+// readable SI-prefixed unit.
+const AutoUnit = "auto"
+
+// SIPrefix is a decimal prefix such as kilo (k, 10³) or micro (µ, 10⁻⁶).
+type SIPrefix struct {
+	Symbol   string
+	Name     string
+	Exponent int
+}
+
+// SIPrefixes lists the SI prefixes from quecto to quetta.
+var SIPrefixes = []SIPrefix{
+	{"q", "quecto", -30}, {"r", "ronto", -27}, {"y", "yocto", -24}, {"z", "zepto", -21},
+	{"a", "atto", -18}, {"f", "femto", -15}, {"p", "pico", -12}, {"n", "nano", -9},
+	{"µ", "micro", -6}, {"m", "milli", -3}, {"c", "centi", -2}, {"d", "deci", -1},
+	{"da", "deca", 1}, {"h", "hecto", 2}, {"k", "kilo", 3}, {"M", "mega", 6},
+	{"G", "giga", 9}, {"T", "tera", 12}, {"P", "peta", 15}, {"E", "exa", 18},
+	{"Z", "zetta", 21}, {"Y", "yotta", 24}, {"R", "ronna", 27}, {"Q", "quetta", 30},
+}
+
+// microSpellings are accepted for µ (U+00B5): the Greek mu (U+03BC) and, as
+// is common in plain text, "u".
+var microSpellings = []string{"μ", "u"}
+
+// Factor returns 10 raised to the prefix exponent.
+func (p SIPrefix) Factor() float64 {
+	return math.Pow10(p.Exponent)
+}
+
+// informationDimension is the dimension of data sizes, which have no
+// fractions of a bit or byte to prefix.
+var informationDimension = dim(0, 0, 0, 0, 0, 0, 0, 0, 1)
+
+// splitPrefix splits symbol into an SI prefix and the metric unit it
+// prefixes. Prefix symbols attach to a unit's symbol and aliases, prefix
+// names to its name and aliases; both are case-sensitive, so "Mm" is a
+// megameter and "mm" a millimeter.
+func (r *UnitRegistry) splitPrefix(symbol string) (SIPrefix, *Unit, bool) {
+	for _, p := range SIPrefixes {
+		for _, spelling := range p.spellings() {
+			rest, ok := strings.CutPrefix(symbol, spelling)
+			if !ok || rest == "" {
+				continue
+			}
+			if base, ok := r.units[rest]; ok && p.prefixes(base) {
+				return p, base, true
+			}
+		}
+	}
+	return SIPrefix{}, nil, false
+}
+
+// prefixedFoldClash reports whether some case variant of symbol resolves as
+// a prefixed metric unit other than unit, as "MM" does to Mm when folding
+// would give mm. Such spellings are left unresolved rather than guessed.
+func (r *UnitRegistry) prefixedFoldClash(symbol string, unit *Unit) bool {
+	for _, p := range SIPrefixes {
+		for _, spelling := range p.spellings() {
+			if len(symbol) <= len(spelling) || !strings.EqualFold(symbol[:len(spelling)], spelling) {
+				continue
+			}
+			rest := symbol[len(spelling):]
+			for key, base := range r.units {
+				if strings.EqualFold(key, rest) && p.prefixes(base) && p.Symbol+base.Symbol != unit.Symbol {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// spellings returns the ways p may be written before a unit.
+func (p SIPrefix) spellings() []string {
+	if p.Symbol == "µ" {
+		return append([]string{p.Symbol, p.Name}, microSpellings...)
+	}
+	return []string{p.Symbol, p.Name}
+}
+
+// prefixes reports whether p may be attached to base.
+func (p SIPrefix) prefixes(base *Unit) bool {
+	return base.Metric && (p.Exponent > 0 || base.Dimension != informationDimension)
+}
+
+// lookupPrefixed resolves a prefixed metric unit such as "Mm", "mL", "µs",
+// "kilowatts" or "nanometres".
+func (r *UnitRegistry) lookupPrefixed(symbol string) (*Unit, bool) {
+	p, base, ok := r.splitPrefix(symbol)
+	if !ok {
+		return nil, false
+	}
+	return prefixedUnit(p, base), true
+}
+
+// prefixedUnit returns base scaled by p, named after the prefix symbol and
+// base symbol ("km") and the prefix and base names ("kilometer",
+// "kilometers").
+func prefixedUnit(p SIPrefix, base *Unit) *Unit {
+	return &Unit{
+		Symbol:    p.Symbol + base.Symbol,
+		Name:      p.Name + base.Name,
+		Plural:    p.Name + base.PluralName(),
+		Dimension: base.Dimension,
+		Factor:    base.Factor * p.Factor(),
+		Types:     base.Types,
+		Systems:   base.Systems,
+	}
+}
+
+// metricBase returns the metric unit that u is or prefixes ("km" and "kWh"
+// give m and Wh) or, failing that, the first metric unit registered with
+// u's dimension.
+func (r *UnitRegistry) metricBase(u *Unit) (*Unit, bool) {
+	if u.Metric {
+		return u, true
+	}
+	if _, base, ok := r.splitPrefix(u.Symbol); ok && base.Dimension == u.Dimension {
+		return base, true
+	}
+	for _, unit := range r.order {
+		if unit.Metric && unit.Dimension == u.Dimension {
+			return unit, true
+		}
+	}
+	return nil, false
+}
+
+// ConvertAuto converts value from one unit to the metric unit of the same
+// quantity with the most readable prefix, the power of 1000 that brings the
+// result into [1, 1000) where the prefixes reach. The metric unit is from's
+// own family ("mL" stays in liters) or else the first registered metric unit
+// of its dimension (feet become meters, MiB bytes). Units with an offset
+// such as Celsius stay as they are, since a prefixed kelvin would read as a
+// different temperature.
+func (r *UnitRegistry) ConvertAuto(value float64, from *Unit) (float64, *Unit, error) {
+	if from.Offset != 0 {
+		return value, from, nil
+	}
+	base, ok := r.metricBase(from)
+	if !ok {
+		return 0, nil, fmt.Errorf("%s has no metric unit to prefix", from.Dimension.Describe())
+	}
+	v, err := r.ConvertUnits(value, from, base)
+	if err != nil {
+		return 0, nil, err
+	}
+	if v == 0 {
+		return 0, base, nil
+	}
+
+	exponent := int(math.Floor(math.Log10(math.Abs(v))/3)) * 3
+	exponent = max(-30, min(30, exponent))
+	if base.Dimension == informationDimension {
+		exponent = max(0, exponent)
+	}
+	if exponent == 0 {
+		return v, base, nil
+	}
+	for _, p := range SIPrefixes {
+		if p.Exponent == exponent {
+			return v / p.Factor(), prefixedUnit(p, base), nil
+		}
+	}
+	return v, base, nil
+}
@@ -0,0 +1,123 @@
+package calculations
+
+import (
+	"math"
+	"testing"
+)
+
+func TestLookupPrefixedUnits(t *testing.T) {
+	r := NewUnitRegistry()
+	tests := []struct {
+		symbol    string
+		name      string
+		factor    float64
+		dimension Dimension
+	}{
+		{"Mm", "megameter", 1e6, dim(1)},
+		{"mm", "millimeter", 1e-3, dim(1)},
+		{"dam", "decameter", 10, dim(1)},
+		{"qm", "quectometer", 1e-30, dim(1)},
+		{"Qm", "quettameter", 1e30, dim(1)},
+		{"mL", "milliliter", 1e-6, dim(3)},
+		{"ML", "megaliter", 1e3, dim(3)},
+		{"cl", "centiliter", 1e-5, dim(3)},
+		{"µs", "microsecond", 1e-6, dim(0, 0, 1)},
+		{"μs", "microsecond", 1e-6, dim(0, 0, 1)},
+		{"ug", "microgram", 1e-9, dim(0, 1)},
+		{"Mg", "megagram", 1e3, dim(0, 1)},
+		{"mg", "milligram", 1e-6, dim(0, 1)},
+		{"Gt", "gigatonne", 1e12, dim(0, 1)},
+		{"mHz", "millihertz", 1e-3, dim(0, 0, -1)},
+		{"MHz", "megahertz", 1e6, dim(0, 0, -1)},
+		{"GWh", "gigawatt-hour", 3.6e12, dim(2, 1, -2)},
+		{"keV", "kiloelectronvolt", 1.602176634e-16, dim(2, 1, -2)},
+		{"mK", "millikelvin", 1e-3, dim(0, 0, 0, 0, 1)},
+		{"mrad", "milliradian", 1e-3, dim(0, 0, 0, 0, 0, 0, 0, 1)},
+		{"kilowatts", "kilowatt", 1e3, dim(2, 1, -3)},
+		{"nanometres", "nanometer", 1e-9, dim(1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.symbol, func(t *testing.T) {
+			unit, ok := r.Lookup(tt.symbol)
+			if !ok {
+				t.Fatalf("Lookup(%q) found no unit", tt.symbol)
+			}
+			if unit.Name != tt.name || math.Abs(unit.Factor-tt.factor) > 1e-12*tt.factor || unit.Dimension != tt.dimension {
+				t.Errorf("Lookup(%q) = %s %v %v, want %s %v %v",
+					tt.symbol, unit.Name, unit.Factor, unit.Dimension, tt.name, tt.factor, tt.dimension)
+			}
+		})
+	}
+
+	// Only metric units take prefixes.
+	for _, symbol := range []string{"kft", "Mlb", "k°C", "kkg", "mmph", "mB", "µbit"} {
+		if unit, ok := r.Lookup(symbol); ok {
+			t.Errorf("Lookup(%q) = %s, want no unit", symbol, unit.Symbol)
+		}
+	}
+
+	// Case is not folded where another case variant is a prefixed unit.
+	for _, symbol := range []string{"mpa", "MPA", "MM", "MG"} {
+		if unit, ok := r.Lookup(symbol); ok {
+			t.Errorf("Lookup(%q) = %s, want no unit", symbol, unit.Symbol)
+		}
+	}
+	for symbol, want := range map[string]string{"KM": "km", "KG": "kg", "MPH": "mph", "Kilometers": "km"} {
+		if unit, ok := r.Lookup(symbol); !ok || unit.Symbol != want {
+			t.Errorf("Lookup(%q) = %v, want %s", symbol, unit, want)
+		}
+	}
+
+	if unit, err := r.Resolve("kN·mm"); err != nil || unit.Factor != 1 {
+		t.Errorf("Resolve(kN·mm) = %v, %v, want factor 1", unit, err)
+	}
+}
+
+func TestConvertAuto(t *testing.T) {
+	tests := []struct {
+		value    float64
+		from     string
+		expected float64
+		unit     string
+		wantErr  string
+	}{
+		{value: 1500, from: "m", expected: 1.5, unit: "km"},
+		{value: 0.002, from: "s", expected: 2, unit: "ms"},
+		{value: 1, from: "mi", expected: 1.609344, unit: "km"},
+		{value: 2500, from: "mL", expected: 2.5, unit: "L"},
+		{value: 0.5, from: "kg", expected: 500, unit: "g"},
+		{value: 5000, from: "kg", expected: 5, unit: "Mg"},
+		{value: 1e6, from: "kWh", expected: 1, unit: "GWh"},
+		{value: 1e6, from: "BTU", expected: 1.05505585262, unit: "GJ"},
+		{value: 1, from: "nm", expected: 1, unit: "nm"},
+		{value: -0.000004, from: "A", expected: -4, unit: "µA"},
+		{value: 1e40, from: "m", expected: 1e10, unit: "Qm"},
+		{value: 0, from: "km", expected: 0, unit: "m"},
+		{value: 1536, from: "KiB", expected: 1.572864, unit: "MB"},
+		{value: 100, from: "Mbit", expected: 100, unit: "Mbit"},
+		{value: 4, from: "bit", expected: 4, unit: "bit"},
+		{value: 30, from: "C", expected: 30, unit: "C"},
+		{value: 98.6, from: "°F", expected: 98.6, unit: "F"},
+		{value: 0.02, from: "K", expected: 20, unit: "mK"},
+		{value: 5, from: "L/100km", wantErr: "fuel economy (L⁻²) has no metric unit to prefix"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.from, func(t *testing.T) {
+			result, unit, err := ConvertUnitAuto(tt.value, tt.from, "", SignificantFigures(12))
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Errorf("ConvertUnitAuto() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ConvertUnitAuto() unexpected error: %v", err)
+			}
+			if unit != tt.unit || math.Abs(result-tt.expected) > 1e-9*math.Abs(tt.expected) {
+				t.Errorf("ConvertUnitAuto(%v %s) = %v %s, want %v %s", tt.value, tt.from, result, unit, tt.expected, tt.unit)
+			}
+		})
+	}
+}
@@ -0,0 +1,359 @@
+package calculations
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// maxQuantityLength bounds the text accepted by ParseQuantity.
+const maxQuantityLength = 200
+
+// Quantity is a value in a unit.
+type Quantity struct {
+	Value float64
+	Unit  *Unit
+}
+
+// compoundUnits lists, by unit symbol, the units a compound quantity in that
+// unit is written in, largest first: 1.78 m is "1 m 78 cm".
+var compoundUnits = map[string][]string{
+	"km":  {"km", "m", "cm"},
+	"m":   {"km", "m", "cm"},
+	"cm":  {"km", "m", "cm"},
+	"ft":  {"ft", "in"},
+	"in":  {"ft", "in"},
+	"kg":  {"kg", "g"},
+	"g":   {"kg", "g"},
+	"st":  {"st", "lb"},
+	"lb":  {"lb", "oz"},
+	"oz":  {"lb", "oz"},
+	"h":   {"h", "min", "s"},
+	"min": {"h", "min", "s"},
+	"s":   {"h", "min", "s"},
+}
+
+// quantityReplacer normalizes the characters people type for signs and
+// temperature scales.
+var quantityReplacer = strings.NewReplacer(
+	"−", "-", // minus sign
+	"º", "°", // masculine ordinal, a common stand-in for the degree sign
+	"℃", "°C",
+	"℉", "°F",
+	"\u212A", "K", // kelvin sign
+)
+
+// quantityMarks are the foot and inch marks of heights such as 5'10".
+var quantityMarks = map[string]string{
+	"'":  "ft",
+	"\"": "in",
+	"''": "in",
+}
+
+// ParseQuantity parses text such as "3.5 lbs", "72°F", "1,5 km" or
+// "5 ft 10 in" into a value and unit. Units are matched as by Resolve, also
+// allowing spaces for underscores ("nautical miles"), a degree sign or word
+// apart from its scale ("72 ° F", "20 degrees C"), abbreviation periods
+// ("lbs.") and the foot and inch marks ' and ".
+//
+// A compound quantity is a run of terms from the largest unit to the
+// smallest, all of one dimension, and is returned in its smallest unit:
+// "5 ft 10 in" is 70 in. A trailing number without a unit is in the next
+// smaller unit, so "5 ft 10" and 5'10 are 70 in too.
+//
+// decimalSeparator is '.' or ',', the other one grouping thousands, or 0 to
+// infer it: a lone comma followed by three digits groups thousands ("1,000
+// m"), and any other lone comma or dot is decimal ("1,5 km", "1.500 kg").
+// When both appear, the last one is decimal ("1.234,5" and "1,234.5").
+func (r *UnitRegistry) ParseQuantity(input string, decimalSeparator rune) (Quantity, error) {
+	if decimalSeparator != 0 && decimalSeparator != '.' && decimalSeparator != ',' {
+		return Quantity{}, fmt.Errorf("decimal separator must be '.' or ','")
+	}
+	if len(input) > maxQuantityLength {
+		return Quantity{}, fmt.Errorf("quantity is longer than %d characters", maxQuantityLength)
+	}
+	text := strings.Join(strings.FieldsFunc(quantityReplacer.Replace(input), unicode.IsSpace), " ")
+	if text == "" {
+		return Quantity{}, fmt.Errorf("quantity is empty")
+	}
+
+	var terms []Quantity
+	for text != "" {
+		value, rest, err := parseQuantityNumber(text, decimalSeparator, len(terms) == 0)
+		if err != nil {
+			return Quantity{}, err
+		}
+		unit, rest, err := r.parseQuantityUnit(rest)
+		if err != nil {
+			return Quantity{}, err
+		}
+		if unit == nil {
+			if len(terms) == 0 {
+				return Quantity{}, fmt.Errorf("%q has no unit", input)
+			}
+			if unit = r.nextCompoundUnit(terms[len(terms)-1].Unit); unit == nil {
+				return Quantity{}, fmt.Errorf("%q has a number without a unit after %s", input, terms[len(terms)-1].Unit.Symbol)
+			}
+		}
+		terms = append(terms, Quantity{value, unit})
+		text = strings.TrimLeft(rest, " ")
+	}
+
+	if len(terms) == 1 {
+		return terms[0], nil
+	}
+	return combineQuantityTerms(terms)
+}
+
+// parseQuantityNumber reads the number at the start of text, returning it
+// and the text after it. Only the first term of a quantity may be signed.
+func parseQuantityNumber(text string, decimalSeparator rune, signed bool) (float64, string, error) {
+	i := 0
+	negative := false
+	if text[0] == '-' || text[0] == '+' {
+		if !signed {
+			return 0, "", fmt.Errorf("only the first term of a compound quantity may have a sign")
+		}
+		negative = text[0] == '-'
+		i++
+	}
+	start := i
+	for i < len(text) && (isASCIIDigit(text[i]) || text[i] == '.' || text[i] == ',') {
+		i++
+	}
+	// A trailing separator belongs to the text after the number: "5, 10".
+	for i > start && (text[i-1] == '.' || text[i-1] == ',') {
+		i--
+	}
+	if !strings.ContainsAny(text[start:i], "0123456789") {
+		return 0, "", fmt.Errorf("expected a number at %q", text)
+	}
+
+	digits, err := normalizeQuantityNumber(text[start:i], decimalSeparator)
+	if err != nil {
+		return 0, "", err
+	}
+	// An exponent needs a digit after the e, so "1eV" stays an electronvolt.
+	if j := i; j < len(text) && (text[j] == 'e' || text[j] == 'E') {
+		j++
+		if j < len(text) && (text[j] == '-' || text[j] == '+') {
+			j++
+		}
+		if j < len(text) && isASCIIDigit(text[j]) {
+			for j < len(text) && isASCIIDigit(text[j]) {
+				j++
+			}
+			digits += text[i:j]
+			i = j
+		}
+	}
+
+	value, err := strconv.ParseFloat(digits, 64)
+	if err != nil || math.IsInf(value, 0) {
+		return 0, "", fmt.Errorf("invalid number %q", text[start:i])
+	}
+	if negative {
+		value = -value
+	}
+	return value, text[i:], nil
+}
+
+// normalizeQuantityNumber rewrites a number written with decimal and
+// grouping separators in Go syntax, checking that groups have three digits.
+func normalizeQuantityNumber(number string, decimalSeparator rune) (string, error) {
+	if decimalSeparator == 0 {
+		decimalSeparator = inferDecimalSeparator(number)
+	}
+	groupSeparator := ","
+	if decimalSeparator == ',' {
+		groupSeparator = "."
+	}
+
+	integer, fraction, hasFraction := strings.Cut(number, string(decimalSeparator))
+	if strings.Contains(fraction, string(decimalSeparator)) || strings.Contains(fraction, groupSeparator) {
+		return "", fmt.Errorf("invalid number %q", number)
+	}
+	groups := strings.Split(integer, groupSeparator)
+	for i, group := range groups {
+		if (i > 0 && len(group) != 3) || (i == 0 && len(groups) > 1 && (group == "" || len(group) > 3)) {
+			return "", fmt.Errorf("invalid number %q: digits are grouped in threes", number)
+		}
+	}
+
+	digits := strings.Join(groups, "")
+	if hasFraction {
+		digits += "." + fraction
+	}
+	return digits, nil
+}
+
+// inferDecimalSeparator guesses the decimal separator of number, which uses
+// at most one of '.' and ',' for each role.
+func inferDecimalSeparator(number string) rune {
+	lastDot, lastComma := strings.LastIndexByte(number, '.'), strings.LastIndexByte(number, ',')
+	switch {
+	case lastDot >= 0 && lastComma >= 0:
+		if lastComma > lastDot {
+			return ','
+		}
+		return '.'
+	case strings.Count(number, ",") == 1 && (len(number)-lastComma-1 != 3 || number[0] == '0' || lastComma == 0):
+		return ','
+	case strings.Count(number, ".") > 1:
+		return ','
+	}
+	return '.'
+}
+
+// parseQuantityUnit reads the unit after a number, returning nil when there
+// is none. The unit runs to the next number that follows a space; failing
+// that, to a number glued onto it, as in "5ft10in".
+func (r *UnitRegistry) parseQuantityUnit(text string) (*Unit, string, error) {
+	end := len(text)
+	for i := 1; i < len(text); i++ {
+		if text[i-1] == ' ' && startsWithNumber(text[i:]) {
+			end = i
+			break
+		}
+	}
+	start := len(text) - len(strings.TrimLeft(text, " "))
+	span := strings.TrimRight(text[start:end], " ")
+	if span == "" {
+		return nil, text[end:], nil
+	}
+	if unit, ok := r.resolveQuantityUnit(span); ok {
+		return unit, text[end:], nil
+	}
+
+	for i := 1; i < len(span); i++ {
+		if isASCIIDigit(span[i]) && !isASCIIDigit(span[i-1]) && !strings.ContainsRune("^/.,+-eE", rune(span[i-1])) {
+			if unit, ok := r.resolveQuantityUnit(span[:i]); ok {
+				return unit, text[start+i:], nil
+			}
+		}
+	}
+	return nil, "", fmt.Errorf("unknown unit %q", span)
+}
+
+// resolveQuantityUnit resolves the unit text of a quantity.
+func (r *UnitRegistry) resolveQuantityUnit(text string) (*Unit, bool) {
+	text = strings.TrimSpace(strings.TrimSuffix(strings.TrimRight(text, ", "), " and"))
+	if mark, ok := quantityMarks[text]; ok {
+		text = mark
+	}
+
+	var candidates []string
+	// A degree sign or word apart from its scale would otherwise read as a
+	// product of an angle and a temperature.
+	lower := strings.ToLower(text)
+	for _, degree := range []string{"° ", "degrees ", "degree ", "deg "} {
+		if strings.HasPrefix(lower, degree) {
+			scale := text[len(degree):]
+			candidates = append(candidates, "°"+scale, scale)
+		}
+	}
+	candidates = append(candidates, text, strings.ReplaceAll(text, " ", "_"))
+	if trimmed := strings.TrimSuffix(text, "."); trimmed != text {
+		candidates = append(candidates, trimmed, strings.ReplaceAll(trimmed, ". ", " "))
+	}
+
+	for _, candidate := range candidates {
+		if unit, err := r.Resolve(candidate); err == nil {
+			return unit, true
+		}
+	}
+	return nil, false
+}
+
+// nextCompoundUnit returns the unit after u in its compound units, or nil.
+func (r *UnitRegistry) nextCompoundUnit(u *Unit) *Unit {
+	units := compoundUnits[u.Symbol]
+	for i, symbol := range units {
+		if symbol == u.Symbol && i+1 < len(units) {
+			next, _ := r.Lookup(units[i+1])
+			return next
+		}
+	}
+	return nil
+}
+
+// combineQuantityTerms adds up the terms of a compound quantity in its
+// smallest unit. The sign of the first term applies to the whole.
+func combineQuantityTerms(terms []Quantity) (Quantity, error) {
+	first, last := terms[0], terms[len(terms)-1]
+	total := 0.0
+	for i, term := range terms {
+		if !term.Unit.IsLinear() {
+			return Quantity{}, fmt.Errorf("%s cannot be part of a compound quantity", term.Unit.Symbol)
+		}
+		if term.Unit.Dimension != first.Unit.Dimension {
+			return Quantity{}, fmt.Errorf("incompatible units: %s is %s but %s is %s",
+				first.Unit.Symbol, first.Unit.Dimension.Describe(), term.Unit.Symbol, term.Unit.Dimension.Describe())
+		}
+		if i > 0 && term.Unit.Factor >= terms[i-1].Unit.Factor {
+			return Quantity{}, fmt.Errorf("compound quantity units must go from largest to smallest, but %s follows %s",
+				term.Unit.Symbol, terms[i-1].Unit.Symbol)
+		}
+		total += math.Abs(term.Value) * term.Unit.Factor
+	}
+
+	value := total / last.Unit.Factor
+	if first.Value < 0 {
+		value = -value
+	}
+	return Quantity{value, last.Unit}, nil
+}
+
+// FormatCompound writes value in unit as a compound quantity such as
+// "5 ft 10 in" or "1 m 78 cm", rounding its smallest part with rounding and
+// leaving out parts that are zero. It reports false for units without
+// compound units and for values that come to fewer than two parts, such as
+// 6 ft, 1500 m (1.5 km gives "1 km 500 m") or 0.5 cm.
+func (r *UnitRegistry) FormatCompound(value float64, unit *Unit, rounding Rounding) (string, bool) {
+	symbols, ok := compoundUnits[unit.Symbol]
+	if !ok || math.IsNaN(value) || math.IsInf(value, 0) {
+		return "", false
+	}
+	units := make([]*Unit, len(symbols))
+	for i, symbol := range symbols {
+		if units[i], ok = r.Lookup(symbol); !ok {
+			return "", false
+		}
+	}
+
+	smallest := units[len(units)-1]
+	remaining := rounding.Round(math.Abs(value) * unit.Factor / smallest.Factor)
+	var parts []string
+	for _, u := range units[:len(units)-1] {
+		// Compound units are whole multiples of each other: 12 in to the foot.
+		ratio := math.Round(u.Factor / smallest.Factor)
+		if n := math.Floor(remaining / ratio); n > 0 {
+			parts = append(parts, strconv.FormatFloat(n, 'f', -1, 64)+" "+u.Symbol)
+			remaining = rounding.Round(remaining - n*ratio)
+		}
+	}
+	if remaining != 0 {
+		parts = append(parts, strconv.FormatFloat(remaining, 'f', -1, 64)+" "+smallest.Symbol)
+	}
+	if len(parts) < 2 {
+		return "", false
+	}
+
+	formatted := strings.Join(parts, " ")
+	if value < 0 {
+		formatted = "-" + formatted
+	}
+	return formatted, true
+}
+
+// startsWithNumber reports whether text starts with a digit, possibly signed.
+func startsWithNumber(text string) bool {
+	text = strings.TrimLeft(text, "+-")
+	return text != "" && isASCIIDigit(text[0])
+}
+
+func isASCIIDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
@@ -0,0 +1,134 @@
+package calculations
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestParseQuantity(t *testing.T) {
+	tests := []struct {
+		input     string
+		separator rune
+		value     float64
+		unit      string
+		wantErr   string
+	}{
+		{input: "3.5 lbs", value: 3.5, unit: "lb"},
+		{input: "3.5 lbs.", value: 3.5, unit: "lb"},
+		{input: "72°F", value: 72, unit: "F"},
+		{input: "72 ° F", value: 72, unit: "F"},
+		{input: "72 ℉", value: 72, unit: "F"},
+		{input: "20 degrees C", value: 20, unit: "C"},
+		{input: "−40 ºC", value: -40, unit: "C"},
+		{input: "90°", value: 90, unit: "deg"},
+		{input: "10 µm", value: 10, unit: "µm"},
+		{input: "10 μm", value: 10, unit: "µm"},
+		{input: "1eV", value: 1, unit: "eV"},
+		{input: "1.5e3 m", value: 1500, unit: "m"},
+		{input: "2 cups", value: 2, unit: "cup"},
+		{input: "5 kilometres", value: 5, unit: "km"},
+		{input: "3 nautical miles", value: 3, unit: "nmi"},
+		{input: "60 miles per hour", value: 60, unit: "mph"},
+		{input: "100 km/h", value: 100, unit: "km/h"},
+
+		// Decimal and grouping separators
+		{input: "1,5 km", value: 1.5, unit: "km"},
+		{input: "1,000 m", value: 1000, unit: "m"},
+		{input: "0,500 kg", value: 0.5, unit: "kg"},
+		{input: "1.500 kg", value: 1.5, unit: "kg"},
+		{input: "1.000.000 m", value: 1e6, unit: "m"},
+		{input: "1.234,5 m", value: 1234.5, unit: "m"},
+		{input: "1,234.5 m", value: 1234.5, unit: "m"},
+		{input: "1,500 km", separator: ',', value: 1.5, unit: "km"},
+		{input: "1.500 kg", separator: ',', value: 1500, unit: "kg"},
+		{input: "1,5 km", separator: '.', wantErr: "digits are grouped in threes"},
+		{input: "1,5,3 m", wantErr: "digits are grouped in threes"},
+
+		// Compound quantities
+		{input: "5 ft 10 in", value: 70, unit: "in"},
+		{input: "5 km 10", value: 5010, unit: "m"},
+		{input: "5ft10in", value: 70, unit: "in"},
+		{input: `5'10"`, value: 70, unit: "in"},
+		{input: `5' 10"`, value: 70, unit: "in"},
+		{input: "5 ft 10", value: 70, unit: "in"},
+		{input: "5 feet, 10 inches", value: 70, unit: "in"},
+		{input: "5 feet and 10 inches", value: 70, unit: "in"},
+		{input: "1 m 78 cm", value: 178, unit: "cm"},
+		{input: "1 st 3 lb", value: 17, unit: "lb"},
+		{input: "-2 h 30 min", value: -150, unit: "min"},
+		{input: "10 in 5 ft", wantErr: "must go from largest to smallest"},
+		{input: "5 ft 3 kg", wantErr: "incompatible units"},
+		{input: "5 ft -3 in", wantErr: "only the first term of a compound quantity may have a sign"},
+		{input: "20 C 5 K", wantErr: "C cannot be part of a compound quantity"},
+		{input: "5 mi 10", wantErr: "number without a unit after mi"},
+
+		{input: "", wantErr: "quantity is empty"},
+		{input: "5", wantErr: "has no unit"},
+		{input: "five feet", wantErr: "expected a number"},
+		{input: "5 parsecs", wantErr: `unknown unit "parsecs"`},
+		{input: "5 m", separator: ';', wantErr: "decimal separator must be"},
+		{input: strings.Repeat("1", 201) + " m", wantErr: "longer than 200 characters"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			q, err := ParseQuantity(tt.input, tt.separator)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("ParseQuantity() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseQuantity() unexpected error: %v", err)
+			}
+			if q.Unit.Symbol != tt.unit || math.Abs(q.Value-tt.value) > 1e-9*math.Abs(tt.value) {
+				t.Errorf("ParseQuantity(%q) = %v %s, want %v %s", tt.input, q.Value, q.Unit.Symbol, tt.value, tt.unit)
+			}
+		})
+	}
+}
+
+func TestFormatCompound(t *testing.T) {
+	tests := []struct {
+		value    float64
+		unit     string
+		expected string
+	}{
+		{1.78, "m", "1 m 78 cm"},
+		{1.785, "m", "1 m 78.5 cm"},
+		{1500, "m", "1 km 500 m"},
+		{1.5, "km", "1 km 500 m"},
+		{1000.05, "m", "1 km 5 cm"},
+		{70, "in", "5 ft 10 in"},
+		{5.8333333333, "ft", "5 ft 10 in"},
+		{-1.5, "h", "-1 h 30 min"},
+		{3700, "s", "1 h 1 min 40 s"},
+		{11.5, "st", "11 st 7 lb"},
+	}
+
+	for _, tt := range tests {
+		got, ok := FormatCompound(tt.value, tt.unit, ConversionRounding)
+		if !ok || got != tt.expected {
+			t.Errorf("FormatCompound(%v, %s) = %q, %v, want %q", tt.value, tt.unit, got, ok, tt.expected)
+		}
+	}
+
+	for _, unit := range []string{"mi", "C", "parsec"} {
+		if got, ok := FormatCompound(1, unit, ConversionRounding); ok {
+			t.Errorf("FormatCompound(1, %s) = %q, want no compound form", unit, got)
+		}
+	}
+
+	// A single part is the plain result.
+	single := []struct {
+		value float64
+		unit  string
+	}{{6, "ft"}, {12.5, "oz"}, {1500, "km"}, {0, "ft"}, {0, "m"}, {5e-6, "m"}}
+	for _, tt := range single {
+		if got, ok := FormatCompound(tt.value, tt.unit, ConversionRounding); ok {
+			t.Errorf("FormatCompound(%v, %s) = %q, want no compound form", tt.value, tt.unit, got)
+		}
+	}
+}
@@ -0,0 +1,210 @@
+// Package random draws random numbers, integers and samples from either a
+// seeded generator, for reproducible output, or the operating system's
+// cryptographically secure source.
+package random
+
+import (
+	crand "crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"sort"
+	"strings"
+
+	"github.com/m-szczepanski/gocalc-api/pkg/calculations"
+)
+
+// Source identifies where a Generator's randomness comes from.
+type Source string
+
+const (
+	// Seeded is a PCG generator: the same seed always yields the same draws.
+	Seeded Source = "seeded"
+	// Crypto reads from crypto/rand and is suitable for draws that must not
+	// be predictable, such as raffles.
+	Crypto Source = "crypto"
+)
+
+// Operation names a kind of draw.
+type Operation string
+
+const (
+	UniformDraw Operation = "uniform"
+	NormalDraw  Operation = "normal"
+	IntegerDraw Operation = "integer"
+	ShuffleDraw Operation = "shuffle"
+	SampleDraw  Operation = "sample"
+	ChoiceDraw  Operation = "choice"
+)
+
+// ValidOperations returns every supported operation.
+func ValidOperations() []Operation {
+	return []Operation{UniformDraw, NormalDraw, IntegerDraw, ShuffleDraw, SampleDraw, ChoiceDraw}
+}
+
+// ParseOperation normalizes an operation name.
+func ParseOperation(name string) (Operation, error) {
+	normalized := Operation(strings.ToLower(strings.TrimSpace(name)))
+	for _, op := range ValidOperations() {
+		if op == normalized {
+			return op, nil
+		}
+	}
+	return "", fmt.Errorf("invalid operation %q (valid operations: %v)", name, ValidOperations())
+}
+
+// UsesItems reports whether op draws from a list of items rather than
+// generating numbers.
+func UsesItems(op Operation) bool {
+	return op == ShuffleDraw || op == SampleDraw || op == ChoiceDraw
+}
+
+// Generator produces random draws. It is not safe for concurrent use.
+type Generator struct {
+	Source Source
+	rng    *rand.Rand
+}
+
+// NewSeeded returns a reproducible generator.
+func NewSeeded(seed uint64) *Generator {
+	return &Generator{Source: Seeded, rng: rand.New(rand.NewPCG(seed, 0))}
+}
+
+// NewCrypto returns a generator backed by crypto/rand.
+func NewCrypto() *Generator {
+	return &Generator{Source: Crypto, rng: rand.New(cryptoSource{})}
+}
+
+type cryptoSource struct{}
+
+func (cryptoSource) Uint64() uint64 {
+	var b [8]byte
+	// crypto/rand.Read never returns an error; it crashes the program
+	// irrecoverably if the operating system source fails.
+	crand.Read(b[:])
+	return binary.LittleEndian.Uint64(b[:])
+}
+
+// Uniform returns n values drawn uniformly from [min, max).
+func (g *Generator) Uniform(min, max float64, n int) ([]float64, error) {
+	if !(min < max) {
+		return nil, fmt.Errorf("min must be less than max, got %v and %v", min, max)
+	}
+	width := max - min
+	if math.IsInf(width, 0) {
+		return nil, fmt.Errorf("%w: the range from %v to %v is too wide", calculations.ErrDomain, min, max)
+	}
+
+	values := make([]float64, n)
+	for i := range values {
+		values[i] = min + width*g.rng.Float64()
+		// Rounding in the addition can reach max itself.
+		if values[i] >= max {
+			values[i] = math.Nextafter(max, min)
+		}
+	}
+	return values, nil
+}
+
+// Normal returns n values drawn from a normal distribution.
+func (g *Generator) Normal(mean, sd float64, n int) ([]float64, error) {
+	if !(sd > 0) || math.IsInf(sd, 0) {
+		return nil, fmt.Errorf("sd must be > 0, got %v", sd)
+	}
+
+	values := make([]float64, n)
+	for i := range values {
+		values[i] = mean + sd*g.rng.NormFloat64()
+		if math.IsInf(values[i], 0) {
+			return nil, fmt.Errorf("%w: draw overflows for mean %v and sd %v", calculations.ErrDomain, mean, sd)
+		}
+	}
+	return values, nil
+}
+
+// Integers returns n integers drawn uniformly from [min, max], inclusive.
+func (g *Generator) Integers(min, max int64, n int) ([]int64, error) {
+	if min > max {
+		return nil, fmt.Errorf("min must not exceed max, got %d and %d", min, max)
+	}
+
+	// The span is computed in uint64 so that the full int64 range does not
+	// overflow; it wraps to 0 only when every int64 is possible.
+	span := uint64(max) - uint64(min) + 1
+	values := make([]int64, n)
+	for i := range values {
+		if span == 0 {
+			values[i] = int64(g.rng.Uint64())
+		} else {
+			values[i] = int64(uint64(min) + g.rng.Uint64N(span))
+		}
+	}
+	return values, nil
+}
+
+// Shuffle returns a uniformly random permutation of the indices 0..n-1.
+func (g *Generator) Shuffle(n int) []int {
+	return g.rng.Perm(n)
+}
+
+// Sample returns k indices drawn uniformly from 0..n-1, with or without
+// replacement.
+func (g *Generator) Sample(n, k int, replace bool) ([]int, error) {
+	if n < 1 {
+		return nil, fmt.Errorf("population must not be empty")
+	}
+	if !replace && k > n {
+		return nil, fmt.Errorf("cannot draw %d items without replacement from %d", k, n)
+	}
+
+	if !replace {
+		return g.rng.Perm(n)[:k], nil
+	}
+	indices := make([]int, k)
+	for i := range indices {
+		indices[i] = g.rng.IntN(n)
+	}
+	return indices, nil
+}
+
+// WeightedChoice returns k indices drawn with replacement, index i being
+// chosen with probability weights[i] / sum(weights). Items with zero weight
+// are never chosen.
+func (g *Generator) WeightedChoice(weights []float64, k int) ([]int, error) {
+	if len(weights) == 0 {
+		return nil, fmt.Errorf("weights must not be empty")
+	}
+
+	cumulative := make([]float64, len(weights))
+	last := -1
+	var total float64
+	for i, w := range weights {
+		if math.IsNaN(w) || math.IsInf(w, 0) || w < 0 {
+			return nil, fmt.Errorf("weight at index %d must be a non-negative number, got %v", i, w)
+		}
+		total += w
+		cumulative[i] = total
+		if w > 0 {
+			last = i
+		}
+	}
+	if last < 0 {
+		return nil, fmt.Errorf("weights must not all be zero")
+	}
+	if math.IsInf(total, 0) {
+		return nil, fmt.Errorf("%w: weights sum to infinity", calculations.ErrDomain)
+	}
+
+	indices := make([]int, k)
+	for j := range indices {
+		r := g.rng.Float64() * total
+		i := sort.Search(len(cumulative), func(i int) bool { return cumulative[i] > r })
+		// r can round up to total, past every cumulative weight.
+		if i > last {
+			i = last
+		}
+		indices[j] = i
+	}
+	return indices, nil
+}
@@ -0,0 +1,161 @@
+package random
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/m-szczepanski/gocalc-api/pkg/calculations"
+)
+
+func TestSeededIsReproducible(t *testing.T) {
+	a, _ := NewSeeded(42).Uniform(0, 1, 5)
+	b, _ := NewSeeded(42).Uniform(0, 1, 5)
+	c, _ := NewSeeded(43).Uniform(0, 1, 5)
+	if !slices.Equal(a, b) {
+		t.Errorf("same seed gave %v and %v", a, b)
+	}
+	if slices.Equal(a, c) {
+		t.Errorf("different seeds gave the same draws %v", a)
+	}
+	if NewSeeded(1).Source != Seeded || NewCrypto().Source != Crypto {
+		t.Errorf("unexpected sources")
+	}
+}
+
+func TestUniform(t *testing.T) {
+	for _, g := range []*Generator{NewSeeded(7), NewCrypto()} {
+		values, err := g.Uniform(-2, 3, 1000)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		for _, v := range values {
+			if v < -2 || v >= 3 {
+				t.Fatalf("%s: value %v outside [-2, 3)", g.Source, v)
+			}
+		}
+	}
+
+	if _, err := NewSeeded(1).Uniform(1, 1, 1); err == nil {
+		t.Errorf("expected error for empty range")
+	}
+	if _, err := NewSeeded(1).Uniform(-math.MaxFloat64, math.MaxFloat64, 1); !errors.Is(err, calculations.ErrDomain) {
+		t.Errorf("expected ErrDomain, got %v", err)
+	}
+}
+
+func TestNormal(t *testing.T) {
+	values, err := NewSeeded(3).Normal(10, 2, 20000)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var sum, sumSq float64
+	for _, v := range values {
+		sum += v
+		sumSq += v * v
+	}
+	mean := sum / float64(len(values))
+	sd := math.Sqrt(sumSq/float64(len(values)) - mean*mean)
+	if math.Abs(mean-10) > 0.1 || math.Abs(sd-2) > 0.1 {
+		t.Errorf("mean = %v, sd = %v", mean, sd)
+	}
+
+	if _, err := NewSeeded(1).Normal(0, 0, 1); err == nil {
+		t.Errorf("expected error for sd = 0")
+	}
+}
+
+func TestIntegers(t *testing.T) {
+	seen := map[int64]bool{}
+	values, err := NewSeeded(5).Integers(1, 6, 600)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, v := range values {
+		if v < 1 || v > 6 {
+			t.Fatalf("value %d outside [1, 6]", v)
+		}
+		seen[v] = true
+	}
+	if len(seen) != 6 {
+		t.Errorf("expected every face of a die, got %v", seen)
+	}
+
+	if values, err := NewSeeded(5).Integers(math.MinInt64, math.MaxInt64, 3); err != nil || len(values) != 3 {
+		t.Errorf("full range: %v, %v", values, err)
+	}
+	if values, _ := NewSeeded(5).Integers(9, 9, 2); values[0] != 9 || values[1] != 9 {
+		t.Errorf("single value range gave %v", values)
+	}
+	if _, err := NewSeeded(5).Integers(2, 1, 1); err == nil {
+		t.Errorf("expected error for min > max")
+	}
+}
+
+func TestShuffleAndSample(t *testing.T) {
+	perm := NewSeeded(9).Shuffle(10)
+	sorted := slices.Clone(perm)
+	slices.Sort(sorted)
+	for i, v := range sorted {
+		if v != i {
+			t.Fatalf("shuffle %v is not a permutation", perm)
+		}
+	}
+
+	sample, err := NewSeeded(9).Sample(10, 10, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	slices.Sort(sample)
+	if !slices.Equal(sample, sorted) {
+		t.Errorf("sampling everything without replacement gave %v", sample)
+	}
+
+	withReplacement, err := NewSeeded(9).Sample(2, 50, true)
+	if err != nil || len(withReplacement) != 50 {
+		t.Fatalf("with replacement: %v, %v", withReplacement, err)
+	}
+
+	if _, err := NewSeeded(9).Sample(3, 4, false); err == nil {
+		t.Errorf("expected error when drawing more than the population")
+	}
+	if _, err := NewSeeded(9).Sample(0, 1, true); err == nil {
+		t.Errorf("expected error for empty population")
+	}
+}
+
+func TestWeightedChoice(t *testing.T) {
+	counts := make([]int, 3)
+	indices, err := NewSeeded(11).WeightedChoice([]float64{1, 0, 3}, 4000)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, i := range indices {
+		counts[i]++
+	}
+	if counts[1] != 0 {
+		t.Errorf("zero-weight item chosen %d times", counts[1])
+	}
+	if ratio := float64(counts[2]) / float64(counts[0]); ratio < 2.6 || ratio > 3.4 {
+		t.Errorf("counts = %v, want roughly 1:3", counts)
+	}
+
+	for _, weights := range [][]float64{nil, {0, 0}, {1, -1}, {math.NaN()}} {
+		if _, err := NewSeeded(1).WeightedChoice(weights, 1); err == nil {
+			t.Errorf("expected error for weights %v", weights)
+		}
+	}
+}
+
+func TestParseOperation(t *testing.T) {
+	if op, err := ParseOperation(" Choice "); err != nil || op != ChoiceDraw || !UsesItems(op) {
+		t.Errorf("ParseOperation(choice) = %v, %v", op, err)
+	}
+	if UsesItems(NormalDraw) {
+		t.Errorf("normal draws do not use items")
+	}
+	if _, err := ParseOperation("poisson"); err == nil {
+		t.Errorf("expected error for unknown operation")
+	}
+}
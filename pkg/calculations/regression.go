@@ -0,0 +1,238 @@
+package calculations
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/m-szczepanski/gocalc-api/pkg/calculations/matrix"
+)
+
+type RegressionModel string
+
+const (
+	RegressionLinear      RegressionModel = "linear"
+	RegressionPolynomial  RegressionModel = "polynomial"
+	RegressionExponential RegressionModel = "exponential"
+	RegressionLogarithmic RegressionModel = "logarithmic"
+	RegressionPower       RegressionModel = "power"
+)
+
+// MaxPolynomialDegree bounds the polynomial degree to keep the fit well conditioned.
+const MaxPolynomialDegree = 10
+
+func ValidRegressionModels() []RegressionModel {
+	return []RegressionModel{
+		RegressionLinear,
+		RegressionPolynomial,
+		RegressionExponential,
+		RegressionLogarithmic,
+		RegressionPower,
+	}
+}
+
+func IsValidRegressionModel(model string) bool {
+	normalized := strings.ToLower(strings.TrimSpace(model))
+	for _, valid := range ValidRegressionModels() {
+		if string(valid) == normalized {
+			return true
+		}
+	}
+	return false
+}
+
+// RegressionResult describes a fitted curve.
+//
+// Coefficients are model specific:
+//
+//	linear, polynomial: c0 + c1*x + c2*x^2 + ... (ascending powers)
+//	exponential:        a * e^(b*x)   -> [a, b]
+//	logarithmic:        a + b*ln(x)   -> [a, b]
+//	power:              a * x^b       -> [a, b]
+type RegressionResult struct {
+	Model        RegressionModel
+	Degree       int
+	Coefficients []float64
+	Equation     string
+	RSquared     float64
+	Residuals    []float64
+}
+
+// Predict evaluates the fitted curve at x.
+func (r *RegressionResult) Predict(x float64) float64 {
+	switch r.Model {
+	case RegressionExponential:
+		return r.Coefficients[0] * math.Exp(r.Coefficients[1]*x)
+	case RegressionLogarithmic:
+		return r.Coefficients[0] + r.Coefficients[1]*math.Log(x)
+	case RegressionPower:
+		return r.Coefficients[0] * math.Pow(x, r.Coefficients[1])
+	default:
+		// Horner's method
+		result := 0.0
+		for i := len(r.Coefficients) - 1; i >= 0; i-- {
+			result = result*x + r.Coefficients[i]
+		}
+		return result
+	}
+}
+
+// FitRegression fits the given model to the points (xs[i], ys[i]) by least squares.
+// Degree is only used by the polynomial model.
+//
+// Exponential, logarithmic and power models are fitted by linear least squares on
+// the log-transformed data, so exponential and power fits require y > 0, and
+// logarithmic and power fits require x > 0. R² is always reported against the
+// original (untransformed) y values.
+//
+// Least squares problems are solved with Householder QR (matrix.LeastSquares)
+// rather than the normal equations, which square the condition number and
+// break down quickly for higher polynomial degrees.
+func FitRegression(xs, ys []float64, model RegressionModel, degree int) (*RegressionResult, error) {
+	if len(xs) != len(ys) {
+		return nil, fmt.Errorf("x and y must have the same length")
+	}
+
+	if model != RegressionPolynomial {
+		degree = 1
+	}
+	if degree < 1 || degree > MaxPolynomialDegree {
+		return nil, fmt.Errorf("polynomial degree must be between 1 and %d", MaxPolynomialDegree)
+	}
+	if len(xs) < degree+1 {
+		return nil, fmt.Errorf("at least %d points are required for a degree %d fit", degree+1, degree)
+	}
+
+	tx := make([]float64, len(xs))
+	ty := make([]float64, len(ys))
+	for i := range xs {
+		tx[i], ty[i] = xs[i], ys[i]
+		if model == RegressionLogarithmic || model == RegressionPower {
+			if xs[i] <= 0 {
+				return nil, fmt.Errorf("%s regression requires x > 0 (x[%d] = %v)", model, i, xs[i])
+			}
+			tx[i] = math.Log(xs[i])
+		}
+		if model == RegressionExponential || model == RegressionPower {
+			if ys[i] <= 0 {
+				return nil, fmt.Errorf("%s regression requires y > 0 (y[%d] = %v)", model, i, ys[i])
+			}
+			ty[i] = math.Log(ys[i])
+		}
+	}
+
+	coefficients, err := polynomialLeastSquares(tx, ty, degree)
+	if err != nil {
+		return nil, err
+	}
+
+	if model == RegressionExponential || model == RegressionPower {
+		coefficients[0] = math.Exp(coefficients[0])
+	}
+
+	result := &RegressionResult{
+		Model:        model,
+		Degree:       degree,
+		Coefficients: coefficients,
+	}
+	result.Equation = result.equation()
+
+	meanY := CompensatedSum(ys) / float64(len(ys))
+	residuals := make([]float64, len(ys))
+	ssRes := make([]float64, len(ys))
+	ssTot := make([]float64, len(ys))
+	for i := range ys {
+		residuals[i] = ys[i] - result.Predict(xs[i])
+		ssRes[i] = residuals[i] * residuals[i]
+		ssTot[i] = (ys[i] - meanY) * (ys[i] - meanY)
+	}
+	result.Residuals = residuals
+
+	totalSumSquares := CompensatedSum(ssTot)
+	if totalSumSquares == 0 {
+		// Every model reproduces a constant y exactly, so the fit is perfect.
+		result.RSquared = 1
+	} else {
+		result.RSquared = 1 - CompensatedSum(ssRes)/totalSumSquares
+	}
+
+	for _, v := range append(append([]float64{result.RSquared}, coefficients...), residuals...) {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return nil, fmt.Errorf("%w: %s fit is outside the representable range", ErrDomain, model)
+		}
+	}
+	return result, nil
+}
+
+// polynomialLeastSquares returns the coefficients (ascending powers) of the
+// degree-n polynomial minimizing the squared error against (xs, ys).
+//
+// Vandermonde columns are scaled to unit norm before the QR solve so that the
+// conditioning check reflects genuine collinearity rather than the magnitude of x^n.
+func polynomialLeastSquares(xs, ys []float64, degree int) ([]float64, error) {
+	cols := degree + 1
+	a := matrix.Zeros(len(xs), cols)
+	scales := make([]float64, cols)
+	for j := 0; j < cols; j++ {
+		for i, x := range xs {
+			a[i][j] = math.Pow(x, float64(j))
+			scales[j] = math.Hypot(scales[j], a[i][j])
+		}
+		if scales[j] == 0 {
+			scales[j] = 1
+		}
+		for i := range xs {
+			a[i][j] /= scales[j]
+		}
+	}
+
+	coefficients, err := matrix.LeastSquares(a, ys)
+	if err != nil {
+		var condErr *matrix.ConditionError
+		if errors.As(err, &condErr) {
+			return nil, fmt.Errorf("x values do not determine a unique degree %d fit (too few distinct x values)", degree)
+		}
+		return nil, err
+	}
+
+	for j := range coefficients {
+		coefficients[j] /= scales[j]
+	}
+	return coefficients, nil
+}
+
+func (r *RegressionResult) equation() string {
+	c := r.Coefficients
+	switch r.Model {
+	case RegressionExponential:
+		return fmt.Sprintf("y = %s * e^(%s*x)", formatCoefficient(c[0]), formatCoefficient(c[1]))
+	case RegressionLogarithmic:
+		return fmt.Sprintf("y = %s + %s*ln(x)", formatCoefficient(c[0]), formatCoefficient(c[1]))
+	case RegressionPower:
+		return fmt.Sprintf("y = %s * x^%s", formatCoefficient(c[0]), formatCoefficient(c[1]))
+	}
+
+	var b strings.Builder
+	b.WriteString("y = ")
+	b.WriteString(formatCoefficient(c[0]))
+	for i := 1; i < len(c); i++ {
+		sign, value := " + ", c[i]
+		if value < 0 {
+			sign, value = " - ", -value
+		}
+		b.WriteString(sign)
+		b.WriteString(formatCoefficient(value))
+		b.WriteString("*x")
+		if i > 1 {
+			b.WriteString("^")
+			b.WriteString(strconv.Itoa(i))
+		}
+	}
+	return b.String()
+}
+
+func formatCoefficient(v float64) string {
+	return strconv.FormatFloat(v, 'g', 6, 64)
+}
@@ -0,0 +1,154 @@
+package calculations
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestFitRegression(t *testing.T) {
+	tests := []struct {
+		name         string
+		xs           []float64
+		ys           []float64
+		model        RegressionModel
+		degree       int
+		coefficients []float64
+		rSquared     float64
+	}{
+		{
+			name:         "exact linear",
+			xs:           []float64{0, 1, 2, 3},
+			ys:           []float64{1, 3, 5, 7},
+			model:        RegressionLinear,
+			coefficients: []float64{1, 2},
+			rSquared:     1,
+		},
+		{
+			name:         "noisy linear",
+			xs:           []float64{1, 2, 3, 4, 5},
+			ys:           []float64{2, 4, 5, 4, 5},
+			model:        RegressionLinear,
+			coefficients: []float64{2.2, 0.6},
+			rSquared:     0.6,
+		},
+		{
+			name:         "quadratic",
+			xs:           []float64{-2, -1, 0, 1, 2, 3},
+			ys:           []float64{11, 4, 1, 2, 7, 16},
+			model:        RegressionPolynomial,
+			degree:       2,
+			coefficients: []float64{1, -1, 2},
+			rSquared:     1,
+		},
+		{
+			name:         "exponential",
+			xs:           []float64{0, 1, 2, 3},
+			ys:           []float64{3, 3 * math.Exp(0.5), 3 * math.Exp(1), 3 * math.Exp(1.5)},
+			model:        RegressionExponential,
+			coefficients: []float64{3, 0.5},
+			rSquared:     1,
+		},
+		{
+			name:         "logarithmic",
+			xs:           []float64{1, math.E, math.E * math.E},
+			ys:           []float64{2, 5, 8},
+			model:        RegressionLogarithmic,
+			coefficients: []float64{2, 3},
+			rSquared:     1,
+		},
+		{
+			name:         "power",
+			xs:           []float64{1, 2, 4, 8},
+			ys:           []float64{5, 20, 80, 320},
+			model:        RegressionPower,
+			coefficients: []float64{5, 2},
+			rSquared:     1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := FitRegression(tt.xs, tt.ys, tt.model, tt.degree)
+			if err != nil {
+				t.Fatalf("FitRegression() unexpected error: %v", err)
+			}
+			if len(result.Coefficients) != len(tt.coefficients) {
+				t.Fatalf("coefficients = %v, want %v", result.Coefficients, tt.coefficients)
+			}
+			for i := range tt.coefficients {
+				if !almostEqual(result.Coefficients[i], tt.coefficients[i], 1e-9) {
+					t.Errorf("coefficients[%d] = %v, want %v", i, result.Coefficients[i], tt.coefficients[i])
+				}
+			}
+			if !almostEqual(result.RSquared, tt.rSquared, 1e-9) {
+				t.Errorf("R² = %v, want %v", result.RSquared, tt.rSquared)
+			}
+			if len(result.Residuals) != len(tt.xs) {
+				t.Errorf("residuals length = %d, want %d", len(result.Residuals), len(tt.xs))
+			}
+		})
+	}
+}
+
+func TestFitRegressionErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		xs     []float64
+		ys     []float64
+		model  RegressionModel
+		degree int
+	}{
+		{"length mismatch", []float64{1, 2}, []float64{1}, RegressionLinear, 0},
+		{"too few points", []float64{1}, []float64{1}, RegressionLinear, 0},
+		{"too few points for degree", []float64{1, 2, 3}, []float64{1, 2, 3}, RegressionPolynomial, 3},
+		{"degree too high", []float64{1, 2}, []float64{1, 2}, RegressionPolynomial, MaxPolynomialDegree + 1},
+		{"identical x values", []float64{2, 2, 2}, []float64{1, 2, 3}, RegressionLinear, 0},
+		{"exponential non-positive y", []float64{1, 2}, []float64{1, -1}, RegressionExponential, 0},
+		{"logarithmic non-positive x", []float64{0, 1}, []float64{1, 2}, RegressionLogarithmic, 0},
+		{"power non-positive x", []float64{-1, 1}, []float64{1, 2}, RegressionPower, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := FitRegression(tt.xs, tt.ys, tt.model, tt.degree); err == nil {
+				t.Error("FitRegression() expected error, got nil")
+			}
+		})
+	}
+}
+
+func TestFitRegressionOverflow(t *testing.T) {
+	tests := []struct {
+		name  string
+		xs    []float64
+		ys    []float64
+		model RegressionModel
+	}{
+		{"linear residuals", []float64{0, 1, 2}, []float64{1e308, -1e308, 1e308}, RegressionLinear},
+		{"exponential coefficient", []float64{-1000, -999}, []float64{1e-300, 1e300}, RegressionExponential},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := FitRegression(tt.xs, tt.ys, tt.model, 0); !errors.Is(err, ErrDomain) {
+				t.Errorf("FitRegression() error = %v, want ErrDomain", err)
+			}
+		})
+	}
+}
+
+func TestRegressionPredictAndEquation(t *testing.T) {
+	result, err := FitRegression([]float64{0, 1, 2}, []float64{1, 0, 3}, RegressionPolynomial, 2)
+	if err != nil {
+		t.Fatalf("FitRegression() unexpected error: %v", err)
+	}
+
+	// y = 1 - 3x + 2x^2
+	if got := result.Predict(3); !almostEqual(got, 10, 1e-9) {
+		t.Errorf("Predict(3) = %v, want 10", got)
+	}
+	if result.Equation != "y = 1 - 3*x + 2*x^2" {
+		t.Errorf("Equation = %q, want %q", result.Equation, "y = 1 - 3*x + 2*x^2")
+	}
+}
@@ -0,0 +1,193 @@
+package calculations
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// RoundingMode selects how a value between two representable results is rounded.
+type RoundingMode string
+
+const (
+	// RoundHalfUp rounds ties away from zero, like math.Round.
+	RoundHalfUp RoundingMode = "half-up"
+	// RoundHalfEven rounds ties to the even neighbour (banker's rounding).
+	RoundHalfEven RoundingMode = "half-even"
+	RoundFloor    RoundingMode = "floor"
+	RoundCeil     RoundingMode = "ceil"
+	RoundTruncate RoundingMode = "truncate"
+)
+
+const (
+	// MaxDecimalPlaces bounds decimal-place rounding in either direction;
+	// negative places round to tens, hundreds and so on.
+	MaxDecimalPlaces = 15
+	// MaxSignificantFigures is the most digits a float64 can carry meaningfully.
+	MaxSignificantFigures = 17
+)
+
+// ParseRoundingMode normalizes a rounding mode. An empty string defaults to half-up.
+func ParseRoundingMode(mode string) (RoundingMode, error) {
+	switch m := RoundingMode(strings.ToLower(strings.TrimSpace(mode))); m {
+	case "":
+		return RoundHalfUp, nil
+	case RoundHalfUp, RoundHalfEven, RoundFloor, RoundCeil, RoundTruncate:
+		return m, nil
+	default:
+		return "", fmt.Errorf("invalid rounding mode: %s (valid modes: half-up, half-even, floor, ceil, truncate)", mode)
+	}
+}
+
+// Rounding rounds values to a number of decimal places or significant figures.
+type Rounding struct {
+	Digits      int
+	Significant bool // Digits counts significant figures rather than decimal places
+	Mode        RoundingMode
+}
+
+// DecimalPlaces rounds half-up to n decimal places.
+func DecimalPlaces(n int) Rounding {
+	return Rounding{Digits: n, Mode: RoundHalfUp}
+}
+
+// SignificantFigures rounds half-up to n significant figures.
+func SignificantFigures(n int) Rounding {
+	return Rounding{Digits: n, Significant: true, Mode: RoundHalfUp}
+}
+
+// ParseRounding parses a precision such as "2dp" or "3sf", optionally followed
+// by a rounding mode: "4sf half-even", "0dp floor".
+func ParseRounding(spec string) (Rounding, error) {
+	fields := strings.Fields(spec)
+	if len(fields) == 0 || len(fields) > 2 {
+		return Rounding{}, fmt.Errorf("invalid rounding %q: expected a precision such as 2dp or 3sf, optionally followed by a mode", spec)
+	}
+
+	precision := strings.ToLower(fields[0])
+	var r Rounding
+	switch {
+	case strings.HasSuffix(precision, "dp"):
+		precision = strings.TrimSuffix(precision, "dp")
+	case strings.HasSuffix(precision, "sf"):
+		precision = strings.TrimSuffix(precision, "sf")
+		r.Significant = true
+	default:
+		return Rounding{}, fmt.Errorf("invalid precision %q: use dp for decimal places or sf for significant figures", fields[0])
+	}
+
+	digits, err := strconv.Atoi(precision)
+	if err != nil {
+		return Rounding{}, fmt.Errorf("invalid precision %q: digits must be an integer", fields[0])
+	}
+	r.Digits = digits
+	if err := r.validate(); err != nil {
+		return Rounding{}, err
+	}
+
+	var mode string
+	if len(fields) == 2 {
+		mode = fields[1]
+	}
+	if r.Mode, err = ParseRoundingMode(mode); err != nil {
+		return Rounding{}, err
+	}
+	return r, nil
+}
+
+func (r Rounding) validate() error {
+	if r.Significant {
+		if r.Digits < 1 || r.Digits > MaxSignificantFigures {
+			return fmt.Errorf("significant figures must be between 1 and %d", MaxSignificantFigures)
+		}
+		return nil
+	}
+	if r.Digits < -MaxDecimalPlaces || r.Digits > MaxDecimalPlaces {
+		return fmt.Errorf("decimal places must be between %d and %d", -MaxDecimalPlaces, MaxDecimalPlaces)
+	}
+	return nil
+}
+
+// String formats r in the syntax accepted by ParseRounding.
+func (r Rounding) String() string {
+	unit := "dp"
+	if r.Significant {
+		unit = "sf"
+	}
+	mode := r.Mode
+	if mode == "" {
+		mode = RoundHalfUp
+	}
+	return fmt.Sprintf("%d%s %s", r.Digits, unit, mode)
+}
+
+// Round rounds x as written in decimal rather than as stored in binary, so
+// 1.005 rounds half-up to 1.01 even though its float64 is slightly below it.
+// NaN, infinities and results that would overflow are returned unchanged,
+// and a result of zero is never negative.
+func (r Rounding) Round(x float64) float64 {
+	if x == 0 || math.IsNaN(x) || math.IsInf(x, 0) {
+		return x
+	}
+
+	// The shortest decimal that reads back as x: digits d0.d1d2... × 10^exp.
+	mantissa, expText, _ := strings.Cut(strconv.FormatFloat(math.Abs(x), 'e', -1, 64), "e")
+	digits := strings.Replace(mantissa, ".", "", 1)
+	exp, _ := strconv.Atoi(expText)
+
+	keep := r.Digits
+	if !r.Significant {
+		keep = exp + 1 + r.Digits
+	}
+	if keep >= len(digits) {
+		return x
+	}
+
+	// Compare the discarded digits, which are never all zero, with half a unit
+	// in the last kept place.
+	half := -1
+	if keep >= 0 {
+		switch first := digits[keep]; {
+		case first > '5', first == '5' && strings.TrimRight(digits[keep+1:], "0") != "":
+			half = 1
+		case first == '5':
+			half = 0
+		}
+	}
+
+	kept := uint64(0)
+	if keep > 0 {
+		kept, _ = strconv.ParseUint(digits[:keep], 10, 64)
+	}
+
+	negative := x < 0
+	var away bool
+	switch r.Mode {
+	case RoundHalfEven:
+		away = half > 0 || half == 0 && kept%2 == 1
+	case RoundFloor:
+		away = negative
+	case RoundCeil:
+		away = !negative
+	case RoundTruncate:
+		away = false
+	default:
+		away = half >= 0
+	}
+	if away {
+		kept++
+	}
+	if kept == 0 {
+		return 0
+	}
+
+	result, err := strconv.ParseFloat(fmt.Sprintf("%de%d", kept, exp+1-keep), 64)
+	if err != nil || math.IsInf(result, 0) {
+		return x
+	}
+	if negative {
+		result = -result
+	}
+	return result
+}
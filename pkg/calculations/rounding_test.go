@@ -0,0 +1,101 @@
+package calculations
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRoundingRound(t *testing.T) {
+	tests := []struct {
+		name     string
+		rounding Rounding
+		x        float64
+		expected float64
+	}{
+		{"half-up decimal tie", DecimalPlaces(2), 1.005, 1.01},
+		{"half-up negative tie", DecimalPlaces(2), -2.675, -2.68},
+		{"half-up below half", DecimalPlaces(2), 1.0049, 1.0},
+		{"already exact", DecimalPlaces(2), 1.5, 1.5},
+		{"zero places", DecimalPlaces(0), 2.5, 3},
+		{"negative places", DecimalPlaces(-2), 1250, 1300},
+		{"negative places below magnitude", DecimalPlaces(-3), 12, 0},
+		{"carry into new digit", DecimalPlaces(2), 9.999, 10},
+		{"half-even down", Rounding{Digits: 0, Mode: RoundHalfEven}, 2.5, 2},
+		{"half-even up", Rounding{Digits: 0, Mode: RoundHalfEven}, 3.5, 4},
+		{"half-even above half", Rounding{Digits: 1, Mode: RoundHalfEven}, 0.2501, 0.3},
+		{"half-even cents", Rounding{Digits: 2, Mode: RoundHalfEven}, 0.125, 0.12},
+		{"floor positive", Rounding{Digits: 1, Mode: RoundFloor}, 1.99, 1.9},
+		{"floor negative", Rounding{Digits: 1, Mode: RoundFloor}, -1.91, -2.0},
+		{"ceil positive", Rounding{Digits: 1, Mode: RoundCeil}, 1.91, 2.0},
+		{"ceil negative", Rounding{Digits: 1, Mode: RoundCeil}, -1.99, -1.9},
+		{"truncate", Rounding{Digits: 1, Mode: RoundTruncate}, -1.99, -1.9},
+		{"truncate to zero", Rounding{Digits: 2, Mode: RoundTruncate}, -0.004, 0},
+		{"ceil tiny", Rounding{Digits: 2, Mode: RoundCeil}, 1e-10, 0.01},
+		{"significant figures", SignificantFigures(3), 123456, 123000},
+		{"significant figures small", SignificantFigures(2), 0.00012345, 0.00012},
+		{"significant figures tie", SignificantFigures(1), 0.15, 0.2},
+		{"significant figures floor", Rounding{Digits: 2, Significant: true, Mode: RoundFloor}, -0.0123, -0.013},
+		{"significant figures exact", SignificantFigures(5), 1.5, 1.5},
+		{"large value", SignificantFigures(2), 6.02214076e23, 6.0e23},
+		{"overflow kept", SignificantFigures(1), math.MaxFloat64, math.MaxFloat64},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.rounding.Round(tt.x)
+			if got != tt.expected || math.Signbit(got) != math.Signbit(tt.expected) {
+				t.Errorf("%v.Round(%v) = %v, want %v", tt.rounding, tt.x, got, tt.expected)
+			}
+		})
+	}
+
+	if got := DecimalPlaces(2).Round(math.NaN()); !math.IsNaN(got) {
+		t.Errorf("Round(NaN) = %v, want NaN", got)
+	}
+	if got := DecimalPlaces(2).Round(math.Inf(-1)); !math.IsInf(got, -1) {
+		t.Errorf("Round(-Inf) = %v, want -Inf", got)
+	}
+}
+
+func TestParseRounding(t *testing.T) {
+	tests := []struct {
+		spec     string
+		expected Rounding
+		wantErr  bool
+	}{
+		{spec: "2dp", expected: Rounding{Digits: 2, Mode: RoundHalfUp}},
+		{spec: " 3SF ", expected: Rounding{Digits: 3, Significant: true, Mode: RoundHalfUp}},
+		{spec: "4sf half-even", expected: Rounding{Digits: 4, Significant: true, Mode: RoundHalfEven}},
+		{spec: "0dp Floor", expected: Rounding{Digits: 0, Mode: RoundFloor}},
+		{spec: "-2dp truncate", expected: Rounding{Digits: -2, Mode: RoundTruncate}},
+		{spec: "", wantErr: true},
+		{spec: "2", wantErr: true},
+		{spec: "xdp", wantErr: true},
+		{spec: "0sf", wantErr: true},
+		{spec: "18sf", wantErr: true},
+		{spec: "16dp", wantErr: true},
+		{spec: "2dp bankers", wantErr: true},
+		{spec: "2dp half-up extra", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			r, err := ParseRounding(tt.spec)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ParseRounding(%q) = %v, want error", tt.spec, r)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseRounding(%q) unexpected error: %v", tt.spec, err)
+			}
+			if r != tt.expected {
+				t.Errorf("ParseRounding(%q) = %+v, want %+v", tt.spec, r, tt.expected)
+			}
+			if again, err := ParseRounding(r.String()); err != nil || again != r {
+				t.Errorf("ParseRounding(%q) = %+v, %v, want round trip", r.String(), again, err)
+			}
+		})
+	}
+}
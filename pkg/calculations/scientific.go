@@ -0,0 +1,270 @@
+package calculations
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// ErrDomain is returned (wrapped) when an input lies outside the mathematical
+// domain of a function, e.g. the logarithm of a negative number or asin(2).
+var ErrDomain = errors.New("domain error")
+
+type AngleMode string
+
+const (
+	AngleRadians AngleMode = "radians"
+	AngleDegrees AngleMode = "degrees"
+)
+
+// ParseAngleMode normalizes an angle mode string. An empty string defaults to radians.
+func ParseAngleMode(mode string) (AngleMode, error) {
+	switch strings.ToLower(strings.TrimSpace(mode)) {
+	case "", "rad", string(AngleRadians):
+		return AngleRadians, nil
+	case "deg", string(AngleDegrees):
+		return AngleDegrees, nil
+	default:
+		return "", fmt.Errorf("invalid angle mode: %s (valid modes: radians, degrees)", mode)
+	}
+}
+
+type ScientificFunction string
+
+const (
+	FuncPow   ScientificFunction = "pow"
+	FuncRoot  ScientificFunction = "root"
+	FuncLog   ScientificFunction = "log"
+	FuncLn    ScientificFunction = "ln"
+	FuncExp   ScientificFunction = "exp"
+	FuncSin   ScientificFunction = "sin"
+	FuncCos   ScientificFunction = "cos"
+	FuncTan   ScientificFunction = "tan"
+	FuncAsin  ScientificFunction = "asin"
+	FuncAcos  ScientificFunction = "acos"
+	FuncAtan  ScientificFunction = "atan"
+	FuncSinh  ScientificFunction = "sinh"
+	FuncCosh  ScientificFunction = "cosh"
+	FuncTanh  ScientificFunction = "tanh"
+	FuncFloor ScientificFunction = "floor"
+	FuncCeil  ScientificFunction = "ceil"
+	FuncRound ScientificFunction = "round"
+)
+
+func ValidScientificFunctions() []ScientificFunction {
+	return []ScientificFunction{
+		FuncPow, FuncRoot, FuncLog, FuncLn, FuncExp,
+		FuncSin, FuncCos, FuncTan, FuncAsin, FuncAcos, FuncAtan,
+		FuncSinh, FuncCosh, FuncTanh,
+		FuncFloor, FuncCeil, FuncRound,
+	}
+}
+
+func IsValidScientificFunction(name string) bool {
+	normalized := strings.ToLower(strings.TrimSpace(name))
+	for _, valid := range ValidScientificFunctions() {
+		if string(valid) == normalized {
+			return true
+		}
+	}
+	return false
+}
+
+// RequiresSecondOperand reports whether fn takes a second argument:
+// the exponent for pow, the degree for root, the base for log and the
+// number of decimal places for round.
+func RequiresSecondOperand(fn ScientificFunction) bool {
+	switch fn {
+	case FuncPow, FuncRoot, FuncLog, FuncRound:
+		return true
+	default:
+		return false
+	}
+}
+
+// EvaluateFunction applies the named scientific function to x (and y for
+// binary functions). Trigonometric inputs and inverse trigonometric outputs
+// are interpreted in the given angle mode.
+func EvaluateFunction(fn ScientificFunction, x, y float64, mode AngleMode) (float64, error) {
+	switch fn {
+	case FuncPow:
+		return Power(x, y)
+	case FuncRoot:
+		return NthRoot(x, y)
+	case FuncLog:
+		return LogBase(x, y)
+	case FuncLn:
+		return Ln(x)
+	case FuncExp:
+		return Exp(x)
+	case FuncSin:
+		return Sin(x, mode), nil
+	case FuncCos:
+		return Cos(x, mode), nil
+	case FuncTan:
+		return Tan(x, mode)
+	case FuncAsin:
+		return Asin(x, mode)
+	case FuncAcos:
+		return Acos(x, mode)
+	case FuncAtan:
+		return Atan(x, mode), nil
+	case FuncSinh:
+		return finite(math.Sinh(x), "sinh")
+	case FuncCosh:
+		return finite(math.Cosh(x), "cosh")
+	case FuncTanh:
+		return math.Tanh(x), nil
+	case FuncFloor:
+		return math.Floor(x), nil
+	case FuncCeil:
+		return math.Ceil(x), nil
+	case FuncRound:
+		return RoundTo(x, y)
+	default:
+		return 0, fmt.Errorf("unsupported function: %s", fn)
+	}
+}
+
+func Power(base, exponent float64) (float64, error) {
+	if base == 0 && exponent < 0 {
+		return 0, fmt.Errorf("%w: zero cannot be raised to a negative power", ErrDomain)
+	}
+	if base < 0 && exponent != math.Trunc(exponent) {
+		return 0, fmt.Errorf("%w: negative base requires an integer exponent", ErrDomain)
+	}
+	return finite(math.Pow(base, exponent), "pow")
+}
+
+// NthRoot returns the real n-th root of x. Odd integer roots of negative
+// numbers are allowed; even or fractional roots of negative numbers are not.
+func NthRoot(x, n float64) (float64, error) {
+	if n == 0 {
+		return 0, fmt.Errorf("%w: root degree cannot be zero", ErrDomain)
+	}
+	if x == 0 && n < 0 {
+		return 0, fmt.Errorf("%w: zero has no root of negative degree", ErrDomain)
+	}
+	if x >= 0 {
+		return finite(math.Pow(x, 1/n), "root")
+	}
+	if n != math.Trunc(n) || math.Mod(n, 2) == 0 {
+		return 0, fmt.Errorf("%w: even or fractional root of a negative number", ErrDomain)
+	}
+	return finite(-math.Pow(-x, 1/n), "root")
+}
+
+func LogBase(x, base float64) (float64, error) {
+	if base <= 0 || base == 1 {
+		return 0, fmt.Errorf("%w: logarithm base must be positive and not equal to 1", ErrDomain)
+	}
+	lnX, err := Ln(x)
+	if err != nil {
+		return 0, err
+	}
+	return lnX / math.Log(base), nil
+}
+
+func Ln(x float64) (float64, error) {
+	if x <= 0 {
+		return 0, fmt.Errorf("%w: logarithm of a non-positive number", ErrDomain)
+	}
+	return math.Log(x), nil
+}
+
+func Exp(x float64) (float64, error) {
+	return finite(math.Exp(x), "exp")
+}
+
+func Sin(x float64, mode AngleMode) float64 {
+	if mode == AngleDegrees {
+		switch r := math.Mod(x, 360); r {
+		case 0, 180, -180:
+			return 0
+		case 90, -270:
+			return 1
+		case -90, 270:
+			return -1
+		}
+	}
+	return math.Sin(ToRadians(x, mode))
+}
+
+func Cos(x float64, mode AngleMode) float64 {
+	if mode == AngleDegrees {
+		switch r := math.Mod(x, 360); r {
+		case 0:
+			return 1
+		case 90, -90, 270, -270:
+			return 0
+		case 180, -180:
+			return -1
+		}
+	}
+	return math.Cos(ToRadians(x, mode))
+}
+
+func Tan(x float64, mode AngleMode) (float64, error) {
+	// In degree mode the poles are exactly representable, so reject them
+	// instead of returning a huge value from math.Tan(π/2).
+	if mode == AngleDegrees {
+		switch math.Mod(x, 180) {
+		case 0:
+			return 0, nil
+		case 90, -90:
+			return 0, fmt.Errorf("%w: tangent is undefined at %v degrees", ErrDomain, x)
+		}
+	}
+	return math.Tan(ToRadians(x, mode)), nil
+}
+
+func Asin(x float64, mode AngleMode) (float64, error) {
+	if x < -1 || x > 1 {
+		return 0, fmt.Errorf("%w: asin is only defined on [-1, 1]", ErrDomain)
+	}
+	return FromRadians(math.Asin(x), mode), nil
+}
+
+func Acos(x float64, mode AngleMode) (float64, error) {
+	if x < -1 || x > 1 {
+		return 0, fmt.Errorf("%w: acos is only defined on [-1, 1]", ErrDomain)
+	}
+	return FromRadians(math.Acos(x), mode), nil
+}
+
+func Atan(x float64, mode AngleMode) float64 {
+	return FromRadians(math.Atan(x), mode)
+}
+
+// RoundTo rounds x half away from zero to the given number of decimal
+// places. Negative places round to tens, hundreds and so on.
+func RoundTo(x, places float64) (float64, error) {
+	if places != math.Trunc(places) || places < -15 || places > 15 {
+		return 0, fmt.Errorf("%w: decimal places must be an integer between -15 and 15", ErrDomain)
+	}
+	return DecimalPlaces(int(places)).Round(x), nil
+}
+
+// ToRadians converts an angle in the given mode to radians.
+func ToRadians(x float64, mode AngleMode) float64 {
+	if mode == AngleDegrees {
+		return x * math.Pi / 180
+	}
+	return x
+}
+
+// FromRadians converts an angle in radians to the given mode.
+func FromRadians(x float64, mode AngleMode) float64 {
+	if mode == AngleDegrees {
+		return x * 180 / math.Pi
+	}
+	return x
+}
+
+func finite(result float64, fn string) (float64, error) {
+	if math.IsNaN(result) || math.IsInf(result, 0) {
+		return 0, fmt.Errorf("%w: %s result is outside the representable range", ErrDomain, fn)
+	}
+	return result, nil
+}
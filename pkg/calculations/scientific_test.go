@@ -0,0 +1,134 @@
+package calculations
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestEvaluateFunction(t *testing.T) {
+	tests := []struct {
+		name     string
+		fn       ScientificFunction
+		x        float64
+		y        float64
+		mode     AngleMode
+		expected float64
+	}{
+		{"power", FuncPow, 2, 10, AngleRadians, 1024},
+		{"negative base integer exponent", FuncPow, -2, 3, AngleRadians, -8},
+		{"square root", FuncRoot, 16, 2, AngleRadians, 4},
+		{"cube root of negative", FuncRoot, -27, 3, AngleRadians, -3},
+		{"log base 2", FuncLog, 8, 2, AngleRadians, 3},
+		{"log base 10", FuncLog, 1000, 10, AngleRadians, 3},
+		{"natural log", FuncLn, math.E, 0, AngleRadians, 1},
+		{"exp", FuncExp, 0, 0, AngleRadians, 1},
+		{"sin radians", FuncSin, math.Pi / 2, 0, AngleRadians, 1},
+		{"sin degrees exact", FuncSin, 180, 0, AngleDegrees, 0},
+		{"sin 30 degrees", FuncSin, 30, 0, AngleDegrees, 0.5},
+		{"cos degrees exact", FuncCos, 90, 0, AngleDegrees, 0},
+		{"cos 60 degrees", FuncCos, 60, 0, AngleDegrees, 0.5},
+		{"tan 45 degrees", FuncTan, 45, 0, AngleDegrees, 1},
+		{"asin degrees", FuncAsin, 1, 0, AngleDegrees, 90},
+		{"acos radians", FuncAcos, -1, 0, AngleRadians, math.Pi},
+		{"atan degrees", FuncAtan, 1, 0, AngleDegrees, 45},
+		{"sinh", FuncSinh, 0, 0, AngleRadians, 0},
+		{"cosh", FuncCosh, 0, 0, AngleRadians, 1},
+		{"tanh", FuncTanh, 0, 0, AngleRadians, 0},
+		{"floor negative", FuncFloor, -1.5, 0, AngleRadians, -2},
+		{"ceil", FuncCeil, 1.2, 0, AngleRadians, 2},
+		{"round to 2 places", FuncRound, 3.14159, 2, AngleRadians, 3.14},
+		{"round to tens", FuncRound, 1234, -1, AngleRadians, 1230},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := EvaluateFunction(tt.fn, tt.x, tt.y, tt.mode)
+			if err != nil {
+				t.Fatalf("EvaluateFunction(%s, %v, %v) unexpected error: %v", tt.fn, tt.x, tt.y, err)
+			}
+			if !almostEqual(result, tt.expected, 1e-9) {
+				t.Errorf("EvaluateFunction(%s, %v, %v) = %v, want %v", tt.fn, tt.x, tt.y, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestEvaluateFunctionDomainErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   ScientificFunction
+		x    float64
+		y    float64
+		mode AngleMode
+	}{
+		{"log of negative", FuncLog, -1, 10, AngleRadians},
+		{"log base one", FuncLog, 10, 1, AngleRadians},
+		{"ln of zero", FuncLn, 0, 0, AngleRadians},
+		{"asin out of range", FuncAsin, 2, 0, AngleRadians},
+		{"acos out of range", FuncAcos, -1.5, 0, AngleDegrees},
+		{"even root of negative", FuncRoot, -4, 2, AngleRadians},
+		{"fractional root of negative", FuncRoot, -4, 2.5, AngleRadians},
+		{"zero degree root", FuncRoot, 4, 0, AngleRadians},
+		{"zero to negative power", FuncPow, 0, -1, AngleRadians},
+		{"negative base fractional exponent", FuncPow, -8, 0.5, AngleRadians},
+		{"pow overflow", FuncPow, 10, 400, AngleRadians},
+		{"exp overflow", FuncExp, 1000, 0, AngleRadians},
+		{"tan pole degrees", FuncTan, 90, 0, AngleDegrees},
+		{"tan negative pole degrees", FuncTan, -270, 0, AngleDegrees},
+		{"round fractional places", FuncRound, 1.5, 0.5, AngleRadians},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := EvaluateFunction(tt.fn, tt.x, tt.y, tt.mode)
+			if err == nil {
+				t.Fatalf("EvaluateFunction(%s, %v, %v) expected error, got nil", tt.fn, tt.x, tt.y)
+			}
+			if !errors.Is(err, ErrDomain) {
+				t.Errorf("EvaluateFunction(%s, %v, %v) error = %v, want ErrDomain", tt.fn, tt.x, tt.y, err)
+			}
+		})
+	}
+}
+
+func TestParseAngleMode(t *testing.T) {
+	tests := []struct {
+		input     string
+		expected  AngleMode
+		wantError bool
+	}{
+		{"", AngleRadians, false},
+		{"radians", AngleRadians, false},
+		{"RAD", AngleRadians, false},
+		{"degrees", AngleDegrees, false},
+		{" deg ", AngleDegrees, false},
+		{"gradians", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			mode, err := ParseAngleMode(tt.input)
+			if (err != nil) != tt.wantError {
+				t.Fatalf("ParseAngleMode(%q) error = %v, wantError %v", tt.input, err, tt.wantError)
+			}
+			if mode != tt.expected {
+				t.Errorf("ParseAngleMode(%q) = %q, want %q", tt.input, mode, tt.expected)
+			}
+		})
+	}
+}
+
+func TestIsValidScientificFunction(t *testing.T) {
+	for _, fn := range ValidScientificFunctions() {
+		if !IsValidScientificFunction(string(fn)) {
+			t.Errorf("IsValidScientificFunction(%q) = false, want true", fn)
+		}
+	}
+	if !IsValidScientificFunction(" SIN ") {
+		t.Error("IsValidScientificFunction should be case-insensitive")
+	}
+	if IsValidScientificFunction("gamma") {
+		t.Error("IsValidScientificFunction(\"gamma\") = true, want false")
+	}
+}
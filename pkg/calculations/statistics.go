@@ -0,0 +1,255 @@
+package calculations
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// DescriptiveStats holds summary statistics for a dataset. Fields that are
+// undefined for the given data (e.g. sample variance of a single value, or
+// skewness of a constant dataset) are nil.
+type DescriptiveStats struct {
+	Count              int
+	Sum                float64
+	Mean               float64
+	Median             float64
+	Modes              []float64
+	PopulationVariance float64
+	SampleVariance     *float64
+	PopulationStdDev   float64
+	SampleStdDev       *float64
+	Min                float64
+	Max                float64
+	Range              float64
+	Q1                 float64
+	Q3                 float64
+	IQR                float64
+	Skewness           *float64
+	Kurtosis           *float64
+}
+
+// CompensatedSum returns the sum of values using Neumaier's variant of Kahan
+// summation, which keeps the rounding error independent of the number of terms
+// and, unlike plain Kahan, stays accurate when a term is larger than the running sum.
+func CompensatedSum(values []float64) float64 {
+	var acc SumAccumulator
+	for _, v := range values {
+		acc.Add(v)
+	}
+	return acc.Sum()
+}
+
+// SumAccumulator is the running state of CompensatedSum, for values that
+// arrive one at a time. The zero value is an empty sum.
+type SumAccumulator struct {
+	sum, compensation float64
+}
+
+// Add adds v to the sum.
+func (a *SumAccumulator) Add(v float64) {
+	t := a.sum + v
+	if math.Abs(a.sum) >= math.Abs(v) {
+		a.compensation += (a.sum - t) + v
+	} else {
+		a.compensation += (v - t) + a.sum
+	}
+	a.sum = t
+}
+
+// Sum returns the compensated sum of the values added so far.
+func (a *SumAccumulator) Sum() float64 {
+	return a.sum + a.compensation
+}
+
+// Describe computes descriptive statistics for values.
+//
+// Mean and central moments are accumulated in a single pass with Welford's
+// online algorithm (extended to third and fourth moments), which avoids the
+// catastrophic cancellation of the naive sum-of-squares formula. The sum is
+// computed separately with compensated summation.
+//
+// Quartiles use linear interpolation between closest ranks (the same method as
+// Excel's PERCENTILE.INC and NumPy's default). Skewness and kurtosis are the
+// population moment coefficients g1 and g2; kurtosis is reported as excess
+// kurtosis (0 for a normal distribution).
+func Describe(values []float64) (*DescriptiveStats, error) {
+	if len(values) == 0 {
+		return nil, fmt.Errorf("dataset must contain at least one value")
+	}
+	for i, v := range values {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return nil, fmt.Errorf("value at index %d must be a valid number", i)
+		}
+	}
+
+	var mean, m2, m3, m4 float64
+	for i, x := range values {
+		n := float64(i + 1)
+		delta := x - mean
+		deltaN := delta / n
+		deltaN2 := deltaN * deltaN
+		term1 := delta * deltaN * float64(i)
+		mean += deltaN
+		m4 += term1*deltaN2*(n*n-3*n+3) + 6*deltaN2*m2 - 4*deltaN*m3
+		m3 += term1*deltaN*(n-2) - 3*deltaN*m2
+		m2 += term1
+	}
+
+	sorted := make([]float64, len(values))
+	copy(sorted, values)
+	sort.Float64s(sorted)
+
+	n := float64(len(values))
+	stats := &DescriptiveStats{
+		Count:              len(values),
+		Sum:                CompensatedSum(values),
+		Mean:               mean,
+		Median:             percentileSorted(sorted, 50),
+		Modes:              modes(sorted),
+		PopulationVariance: m2 / n,
+		PopulationStdDev:   math.Sqrt(m2 / n),
+		Min:                sorted[0],
+		Max:                sorted[len(sorted)-1],
+		Q1:                 percentileSorted(sorted, 25),
+		Q3:                 percentileSorted(sorted, 75),
+	}
+	stats.Range = stats.Max - stats.Min
+	stats.IQR = stats.Q3 - stats.Q1
+
+	if len(values) > 1 {
+		sampleVariance := m2 / (n - 1)
+		sampleStdDev := math.Sqrt(sampleVariance)
+		stats.SampleVariance = &sampleVariance
+		stats.SampleStdDev = &sampleStdDev
+	}
+
+	if m2 > 0 {
+		skewness := math.Sqrt(n) * m3 / math.Pow(m2, 1.5)
+		kurtosis := n*m4/(m2*m2) - 3
+		stats.Skewness = &skewness
+		stats.Kurtosis = &kurtosis
+	}
+
+	if !stats.finite() {
+		return nil, fmt.Errorf("%w: statistics are outside the representable range", ErrDomain)
+	}
+	return stats, nil
+}
+
+// finite reports whether every statistic is a finite number. Values near the
+// float64 limits can overflow the sum, range or moments.
+func (s *DescriptiveStats) finite() bool {
+	values := []float64{s.Sum, s.Mean, s.Median, s.PopulationVariance, s.PopulationStdDev,
+		s.Min, s.Max, s.Range, s.Q1, s.Q3, s.IQR}
+	for _, v := range []*float64{s.SampleVariance, s.SampleStdDev, s.Skewness, s.Kurtosis} {
+		if v != nil {
+			values = append(values, *v)
+		}
+	}
+	for _, v := range values {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return false
+		}
+	}
+	return true
+}
+
+// Percentile returns the p-th percentile (0 <= p <= 100) of values using
+// linear interpolation between closest ranks.
+func Percentile(values []float64, p float64) (float64, error) {
+	results, err := Percentiles(values, []float64{p})
+	if err != nil {
+		return 0, err
+	}
+	return results[0], nil
+}
+
+// Percentiles returns the requested percentiles of values, sorting the data only once.
+func Percentiles(values []float64, ps []float64) ([]float64, error) {
+	if len(values) == 0 {
+		return nil, fmt.Errorf("dataset must contain at least one value")
+	}
+	for _, p := range ps {
+		if math.IsNaN(p) || p < 0 || p > 100 {
+			return nil, fmt.Errorf("percentile must be between 0 and 100, got %v", p)
+		}
+	}
+
+	sorted := make([]float64, len(values))
+	copy(sorted, values)
+	sort.Float64s(sorted)
+
+	results := make([]float64, len(ps))
+	for i, p := range ps {
+		result, err := finite(percentileSorted(sorted, p), "percentile")
+		if err != nil {
+			return nil, err
+		}
+		results[i] = result
+	}
+	return results, nil
+}
+
+// WeightedMean returns sum(w_i * x_i) / sum(w_i). Weights must be
+// non-negative and not all zero.
+func WeightedMean(values, weights []float64) (float64, error) {
+	if len(values) == 0 {
+		return 0, fmt.Errorf("dataset must contain at least one value")
+	}
+	if len(values) != len(weights) {
+		return 0, fmt.Errorf("weights length (%d) must match values length (%d)", len(weights), len(values))
+	}
+
+	products := make([]float64, len(values))
+	for i, w := range weights {
+		if math.IsNaN(w) || math.IsInf(w, 0) || w < 0 {
+			return 0, fmt.Errorf("weight at index %d must be a non-negative number", i)
+		}
+		products[i] = w * values[i]
+	}
+
+	totalWeight := CompensatedSum(weights)
+	if totalWeight == 0 {
+		return 0, fmt.Errorf("weights must not all be zero")
+	}
+
+	return finite(CompensatedSum(products)/totalWeight, "weighted mean")
+}
+
+func percentileSorted(sorted []float64, p float64) float64 {
+	if len(sorted) == 1 {
+		return sorted[0]
+	}
+	rank := p / 100 * float64(len(sorted)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+	if lower == upper {
+		return sorted[lower]
+	}
+	fraction := rank - float64(lower)
+	return sorted[lower] + fraction*(sorted[upper]-sorted[lower])
+}
+
+// modes returns every value that occurs with the highest frequency, in
+// ascending order. A dataset in which every value occurs once has no mode.
+func modes(sorted []float64) []float64 {
+	result := []float64{}
+	best := 1
+	for i := 0; i < len(sorted); {
+		j := i
+		for j < len(sorted) && sorted[j] == sorted[i] {
+			j++
+		}
+		count := j - i
+		switch {
+		case count > best:
+			best = count
+			result = []float64{sorted[i]}
+		case count == best && best > 1:
+			result = append(result, sorted[i])
+		}
+		i = j
+	}
+	return result
+}
@@ -0,0 +1,211 @@
+package calculations
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestDescribe(t *testing.T) {
+	values := []float64{2, 4, 4, 4, 5, 5, 7, 9}
+
+	stats, err := Describe(values)
+	if err != nil {
+		t.Fatalf("Describe() unexpected error: %v", err)
+	}
+
+	checks := []struct {
+		name     string
+		got      float64
+		expected float64
+	}{
+		{"sum", stats.Sum, 40},
+		{"mean", stats.Mean, 5},
+		{"median", stats.Median, 4.5},
+		{"population variance", stats.PopulationVariance, 4},
+		{"population std dev", stats.PopulationStdDev, 2},
+		{"sample variance", *stats.SampleVariance, 32.0 / 7},
+		{"sample std dev", *stats.SampleStdDev, math.Sqrt(32.0 / 7)},
+		{"min", stats.Min, 2},
+		{"max", stats.Max, 9},
+		{"range", stats.Range, 7},
+		{"q1", stats.Q1, 4},
+		{"q3", stats.Q3, 5.5},
+		{"iqr", stats.IQR, 1.5},
+		{"skewness", *stats.Skewness, 0.65625},
+		{"kurtosis", *stats.Kurtosis, -0.21875},
+	}
+
+	for _, c := range checks {
+		if !almostEqual(c.got, c.expected, 1e-9) {
+			t.Errorf("%s = %v, want %v", c.name, c.got, c.expected)
+		}
+	}
+
+	if stats.Count != 8 {
+		t.Errorf("count = %d, want 8", stats.Count)
+	}
+	if len(stats.Modes) != 1 || stats.Modes[0] != 4 {
+		t.Errorf("modes = %v, want [4]", stats.Modes)
+	}
+}
+
+func TestDescribeEdgeCases(t *testing.T) {
+	t.Run("single value", func(t *testing.T) {
+		stats, err := Describe([]float64{3})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if stats.SampleVariance != nil || stats.SampleStdDev != nil {
+			t.Errorf("sample statistics should be undefined for a single value")
+		}
+		if stats.Skewness != nil || stats.Kurtosis != nil {
+			t.Errorf("skewness and kurtosis should be undefined for a single value")
+		}
+		if stats.Median != 3 || stats.Q1 != 3 || stats.Q3 != 3 {
+			t.Errorf("median/quartiles = %v/%v/%v, want 3", stats.Median, stats.Q1, stats.Q3)
+		}
+	})
+
+	t.Run("multimodal", func(t *testing.T) {
+		stats, _ := Describe([]float64{3, 1, 3, 1, 2})
+		if len(stats.Modes) != 2 || stats.Modes[0] != 1 || stats.Modes[1] != 3 {
+			t.Errorf("modes = %v, want [1 3]", stats.Modes)
+		}
+	})
+
+	t.Run("no mode when all values are unique", func(t *testing.T) {
+		stats, _ := Describe([]float64{1, 2, 3})
+		if len(stats.Modes) != 0 {
+			t.Errorf("modes = %v, want []", stats.Modes)
+		}
+	})
+
+	t.Run("large offset keeps variance accurate", func(t *testing.T) {
+		// The naive sum-of-squares formula loses all precision here.
+		stats, _ := Describe([]float64{1e9 + 4, 1e9 + 7, 1e9 + 13, 1e9 + 16})
+		if !almostEqual(*stats.SampleVariance, 30, 1e-6) {
+			t.Errorf("sample variance = %v, want 30", *stats.SampleVariance)
+		}
+	})
+
+	t.Run("empty dataset", func(t *testing.T) {
+		if _, err := Describe(nil); err == nil {
+			t.Error("expected error for empty dataset")
+		}
+	})
+
+	t.Run("NaN value", func(t *testing.T) {
+		if _, err := Describe([]float64{1, math.NaN()}); err == nil {
+			t.Error("expected error for NaN value")
+		}
+	})
+
+	t.Run("overflowing sum", func(t *testing.T) {
+		if _, err := Describe([]float64{1e308, 1e308}); !errors.Is(err, ErrDomain) {
+			t.Errorf("error = %v, want ErrDomain", err)
+		}
+	})
+
+	t.Run("overflowing range", func(t *testing.T) {
+		if _, err := Describe([]float64{-1e308, 1e308}); !errors.Is(err, ErrDomain) {
+			t.Errorf("error = %v, want ErrDomain", err)
+		}
+	})
+}
+
+func TestCompensatedSum(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []float64
+		expected float64
+	}{
+		{"empty", nil, 0},
+		{"simple", []float64{1, 2, 3}, 6},
+		{"cancellation", []float64{1, 1e100, 1, -1e100}, 2},
+		{"tenths", []float64{0.1, 0.1, 0.1, 0.1, 0.1, 0.1, 0.1, 0.1, 0.1, 0.1}, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := CompensatedSum(tt.values); result != tt.expected {
+				t.Errorf("CompensatedSum(%v) = %v, want %v", tt.values, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	values := []float64{15, 20, 35, 40, 50}
+
+	tests := []struct {
+		p         float64
+		expected  float64
+		wantError bool
+	}{
+		{0, 15, false},
+		{25, 20, false},
+		{40, 29, false},
+		{100, 50, false},
+		{-1, 0, true},
+		{101, 0, true},
+	}
+
+	for _, tt := range tests {
+		result, err := Percentile(values, tt.p)
+		if (err != nil) != tt.wantError {
+			t.Errorf("Percentile(%v) error = %v, wantError %v", tt.p, err, tt.wantError)
+			continue
+		}
+		if !tt.wantError && !almostEqual(result, tt.expected, 1e-9) {
+			t.Errorf("Percentile(%v) = %v, want %v", tt.p, result, tt.expected)
+		}
+	}
+}
+
+func TestWeightedMean(t *testing.T) {
+	tests := []struct {
+		name      string
+		values    []float64
+		weights   []float64
+		expected  float64
+		wantError bool
+	}{
+		{"equal weights", []float64{1, 2, 3}, []float64{1, 1, 1}, 2, false},
+		{"skewed weights", []float64{80, 90}, []float64{20, 30}, 86, false},
+		{"zero weight ignored", []float64{10, 1000}, []float64{1, 0}, 10, false},
+		{"length mismatch", []float64{1, 2}, []float64{1}, 0, true},
+		{"negative weight", []float64{1, 2}, []float64{1, -1}, 0, true},
+		{"all zero weights", []float64{1, 2}, []float64{0, 0}, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := WeightedMean(tt.values, tt.weights)
+			if (err != nil) != tt.wantError {
+				t.Fatalf("WeightedMean() error = %v, wantError %v", err, tt.wantError)
+			}
+			if !tt.wantError && !almostEqual(result, tt.expected, 1e-9) {
+				t.Errorf("WeightedMean() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestPercentiles(t *testing.T) {
+	results, err := Percentiles([]float64{50, 15, 40, 20, 35}, []float64{10, 50, 90})
+	if err != nil {
+		t.Fatalf("Percentiles() unexpected error: %v", err)
+	}
+
+	expected := []float64{17, 35, 46}
+	for i := range expected {
+		if !almostEqual(results[i], expected[i], 1e-9) {
+			t.Errorf("Percentiles()[%d] = %v, want %v", i, results[i], expected[i])
+		}
+	}
+
+	if _, err := Percentiles(nil, []float64{50}); err == nil {
+		t.Error("expected error for empty dataset")
+	}
+}
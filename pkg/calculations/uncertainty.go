@@ -0,0 +1,351 @@
+package calculations
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Measurement is a value with a standard uncertainty, as in 12.3 ± 0.2.
+type Measurement struct {
+	Value       float64
+	Uncertainty float64
+}
+
+// Interval is a closed range [Lower, Upper] that contains the true value.
+type Interval struct {
+	Lower float64
+	Upper float64
+}
+
+// uncertaintySeparators are tried in order, so that "+/-" is not read as "+".
+var uncertaintySeparators = []string{"±", "+/-", "+-"}
+
+// ParseMeasurement parses "12.3 ± 0.2", "12.3 +/- 0.2" or "12.3+-0.2". A
+// plain number has zero uncertainty.
+func ParseMeasurement(s string) (Measurement, error) {
+	valueText, uncertaintyText := s, ""
+	for _, sep := range uncertaintySeparators {
+		if v, u, ok := strings.Cut(s, sep); ok {
+			valueText, uncertaintyText = v, u
+			break
+		}
+	}
+
+	value, err := strconv.ParseFloat(strings.TrimSpace(valueText), 64)
+	if err != nil || math.IsInf(value, 0) || math.IsNaN(value) {
+		return Measurement{}, fmt.Errorf("invalid measurement %q (expected forms like 12.3 ± 0.2 or 12.3 +/- 0.2)", s)
+	}
+	m := Measurement{Value: value}
+	if uncertaintyText == "" {
+		return m, nil
+	}
+
+	m.Uncertainty, err = strconv.ParseFloat(strings.TrimSpace(uncertaintyText), 64)
+	if err != nil || math.IsInf(m.Uncertainty, 0) || math.IsNaN(m.Uncertainty) || m.Uncertainty < 0 {
+		return Measurement{}, fmt.Errorf("invalid measurement %q: uncertainty must be a number >= 0", s)
+	}
+	return m, nil
+}
+
+// Bounds returns the interval Value ± Uncertainty, rounded outward.
+func (m Measurement) Bounds() Interval {
+	if m.Uncertainty == 0 {
+		return Interval{Lower: m.Value, Upper: m.Value}
+	}
+	return widen(m.Value-m.Uncertainty, m.Value+m.Uncertainty)
+}
+
+// Measurement returns the midpoint of the interval with its half-width as the
+// uncertainty.
+func (iv Interval) Measurement() Measurement {
+	mid := iv.Lower/2 + iv.Upper/2
+	return Measurement{Value: mid, Uncertainty: math.Max(iv.Upper-mid, mid-iv.Lower)}
+}
+
+// The *Uncertain functions propagate uncertainties to first order, treating
+// the operands as uncorrelated: the result's uncertainty is the root sum of
+// squares of each operand's uncertainty times the partial derivative.
+
+func AddUncertain(a, b Measurement) (Measurement, error) {
+	return finiteMeasurement(Measurement{
+		Value:       a.Value + b.Value,
+		Uncertainty: math.Hypot(a.Uncertainty, b.Uncertainty),
+	}, "add")
+}
+
+func SubtractUncertain(a, b Measurement) (Measurement, error) {
+	return finiteMeasurement(Measurement{
+		Value:       a.Value - b.Value,
+		Uncertainty: math.Hypot(a.Uncertainty, b.Uncertainty),
+	}, "subtract")
+}
+
+func MultiplyUncertain(a, b Measurement) (Measurement, error) {
+	return finiteMeasurement(Measurement{
+		Value:       a.Value * b.Value,
+		Uncertainty: math.Hypot(b.Value*a.Uncertainty, a.Value*b.Uncertainty),
+	}, "multiply")
+}
+
+func DivideUncertain(a, b Measurement) (Measurement, error) {
+	if b.Value == 0 {
+		return Measurement{}, fmt.Errorf("division by zero")
+	}
+	value := a.Value / b.Value
+	return finiteMeasurement(Measurement{
+		Value:       value,
+		Uncertainty: math.Hypot(a.Uncertainty/b.Value, value*b.Uncertainty/b.Value),
+	}, "divide")
+}
+
+// EvaluateUncertain applies a scientific function to measurements. Floor, ceil
+// and round have zero derivative, so their results carry no uncertainty.
+func EvaluateUncertain(fn ScientificFunction, x, y Measurement, mode AngleMode) (Measurement, error) {
+	value, err := EvaluateFunction(fn, x.Value, y.Value, mode)
+	if err != nil {
+		return Measurement{}, err
+	}
+
+	dx, dy := partials(fn, x.Value, y.Value, value, mode)
+	u := math.Hypot(scaledUncertainty(dx, x.Uncertainty), scaledUncertainty(dy, y.Uncertainty))
+	if math.IsNaN(u) || math.IsInf(u, 0) {
+		return Measurement{}, fmt.Errorf("%w: %s has no finite derivative at these operands, so the uncertainty cannot be propagated", ErrDomain, fn)
+	}
+	return Measurement{Value: value, Uncertainty: u}, nil
+}
+
+// scaledUncertainty returns |d|·u, treating an exact operand as contributing
+// nothing even where the derivative is unbounded.
+func scaledUncertainty(d, u float64) float64 {
+	if u == 0 {
+		return 0
+	}
+	return math.Abs(d) * u
+}
+
+// partials returns ∂f/∂x and ∂f/∂y at (x, y), where value = f(x, y). An
+// undefined derivative is NaN or infinite.
+func partials(fn ScientificFunction, x, y, value float64, mode AngleMode) (dx, dy float64) {
+	// Derivatives of trigonometric functions pick up the radians-per-unit
+	// factor of the angle mode.
+	k := ToRadians(1, mode)
+
+	switch fn {
+	case FuncPow:
+		dx = y * math.Pow(x, y-1)
+		switch {
+		case x > 0:
+			dy = value * math.Log(x)
+		case value == 0:
+			dy = 0
+		default:
+			dy = math.NaN()
+		}
+	case FuncRoot:
+		dx = value / (y * x)
+		if x > 0 {
+			dy = -value * math.Log(x) / (y * y)
+		} else {
+			dy = math.NaN()
+		}
+	case FuncLog:
+		dx = 1 / (x * math.Log(y))
+		dy = -value / (y * math.Log(y))
+	case FuncLn:
+		dx = 1 / x
+	case FuncExp:
+		dx = value
+	case FuncSin:
+		dx = math.Cos(ToRadians(x, mode)) * k
+	case FuncCos:
+		dx = -math.Sin(ToRadians(x, mode)) * k
+	case FuncTan:
+		dx = (1 + value*value) * k
+	case FuncAsin:
+		dx = 1 / math.Sqrt(1-x*x) / k
+	case FuncAcos:
+		dx = -1 / math.Sqrt(1-x*x) / k
+	case FuncAtan:
+		dx = 1 / (1 + x*x) / k
+	case FuncSinh:
+		dx = math.Cosh(x)
+	case FuncCosh:
+		dx = math.Sinh(x)
+	case FuncTanh:
+		dx = 1 - value*value
+	}
+	return dx, dy
+}
+
+func finiteMeasurement(m Measurement, op string) (Measurement, error) {
+	if math.IsInf(m.Value, 0) || math.IsNaN(m.Value) || math.IsInf(m.Uncertainty, 0) || math.IsNaN(m.Uncertainty) {
+		return Measurement{}, fmt.Errorf("%w: %s result is outside the representable range", ErrDomain, op)
+	}
+	return m, nil
+}
+
+// The *Intervals functions return bounds that are guaranteed to contain every
+// possible result: each bound is rounded one ulp outward, which covers the
+// rounding error of the operation.
+
+func AddIntervals(a, b Interval) (Interval, error) {
+	return finiteInterval(widen(a.Lower+b.Lower, a.Upper+b.Upper), "add")
+}
+
+func SubtractIntervals(a, b Interval) (Interval, error) {
+	return finiteInterval(widen(a.Lower-b.Upper, a.Upper-b.Lower), "subtract")
+}
+
+func MultiplyIntervals(a, b Interval) (Interval, error) {
+	lo, hi := extremes(a.Lower*b.Lower, a.Lower*b.Upper, a.Upper*b.Lower, a.Upper*b.Upper)
+	return finiteInterval(widen(lo, hi), "multiply")
+}
+
+func DivideIntervals(a, b Interval) (Interval, error) {
+	if b.Lower <= 0 && b.Upper >= 0 {
+		return Interval{}, fmt.Errorf("%w: divisor interval [%v, %v] contains zero", ErrDomain, b.Lower, b.Upper)
+	}
+	lo, hi := extremes(a.Lower/b.Lower, a.Lower/b.Upper, a.Upper/b.Lower, a.Upper/b.Upper)
+	return finiteInterval(widen(lo, hi), "divide")
+}
+
+// EvaluateInterval bounds a scientific function over intervals. The function
+// is evaluated at the endpoints, and at interior extrema where it has any.
+func EvaluateInterval(fn ScientificFunction, x, y Interval, mode AngleMode) (Interval, error) {
+	eval := func(a, b float64) (float64, error) { return EvaluateFunction(fn, a, b, mode) }
+
+	var candidates []float64
+	switch fn {
+	case FuncFloor, FuncCeil, FuncRound:
+		if fn == FuncRound && y.Lower != y.Upper {
+			return Interval{}, fmt.Errorf("%w: round needs an exact number of decimal places", ErrDomain)
+		}
+		// These step functions are monotone and their results exact, so the
+		// bounds need no widening.
+		lo, err := eval(x.Lower, y.Lower)
+		if err != nil {
+			return Interval{}, err
+		}
+		hi, err := eval(x.Upper, y.Lower)
+		if err != nil {
+			return Interval{}, err
+		}
+		return Interval{Lower: lo, Upper: hi}, nil
+	case FuncSin, FuncCos:
+		return trigInterval(fn, x, mode)
+	case FuncTan:
+		period := FromRadians(math.Pi, mode)
+		if containsPeriodic(x, period/2, period) {
+			return Interval{}, fmt.Errorf("%w: tan is unbounded on an interval containing an asymptote", ErrDomain)
+		}
+		candidates = []float64{x.Lower, x.Upper}
+	case FuncCosh:
+		candidates = []float64{x.Lower, x.Upper}
+		if x.Lower < 0 && x.Upper > 0 {
+			candidates = append(candidates, 0)
+		}
+	case FuncPow, FuncRoot, FuncLog:
+		if y.Lower != y.Upper {
+			return binaryInterval(fn, x, y, mode)
+		}
+		// With a fixed second operand these are monotone on each side of
+		// zero; evaluating at zero also reports a pole there as an error.
+		candidates = []float64{x.Lower, x.Upper}
+		if x.Lower < 0 && x.Upper > 0 {
+			candidates = append(candidates, 0)
+		}
+	default:
+		// exp, ln, sinh, tanh, asin, acos and atan are monotone.
+		candidates = []float64{x.Lower, x.Upper}
+	}
+
+	values := make([]float64, len(candidates))
+	for i, c := range candidates {
+		v, err := eval(c, y.Lower)
+		if err != nil {
+			return Interval{}, err
+		}
+		values[i] = v
+	}
+	lo, hi := extremes(values...)
+	return finiteInterval(widen(lo, hi), string(fn))
+}
+
+// binaryInterval bounds pow, root and log when both operands are uncertain by
+// evaluating the corners of the box, over which each is monotone in both
+// arguments once the operands are restricted as below.
+func binaryInterval(fn ScientificFunction, x, y Interval, mode AngleMode) (Interval, error) {
+	if x.Lower <= 0 {
+		return Interval{}, fmt.Errorf("%w: %s with an uncertain second operand needs x > 0", ErrDomain, fn)
+	}
+	if fn == FuncRoot && y.Lower <= 0 && y.Upper >= 0 {
+		return Interval{}, fmt.Errorf("%w: root degree interval contains zero", ErrDomain)
+	}
+	if fn == FuncLog && (y.Lower <= 0 || (y.Lower <= 1 && y.Upper >= 1)) {
+		return Interval{}, fmt.Errorf("%w: logarithm base interval must be positive and exclude 1", ErrDomain)
+	}
+
+	var values []float64
+	for _, a := range []float64{x.Lower, x.Upper} {
+		for _, b := range []float64{y.Lower, y.Upper} {
+			v, err := EvaluateFunction(fn, a, b, mode)
+			if err != nil {
+				return Interval{}, err
+			}
+			values = append(values, v)
+		}
+	}
+	lo, hi := extremes(values...)
+	return finiteInterval(widen(lo, hi), string(fn))
+}
+
+// trigInterval bounds sin or cos, reaching ±1 when the interval contains a
+// peak or trough.
+func trigInterval(fn ScientificFunction, x Interval, mode AngleMode) (Interval, error) {
+	period := FromRadians(2*math.Pi, mode)
+	peak, trough := period/4, -period/4
+	if fn == FuncCos {
+		peak, trough = 0, period/2
+	}
+
+	f := Sin
+	if fn == FuncCos {
+		f = Cos
+	}
+	lo, hi := extremes(f(x.Lower, mode), f(x.Upper, mode))
+	lo, hi = math.Nextafter(lo, -1), math.Nextafter(hi, 1)
+	if containsPeriodic(x, peak, period) {
+		hi = 1
+	}
+	if containsPeriodic(x, trough, period) {
+		lo = -1
+	}
+	return Interval{Lower: math.Max(lo, -1), Upper: math.Min(hi, 1)}, nil
+}
+
+// containsPeriodic reports whether iv contains offset + k·period for some
+// integer k.
+func containsPeriodic(iv Interval, offset, period float64) bool {
+	return math.Ceil((iv.Lower-offset)/period) <= math.Floor((iv.Upper-offset)/period)
+}
+
+func extremes(values ...float64) (lo, hi float64) {
+	lo, hi = values[0], values[0]
+	for _, v := range values[1:] {
+		lo, hi = math.Min(lo, v), math.Max(hi, v)
+	}
+	return lo, hi
+}
+
+func widen(lo, hi float64) Interval {
+	return Interval{Lower: math.Nextafter(lo, math.Inf(-1)), Upper: math.Nextafter(hi, math.Inf(1))}
+}
+
+func finiteInterval(iv Interval, op string) (Interval, error) {
+	if math.IsInf(iv.Lower, 0) || math.IsInf(iv.Upper, 0) || math.IsNaN(iv.Lower) || math.IsNaN(iv.Upper) {
+		return Interval{}, fmt.Errorf("%w: %s result is outside the representable range", ErrDomain, op)
+	}
+	return iv, nil
+}
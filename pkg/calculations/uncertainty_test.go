@@ -0,0 +1,205 @@
+package calculations
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestParseMeasurement(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    Measurement
+		wantErr bool
+	}{
+		{"12.3 ± 0.2", Measurement{12.3, 0.2}, false},
+		{"12.3 +/- 0.2", Measurement{12.3, 0.2}, false},
+		{"-1e3+-5", Measurement{-1000, 5}, false},
+		{" 7 ", Measurement{7, 0}, false},
+		{"", Measurement{}, true},
+		{"12.3 ± -0.2", Measurement{}, true},
+		{"12.3 ± x", Measurement{}, true},
+		{"Inf ± 1", Measurement{}, true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseMeasurement(tt.input)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseMeasurement(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseMeasurement(%q) = %+v, want %+v", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestUncertainArithmetic(t *testing.T) {
+	a, b := Measurement{12.3, 0.2}, Measurement{4, 0.1}
+
+	tests := []struct {
+		name string
+		op   func(a, b Measurement) (Measurement, error)
+		want Measurement
+	}{
+		{"add", AddUncertain, Measurement{16.3, math.Hypot(0.2, 0.1)}},
+		{"subtract", SubtractUncertain, Measurement{8.3, math.Hypot(0.2, 0.1)}},
+		{"multiply", MultiplyUncertain, Measurement{49.2, math.Hypot(0.8, 1.23)}},
+		{"divide", DivideUncertain, Measurement{3.075, 3.075 * math.Hypot(0.2/12.3, 0.1/4)}},
+	}
+
+	for _, tt := range tests {
+		got, err := tt.op(a, b)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.name, err)
+		}
+		if math.Abs(got.Value-tt.want.Value) > 1e-12 || math.Abs(got.Uncertainty-tt.want.Uncertainty) > 1e-12 {
+			t.Errorf("%s = %+v, want %+v", tt.name, got, tt.want)
+		}
+	}
+
+	if _, err := DivideUncertain(a, Measurement{0, 1}); err == nil {
+		t.Error("expected division by zero error")
+	}
+	if _, err := MultiplyUncertain(Measurement{1e200, 1e200}, Measurement{1e200, 0}); !errors.Is(err, ErrDomain) {
+		t.Errorf("expected ErrDomain on overflow, got %v", err)
+	}
+}
+
+func TestEvaluateUncertain(t *testing.T) {
+	tests := []struct {
+		name  string
+		fn    ScientificFunction
+		x, y  Measurement
+		mode  AngleMode
+		value float64
+		u     float64
+	}{
+		{"sin in degrees", FuncSin, Measurement{30, 1}, Measurement{}, AngleDegrees, 0.5, math.Cos(math.Pi/6) * math.Pi / 180},
+		{"ln", FuncLn, Measurement{2, 0.1}, Measurement{}, AngleRadians, math.Ln2, 0.05},
+		{"pow in both operands", FuncPow, Measurement{2, 0.1}, Measurement{3, 0.01}, AngleRadians, 8, math.Hypot(3*4*0.1, 8*math.Ln2*0.01)},
+		{"log base", FuncLog, Measurement{100, 1}, Measurement{10, 0}, AngleRadians, 2, 1 / (100 * math.Ln10)},
+		{"asin in degrees", FuncAsin, Measurement{0, 0.01}, Measurement{}, AngleDegrees, 0, 0.01 * 180 / math.Pi},
+		{"floor has no uncertainty", FuncFloor, Measurement{2.5, 0.3}, Measurement{}, AngleRadians, 2, 0},
+		{"exact operand at unbounded derivative", FuncRoot, Measurement{0, 0}, Measurement{2, 0}, AngleRadians, 0, 0},
+	}
+
+	for _, tt := range tests {
+		got, err := EvaluateUncertain(tt.fn, tt.x, tt.y, tt.mode)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.name, err)
+		}
+		if math.Abs(got.Value-tt.value) > 1e-12 || math.Abs(got.Uncertainty-tt.u) > 1e-12 {
+			t.Errorf("%s = %+v, want %v ± %v", tt.name, got, tt.value, tt.u)
+		}
+	}
+
+	if _, err := EvaluateUncertain(FuncRoot, Measurement{0, 0.1}, Measurement{2, 0}, AngleRadians); !errors.Is(err, ErrDomain) {
+		t.Errorf("expected ErrDomain for sqrt at 0 ± 0.1, got %v", err)
+	}
+	if _, err := EvaluateUncertain(FuncLn, Measurement{-1, 0.1}, Measurement{}, AngleRadians); !errors.Is(err, ErrDomain) {
+		t.Errorf("expected ErrDomain for ln(-1), got %v", err)
+	}
+}
+
+func contains(outer Interval, lo, hi float64) bool {
+	return outer.Lower <= lo && outer.Upper >= hi
+}
+
+// tight reports whether iv is within a few ulps of [lo, hi].
+func tight(iv Interval, lo, hi float64) bool {
+	return contains(iv, lo, hi) && lo-iv.Lower <= 4e-16*math.Max(1, math.Abs(lo)) && iv.Upper-hi <= 4e-16*math.Max(1, math.Abs(hi))
+}
+
+func TestIntervalArithmetic(t *testing.T) {
+	a, b := Interval{1, 2}, Interval{-3, 4}
+
+	tests := []struct {
+		name   string
+		op     func(a, b Interval) (Interval, error)
+		a, b   Interval
+		lo, hi float64
+	}{
+		{"add", AddIntervals, a, b, -2, 6},
+		{"subtract", SubtractIntervals, a, b, -3, 5},
+		{"multiply", MultiplyIntervals, a, b, -6, 8},
+		{"divide", DivideIntervals, a, Interval{4, 8}, 0.125, 0.5},
+		{"rounding is outward", AddIntervals, Interval{0.1, 0.1}, Interval{0.2, 0.2}, 0.1 + 0.2, 0.1 + 0.2},
+	}
+
+	for _, tt := range tests {
+		got, err := tt.op(tt.a, tt.b)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.name, err)
+		}
+		if !tight(got, tt.lo, tt.hi) || got.Lower == got.Upper {
+			t.Errorf("%s = %+v, want just outside [%v, %v]", tt.name, got, tt.lo, tt.hi)
+		}
+	}
+
+	if _, err := DivideIntervals(a, Interval{-1, 1}); !errors.Is(err, ErrDomain) {
+		t.Errorf("expected ErrDomain for a divisor containing zero, got %v", err)
+	}
+}
+
+func TestEvaluateInterval(t *testing.T) {
+	exact := Interval{}
+
+	tests := []struct {
+		name   string
+		fn     ScientificFunction
+		x, y   Interval
+		mode   AngleMode
+		lo, hi float64
+	}{
+		{"exp is increasing", FuncExp, Interval{0, 1}, exact, AngleRadians, 1, math.E},
+		{"acos is decreasing", FuncAcos, Interval{0, 1}, exact, AngleRadians, 0, math.Pi / 2},
+		{"sin reaches its peak", FuncSin, Interval{60, 120}, exact, AngleDegrees, math.Sqrt(3) / 2, 1},
+		{"cos over a full period", FuncCos, Interval{0, 7}, exact, AngleRadians, -1, 1},
+		{"cosh has a minimum at zero", FuncCosh, Interval{-1, 2}, exact, AngleRadians, 1, math.Cosh(2)},
+		{"even power across zero", FuncPow, Interval{-2, 3}, Interval{2, 2}, AngleRadians, 0, 9},
+		{"uncertain exponent", FuncPow, Interval{2, 3}, Interval{-1, 2}, AngleRadians, 1.0 / 3, 9},
+		{"floor is exact", FuncFloor, Interval{1.5, 3.2}, exact, AngleRadians, 1, 3},
+	}
+
+	for _, tt := range tests {
+		got, err := EvaluateInterval(tt.fn, tt.x, tt.y, tt.mode)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.name, err)
+		}
+		if !tight(got, tt.lo, tt.hi) {
+			t.Errorf("%s = %+v, want [%v, %v]", tt.name, got, tt.lo, tt.hi)
+		}
+	}
+
+	errorCases := []struct {
+		name string
+		fn   ScientificFunction
+		x, y Interval
+		mode AngleMode
+	}{
+		{"tan across an asymptote", FuncTan, Interval{80, 100}, exact, AngleDegrees},
+		{"ln across zero", FuncLn, Interval{-1, 2}, exact, AngleRadians},
+		{"negative power across zero", FuncPow, Interval{-1, 1}, Interval{-1, -1}, AngleRadians},
+		{"log base interval containing 1", FuncLog, Interval{2, 3}, Interval{0.5, 2}, AngleRadians},
+		{"round with uncertain places", FuncRound, Interval{1, 2}, Interval{1, 2}, AngleRadians},
+	}
+	for _, tt := range errorCases {
+		if _, err := EvaluateInterval(tt.fn, tt.x, tt.y, tt.mode); !errors.Is(err, ErrDomain) {
+			t.Errorf("%s: expected ErrDomain, got %v", tt.name, err)
+		}
+	}
+}
+
+func TestMeasurementBounds(t *testing.T) {
+	iv := Measurement{10, 0.5}.Bounds()
+	if !tight(iv, 9.5, 10.5) {
+		t.Errorf("Bounds() = %+v", iv)
+	}
+	if m := (Interval{9, 11}).Measurement(); m.Value != 10 || m.Uncertainty != 1 {
+		t.Errorf("Measurement() = %+v", m)
+	}
+	if iv := (Measurement{3, 0}).Bounds(); iv.Lower != 3 || iv.Upper != 3 {
+		t.Errorf("exact Bounds() = %+v", iv)
+	}
+}
@@ -0,0 +1,102 @@
+package calculations
+
+import (
+	"fmt"
+	"strings"
+)
+
+// UnitSystem is a system of measurement a unit belongs to.
+type UnitSystem string
+
+const (
+	SystemMetric      UnitSystem = "metric"
+	SystemImperial    UnitSystem = "imperial"
+	SystemUSCustomary UnitSystem = "us_customary"
+)
+
+// ValidUnitSystems lists the measurement systems units are grouped into.
+func ValidUnitSystems() []UnitSystem {
+	return []UnitSystem{SystemMetric, SystemImperial, SystemUSCustomary}
+}
+
+// irregularPlurals maps word endings to their plural forms.
+var irregularPlurals = map[string]string{
+	"foot":       "feet",
+	"hertz":      "hertz",
+	"horsepower": "horsepower",
+	"torr":       "torr",
+}
+
+// PluralName returns the plural of u's name: Plural when set, otherwise the
+// name with its leading noun phrase pluralized ("miles per hour", "cubic
+// feet", "millimeters of mercury").
+func (u *Unit) PluralName() string {
+	if u.Plural != "" || u.Name == "" {
+		return u.Plural
+	}
+	head, tail := u.Name, ""
+	for _, sep := range []string{" per ", " of "} {
+		if i := strings.Index(head, sep); i >= 0 {
+			head, tail = head[:i], head[i:]+tail
+		}
+	}
+	i := strings.LastIndexByte(head, ' ') + 1
+	return head[:i] + pluralWord(head[i:]) + tail
+}
+
+// pluralWord returns the plural of an English word.
+func pluralWord(word string) string {
+	for singular, plural := range irregularPlurals {
+		if stem, ok := strings.CutSuffix(word, singular); ok {
+			return stem + plural
+		}
+	}
+	switch {
+	case strings.HasSuffix(word, "s"), strings.HasSuffix(word, "x"), strings.HasSuffix(word, "z"),
+		strings.HasSuffix(word, "ch"), strings.HasSuffix(word, "sh"):
+		return word + "es"
+	case strings.HasSuffix(word, "y") && len(word) > 1 && !strings.ContainsRune("aeiou", rune(word[len(word)-2])):
+		return word[:len(word)-1] + "ies"
+	}
+	return word + "s"
+}
+
+// UnitListing is a unit as offered under a unit type. A value v in the unit
+// is Factor*v + Offset in the type's base unit, or Factor/v for an inverse
+// unit.
+type UnitListing struct {
+	Unit   *Unit
+	Factor float64
+	Offset float64
+}
+
+// UnitTypeListing is a unit type with the units offered under it, in
+// registration order.
+type UnitTypeListing struct {
+	Type      UnitType
+	Dimension Dimension
+	Base      *Unit
+	Units     []UnitListing
+}
+
+// ListUnits lists the units offered under unitType, as GetValidUnits does,
+// with their factors relative to the type's base unit.
+func (r *UnitRegistry) ListUnits(unitType UnitType) (UnitTypeListing, error) {
+	info, exists := unitTypeInfo[unitType]
+	if !exists {
+		return UnitTypeListing{}, fmt.Errorf("unsupported unit type: %s", unitType)
+	}
+	base, _ := r.Lookup(info.base)
+
+	listing := UnitTypeListing{Type: unitType, Dimension: info.dimension, Base: base}
+	for _, unit := range r.order {
+		if unit.listedUnder(unitType, info.dimension) {
+			listing.Units = append(listing.Units, UnitListing{
+				Unit:   unit,
+				Factor: unit.Factor / base.Factor,
+				Offset: unit.Offset / base.Factor,
+			})
+		}
+	}
+	return listing, nil
+}
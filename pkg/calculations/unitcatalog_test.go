@@ -0,0 +1,107 @@
+package calculations
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestPluralName(t *testing.T) {
+	r := NewUnitRegistry()
+	tests := map[string]string{
+		"m":       "meters",
+		"ft":      "feet",
+		"ft³":     "cubic feet",
+		"in":      "inches",
+		"d":       "days",
+		"mph":     "miles per hour",
+		"mmHg":    "millimeters of mercury",
+		"L/100km": "liters per 100 kilometers",
+		"F":       "degrees Fahrenheit",
+		"lbf":     "pounds-force",
+		"kWh":     "kilowatt-hours",
+		"MHz":     "megahertz",
+		"hp":      "mechanical horsepower",
+		"Torr":    "torr",
+		"km":      "kilometers",
+		"GHz":     "gigahertz",
+	}
+	for symbol, want := range tests {
+		unit, ok := r.Lookup(symbol)
+		if !ok {
+			t.Errorf("Lookup(%q) found no unit", symbol)
+			continue
+		}
+		if got := unit.PluralName(); got != want {
+			t.Errorf("%s.PluralName() = %q, want %q", symbol, got, want)
+		}
+	}
+
+	// Prefixed units take the plural of their base unit.
+	if unit, ok := r.Lookup("kHz"); !ok || unit.PluralName() != "kilohertz" {
+		t.Errorf("Lookup(kHz) plural = %v", unit)
+	}
+	if unit, ok := r.Lookup("Mm"); !ok || unit.PluralName() != "megameters" {
+		t.Errorf("Lookup(Mm) plural = %v", unit)
+	}
+
+	// Plurals are lookup keys.
+	for _, key := range []string{"degrees Celsius", "miles per hour", "cubic feet", "kilowatt-hours"} {
+		if _, ok := r.Lookup(key); !ok {
+			t.Errorf("Lookup(%q) found no unit", key)
+		}
+	}
+}
+
+func TestListUnits(t *testing.T) {
+	r, err := NewUnitRegistryWith([]UnitDefinition{
+		{Symbol: "hh", Name: "hand", Dimension: "height", Factor: 0.1016, Systems: []UnitSystem{SystemImperial}},
+	})
+	if err != nil {
+		t.Fatalf("NewUnitRegistryWith() unexpected error: %v", err)
+	}
+
+	listing, err := r.ListUnits(UnitTypeHeight)
+	if err != nil {
+		t.Fatalf("ListUnits(height) unexpected error: %v", err)
+	}
+	if listing.Base.Symbol != "m" || listing.Dimension != dim(1) {
+		t.Errorf("ListUnits(height) base = %s %v, want m L", listing.Base.Symbol, listing.Dimension)
+	}
+
+	var symbols []string
+	for _, u := range listing.Units {
+		symbols = append(symbols, u.Unit.Symbol)
+	}
+	if got := strings.Join(symbols, ","); got != "m,cm,mm,in,ft,hh" {
+		t.Errorf("ListUnits(height) = %s, want m,cm,mm,in,ft,hh in registration order", got)
+	}
+	hand := listing.Units[len(listing.Units)-1]
+	if hand.Factor != 0.1016 || hand.Unit.PluralName() != "hands" || len(hand.Unit.Systems) != 1 || hand.Unit.Systems[0] != SystemImperial {
+		t.Errorf("ListUnits(height) hand = %+v %+v", hand, hand.Unit)
+	}
+
+	// Factors are relative to the type's base unit.
+	cooking, _ := r.ListUnits(UnitTypeCooking)
+	for _, u := range cooking.Units {
+		if u.Unit.Symbol == "L" && math.Abs(u.Factor-1000) > 1e-9 {
+			t.Errorf("ListUnits(cooking) L factor = %v, want 1000 (ml)", u.Factor)
+		}
+	}
+	temperature, _ := r.ListUnits(UnitTypeTemperature)
+	for _, u := range temperature.Units {
+		if u.Unit.Symbol == "F" && (math.Abs(u.Factor-5.0/9) > 1e-12 || math.Abs(u.Offset-255.372222) > 1e-6) {
+			t.Errorf("ListUnits(temperature) F = %v·v + %v", u.Factor, u.Offset)
+		}
+	}
+
+	if _, err := r.ListUnits("parsec"); err == nil {
+		t.Error("ListUnits(parsec) expected error")
+	}
+	if _, err := ListUnits("PARSEC"); err == nil || !strings.Contains(err.Error(), "valid types: weight") {
+		t.Errorf("ListUnits(PARSEC) error = %v", err)
+	}
+	if listings := ListAllUnits(); len(listings) != len(ValidUnitTypes()) || listings[0].Type != UnitTypeWeight {
+		t.Errorf("ListAllUnits() = %d listings, want one per unit type", len(listings))
+	}
+}
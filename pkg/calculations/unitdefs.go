@@ -0,0 +1,392 @@
+package calculations
+
+import "math"
+
+// Exact definitions shared by several units.
+const (
+	meterPerInch      = 0.0254
+	meterPerFoot      = 0.3048
+	meterPerMile      = 1609.344
+	kilogramPerPound  = 0.45359237
+	cubicMeterPerGal  = 3.785411784e-3 // US liquid gallon
+	cubicMeterPerIGal = 4.54609e-3     // imperial gallon
+	joulePerBTU       = 1055.05585262  // International Table BTU
+	pascalPerMmHg     = 133.322387415
+)
+
+// builtinUnits returns the units every registry starts with, grouped by unit
+// type. Types lists the unit types a unit is offered under; any unit of the
+// right dimension still converts within a type. Systems lists the measurement
+// systems a unit belongs to: units the imperial and US customary systems
+// share belong to both, and units of neither nor the metric system (nautical
+// miles, months, data sizes) to none.
+func builtinUnits() []Unit {
+	var (
+		length      = dim(1)
+		mass        = dim(0, 1)
+		timeDim     = dim(0, 0, 1)
+		temperature = dim(0, 0, 0, 0, 1)
+		angle       = dim(0, 0, 0, 0, 0, 0, 0, 1)
+		area        = dim(2)
+		volume      = dim(3)
+		speed       = dim(1, 0, -1)
+		frequency   = dim(0, 0, -1)
+		force       = dim(1, 1, -2)
+		energy      = dim(2, 1, -2)
+		power       = dim(2, 1, -3)
+		pressure    = dim(-1, 1, -2)
+		density     = dim(-3, 1)
+		flowRate    = dim(3, 0, -1)
+		fuelEconomy = dim(-2)
+
+		heightAndDistance = []UnitType{UnitTypeHeight, UnitTypeDistance}
+		distance          = []UnitType{UnitTypeDistance}
+		weight            = []UnitType{UnitTypeWeight}
+		volumeOnly        = []UnitType{UnitTypeVolume}
+		kitchen           = []UnitType{UnitTypeVolume, UnitTypeCooking}
+		cooking           = []UnitType{UnitTypeCooking}
+
+		metric      = []UnitSystem{SystemMetric}
+		imperial    = []UnitSystem{SystemImperial}
+		usCustomary = []UnitSystem{SystemUSCustomary}
+		english     = []UnitSystem{SystemImperial, SystemUSCustomary}
+	)
+
+	units := []Unit{
+		{Symbol: "m", Name: "meter", Aliases: []string{"meters", "metre", "metres"},
+			Dimension: length, Factor: 1, Metric: true, Types: heightAndDistance, Systems: metric},
+		{Symbol: "km", Name: "kilometer", Aliases: []string{"kilometers", "kilometre", "kilometres"},
+			Dimension: length, Factor: 1000, Types: distance, Systems: metric},
+		{Symbol: "cm", Name: "centimeter", Aliases: []string{"centimeters", "centimetre", "centimetres"},
+			Dimension: length, Factor: 0.01, Types: heightAndDistance, Systems: metric},
+		{Symbol: "mm", Name: "millimeter", Aliases: []string{"millimeters", "millimetre", "millimetres"},
+			Dimension: length, Factor: 0.001, Types: heightAndDistance, Systems: metric},
+		{Symbol: "in", Name: "inch", Aliases: []string{"inches"},
+			Dimension: length, Factor: meterPerInch, Types: heightAndDistance, Systems: english},
+		{Symbol: "ft", Name: "foot", Aliases: []string{"feet"},
+			Dimension: length, Factor: meterPerFoot, Types: heightAndDistance, Systems: english},
+		{Symbol: "yd", Name: "yard", Aliases: []string{"yards"},
+			Dimension: length, Factor: 0.9144, Types: distance, Systems: english},
+		{Symbol: "mi", Name: "mile", Aliases: []string{"miles"},
+			Dimension: length, Factor: meterPerMile, Types: distance, Systems: english},
+		{Symbol: "nmi", Name: "nautical mile", Aliases: []string{"nautical_mile", "nautical_miles"},
+			Dimension: length, Factor: 1852, Types: distance},
+
+		{Symbol: "kg", Name: "kilogram", Aliases: []string{"kilograms", "kilo", "kilos"},
+			Dimension: mass, Factor: 1, Types: weight, Systems: metric},
+		{Symbol: "g", Name: "gram", Aliases: []string{"grams", "gramme", "grammes"},
+			Dimension: mass, Factor: 0.001, Metric: true, Types: weight, Systems: metric},
+		{Symbol: "mg", Name: "milligram", Aliases: []string{"milligrams"},
+			Dimension: mass, Factor: 1e-6, Types: weight, Systems: metric},
+		{Symbol: "t", Name: "tonne", Aliases: []string{"tonnes", "metric_ton", "metric_tons"},
+			Dimension: mass, Factor: 1000, Metric: true, Types: weight, Systems: metric},
+		{Symbol: "lb", Name: "pound", Aliases: []string{"lbs", "pounds"},
+			Dimension: mass, Factor: kilogramPerPound, Types: weight, Systems: english},
+		{Symbol: "oz", Name: "ounce", Aliases: []string{"ounces"},
+			Dimension: mass, Factor: kilogramPerPound / 16, Types: weight, Systems: english},
+		{Symbol: "st", Name: "stone", Aliases: []string{"stones"},
+			Dimension: mass, Factor: kilogramPerPound * 14, Types: weight, Systems: imperial},
+		{Symbol: "ton", Name: "short ton", Aliases: []string{"tons", "short_ton", "short_tons"},
+			Dimension: mass, Factor: kilogramPerPound * 2000, Types: weight, Systems: usCustomary},
+		{Symbol: "long_ton", Name: "long ton", Aliases: []string{"long_tons"},
+			Dimension: mass, Factor: kilogramPerPound * 2240, Types: weight, Systems: imperial},
+		{Symbol: "ct", Name: "carat", Aliases: []string{"carats"},
+			Dimension: mass, Factor: 0.0002, Types: weight, Systems: metric},
+		{Symbol: "gr", Name: "grain", Aliases: []string{"grains"},
+			Dimension: mass, Factor: kilogramPerPound / 7000, Types: weight, Systems: english},
+
+		{Symbol: "K", Name: "kelvin", Aliases: []string{"kelvins"},
+			Dimension: temperature, Factor: 1, Metric: true, Types: []UnitType{UnitTypeTemperature}, Systems: metric},
+		{Symbol: "C", Name: "degree Celsius", Plural: "degrees Celsius", Aliases: []string{"°C", "degC", "celsius"},
+			Dimension: temperature, Factor: 1, Offset: 273.15, Types: []UnitType{UnitTypeTemperature}, Systems: metric},
+		{Symbol: "F", Name: "degree Fahrenheit", Plural: "degrees Fahrenheit", Aliases: []string{"°F", "degF", "fahrenheit"},
+			Dimension: temperature, Factor: 5.0 / 9, Offset: 273.15 - 32*5.0/9, Types: []UnitType{UnitTypeTemperature}, Systems: english},
+		{Symbol: "R", Name: "degree Rankine", Plural: "degrees Rankine", Aliases: []string{"°R", "degR", "rankine"},
+			Dimension: temperature, Factor: 5.0 / 9, Types: []UnitType{UnitTypeTemperature}, Systems: english},
+
+		{Symbol: "m³", Name: "cubic meter", Aliases: []string{"m3", "cubic_meter", "cubic_meters"},
+			Dimension: volume, Factor: 1, Types: volumeOnly, Systems: metric},
+		{Symbol: "L", Name: "liter", Aliases: []string{"l", "liters", "litre", "litres"},
+			Dimension: volume, Factor: 0.001, Metric: true, Types: kitchen, Systems: metric},
+		{Symbol: "ml", Name: "milliliter", Aliases: []string{"mL", "milliliters", "millilitre", "millilitres"},
+			Dimension: volume, Factor: 1e-6, Types: kitchen, Systems: metric},
+		{Symbol: "cm³", Name: "cubic centimeter", Aliases: []string{"cm3", "cc"},
+			Dimension: volume, Factor: 1e-6, Types: volumeOnly, Systems: metric},
+		{Symbol: "gal", Name: "US gallon", Aliases: []string{"gallon", "gallons", "gal_us"},
+			Dimension: volume, Factor: cubicMeterPerGal, Types: volumeOnly, Systems: usCustomary},
+		{Symbol: "gal_imp", Name: "imperial gallon", Aliases: []string{"gal_uk", "imperial_gallon", "imperial_gallons"},
+			Dimension: volume, Factor: cubicMeterPerIGal, Types: volumeOnly, Systems: imperial},
+		{Symbol: "qt", Name: "US quart", Aliases: []string{"quart", "quarts"},
+			Dimension: volume, Factor: cubicMeterPerGal / 4, Types: kitchen, Systems: usCustomary},
+		{Symbol: "pt", Name: "US pint", Aliases: []string{"pint", "pints"},
+			Dimension: volume, Factor: cubicMeterPerGal / 8, Types: kitchen, Systems: usCustomary},
+		{Symbol: "pt_imp", Name: "imperial pint", Aliases: []string{"pt_uk", "imperial_pint", "imperial_pints"},
+			Dimension: volume, Factor: cubicMeterPerIGal / 8, Types: volumeOnly, Systems: imperial},
+		{Symbol: "fl_oz", Name: "US fluid ounce", Aliases: []string{"fl oz", "floz", "fluid_ounce", "fluid_ounces"},
+			Dimension: volume, Factor: cubicMeterPerGal / 128, Types: kitchen, Systems: usCustomary},
+		{Symbol: "fl_oz_imp", Name: "imperial fluid ounce", Aliases: []string{"fl_oz_uk"},
+			Dimension: volume, Factor: cubicMeterPerIGal / 160, Types: volumeOnly, Systems: imperial},
+		{Symbol: "ft³", Name: "cubic foot", Aliases: []string{"ft3", "cu_ft", "cubic_foot", "cubic_feet"},
+			Dimension: volume, Factor: meterPerFoot * meterPerFoot * meterPerFoot, Types: volumeOnly, Systems: english},
+		{Symbol: "in³", Name: "cubic inch", Aliases: []string{"in3", "cu_in", "cubic_inch", "cubic_inches"},
+			Dimension: volume, Factor: meterPerInch * meterPerInch * meterPerInch, Types: volumeOnly, Systems: english},
+		{Symbol: "bbl", Name: "oil barrel", Aliases: []string{"barrel", "barrels"},
+			Dimension: volume, Factor: cubicMeterPerGal * 42, Types: volumeOnly, Systems: usCustomary},
+
+		{Symbol: "cup", Name: "US cup", Aliases: []string{"cups", "cup_us"},
+			Dimension: volume, Factor: cubicMeterPerGal / 16, Types: cooking, Systems: usCustomary},
+		{Symbol: "cup_metric", Name: "metric cup", Aliases: []string{"metric_cup", "metric_cups"},
+			Dimension: volume, Factor: 250e-6, Types: cooking, Systems: metric},
+		{Symbol: "tbsp", Name: "US tablespoon", Aliases: []string{"tbs", "tablespoon", "tablespoons"},
+			Dimension: volume, Factor: cubicMeterPerGal / 256, Types: cooking, Systems: usCustomary},
+		{Symbol: "tbsp_metric", Name: "metric tablespoon", Aliases: []string{"metric_tablespoon", "metric_tablespoons"},
+			Dimension: volume, Factor: 15e-6, Types: cooking, Systems: metric},
+		{Symbol: "tsp", Name: "US teaspoon", Aliases: []string{"teaspoon", "teaspoons"},
+			Dimension: volume, Factor: cubicMeterPerGal / 768, Types: cooking, Systems: usCustomary},
+		{Symbol: "tsp_metric", Name: "metric teaspoon", Aliases: []string{"metric_teaspoon", "metric_teaspoons"},
+			Dimension: volume, Factor: 5e-6, Types: cooking, Systems: metric},
+
+		{Symbol: "m²", Name: "square meter", Aliases: []string{"m2", "sq_m", "square_meter", "square_meters"},
+			Dimension: area, Factor: 1, Types: []UnitType{UnitTypeArea}, Systems: metric},
+		{Symbol: "km²", Name: "square kilometer", Aliases: []string{"km2", "sq_km", "square_kilometer", "square_kilometers"},
+			Dimension: area, Factor: 1e6, Types: []UnitType{UnitTypeArea}, Systems: metric},
+		{Symbol: "cm²", Name: "square centimeter", Aliases: []string{"cm2", "sq_cm"},
+			Dimension: area, Factor: 1e-4, Types: []UnitType{UnitTypeArea}, Systems: metric},
+		{Symbol: "mm²", Name: "square millimeter", Aliases: []string{"mm2", "sq_mm"},
+			Dimension: area, Factor: 1e-6, Types: []UnitType{UnitTypeArea}, Systems: metric},
+		{Symbol: "ha", Name: "hectare", Aliases: []string{"hectares"},
+			Dimension: area, Factor: 1e4, Types: []UnitType{UnitTypeArea}, Systems: metric},
+		{Symbol: "ac", Name: "acre", Aliases: []string{"acres"},
+			Dimension: area, Factor: meterPerFoot * meterPerFoot * 43560, Types: []UnitType{UnitTypeArea}, Systems: english},
+		{Symbol: "ft²", Name: "square foot", Aliases: []string{"ft2", "sq_ft", "square_foot", "square_feet"},
+			Dimension: area, Factor: meterPerFoot * meterPerFoot, Types: []UnitType{UnitTypeArea}, Systems: english},
+		{Symbol: "in²", Name: "square inch", Aliases: []string{"in2", "sq_in", "square_inch", "square_inches"},
+			Dimension: area, Factor: meterPerInch * meterPerInch, Types: []UnitType{UnitTypeArea}, Systems: english},
+		{Symbol: "yd²", Name: "square yard", Aliases: []string{"yd2", "sq_yd", "square_yard", "square_yards"},
+			Dimension: area, Factor: 0.9144 * 0.9144, Types: []UnitType{UnitTypeArea}, Systems: english},
+		{Symbol: "mi²", Name: "square mile", Aliases: []string{"mi2", "sq_mi", "square_mile", "square_miles"},
+			Dimension: area, Factor: meterPerMile * meterPerMile, Types: []UnitType{UnitTypeArea}, Systems: english},
+
+		{Symbol: "m/s", Name: "meter per second", Aliases: []string{"mps", "meters_per_second"},
+			Dimension: speed, Factor: 1, Types: []UnitType{UnitTypeSpeed}, Systems: metric},
+		{Symbol: "km/h", Name: "kilometer per hour", Aliases: []string{"kph", "kmh", "km/hr", "kilometers_per_hour"},
+			Dimension: speed, Factor: 1000.0 / 3600, Types: []UnitType{UnitTypeSpeed}, Systems: metric},
+		{Symbol: "mph", Name: "mile per hour", Aliases: []string{"mi/h", "miles_per_hour"},
+			Dimension: speed, Factor: meterPerMile / 3600, Types: []UnitType{UnitTypeSpeed}, Systems: english},
+		{Symbol: "kn", Name: "knot", Aliases: []string{"kt", "kts", "knots"},
+			Dimension: speed, Factor: 1852.0 / 3600, Types: []UnitType{UnitTypeSpeed}},
+		{Symbol: "ft/s", Name: "foot per second", Aliases: []string{"fps", "feet_per_second"},
+			Dimension: speed, Factor: meterPerFoot, Types: []UnitType{UnitTypeSpeed}, Systems: english},
+
+		{Symbol: "s", Name: "second", Aliases: []string{"sec", "secs", "seconds"},
+			Dimension: timeDim, Factor: 1, Metric: true, Types: []UnitType{UnitTypeTime}, Systems: metric},
+		{Symbol: "ms", Name: "millisecond", Aliases: []string{"milliseconds"},
+			Dimension: timeDim, Factor: 1e-3, Types: []UnitType{UnitTypeTime}, Systems: metric},
+		{Symbol: "µs", Name: "microsecond", Aliases: []string{"us", "microseconds"},
+			Dimension: timeDim, Factor: 1e-6, Types: []UnitType{UnitTypeTime}, Systems: metric},
+		{Symbol: "ns", Name: "nanosecond", Aliases: []string{"nanoseconds"},
+			Dimension: timeDim, Factor: 1e-9, Types: []UnitType{UnitTypeTime}, Systems: metric},
+		{Symbol: "min", Name: "minute", Aliases: []string{"mins", "minutes"},
+			Dimension: timeDim, Factor: 60, Types: []UnitType{UnitTypeTime}, Systems: metric},
+		{Symbol: "h", Name: "hour", Aliases: []string{"hr", "hrs", "hours"},
+			Dimension: timeDim, Factor: 3600, Types: []UnitType{UnitTypeTime}, Systems: metric},
+		{Symbol: "d", Name: "day", Aliases: []string{"days"},
+			Dimension: timeDim, Factor: 86400, Types: []UnitType{UnitTypeTime}, Systems: metric},
+		{Symbol: "wk", Name: "week", Aliases: []string{"weeks"},
+			Dimension: timeDim, Factor: 7 * 86400, Types: []UnitType{UnitTypeTime}},
+		// Months and years are Gregorian averages (365.2425 days a year).
+		{Symbol: "mo", Name: "month", Aliases: []string{"months"},
+			Dimension: timeDim, Factor: 2629746, Types: []UnitType{UnitTypeTime}},
+		{Symbol: "yr", Name: "year", Aliases: []string{"years"},
+			Dimension: timeDim, Factor: 31556952, Types: []UnitType{UnitTypeTime}},
+
+		{Symbol: "Pa", Name: "pascal", Aliases: []string{"pascals"},
+			Dimension: pressure, Factor: 1, Metric: true, Types: []UnitType{UnitTypePressure}, Systems: metric},
+		{Symbol: "hPa", Name: "hectopascal", Aliases: []string{"hectopascals"},
+			Dimension: pressure, Factor: 100, Types: []UnitType{UnitTypePressure}, Systems: metric},
+		{Symbol: "kPa", Name: "kilopascal", Aliases: []string{"kilopascals"},
+			Dimension: pressure, Factor: 1000, Types: []UnitType{UnitTypePressure}, Systems: metric},
+		{Symbol: "MPa", Name: "megapascal", Aliases: []string{"megapascals"},
+			Dimension: pressure, Factor: 1e6, Types: []UnitType{UnitTypePressure}, Systems: metric},
+		{Symbol: "bar", Name: "bar", Aliases: []string{"bars"},
+			Dimension: pressure, Factor: 1e5, Metric: true, Types: []UnitType{UnitTypePressure}, Systems: metric},
+		{Symbol: "mbar", Name: "millibar", Aliases: []string{"millibars"},
+			Dimension: pressure, Factor: 100, Types: []UnitType{UnitTypePressure}, Systems: metric},
+		{Symbol: "atm", Name: "standard atmosphere", Aliases: []string{"atmosphere", "atmospheres"},
+			Dimension: pressure, Factor: 101325, Types: []UnitType{UnitTypePressure}},
+		{Symbol: "psi", Name: "pound per square inch", Aliases: []string{"lbf/in2", "lbf/in²"},
+			Dimension: pressure, Factor: 4.4482216152605 / (meterPerInch * meterPerInch), Types: []UnitType{UnitTypePressure}, Systems: english},
+		{Symbol: "mmHg", Name: "millimeter of mercury", Aliases: []string{"mm_hg"},
+			Dimension: pressure, Factor: pascalPerMmHg, Types: []UnitType{UnitTypePressure}},
+		{Symbol: "inHg", Name: "inch of mercury", Aliases: []string{"in_hg"},
+			Dimension: pressure, Factor: pascalPerMmHg * 25.4, Types: []UnitType{UnitTypePressure}, Systems: english},
+		{Symbol: "Torr", Name: "torr", Aliases: []string{"torrs"},
+			Dimension: pressure, Factor: 101325.0 / 760, Types: []UnitType{UnitTypePressure}},
+
+		{Symbol: "J", Name: "joule", Aliases: []string{"joules"},
+			Dimension: energy, Factor: 1, Metric: true, Types: []UnitType{UnitTypeEnergy}, Systems: metric},
+		{Symbol: "kJ", Name: "kilojoule", Aliases: []string{"kilojoules"},
+			Dimension: energy, Factor: 1000, Types: []UnitType{UnitTypeEnergy}, Systems: metric},
+		{Symbol: "MJ", Name: "megajoule", Aliases: []string{"megajoules"},
+			Dimension: energy, Factor: 1e6, Types: []UnitType{UnitTypeEnergy}, Systems: metric},
+		{Symbol: "cal", Name: "calorie", Aliases: []string{"calories"},
+			Dimension: energy, Factor: 4.184, Metric: true, Types: []UnitType{UnitTypeEnergy}, Systems: metric},
+		{Symbol: "kcal", Name: "kilocalorie", Aliases: []string{"Cal", "kilocalories"},
+			Dimension: energy, Factor: 4184, Types: []UnitType{UnitTypeEnergy}, Systems: metric},
+		{Symbol: "Wh", Name: "watt-hour", Aliases: []string{"watt_hour", "watt_hours"},
+			Dimension: energy, Factor: 3600, Metric: true, Types: []UnitType{UnitTypeEnergy}, Systems: metric},
+		{Symbol: "kWh", Name: "kilowatt-hour", Aliases: []string{"kilowatt_hour", "kilowatt_hours"},
+			Dimension: energy, Factor: 3.6e6, Types: []UnitType{UnitTypeEnergy}, Systems: metric},
+		{Symbol: "BTU", Name: "British thermal unit", Aliases: []string{"Btu", "btu"},
+			Dimension: energy, Factor: joulePerBTU, Types: []UnitType{UnitTypeEnergy}, Systems: english},
+		{Symbol: "therm", Name: "therm", Aliases: []string{"therms"},
+			Dimension: energy, Factor: joulePerBTU * 1e5, Types: []UnitType{UnitTypeEnergy}, Systems: english},
+		{Symbol: "eV", Name: "electronvolt", Aliases: []string{"electronvolts"},
+			Dimension: energy, Factor: 1.602176634e-19, Metric: true, Types: []UnitType{UnitTypeEnergy}, Systems: metric},
+
+		{Symbol: "W", Name: "watt", Aliases: []string{"watts"},
+			Dimension: power, Factor: 1, Metric: true, Types: []UnitType{UnitTypePower}, Systems: metric},
+		{Symbol: "kW", Name: "kilowatt", Aliases: []string{"kilowatts"},
+			Dimension: power, Factor: 1000, Types: []UnitType{UnitTypePower}, Systems: metric},
+		{Symbol: "MW", Name: "megawatt", Aliases: []string{"megawatts"},
+			Dimension: power, Factor: 1e6, Types: []UnitType{UnitTypePower}, Systems: metric},
+		{Symbol: "hp", Name: "mechanical horsepower", Aliases: []string{"horsepower"},
+			Dimension: power, Factor: 745.69987158227022, Types: []UnitType{UnitTypePower}, Systems: english},
+		{Symbol: "PS", Name: "metric horsepower", Aliases: []string{"metric_horsepower"},
+			Dimension: power, Factor: 735.49875, Types: []UnitType{UnitTypePower}, Systems: metric},
+		{Symbol: "BTU/h", Name: "BTU per hour", Aliases: []string{"Btu/h", "btu/h", "BTU/hr"},
+			Dimension: power, Factor: joulePerBTU / 3600, Types: []UnitType{UnitTypePower}, Systems: english},
+
+		{Symbol: "N", Name: "newton", Aliases: []string{"newtons"},
+			Dimension: force, Factor: 1, Metric: true, Types: []UnitType{UnitTypeForce}, Systems: metric},
+		{Symbol: "kN", Name: "kilonewton", Aliases: []string{"kilonewtons"},
+			Dimension: force, Factor: 1000, Types: []UnitType{UnitTypeForce}, Systems: metric},
+		{Symbol: "lbf", Name: "pound-force", Plural: "pounds-force", Aliases: []string{"pound_force"},
+			Dimension: force, Factor: 4.4482216152605, Types: []UnitType{UnitTypeForce}, Systems: english},
+		{Symbol: "kgf", Name: "kilogram-force", Plural: "kilograms-force", Aliases: []string{"kilogram_force", "kp"},
+			Dimension: force, Factor: 9.80665, Types: []UnitType{UnitTypeForce}, Systems: metric},
+		{Symbol: "dyn", Name: "dyne", Aliases: []string{"dynes"},
+			Dimension: force, Factor: 1e-5, Types: []UnitType{UnitTypeForce}, Systems: metric},
+
+		{Symbol: "rad", Name: "radian", Aliases: []string{"radians"},
+			Dimension: angle, Factor: 1, Metric: true, Types: []UnitType{UnitTypeAngle}, Systems: metric},
+		{Symbol: "deg", Name: "degree", Aliases: []string{"°", "degrees"},
+			Dimension: angle, Factor: math.Pi / 180, Types: []UnitType{UnitTypeAngle}, Systems: metric},
+		{Symbol: "grad", Name: "gradian", Aliases: []string{"gon", "gradians"},
+			Dimension: angle, Factor: math.Pi / 200, Types: []UnitType{UnitTypeAngle}, Systems: metric},
+		{Symbol: "arcmin", Name: "arcminute", Aliases: []string{"′", "arcminutes"},
+			Dimension: angle, Factor: math.Pi / 10800, Types: []UnitType{UnitTypeAngle}, Systems: metric},
+		{Symbol: "arcsec", Name: "arcsecond", Aliases: []string{"″", "arcseconds"},
+			Dimension: angle, Factor: math.Pi / 648000, Types: []UnitType{UnitTypeAngle}, Systems: metric},
+		{Symbol: "turn", Name: "turn", Aliases: []string{"turns", "rev", "revolution", "revolutions"},
+			Dimension: angle, Factor: 2 * math.Pi, Types: []UnitType{UnitTypeAngle}},
+
+		{Symbol: "Hz", Name: "hertz",
+			Dimension: frequency, Factor: 1, Metric: true, Types: []UnitType{UnitTypeFrequency}, Systems: metric},
+		{Symbol: "kHz", Name: "kilohertz",
+			Dimension: frequency, Factor: 1e3, Types: []UnitType{UnitTypeFrequency}, Systems: metric},
+		{Symbol: "MHz", Name: "megahertz",
+			Dimension: frequency, Factor: 1e6, Types: []UnitType{UnitTypeFrequency}, Systems: metric},
+		{Symbol: "GHz", Name: "gigahertz",
+			Dimension: frequency, Factor: 1e9, Types: []UnitType{UnitTypeFrequency}, Systems: metric},
+		{Symbol: "rpm", Name: "revolution per minute", Aliases: []string{"rev/min"},
+			Dimension: frequency, Factor: 1.0 / 60, Types: []UnitType{UnitTypeFrequency}},
+
+		{Symbol: "km/L", Name: "kilometer per liter", Aliases: []string{"km/l", "kmpl"},
+			Dimension: fuelEconomy, Factor: 1e6, Types: []UnitType{UnitTypeFuelEconomy}, Systems: metric},
+		// 1 L/100km is 1e-8 m³/m, the reciprocal of 1e8 m/m³.
+		{Symbol: "L/100km", Name: "liter per 100 kilometers", Aliases: []string{"l/100km", "L/100 km", "l/100 km"},
+			Dimension: fuelEconomy, Factor: 1e8, Inverse: true, Types: []UnitType{UnitTypeFuelEconomy}, Systems: metric},
+		{Symbol: "mpg", Name: "mile per US gallon", Aliases: []string{"mpg_us"},
+			Dimension: fuelEconomy, Factor: meterPerMile / cubicMeterPerGal, Types: []UnitType{UnitTypeFuelEconomy}, Systems: usCustomary},
+		{Symbol: "mpg_imp", Name: "mile per imperial gallon", Aliases: []string{"mpg_uk"},
+			Dimension: fuelEconomy, Factor: meterPerMile / cubicMeterPerIGal, Types: []UnitType{UnitTypeFuelEconomy}, Systems: imperial},
+
+		{Symbol: "kg/m³", Name: "kilogram per cubic meter", Aliases: []string{"kg/m3"},
+			Dimension: density, Factor: 1, Types: []UnitType{UnitTypeDensity}, Systems: metric},
+		{Symbol: "g/cm³", Name: "gram per cubic centimeter", Aliases: []string{"g/cm3", "g/cc", "g/ml", "g/mL"},
+			Dimension: density, Factor: 1000, Types: []UnitType{UnitTypeDensity}, Systems: metric},
+		{Symbol: "g/L", Name: "gram per liter", Aliases: []string{"g/l"},
+			Dimension: density, Factor: 1, Types: []UnitType{UnitTypeDensity}, Systems: metric},
+		{Symbol: "lb/ft³", Name: "pound per cubic foot", Aliases: []string{"lb/ft3"},
+			Dimension: density, Factor: kilogramPerPound / (meterPerFoot * meterPerFoot * meterPerFoot), Types: []UnitType{UnitTypeDensity}, Systems: english},
+		{Symbol: "lb/in³", Name: "pound per cubic inch", Aliases: []string{"lb/in3"},
+			Dimension: density, Factor: kilogramPerPound / (meterPerInch * meterPerInch * meterPerInch), Types: []UnitType{UnitTypeDensity}, Systems: english},
+		{Symbol: "lb/gal", Name: "pound per US gallon", Aliases: []string{"ppg"},
+			Dimension: density, Factor: kilogramPerPound / cubicMeterPerGal, Types: []UnitType{UnitTypeDensity}, Systems: usCustomary},
+
+		{Symbol: "m³/s", Name: "cubic meter per second", Aliases: []string{"m3/s", "cumecs"},
+			Dimension: flowRate, Factor: 1, Types: []UnitType{UnitTypeFlowRate}, Systems: metric},
+		{Symbol: "m³/h", Name: "cubic meter per hour", Aliases: []string{"m3/h"},
+			Dimension: flowRate, Factor: 1.0 / 3600, Types: []UnitType{UnitTypeFlowRate}, Systems: metric},
+		{Symbol: "L/s", Name: "liter per second", Aliases: []string{"l/s"},
+			Dimension: flowRate, Factor: 1e-3, Types: []UnitType{UnitTypeFlowRate}, Systems: metric},
+		{Symbol: "L/min", Name: "liter per minute", Aliases: []string{"l/min", "lpm"},
+			Dimension: flowRate, Factor: 1e-3 / 60, Types: []UnitType{UnitTypeFlowRate}, Systems: metric},
+		{Symbol: "L/h", Name: "liter per hour", Aliases: []string{"l/h", "lph"},
+			Dimension: flowRate, Factor: 1e-3 / 3600, Types: []UnitType{UnitTypeFlowRate}, Systems: metric},
+		{Symbol: "gal/min", Name: "US gallon per minute", Aliases: []string{"gpm"},
+			Dimension: flowRate, Factor: cubicMeterPerGal / 60, Types: []UnitType{UnitTypeFlowRate}, Systems: usCustomary},
+		{Symbol: "gal/h", Name: "US gallon per hour", Aliases: []string{"gph"},
+			Dimension: flowRate, Factor: cubicMeterPerGal / 3600, Types: []UnitType{UnitTypeFlowRate}, Systems: usCustomary},
+		{Symbol: "ft³/s", Name: "cubic foot per second", Aliases: []string{"ft3/s", "cfs"},
+			Dimension: flowRate, Factor: meterPerFoot * meterPerFoot * meterPerFoot, Types: []UnitType{UnitTypeFlowRate}, Systems: english},
+		{Symbol: "ft³/min", Name: "cubic foot per minute", Aliases: []string{"ft3/min", "cfm"},
+			Dimension: flowRate, Factor: meterPerFoot * meterPerFoot * meterPerFoot / 60, Types: []UnitType{UnitTypeFlowRate}, Systems: english},
+
+		// Units outside any unit type, available in expressions.
+		{Symbol: "A", Name: "ampere", Aliases: []string{"amp", "amps", "amperes"},
+			Dimension: dim(0, 0, 0, 1), Factor: 1, Metric: true, Systems: metric},
+		{Symbol: "mol", Name: "mole", Aliases: []string{"moles"},
+			Dimension: dim(0, 0, 0, 0, 0, 1), Factor: 1, Metric: true, Systems: metric},
+		{Symbol: "cd", Name: "candela", Aliases: []string{"candelas"},
+			Dimension: dim(0, 0, 0, 0, 0, 0, 1), Factor: 1, Metric: true, Systems: metric},
+	}
+
+	return append(units, dataUnits()...)
+}
+
+// dataUnits returns the bit and the byte with their SI (decimal) and IEC
+// (binary) multiples: kB is 1000 bytes, KiB 1024. Bit multiples also answer
+// to "kb", "Mb", ..., which keeps "mb" from folding to megabytes.
+func dataUnits() []Unit {
+	information := dim(0, 0, 0, 0, 0, 0, 0, 0, 1)
+	data := []UnitType{UnitTypeData}
+
+	units := []Unit{
+		{Symbol: "B", Name: "byte", Aliases: []string{"bytes", "octet", "octets"},
+			Dimension: information, Factor: 8, Metric: true, Types: data},
+		{Symbol: "bit", Name: "bit", Aliases: []string{"b", "bits"},
+			Dimension: information, Factor: 1, Metric: true, Types: data},
+	}
+
+	decimal := []struct{ symbol, name string }{
+		{"k", "kilo"}, {"M", "mega"}, {"G", "giga"}, {"T", "tera"}, {"P", "peta"},
+		{"E", "exa"}, {"Z", "zetta"}, {"Y", "yotta"}, {"R", "ronna"}, {"Q", "quetta"},
+	}
+	scale := 1.0
+	for _, p := range decimal {
+		scale *= 1000
+		units = append(units,
+			Unit{Symbol: p.symbol + "B", Name: p.name + "byte", Aliases: []string{p.name + "bytes"},
+				Dimension: information, Factor: 8 * scale, Types: data},
+			Unit{Symbol: p.symbol + "bit", Name: p.name + "bit", Aliases: []string{p.symbol + "b", p.name + "bits"},
+				Dimension: information, Factor: scale, Types: data})
+	}
+
+	binary := []struct{ symbol, name string }{
+		{"Ki", "kibi"}, {"Mi", "mebi"}, {"Gi", "gibi"}, {"Ti", "tebi"},
+		{"Pi", "pebi"}, {"Ei", "exbi"}, {"Zi", "zebi"}, {"Yi", "yobi"},
+	}
+	scale = 1.0
+	for _, p := range binary {
+		scale *= 1024
+		units = append(units,
+			Unit{Symbol: p.symbol + "B", Name: p.name + "byte", Aliases: []string{p.name + "bytes"},
+				Dimension: information, Factor: 8 * scale, Types: data},
+			Unit{Symbol: p.symbol + "bit", Name: p.name + "bit", Aliases: []string{p.symbol + "b", p.name + "bits"},
+				Dimension: information, Factor: scale, Types: data})
+	}
+
+	return units
+}
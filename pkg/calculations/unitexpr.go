@@ -0,0 +1,294 @@
+package calculations
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const (
+	// maxUnitExpressionLength bounds the text accepted by parseUnitExpression.
+	maxUnitExpressionLength = 200
+	// maxUnitExponent bounds explicit powers such as "m^3".
+	maxUnitExponent = 12
+)
+
+type unitTokenKind int
+
+const (
+	unitTokenEnd unitTokenKind = iota
+	unitTokenSymbol
+	unitTokenNumber
+	unitTokenMul
+	unitTokenDiv
+	unitTokenPow
+	unitTokenMinus
+	unitTokenSuperscript
+	unitTokenLParen
+	unitTokenRParen
+)
+
+type unitToken struct {
+	kind unitTokenKind
+	text string
+	pos  int
+}
+
+var superscriptValues = map[rune]rune{
+	'⁰': '0', '¹': '1', '²': '2', '³': '3', '⁴': '4',
+	'⁵': '5', '⁶': '6', '⁷': '7', '⁸': '8', '⁹': '9', '⁻': '-',
+}
+
+func isUnitSymbolRune(c rune) bool {
+	return unicode.IsLetter(c) || c == '_' || c == '°' || c == 'µ' || c == 'μ' || c == 'Ω'
+}
+
+func tokenizeUnitExpression(expr string) ([]unitToken, error) {
+	var tokens []unitToken
+	runes := []rune(expr)
+	for i := 0; i < len(runes); {
+		c := runes[i]
+		start := i
+		switch {
+		case unicode.IsSpace(c):
+			i++
+			continue
+		case c == '*' && i+1 < len(runes) && runes[i+1] == '*':
+			tokens = append(tokens, unitToken{unitTokenPow, "**", start})
+			i += 2
+		case c == '*' || c == '·' || c == '⋅' || c == '×':
+			tokens = append(tokens, unitToken{unitTokenMul, string(c), start})
+			i++
+		case c == '/':
+			tokens = append(tokens, unitToken{unitTokenDiv, "/", start})
+			i++
+		case c == '^':
+			tokens = append(tokens, unitToken{unitTokenPow, "^", start})
+			i++
+		case c == '-':
+			tokens = append(tokens, unitToken{unitTokenMinus, "-", start})
+			i++
+		case c == '(':
+			tokens = append(tokens, unitToken{unitTokenLParen, "(", start})
+			i++
+		case c == ')':
+			tokens = append(tokens, unitToken{unitTokenRParen, ")", start})
+			i++
+		case superscriptValues[c] != 0:
+			var b strings.Builder
+			for ; i < len(runes) && superscriptValues[runes[i]] != 0; i++ {
+				b.WriteRune(superscriptValues[runes[i]])
+			}
+			tokens = append(tokens, unitToken{unitTokenSuperscript, b.String(), start})
+		case unicode.IsDigit(c) || c == '.':
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.') {
+				i++
+			}
+			tokens = append(tokens, unitToken{unitTokenNumber, string(runes[start:i]), start})
+		case isUnitSymbolRune(c):
+			// Digits may follow letters: "m3" is m³ unless a unit is named so.
+			for i < len(runes) && (isUnitSymbolRune(runes[i]) || unicode.IsDigit(runes[i])) {
+				i++
+			}
+			tokens = append(tokens, unitToken{unitTokenSymbol, string(runes[start:i]), start})
+		default:
+			return nil, fmt.Errorf("unexpected character %q at position %d", c, start)
+		}
+	}
+	return append(tokens, unitToken{kind: unitTokenEnd, pos: len(runes)}), nil
+}
+
+// unitTerm is a partially evaluated unit expression.
+type unitTerm struct {
+	factor    float64
+	dimension Dimension
+}
+
+func (t unitTerm) mul(other unitTerm) (unitTerm, error) {
+	d, err := t.dimension.Mul(other.dimension)
+	return unitTerm{t.factor * other.factor, d}, err
+}
+
+func (t unitTerm) pow(n int) (unitTerm, error) {
+	d, err := t.dimension.Pow(n)
+	return unitTerm{math.Pow(t.factor, float64(n)), d}, err
+}
+
+type unitParser struct {
+	registry *UnitRegistry
+	tokens   []unitToken
+	pos      int
+}
+
+// parseUnitExpression evaluates a product of units such as "m^2", "kg/m3",
+// "ft*lbf", "kg·m/s²" or "W/(m²·K)". Terms are joined by "*", "·", "×", "/"
+// or juxtaposition ("N m"); division applies to the next term only, so
+// "J/kg/K" is J/(kg·K). Powers are written "^2", "**2", "²" or as digits
+// directly after a symbol ("m3"), and may be negative. Bare numbers scale the
+// unit ("100km"). The offsets of temperature scales do not apply inside an
+// expression, so °C there is a temperature difference, the same as K.
+func (r *UnitRegistry) parseUnitExpression(expr string) (*Unit, error) {
+	trimmed := strings.TrimSpace(expr)
+	if trimmed == "" {
+		return nil, fmt.Errorf("unit is required")
+	}
+	if len(trimmed) > maxUnitExpressionLength {
+		return nil, fmt.Errorf("unit expression exceeds %d characters", maxUnitExpressionLength)
+	}
+
+	tokens, err := tokenizeUnitExpression(trimmed)
+	if err != nil {
+		return nil, fmt.Errorf("invalid unit %q: %w", trimmed, err)
+	}
+	p := &unitParser{registry: r, tokens: tokens}
+	term, err := p.parseProduct()
+	if err == nil && p.peek().kind != unitTokenEnd {
+		err = fmt.Errorf("unexpected %q at position %d", p.peek().text, p.peek().pos)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid unit %q: %w", trimmed, err)
+	}
+	if term.factor <= 0 || math.IsInf(term.factor, 0) || math.IsNaN(term.factor) {
+		return nil, fmt.Errorf("invalid unit %q: scale must be a positive finite number", trimmed)
+	}
+
+	return &Unit{Symbol: trimmed, Dimension: term.dimension, Factor: term.factor}, nil
+}
+
+func (p *unitParser) peek() unitToken {
+	return p.tokens[p.pos]
+}
+
+func (p *unitParser) next() unitToken {
+	t := p.tokens[p.pos]
+	if t.kind != unitTokenEnd {
+		p.pos++
+	}
+	return t
+}
+
+func (p *unitParser) parseProduct() (unitTerm, error) {
+	result, err := p.parsePower()
+	if err != nil {
+		return unitTerm{}, err
+	}
+	for {
+		divide := false
+		switch p.peek().kind {
+		case unitTokenMul:
+			p.next()
+		case unitTokenDiv:
+			p.next()
+			divide = true
+		case unitTokenSymbol, unitTokenNumber, unitTokenLParen:
+			// Juxtaposition multiplies.
+		default:
+			return result, nil
+		}
+
+		term, err := p.parsePower()
+		if err != nil {
+			return unitTerm{}, err
+		}
+		if divide {
+			if term, err = term.pow(-1); err != nil {
+				return unitTerm{}, err
+			}
+		}
+		if result, err = result.mul(term); err != nil {
+			return unitTerm{}, err
+		}
+	}
+}
+
+func (p *unitParser) parsePower() (unitTerm, error) {
+	base, err := p.parsePrimary()
+	if err != nil {
+		return unitTerm{}, err
+	}
+
+	var exponent string
+	switch p.peek().kind {
+	case unitTokenPow:
+		p.next()
+		if p.peek().kind == unitTokenMinus {
+			p.next()
+			exponent = "-"
+		}
+		t := p.next()
+		if t.kind != unitTokenNumber {
+			return unitTerm{}, fmt.Errorf("expected an exponent at position %d", t.pos)
+		}
+		exponent += t.text
+	case unitTokenSuperscript:
+		exponent = p.next().text
+	default:
+		return base, nil
+	}
+
+	n, err := parseUnitExponent(exponent)
+	if err != nil {
+		return unitTerm{}, err
+	}
+	return base.pow(n)
+}
+
+func (p *unitParser) parsePrimary() (unitTerm, error) {
+	t := p.next()
+	switch t.kind {
+	case unitTokenSymbol:
+		return p.parseSymbol(t)
+	case unitTokenNumber:
+		v, err := strconv.ParseFloat(t.text, 64)
+		if err != nil {
+			return unitTerm{}, fmt.Errorf("invalid number %q at position %d", t.text, t.pos)
+		}
+		return unitTerm{factor: v}, nil
+	case unitTokenLParen:
+		inner, err := p.parseProduct()
+		if err != nil {
+			return unitTerm{}, err
+		}
+		if closing := p.next(); closing.kind != unitTokenRParen {
+			return unitTerm{}, fmt.Errorf("expected ')' at position %d", closing.pos)
+		}
+		return inner, nil
+	case unitTokenEnd:
+		return unitTerm{}, fmt.Errorf("expected a unit at the end")
+	default:
+		return unitTerm{}, fmt.Errorf("unexpected %q at position %d", t.text, t.pos)
+	}
+}
+
+// parseSymbol resolves a unit symbol, reading trailing digits as a power when
+// no unit has the full name: "m3" is m³.
+func (p *unitParser) parseSymbol(t unitToken) (unitTerm, error) {
+	if unit, ok := p.registry.Lookup(t.text); ok {
+		return unitTerm{unit.Factor, unit.Dimension}, nil
+	}
+
+	name := strings.TrimRightFunc(t.text, unicode.IsDigit)
+	if name != t.text {
+		if unit, ok := p.registry.Lookup(name); ok {
+			n, err := parseUnitExponent(t.text[len(name):])
+			if err != nil {
+				return unitTerm{}, err
+			}
+			return unitTerm{unit.Factor, unit.Dimension}.pow(n)
+		}
+	}
+	return unitTerm{}, fmt.Errorf("unknown unit %q", t.text)
+}
+
+func parseUnitExponent(text string) (int, error) {
+	n, err := strconv.Atoi(text)
+	if err != nil {
+		return 0, fmt.Errorf("exponent %q must be an integer", text)
+	}
+	if n < -maxUnitExponent || n > maxUnitExponent {
+		return 0, fmt.Errorf("exponent %d is outside [-%d, %d]", n, maxUnitExponent, maxUnitExponent)
+	}
+	return n, nil
+}
@@ -2,6 +2,8 @@ package calculations
 
 import (
 	"fmt"
+	"math"
+	"slices"
 	"sort"
 	"strings"
 )
@@ -14,6 +16,20 @@ const (
 	UnitTypeTemperature UnitType = "temperature"
 	UnitTypeDistance    UnitType = "distance"
 	UnitTypeVolume      UnitType = "volume"
+	UnitTypeArea        UnitType = "area"
+	UnitTypeSpeed       UnitType = "speed"
+	UnitTypeTime        UnitType = "time"
+	UnitTypePressure    UnitType = "pressure"
+	UnitTypeEnergy      UnitType = "energy"
+	UnitTypePower       UnitType = "power"
+	UnitTypeForce       UnitType = "force"
+	UnitTypeAngle       UnitType = "angle"
+	UnitTypeFrequency   UnitType = "frequency"
+	UnitTypeData        UnitType = "data"
+	UnitTypeFuelEconomy UnitType = "fuel_economy"
+	UnitTypeDensity     UnitType = "density"
+	UnitTypeFlowRate    UnitType = "flow_rate"
+	UnitTypeCooking     UnitType = "cooking"
 )
 
 func ValidUnitTypes() []UnitType {
@@ -23,6 +39,20 @@ func ValidUnitTypes() []UnitType {
 		UnitTypeTemperature,
 		UnitTypeDistance,
 		UnitTypeVolume,
+		UnitTypeArea,
+		UnitTypeSpeed,
+		UnitTypeTime,
+		UnitTypePressure,
+		UnitTypeEnergy,
+		UnitTypePower,
+		UnitTypeForce,
+		UnitTypeAngle,
+		UnitTypeFrequency,
+		UnitTypeData,
+		UnitTypeFuelEconomy,
+		UnitTypeDensity,
+		UnitTypeFlowRate,
+		UnitTypeCooking,
 	}
 }
 
@@ -36,6 +66,38 @@ func IsValidUnitType(unitType string) bool {
 	return false
 }
 
+// unitTypeInfo gives the dimension every unit of a type must have and the
+// base unit that GetConversionFactor and ConvertToBaseUnit are relative to.
+var unitTypeInfo = map[UnitType]struct {
+	dimension Dimension
+	base      string
+}{
+	UnitTypeWeight:      {dim(0, 1), string(WeightKilogram)},
+	UnitTypeHeight:      {dim(1), string(HeightMeter)},
+	UnitTypeTemperature: {dim(0, 0, 0, 0, 1), string(TemperatureKelvin)},
+	UnitTypeDistance:    {dim(1), string(DistanceMeter)},
+	UnitTypeVolume:      {dim(3), string(VolumeLiter)},
+	UnitTypeArea:        {dim(2), "m²"},
+	UnitTypeSpeed:       {dim(1, 0, -1), "m/s"},
+	UnitTypeTime:        {dim(0, 0, 1), "s"},
+	UnitTypePressure:    {dim(-1, 1, -2), "Pa"},
+	UnitTypeEnergy:      {dim(2, 1, -2), "J"},
+	UnitTypePower:       {dim(2, 1, -3), "W"},
+	UnitTypeForce:       {dim(1, 1, -2), "N"},
+	UnitTypeAngle:       {dim(0, 0, 0, 0, 0, 0, 0, 1), "rad"},
+	UnitTypeFrequency:   {dim(0, 0, -1), "Hz"},
+	UnitTypeData:        {dim(0, 0, 0, 0, 0, 0, 0, 0, 1), "B"},
+	UnitTypeFuelEconomy: {dim(-2), "km/L"},
+	UnitTypeDensity:     {dim(-3, 1), "kg/m³"},
+	UnitTypeFlowRate:    {dim(3, 0, -1), "m³/s"},
+	UnitTypeCooking:     {dim(3), string(VolumeMilliliter)},
+}
+
+// Dimension returns the dimension shared by every unit of type t.
+func (t UnitType) Dimension() Dimension {
+	return unitTypeInfo[t].dimension
+}
+
 type WeightUnit string
 
 const (
@@ -81,161 +143,257 @@ const (
 	VolumeFluidOunce VolumeUnit = "fl_oz"
 )
 
+// Unit is a unit of measurement. A value v in the unit is Factor*v + Offset
+// in coherent SI units of its dimension; only absolute temperature scales
+// have an offset. Inverse units measure the reciprocal quantity, so v is
+// Factor/v in SI units: L/100km is fuel per distance where km/L and mpg are
+// distance per fuel.
+type Unit struct {
+	Symbol    string
+	Name      string
+	Plural    string // plural of Name where PluralName cannot derive it
+	Aliases   []string
+	Dimension Dimension
+	Factor    float64
+	Offset    float64
+	Inverse   bool
+	Metric    bool         // accepts SI prefixes: Mm, µs, kilowatts
+	Types     []UnitType   // unit types the unit is listed under
+	Systems   []UnitSystem // measurement systems the unit belongs to
+}
+
+// ToSI converts v from u to coherent SI units.
+func (u *Unit) ToSI(v float64) float64 {
+	if u.Inverse {
+		return u.Factor / v
+	}
+	return v*u.Factor + u.Offset
+}
+
+// FromSI converts v from coherent SI units to u.
+func (u *Unit) FromSI(v float64) float64 {
+	if u.Inverse {
+		return u.Factor / v
+	}
+	return (v - u.Offset) / u.Factor
+}
+
+// IsLinear reports whether u is a plain multiple of the SI unit, without an
+// offset or inversion, and so can be scaled and combined with other units.
+func (u *Unit) IsLinear() bool {
+	return u.Offset == 0 && !u.Inverse
+}
+
+// UnitRegistry resolves unit symbols and expressions built from them.
 type UnitRegistry struct {
-	conversions map[UnitType]map[string]float64
+	units  map[string]*Unit // by symbol and alias
+	folded map[string]*Unit // by lower-cased symbol and alias; nil where ambiguous
+	order  []*Unit
 }
 
 func NewUnitRegistry() *UnitRegistry {
-	registry := &UnitRegistry{
-		conversions: make(map[UnitType]map[string]float64),
+	r := &UnitRegistry{
+		units:  make(map[string]*Unit),
+		folded: make(map[string]*Unit),
 	}
 
-	registry.conversions[UnitTypeWeight] = map[string]float64{
-		string(WeightKilogram): 1.0,
-		string(WeightGram):     0.001,
-		string(WeightPound):    0.45359237,
-		string(WeightOunce):    0.0283495231,
+	for _, u := range builtinUnits() {
+		r.mustRegister(u)
 	}
+	return r
+}
 
-	registry.conversions[UnitTypeHeight] = map[string]float64{
-		string(HeightMeter):      1.0,
-		string(HeightCentimeter): 0.01,
-		string(HeightFoot):       0.3048,
-		string(HeightInch):       0.0254,
+// register adds u under its symbol, name, plural name and aliases, which must
+// not already be taken.
+func (r *UnitRegistry) register(u Unit) error {
+	if u.Factor <= 0 {
+		return fmt.Errorf("unit %q must have a positive factor", u.Symbol)
+	}
+	if u.Inverse && u.Offset != 0 {
+		return fmt.Errorf("unit %q cannot be both inverse and offset", u.Symbol)
+	}
+	keys := unitKeys(u)
+	for _, key := range keys {
+		if _, exists := r.units[key]; exists {
+			return fmt.Errorf("unit %q is already defined", key)
+		}
 	}
 
-	registry.conversions[UnitTypeDistance] = map[string]float64{
-		string(DistanceMeter):     1.0,
-		string(DistanceKilometer): 1000.0,
-		string(DistanceMile):      1609.344,
-		string(DistanceFoot):      0.3048,
-		string(DistanceYard):      0.9144,
+	unit := &u
+	r.order = append(r.order, unit)
+	for _, key := range keys {
+		r.units[key] = unit
+		folded := strings.ToLower(key)
+		if existing, exists := r.folded[folded]; exists && existing != unit {
+			r.folded[folded] = nil
+		} else {
+			r.folded[folded] = unit
+		}
 	}
+	return nil
+}
 
-	registry.conversions[UnitTypeVolume] = map[string]float64{
-		string(VolumeLiter):      1.0,
-		string(VolumeMilliliter): 0.001,
-		string(VolumeGallon):     3.78541,
-		string(VolumeFluidOunce): 0.0295735,
-		"l":                      1.0,
+// unitKeys lists the distinct strings u is looked up by.
+func unitKeys(u Unit) []string {
+	keys := []string{u.Symbol}
+	for _, key := range append([]string{u.Name, u.PluralName()}, u.Aliases...) {
+		if key != "" && !slices.Contains(keys, key) {
+			keys = append(keys, key)
+		}
 	}
+	return keys
+}
 
-	registry.conversions[UnitTypeTemperature] = map[string]float64{
-		string(TemperatureCelsius):    1.0,
-		string(TemperatureFahrenheit): 1.0,
-		string(TemperatureKelvin):     1.0,
-		"c":                           1.0,
-		"f":                           1.0,
-		"k":                           1.0,
+func (r *UnitRegistry) mustRegister(u Unit) {
+	if err := r.register(u); err != nil {
+		panic(err)
 	}
+}
 
-	return registry
+// Lookup finds a unit by symbol or alias, then as an SI prefix on a metric
+// unit, falling back to a case-insensitive match when that is unambiguous.
+// Prefixes are matched before case is folded, so "mHz" is a millihertz
+// rather than the registered MHz, and a spelling such as "MM" that folds to
+// one unit but is a case variant of a prefixed other ("Mm") is rejected.
+func (r *UnitRegistry) Lookup(symbol string) (*Unit, bool) {
+	symbol = strings.TrimSpace(symbol)
+	if unit, ok := r.units[symbol]; ok {
+		return unit, true
+	}
+	if unit, ok := r.lookupPrefixed(symbol); ok {
+		return unit, true
+	}
+	unit := r.folded[strings.ToLower(symbol)]
+	if unit == nil || r.prefixedFoldClash(symbol, unit) {
+		return nil, false
+	}
+	return unit, true
 }
 
-func (r *UnitRegistry) GetConversionFactor(unitType UnitType, unit string) (float64, error) {
-	normalized := strings.ToLower(strings.TrimSpace(unit))
+// Resolve returns the unit named by a symbol or by an expression such as
+// "km/h", "kg·m/s²" or "ft*lbf"; see parseUnitExpression.
+func (r *UnitRegistry) Resolve(expr string) (*Unit, error) {
+	if unit, ok := r.Lookup(expr); ok {
+		return unit, nil
+	}
+	return r.parseUnitExpression(expr)
+}
 
-	typeConversions, exists := r.conversions[unitType]
-	if !exists {
-		return 0, fmt.Errorf("unsupported unit type: %s", unitType)
+// ConvertUnits converts value between two units of the same dimension.
+func (r *UnitRegistry) ConvertUnits(value float64, from, to *Unit) (float64, error) {
+	if from.Dimension != to.Dimension {
+		return 0, fmt.Errorf("incompatible units: %s is %s but %s is %s",
+			from.Symbol, from.Dimension.Describe(), to.Symbol, to.Dimension.Describe())
+	}
+	if (from.Inverse || to.Inverse) && !(value > 0) {
+		// A reciprocal of a non-positive amount, such as -5 mpg in L/100km, has no meaning.
+		return 0, fmt.Errorf("%g %s must be positive to convert to %s", value, from.Symbol, to.Symbol)
 	}
+	result := to.FromSI(from.ToSI(value))
+	if math.IsInf(result, 0) || math.IsNaN(result) {
+		return 0, fmt.Errorf("%g %s has no finite value in %s", value, from.Symbol, to.Symbol)
+	}
+	return result, nil
+}
 
-	factor, exists := typeConversions[normalized]
+// resolveTyped resolves unit and checks that it belongs to unitType.
+func (r *UnitRegistry) resolveTyped(unitType UnitType, unit string) (*Unit, error) {
+	info, exists := unitTypeInfo[unitType]
 	if !exists {
-		return 0, fmt.Errorf("unsupported unit '%s' for type '%s'", unit, unitType)
+		return nil, fmt.Errorf("unsupported unit type: %s", unitType)
 	}
+	resolved, err := r.Resolve(unit)
+	if err != nil {
+		return nil, err
+	}
+	if resolved.Dimension != info.dimension {
+		return nil, fmt.Errorf("%s is %s, but type '%s' is %s",
+			resolved.Symbol, resolved.Dimension.Describe(), unitType, info.dimension.Describe())
+	}
+	return resolved, nil
+}
 
-	return factor, nil
+// GetConversionFactor returns the factor that converts unit to the base unit
+// of unitType, e.g. 0.001 for g in weight (base kg) or ml in volume (base L).
+func (r *UnitRegistry) GetConversionFactor(unitType UnitType, unit string) (float64, error) {
+	resolved, err := r.resolveTyped(unitType, unit)
+	if err != nil {
+		return 0, err
+	}
+	base, _ := r.Lookup(unitTypeInfo[unitType].base)
+	if !resolved.IsLinear() || !base.IsLinear() {
+		return 0, fmt.Errorf("%s is not a multiple of %s", resolved.Symbol, base.Symbol)
+	}
+	return resolved.Factor / base.Factor, nil
 }
 
 func (r *UnitRegistry) IsValidUnit(unitType UnitType, unit string) bool {
-	_, err := r.GetConversionFactor(unitType, unit)
+	_, err := r.resolveTyped(unitType, unit)
 	return err == nil
 }
 
+// GetValidUnits lists the symbols of the registered units listed under
+// unitType, or of its dimension for units that name no types.
 func (r *UnitRegistry) GetValidUnits(unitType UnitType) []string {
-	typeConversions, exists := r.conversions[unitType]
+	info, exists := unitTypeInfo[unitType]
 	if !exists {
 		return []string{}
 	}
 
-	units := make([]string, 0, len(typeConversions))
-	for unit := range typeConversions {
-		units = append(units, unit)
+	units := []string{}
+	for _, unit := range r.order {
+		if unit.listedUnder(unitType, info.dimension) {
+			units = append(units, unit.Symbol)
+		}
 	}
 	// Sort for deterministic output in error messages
 	sort.Strings(units)
 	return units
 }
 
+// listedUnder reports whether u is offered under unitType of dimension d:
+// u names the type or, naming none, has its dimension.
+func (u *Unit) listedUnder(unitType UnitType, d Dimension) bool {
+	return slices.Contains(u.Types, unitType) || (len(u.Types) == 0 && u.Dimension == d)
+}
+
 func (r *UnitRegistry) ConvertToBaseUnit(value float64, unitType UnitType, fromUnit string) (float64, error) {
-	factor, err := r.GetConversionFactor(unitType, fromUnit)
-	if err != nil {
-		return 0, err
-	}
-	return value * factor, nil
+	return r.Convert(value, unitType, fromUnit, unitTypeInfo[unitType].base)
 }
 
 func (r *UnitRegistry) ConvertFromBaseUnit(value float64, unitType UnitType, toUnit string) (float64, error) {
-	factor, err := r.GetConversionFactor(unitType, toUnit)
-	if err != nil {
-		return 0, err
-	}
-	return value / factor, nil
+	return r.Convert(value, unitType, unitTypeInfo[unitType].base, toUnit)
 }
 
+// Convert converts value between two units of unitType.
 func (r *UnitRegistry) Convert(value float64, unitType UnitType, fromUnit, toUnit string) (float64, error) {
-	// Special handling for temperature
-	if unitType == UnitTypeTemperature {
-		return convertTemperature(value, fromUnit, toUnit)
-	}
-
-	// Convert to base unit
-	baseValue, err := r.ConvertToBaseUnit(value, unitType, fromUnit)
+	from, err := r.resolveTyped(unitType, fromUnit)
 	if err != nil {
 		return 0, err
 	}
-
-	// Convert from base unit to target unit
-	result, err := r.ConvertFromBaseUnit(baseValue, unitType, toUnit)
+	to, err := r.resolveTyped(unitType, toUnit)
 	if err != nil {
 		return 0, err
 	}
-
-	return result, nil
+	return r.ConvertUnits(value, from, to)
 }
 
-func convertTemperature(value float64, fromUnit, toUnit string) (float64, error) {
-	from := strings.ToUpper(strings.TrimSpace(fromUnit))
-	to := strings.ToUpper(strings.TrimSpace(toUnit))
-
-	if from == to {
-		return value, nil
+// Scale returns the factor that converts a quantity from one unit to another.
+// Temperatures have offsets and cannot be scaled.
+func (r *UnitRegistry) Scale(unitType UnitType, fromUnit, toUnit string) (float64, error) {
+	from, err := r.resolveTyped(unitType, fromUnit)
+	if err != nil {
+		return 0, err
 	}
-
-	var celsius float64
-	switch from {
-	case "C":
-		celsius = value
-	case "F":
-		celsius = (value - 32) * 5 / 9
-	case "K":
-		celsius = value - 273.15
-	default:
-		return 0, fmt.Errorf("unsupported temperature unit: %s", fromUnit)
+	to, err := r.resolveTyped(unitType, toUnit)
+	if err != nil {
+		return 0, err
 	}
-
-	var result float64
-	switch to {
-	case "C":
-		result = celsius
-	case "F":
-		result = celsius*9/5 + 32
-	case "K":
-		result = celsius + 273.15
-	default:
-		return 0, fmt.Errorf("unsupported temperature unit: %s", toUnit)
+	if unitType == UnitTypeTemperature {
+		return 0, fmt.Errorf("temperature conversions are not a scale factor")
 	}
-
-	return result, nil
+	if !from.IsLinear() || !to.IsLinear() {
+		return 0, fmt.Errorf("conversion from %s to %s is not a scale factor", from.Symbol, to.Symbol)
+	}
+	return from.Factor / to.Factor, nil
 }
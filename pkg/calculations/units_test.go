@@ -0,0 +1,249 @@
+package calculations
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestUnitRegistryResolve(t *testing.T) {
+	r := NewUnitRegistry()
+	tests := []struct {
+		expr      string
+		factor    float64
+		dimension Dimension
+		wantErr   string
+	}{
+		{expr: "kg", factor: 1, dimension: dim(0, 1)},
+		{expr: " KG ", factor: 1, dimension: dim(0, 1)},
+		{expr: "l", factor: 0.001, dimension: dim(3)},
+		{expr: "°C", factor: 1, dimension: dim(0, 0, 0, 0, 1)},
+		{expr: "m^2", factor: 1, dimension: dim(2)},
+		{expr: "ft2", factor: 0.3048 * 0.3048, dimension: dim(2)},
+		{expr: "m²", factor: 1, dimension: dim(2)},
+		{expr: "kg/m3", factor: 1, dimension: dim(-3, 1)},
+		{expr: "ft*lbf", factor: 0.3048 * 4.4482216152605, dimension: dim(2, 1, -2)},
+		{expr: "kg·m/s²", factor: 1, dimension: dim(1, 1, -2)},
+		{expr: "km/h", factor: 1000.0 / 3600, dimension: dim(1, 0, -1)},
+		{expr: "N m", factor: 1, dimension: dim(2, 1, -2)},
+		{expr: "J/kg/K", factor: 1, dimension: dim(2, 0, -2, 0, -1)},
+		{expr: "W/(m²·K)", factor: 1, dimension: dim(0, 1, -3, 0, -1)},
+		{expr: "s^-1", factor: 1, dimension: dim(0, 0, -1)},
+		{expr: "m**3", factor: 1, dimension: dim(3)},
+		{expr: "s⁻²", factor: 1, dimension: dim(0, 0, -2)},
+		{expr: "1/min", factor: 1.0 / 60, dimension: dim(0, 0, -1)},
+		{expr: "100km", factor: 100000, dimension: dim(1)},
+		{expr: "", wantErr: "unit is required"},
+		{expr: "xyz", wantErr: `unknown unit "xyz"`},
+		{expr: "m^", wantErr: "expected an exponent"},
+		{expr: "m^1.5", wantErr: "must be an integer"},
+		{expr: "m^13", wantErr: "outside"},
+		{expr: "(m/s", wantErr: "expected ')'"},
+		{expr: "m/s)", wantErr: "unexpected"},
+		{expr: "kg/", wantErr: "expected a unit"},
+		{expr: "m$", wantErr: "unexpected character"},
+		{expr: "0m", wantErr: "positive"},
+		{expr: strings.Repeat("m*", 150) + "m", wantErr: "exceeds"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			unit, err := r.Resolve(tt.expr)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("Resolve(%q) error = %v, want %q", tt.expr, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Resolve(%q) unexpected error: %v", tt.expr, err)
+			}
+			if math.Abs(unit.Factor-tt.factor) > 1e-12*tt.factor || unit.Dimension != tt.dimension {
+				t.Errorf("Resolve(%q) = %v %v, want %v %v", tt.expr, unit.Factor, unit.Dimension, tt.factor, tt.dimension)
+			}
+		})
+	}
+}
+
+func TestConvertCompoundUnits(t *testing.T) {
+	tests := []struct {
+		value    float64
+		from, to string
+		expected float64
+		wantErr  string
+	}{
+		{value: 36, from: "km/h", to: "m/s", expected: 10},
+		{value: 3, from: "kg·m/s²", to: "N", expected: 3},
+		{value: 1, from: "kWh", to: "J", expected: 3.6e6},
+		{value: 1, from: "ft*lbf", to: "J", expected: 1.3558179483314004},
+		{value: 1, from: "g/cm^3", to: "kg/m3", expected: 1000},
+		{value: 1, from: "m^2", to: "ft^2", expected: 10.763910416709722},
+		{value: 100, from: "°C", to: "F", expected: 212},
+		{value: 1, from: "km/h", to: "kg", wantErr: "km/h is speed (L·T⁻¹) but kg is mass (M)"},
+		{value: 1, from: "J", to: "W", wantErr: "J is energy (L²·M·T⁻²) but W is power (L²·M·T⁻³)"},
+		{value: 1, from: "m/s²", to: "m/s^3", wantErr: "m/s² is acceleration (L·T⁻²) but m/s^3 is L·T⁻³"},
+		{value: 0, from: "L/100km", to: "mpg", wantErr: "0 L/100km must be positive to convert to mpg"},
+		{value: 0, from: "km/L", to: "L/100km", wantErr: "0 km/L must be positive to convert to L/100km"},
+		{value: -5, from: "mpg", to: "L/100km", wantErr: "-5 mpg must be positive to convert to L/100km"},
+		{value: 1e-320, from: "km/L", to: "L/100km", wantErr: "1e-320 km/L has no finite value in L/100km"},
+		{value: 1, from: "rad", to: "Hz", wantErr: "rad is angle (rad) but Hz is frequency (T⁻¹)"},
+	}
+
+	r := NewUnitRegistry()
+	for _, tt := range tests {
+		t.Run(tt.from+" to "+tt.to, func(t *testing.T) {
+			from, err := r.Resolve(tt.from)
+			if err != nil {
+				t.Fatalf("Resolve(%q) unexpected error: %v", tt.from, err)
+			}
+			to, err := r.Resolve(tt.to)
+			if err != nil {
+				t.Fatalf("Resolve(%q) unexpected error: %v", tt.to, err)
+			}
+
+			result, err := r.ConvertUnits(tt.value, from, to)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("ConvertUnits() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ConvertUnits() unexpected error: %v", err)
+			}
+			if math.Abs(result-tt.expected) > 1e-9*math.Abs(tt.expected) {
+				t.Errorf("ConvertUnits(%v %s to %s) = %v, want %v", tt.value, tt.from, tt.to, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestUnitRegistryTypes(t *testing.T) {
+	r := NewUnitRegistry()
+
+	if factor, err := r.GetConversionFactor(UnitTypeVolume, "ml"); err != nil || math.Abs(factor-0.001) > 1e-15 {
+		t.Errorf("GetConversionFactor(volume, ml) = %v, %v, want 0.001", factor, err)
+	}
+	if !r.IsValidUnit(UnitTypeDistance, "km/h*h") {
+		t.Error("IsValidUnit(distance, km/h*h) = false, want true")
+	}
+	if r.IsValidUnit(UnitTypeWeight, "km") {
+		t.Error("IsValidUnit(weight, km) = true, want false")
+	}
+	if got := r.GetValidUnits(UnitTypeWeight); strings.Join(got, ",") != "ct,g,gr,kg,lb,long_ton,mg,oz,st,t,ton" {
+		t.Errorf("GetValidUnits(weight) = %v", got)
+	}
+	if _, err := r.Scale(UnitTypeTemperature, "C", "K"); err == nil {
+		t.Error("Scale(temperature) expected error")
+	}
+	if err := r.register(Unit{Symbol: "kg", Dimension: dim(0, 1), Factor: 1}); err == nil {
+		t.Error("register() of a duplicate symbol expected error")
+	}
+	if err := r.register(Unit{Symbol: "zero", Factor: 0}); err == nil {
+		t.Error("register() of a zero factor expected error")
+	}
+}
+
+func TestBuiltinUnitTypes(t *testing.T) {
+	tests := []struct {
+		unitType UnitType
+		value    float64
+		from, to string
+		expected float64
+	}{
+		{UnitTypeDistance, 1, "nmi", "km", 1.852},
+		{UnitTypeWeight, 1, "st", "lb", 14},
+		{UnitTypeWeight, 1, "ton", "kg", 907.18474},
+		{UnitTypeTemperature, 0, "F", "R", 459.67},
+		{UnitTypeTemperature, 300, "kelvin", "celsius", 26.85},
+		{UnitTypeTemperature, -40, "°C", "°F", -40},
+		{UnitTypeVolume, 1, "gal_imp", "L", 4.54609},
+		{UnitTypeVolume, 1, "bbl", "gal", 42},
+		{UnitTypeArea, 1, "ha", "m²", 10000},
+		{UnitTypeArea, 640, "acres", "sq_mi", 1},
+		{UnitTypeArea, 1, "ft2", "in²", 144},
+		{UnitTypeSpeed, 100, "km/h", "mph", 62.13711922373339},
+		{UnitTypeSpeed, 1, "knot", "km/h", 1.852},
+		{UnitTypeTime, 1, "wk", "h", 168},
+		{UnitTypeTime, 1, "yr", "d", 365.2425},
+		{UnitTypeTime, 1500, "ms", "s", 1.5},
+		{UnitTypePressure, 1, "atm", "kPa", 101.325},
+		{UnitTypePressure, 760, "Torr", "mmHg", 759.9998917256112},
+		{UnitTypePressure, 1, "psi", "Pa", 6894.757293168361},
+		{UnitTypeEnergy, 1, "kcal", "kJ", 4.184},
+		{UnitTypeEnergy, 1, "kWh", "BTU", 3412.141633127942},
+		{UnitTypePower, 1, "hp", "W", 745.6998715822702},
+		{UnitTypePower, 1, "kW", "BTU/h", 3412.141633127942},
+		{UnitTypeForce, 1, "kgf", "N", 9.80665},
+		{UnitTypeForce, 1, "N", "dyn", 1e5},
+		{UnitTypeAngle, 180, "deg", "rad", math.Pi},
+		{UnitTypeAngle, 1, "turn", "grad", 400},
+		{UnitTypeAngle, 1, "°", "arcsec", 3600},
+		{UnitTypeFrequency, 3000, "rpm", "Hz", 50},
+		{UnitTypeFrequency, 2.4, "GHz", "MHz", 2400},
+		{UnitTypeData, 1, "KiB", "B", 1024},
+		{UnitTypeData, 1, "kB", "B", 1000},
+		{UnitTypeData, 1, "GiB", "MB", 1073.741824},
+		{UnitTypeData, 100, "Mbit", "MB", 12.5},
+		{UnitTypeData, 1, "byte", "bits", 8},
+		{UnitTypeFuelEconomy, 5, "L/100km", "km/L", 20},
+		{UnitTypeFuelEconomy, 20, "km/L", "L/100km", 5},
+		{UnitTypeFuelEconomy, 10, "L/100km", "mpg", 23.521458333333332},
+		{UnitTypeFuelEconomy, 30, "mpg", "L/100km", 7.840486111111111},
+		{UnitTypeFuelEconomy, 30, "mpg", "mpg_imp", 36.02849776514565},
+		{UnitTypeDensity, 1, "g/cm³", "kg/m3", 1000},
+		{UnitTypeDensity, 1, "g/mL", "lb/ft³", 62.42796057614462},
+		{UnitTypeFlowRate, 1, "m³/s", "L/min", 60000},
+		{UnitTypeFlowRate, 1, "gpm", "L/min", 3.785411784},
+		{UnitTypeFlowRate, 1, "cfs", "cfm", 60},
+		{UnitTypeCooking, 1, "tbsp", "tsp", 3},
+		{UnitTypeCooking, 1, "cup", "tbsp", 16},
+		{UnitTypeCooking, 1, "cup_metric", "ml", 250},
+		{UnitTypeCooking, 2, "cups", "fl_oz", 16},
+	}
+
+	r := NewUnitRegistry()
+	for _, tt := range tests {
+		t.Run(string(tt.unitType)+" "+tt.from+" to "+tt.to, func(t *testing.T) {
+			result, err := r.Convert(tt.value, tt.unitType, tt.from, tt.to)
+			if err != nil {
+				t.Fatalf("Convert() unexpected error: %v", err)
+			}
+			if math.Abs(result-tt.expected) > 1e-9*math.Abs(tt.expected) {
+				t.Errorf("Convert(%v %s to %s) = %v, want %v", tt.value, tt.from, tt.to, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestBuiltinUnitsAreConsistent(t *testing.T) {
+	r := NewUnitRegistry()
+	for _, unitType := range ValidUnitTypes() {
+		info := unitTypeInfo[unitType]
+		base, ok := r.Lookup(info.base)
+		if !ok || base.Dimension != info.dimension {
+			t.Errorf("type %s: base unit %q missing or of the wrong dimension", unitType, info.base)
+		}
+		units := r.GetValidUnits(unitType)
+		if len(units) < 2 {
+			t.Errorf("type %s lists %v", unitType, units)
+		}
+		for _, symbol := range units {
+			if !r.IsValidUnit(unitType, symbol) {
+				t.Errorf("type %s lists %s, which does not belong to it", unitType, symbol)
+			}
+		}
+	}
+
+	// Case-insensitive lookup must not guess between units differing in case.
+	for _, symbol := range []string{"mb", "CAL"} {
+		if unit, ok := r.Lookup(symbol); ok {
+			t.Errorf("Lookup(%q) = %s, want no unit", symbol, unit.Symbol)
+		}
+	}
+	for symbol, want := range map[string]string{"MB": "MB", "Mb": "Mbit", "Cal": "kcal", "gallons": "gal", "US gallon": "gal"} {
+		if unit, ok := r.Lookup(symbol); !ok || unit.Symbol != want {
+			t.Errorf("Lookup(%q) = %v, want %s", symbol, unit, want)
+		}
+	}
+}
@@ -4,9 +4,35 @@ import (
 	"fmt"
 	"math"
 	"strings"
+	"sync/atomic"
 )
 
-var globalRegistry = NewUnitRegistry()
+// globalRegistry holds the registry behind the package-level conversion
+// functions. LoadCustomUnits replaces it while requests are being served, so
+// each call loads it once and uses that registry throughout.
+var globalRegistry atomic.Pointer[UnitRegistry]
+
+func init() {
+	globalRegistry.Store(NewUnitRegistry())
+}
+
+// Default roundings of BMIResult.BMI and ConvertUnit results.
+var (
+	BMIRounding        = DecimalPlaces(2)
+	ConversionRounding = DecimalPlaces(6)
+)
+
+// DistanceScale returns the factor that converts a length in fromUnit to
+// toUnit. Areas scale by its square and volumes by its cube.
+func DistanceScale(fromUnit, toUnit string) (float64, error) {
+	return globalRegistry.Load().Scale(UnitTypeDistance, fromUnit, toUnit)
+}
+
+// ResolveDistanceUnit returns the length unit named by unit, whose Symbol is
+// its canonical spelling: "metres" and "Mm" give m and Mm.
+func ResolveDistanceUnit(unit string) (*Unit, error) {
+	return globalRegistry.Load().resolveTyped(UnitTypeDistance, unit)
+}
 
 type BMICategory string
 
@@ -24,7 +50,9 @@ type BMIResult struct {
 	Category BMICategory
 }
 
-func CalculateBMI(weight float64, weightUnit string, height float64, heightUnit string) (*BMIResult, error) {
+// CalculateBMI computes the body mass index, rounded with rounding (normally
+// BMIRounding). The category is taken from the unrounded value.
+func CalculateBMI(weight float64, weightUnit string, height float64, heightUnit string, rounding Rounding) (*BMIResult, error) {
 	// Validate inputs - check for NaN/Inf first
 	if math.IsNaN(weight) || math.IsInf(weight, 0) {
 		return nil, fmt.Errorf("weight must be a valid number")
@@ -40,13 +68,14 @@ func CalculateBMI(weight float64, weightUnit string, height float64, heightUnit
 	}
 
 	// Convert weight to kg
-	weightKg, err := globalRegistry.ConvertToBaseUnit(weight, UnitTypeWeight, weightUnit)
+	registry := globalRegistry.Load()
+	weightKg, err := registry.ConvertToBaseUnit(weight, UnitTypeWeight, weightUnit)
 	if err != nil {
 		return nil, fmt.Errorf("invalid weight unit: %w", err)
 	}
 
 	// Convert height to m
-	heightM, err := globalRegistry.ConvertToBaseUnit(height, UnitTypeHeight, heightUnit)
+	heightM, err := registry.ConvertToBaseUnit(height, UnitTypeHeight, heightUnit)
 	if err != nil {
 		return nil, fmt.Errorf("invalid height unit: %w", err)
 	}
@@ -58,7 +87,7 @@ func CalculateBMI(weight float64, weightUnit string, height float64, heightUnit
 	category := categorizeBMI(bmi)
 
 	// Round BMI for display
-	roundedBMI := math.Round(bmi*100) / 100
+	roundedBMI := rounding.Round(bmi)
 
 	return &BMIResult{
 		BMI:      roundedBMI,
@@ -83,37 +112,128 @@ func categorizeBMI(bmi float64) BMICategory {
 	}
 }
 
-// ConvertUnit performs unit conversion for various unit types
-// Supports: weight, height, temperature, distance, volume
-func ConvertUnit(value float64, fromUnit, toUnit string, unitType string) (float64, error) {
+// ResolveUnit returns the unit named by a symbol or by an expression such as
+// "km/h" or "kg·m/s²".
+func ResolveUnit(expr string) (*Unit, error) {
+	return globalRegistry.Load().Resolve(expr)
+}
+
+// ConvertUnit converts value between units of the same dimension. The units
+// may be expressions such as "km/h" or "kg/m3". unitType is optional; when
+// given, both units must belong to it.
+// The result is rounded with rounding, normally ConversionRounding.
+func ConvertUnit(value float64, fromUnit, toUnit string, unitType string, rounding Rounding) (float64, error) {
 	if math.IsNaN(value) || math.IsInf(value, 0) {
 		return 0, fmt.Errorf("value must be a valid number")
 	}
 
-	// Normalize and validate unit type
-	if !IsValidUnitType(unitType) {
-		return 0, fmt.Errorf("invalid unit type: %s (valid types: weight, height, temperature, distance, volume)", unitType)
+	registry := globalRegistry.Load()
+	from, err := registry.resolveConversionUnit(fromUnit, unitType, "source")
+	if err != nil {
+		return 0, err
+	}
+	to, err := registry.resolveConversionUnit(toUnit, unitType, "target")
+	if err != nil {
+		return 0, err
 	}
 
-	// Use the normalized version for UnitType conversion
-	normalizedUnitType := strings.ToLower(strings.TrimSpace(unitType))
-	ut := UnitType(normalizedUnitType)
+	result, err := registry.ConvertUnits(value, from, to)
+	if err != nil {
+		return 0, err
+	}
 
-	if !globalRegistry.IsValidUnit(ut, fromUnit) {
-		validUnits := globalRegistry.GetValidUnits(ut)
-		return 0, fmt.Errorf("invalid source unit '%s' for type '%s' (valid units: %v)", fromUnit, unitType, validUnits)
+	return rounding.Round(result), nil
+}
+
+// ConvertUnitAuto converts value to the SI-prefixed metric unit that reads
+// best, as for a target of AutoUnit: 1500 m is 1.5 km and 0.002 s is 2 ms.
+// It returns the result, rounded with rounding, and the chosen unit symbol.
+func ConvertUnitAuto(value float64, fromUnit string, unitType string, rounding Rounding) (float64, string, error) {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return 0, "", fmt.Errorf("value must be a valid number")
 	}
-	if !globalRegistry.IsValidUnit(ut, toUnit) {
-		validUnits := globalRegistry.GetValidUnits(ut)
-		return 0, fmt.Errorf("invalid target unit '%s' for type '%s' (valid units: %v)", toUnit, unitType, validUnits)
+
+	registry := globalRegistry.Load()
+	from, err := registry.resolveConversionUnit(fromUnit, unitType, "source")
+	if err != nil {
+		return 0, "", err
 	}
 
-	result, err := globalRegistry.Convert(value, ut, fromUnit, toUnit)
+	result, to, err := registry.ConvertAuto(value, from)
 	if err != nil {
-		return 0, fmt.Errorf("conversion failed: %w", err)
+		return 0, "", err
+	}
+
+	return rounding.Round(result), to.Symbol, nil
+}
+
+// ParseQuantity parses text such as "5 ft 10 in", "3.5 lbs", "72°F" or
+// "1,5 km" into a value and unit; see UnitRegistry.ParseQuantity.
+func ParseQuantity(input string, decimalSeparator rune) (Quantity, error) {
+	return globalRegistry.Load().ParseQuantity(input, decimalSeparator)
+}
+
+// FormatCompound writes value in unit as a compound quantity such as
+// "5 ft 10 in" or "1 m 78 cm". It reports false for units that are not
+// written that way.
+func FormatCompound(value float64, unit string, rounding Rounding) (string, bool) {
+	registry := globalRegistry.Load()
+	resolved, ok := registry.Lookup(unit)
+	if !ok {
+		return "", false
+	}
+	return registry.FormatCompound(value, resolved, rounding)
+}
+
+// ListUnits lists the units offered under unitType.
+func ListUnits(unitType string) (UnitTypeListing, error) {
+	if !IsValidUnitType(unitType) {
+		return UnitTypeListing{}, fmt.Errorf("invalid unit type: %s (valid types: %s)", unitType, joinUnitTypes(ValidUnitTypes()))
 	}
+	return globalRegistry.Load().ListUnits(UnitType(strings.ToLower(strings.TrimSpace(unitType))))
+}
 
-	result = math.Round(result*1000000) / 1000000
+// ListAllUnits lists the units offered under every unit type, in the order
+// of ValidUnitTypes.
+func ListAllUnits() []UnitTypeListing {
+	registry := globalRegistry.Load()
+	types := ValidUnitTypes()
+	listings := make([]UnitTypeListing, len(types))
+	for i, unitType := range types {
+		listings[i], _ = registry.ListUnits(unitType)
+	}
+	return listings
+}
 
-	return result, nil
+// resolveConversionUnit resolves the source or target unit of a conversion,
+// checking it against unitType when one is given.
+func (r *UnitRegistry) resolveConversionUnit(unit, unitType, role string) (*Unit, error) {
+	if strings.TrimSpace(unitType) == "" {
+		resolved, err := r.Resolve(unit)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s unit '%s': %w", role, unit, err)
+		}
+		return resolved, nil
+	}
+
+	// Normalize and validate unit type
+	if !IsValidUnitType(unitType) {
+		return nil, fmt.Errorf("invalid unit type: %s (valid types: %s)", unitType, joinUnitTypes(ValidUnitTypes()))
+	}
+	ut := UnitType(strings.ToLower(strings.TrimSpace(unitType)))
+
+	resolved, err := r.resolveTyped(ut, unit)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s unit '%s' for type '%s' (valid units: %v): %w",
+			role, unit, unitType, r.GetValidUnits(ut), err)
+	}
+	return resolved, nil
+}
+
+func joinUnitTypes(types []UnitType) string {
+	names := make([]string, len(types))
+	for i, t := range types {
+		names[i] = string(t)
+	}
+	return strings.Join(names, ", ")
 }
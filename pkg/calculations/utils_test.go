@@ -164,7 +164,7 @@ func TestCalculateBMI(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result, err := CalculateBMI(tt.weight, tt.weightUnit, tt.height, tt.heightUnit)
+			result, err := CalculateBMI(tt.weight, tt.weightUnit, tt.height, tt.heightUnit, BMIRounding)
 
 			if tt.expectError {
 				if err == nil {
@@ -434,7 +434,7 @@ func TestConvertUnit(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result, err := ConvertUnit(tt.value, tt.fromUnit, tt.toUnit, tt.unitType)
+			result, err := ConvertUnit(tt.value, tt.fromUnit, tt.toUnit, tt.unitType, ConversionRounding)
 
 			if tt.expectError {
 				if err == nil {
@@ -455,3 +455,17 @@ func TestConvertUnit(t *testing.T) {
 		})
 	}
 }
+
+func TestDistanceScale(t *testing.T) {
+	scale, err := DistanceScale("km", "m")
+	if err != nil || scale != 1000 {
+		t.Errorf("DistanceScale(km, m) = %v, %v, want 1000", scale, err)
+	}
+	scale, err = DistanceScale("ft", "yd")
+	if err != nil || math.Abs(scale-1.0/3) > 1e-12 {
+		t.Errorf("DistanceScale(ft, yd) = %v, %v, want 1/3", scale, err)
+	}
+	if _, err := DistanceScale("kg", "m"); err == nil {
+		t.Error("DistanceScale() expected error for a weight unit")
+	}
+}